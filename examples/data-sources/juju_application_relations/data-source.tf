@@ -0,0 +1,17 @@
+data "juju_application_relations" "database" {
+  model            = juju_model.development.name
+  application_name = juju_application.database.name
+}
+
+# Refuse to scale the database down while other applications rely on it.
+resource "terraform_data" "scale_down" {
+  lifecycle {
+    precondition {
+      condition = length([
+        for relation in data.juju_application_relations.database.relations : relation
+        if relation.role != "peer"
+      ]) == 0
+      error_message = "The database still backs other applications."
+    }
+  }
+}
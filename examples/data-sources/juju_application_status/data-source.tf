@@ -0,0 +1,13 @@
+data "juju_application_status" "this" {
+  model            = juju_model.development.name
+  application_name = juju_application.website.name
+}
+
+# Point a DNS record at the public address of each unit.
+resource "aws_route53_record" "website" {
+  zone_id = var.zone_id
+  name    = "www.example.com"
+  type    = "A"
+  ttl     = 300
+  records = [for unit in data.juju_application_status.this.units : unit.public_address if unit.public_address != ""]
+}
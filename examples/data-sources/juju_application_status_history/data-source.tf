@@ -0,0 +1,20 @@
+data "juju_application_status_history" "this" {
+  model            = juju_model.development.name
+  application_name = juju_application.database.name
+  unit             = "${juju_application.database.name}/0"
+  kind             = "workload"
+  size             = 10
+}
+
+# Only proceed once the workload has not changed status for 30 minutes.
+resource "terraform_data" "promotion" {
+  lifecycle {
+    precondition {
+      condition = (
+        data.juju_application_status_history.this.entries[0].status == "active" &&
+        timecmp(timeadd(data.juju_application_status_history.this.entries[0].since, "30m"), plantimestamp()) <= 0
+      )
+      error_message = "The database has not been active for 30 minutes."
+    }
+  }
+}
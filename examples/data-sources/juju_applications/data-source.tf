@@ -0,0 +1,19 @@
+data "juju_applications" "postgresql" {
+  model      = juju_model.development.name
+  charm_name = "postgresql"
+}
+
+# Relate every PostgreSQL application of the model to the logging agent.
+resource "juju_integration" "logging" {
+  for_each = toset(data.juju_applications.postgresql.names)
+
+  model = juju_model.development.name
+
+  application {
+    name = each.value
+  }
+
+  application {
+    name = juju_application.grafana_agent.name
+  }
+}
@@ -0,0 +1,14 @@
+data "juju_cloud_regions" "aws" {
+  cloud = "aws"
+}
+
+resource "juju_model" "this" {
+  for_each = toset(data.juju_cloud_regions.aws.names)
+
+  name = "app-${each.value}"
+
+  cloud {
+    name   = "aws"
+    region = each.value
+  }
+}
@@ -0,0 +1,13 @@
+data "juju_jaas_controllers" "all" {}
+
+locals {
+  # The available controllers running in eu-west-1.
+  eu_west_controllers = [
+    for controller in data.juju_jaas_controllers.all.controllers : controller.name
+    if controller.status == "available" && controller.cloud_region == "eu-west-1"
+  ]
+}
+
+output "eu_west_controllers" {
+  value = local.eu_west_controllers
+}
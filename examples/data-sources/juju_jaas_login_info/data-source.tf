@@ -0,0 +1,8 @@
+data "juju_jaas_login_info" "current" {}
+
+check "expected_identity" {
+  assert {
+    condition     = data.juju_jaas_login_info.current.identity == "deployer@serviceaccount"
+    error_message = "Terraform is not running as the deployer service account."
+  }
+}
@@ -0,0 +1,9 @@
+data "juju_jaas_role" "admins" {
+  name = "admins"
+}
+
+resource "juju_jaas_access_model" "development" {
+  model_uuid = juju_model.development.uuid
+  access     = "administrator"
+  roles      = [data.juju_jaas_role.admins.uuid]
+}
@@ -0,0 +1,9 @@
+data "juju_model_config" "this" {
+  name = "development"
+}
+
+# Reuse the proxy settings of the model, whether they are set on the
+# model or inherited from the controller.
+locals {
+  http_proxy = data.juju_model_config.this.config["juju-http-proxy"]
+}
@@ -0,0 +1,20 @@
+data "juju_model_credential_validity" "this" {
+  model = "development"
+}
+
+# Stop the deployment when the controller can no longer use the credential
+# of the model, e.g. after the cloud keys were rotated.
+resource "juju_application" "this" {
+  model = data.juju_model_credential_validity.this.model
+
+  charm {
+    name = "postgresql"
+  }
+
+  lifecycle {
+    precondition {
+      condition     = data.juju_model_credential_validity.this.valid
+      error_message = join("\n", data.juju_model_credential_validity.this.errors)
+    }
+  }
+}
@@ -1,3 +1,29 @@
 data "juju_offer" "this" {
   url = "admin/development.mysql"
 }
+
+# Integrate with the offer through its endpoint of the "mysql"
+# interface, without hardcoding the name of the endpoint.
+locals {
+  mysql_endpoint = one([for e in data.juju_offer.this.endpoints : e.name if e.interface == "mysql"])
+}
+
+resource "juju_integration" "wordpress_mysql" {
+  model = juju_model.development.name
+
+  application {
+    name     = juju_application.wordpress.name
+    endpoint = "db"
+  }
+
+  application {
+    offer_url = data.juju_offer.this.url
+  }
+
+  lifecycle {
+    precondition {
+      condition     = local.mysql_endpoint != null
+      error_message = "The offer has no endpoint of the mysql interface."
+    }
+  }
+}
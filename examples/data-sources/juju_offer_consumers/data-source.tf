@@ -0,0 +1,13 @@
+data "juju_offer_consumers" "this" {
+  offer_url = juju_offer.mysql.url
+}
+
+# Fail the plan when the offer is consumed by a user who should not.
+resource "terraform_data" "audit" {
+  lifecycle {
+    precondition {
+      condition     = alltrue([for c in data.juju_offer_consumers.this.consumers : contains(["admin", "alice"], c.username)])
+      error_message = "The offer is consumed by an unexpected user."
+    }
+  }
+}
@@ -0,0 +1,5 @@
+# Compare user names regardless of the form they are given in,
+# e.g. "bob@local" and "bob" are the same local user.
+locals {
+  admins = toset([for user in var.admins : provider::juju::canonical_user_name(user)])
+}
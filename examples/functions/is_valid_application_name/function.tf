@@ -0,0 +1,8 @@
+variable "application_name" {
+  type = string
+
+  validation {
+    condition     = provider::juju::is_valid_application_name(var.application_name)
+    error_message = "The application name is not a valid Juju application name."
+  }
+}
@@ -0,0 +1,8 @@
+variable "model_name" {
+  type = string
+
+  validation {
+    condition     = provider::juju::is_valid_model_name(var.model_name)
+    error_message = "The model name is not a valid Juju model name."
+  }
+}
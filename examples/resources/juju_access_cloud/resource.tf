@@ -0,0 +1,5 @@
+resource "juju_access_cloud" "this" {
+  cloud  = "aws"
+  access = "add-model"
+  users  = [juju_user.dev.name, juju_user.qa.name]
+}
@@ -0,0 +1,3 @@
+# Access Controllers can be imported using the access
+# and comma separated list of users
+$ terraform import juju_access_controller.this login:user-one,user-two
@@ -0,0 +1,4 @@
+resource "juju_access_controller" "this" {
+  access = "login"
+  users  = [juju_user.dev.name, juju_user.qa.name]
+}
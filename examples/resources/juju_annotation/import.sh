@@ -0,0 +1,3 @@
+# Annotations can be imported by using the model name and the tag of the
+# annotated entity, all the annotations of the entity are then managed
+$ terraform import juju_annotation.database development:application-database
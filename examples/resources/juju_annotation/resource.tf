@@ -0,0 +1,21 @@
+resource "juju_annotation" "database" {
+  model  = juju_model.development.name
+  entity = "application-${juju_application.database.name}"
+  annotations = {
+    owner = "data-team"
+    tier  = "backend"
+  }
+}
+
+# Annotate the model itself using its UUID.
+data "juju_model" "development" {
+  name = juju_model.development.name
+}
+
+resource "juju_annotation" "development" {
+  model  = juju_model.development.name
+  entity = "model-${data.juju_model.development.uuid}"
+  annotations = {
+    cost-center = "1234"
+  }
+}
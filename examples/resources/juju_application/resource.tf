@@ -1,5 +1,6 @@
 resource "juju_application" "this" {
-  name = "my-application"
+  name        = "my-application"
+  description = "Serves the public website, owned by the web team"
 
   model = juju_model.development.name
 
@@ -25,4 +26,136 @@ resource "juju_application" "this" {
   config = {
     external-hostname = "..."
   }
-}
\ No newline at end of file
+}
+# Deploy on the newest Ubuntu LTS supported by the charm, the selected
+# base is recorded in charm.base.
+resource "juju_application" "lts" {
+  model = juju_model.development.name
+
+  charm {
+    name           = "ubuntu"
+    base_selection = "latest-lts"
+  }
+}
+
+# Deploy a charm built locally, e.g. in CI, with charmcraft pack. The
+# application is refreshed when the content of the archive changes.
+resource "juju_application" "local" {
+  model = juju_model.development.name
+
+  charm {
+    path = "./my-charm_ubuntu-22.04-amd64.charm"
+  }
+
+  resources = {
+    config-file = "./files/config.yaml"
+  }
+}
+
+# Fail the apply if the pods of a kubernetes charm do not become ready,
+# e.g. because its OCI image cannot be pulled.
+resource "juju_application" "k8s" {
+  model = juju_model.k8s.name
+
+  charm {
+    name = "grafana-k8s"
+  }
+
+  resources = {
+    grafana-image = "ubuntu/grafana:10"
+  }
+
+  wait_for_ready = true
+}
+
+# Bind all endpoints to the "public" space, except the "db" endpoint
+# which is bound to the "internal" space. Changing a space rebinds the
+# endpoint of the deployed application.
+resource "juju_application" "bound" {
+  model = juju_model.development.name
+
+  charm {
+    name = "wordpress"
+  }
+
+  endpoint_bindings = [
+    {
+      space = "public"
+    },
+    {
+      endpoint = "db"
+      space    = "internal"
+    },
+  ]
+}
+
+# Request two 100G volumes from the "ebs" pool for the "data" storage of
+# the charm, the attached volumes are reported in storage.
+resource "juju_application" "with_storage" {
+  model = juju_model.development.name
+
+  charm {
+    name = "postgresql"
+  }
+
+  storage_directives = {
+    data = "ebs,2,100G"
+  }
+}
+
+# Deploy to the machines 0 and 1 of a bundle-like plan, mapped to the
+# existing machines 4 and 7 of a re-created model.
+resource "juju_application" "mapped" {
+  model = juju_model.development.name
+
+  charm {
+    name = "ubuntu"
+  }
+
+  units     = 2
+  placement = "0,1"
+  map_machines = {
+    "0" = "4"
+    "1" = "7"
+  }
+}
+
+# Deploy one unit on machine 0 and one unit in a new LXD container on
+# machine 1.
+resource "juju_application" "placed" {
+  model = juju_model.development.name
+
+  charm {
+    name = "ubuntu"
+  }
+
+  units = 2
+
+  placement_directive {
+    scope     = "machine"
+    directive = "0"
+  }
+
+  placement_directive {
+    scope          = "container"
+    container_type = "lxd"
+    directive      = "1"
+  }
+}
+
+# Constraints can be given as attributes, validated at plan time. Sizes
+# are compared by value, "4G" is the same memory as "4096M".
+resource "juju_application" "constrained" {
+  model = juju_model.development.name
+
+  charm {
+    name = "ubuntu"
+  }
+
+  structured_constraints = {
+    cores     = 2
+    mem       = "4G"
+    root_disk = "16G"
+    spaces    = ["public"]
+  }
+}
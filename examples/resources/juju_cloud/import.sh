@@ -0,0 +1,2 @@
+# Clouds can be imported by using their name
+$ terraform import juju_cloud.maas my-maas
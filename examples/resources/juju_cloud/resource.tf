@@ -0,0 +1,23 @@
+resource "juju_cloud" "maas" {
+  name       = "my-maas"
+  type       = "maas"
+  auth_types = ["oauth1"]
+  endpoint   = "http://10.0.0.2:5240/MAAS"
+}
+
+resource "juju_cloud" "openstack" {
+  name            = "my-openstack"
+  type            = "openstack"
+  auth_types      = ["userpass"]
+  endpoint        = "https://keystone.example.com:5000/v3"
+  ca_certificates = [file("~/openstack-ca.pem")]
+
+  region {
+    name = "region-one"
+  }
+
+  region {
+    name     = "region-two"
+    endpoint = "https://keystone-two.example.com:5000/v3"
+  }
+}
@@ -0,0 +1,3 @@
+# The controller default authorized keys of a cloud can be imported using
+# the cloud name. All the keys currently set are then managed by Terraform.
+$ terraform import juju_controller_authorized_keys.this localhost
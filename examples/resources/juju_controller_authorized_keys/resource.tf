@@ -0,0 +1,6 @@
+resource "juju_controller_authorized_keys" "this" {
+  cloud = "localhost"
+  keys = [
+    file("~/.ssh/id_ed25519.pub"),
+  ]
+}
@@ -0,0 +1,3 @@
+# The controller config can be imported using the controller UUID. All the
+# keys which can be updated are then managed by Terraform.
+$ terraform import juju_controller_config.this 5a6e9c1e-7bd0-4c6b-8d5b-4c4a7f0ee2b1
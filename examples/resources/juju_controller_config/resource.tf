@@ -0,0 +1,6 @@
+resource "juju_controller_config" "this" {
+  config = {
+    "audit-log-max-size"        = "500M"
+    "audit-log-exclude-methods" = jsonencode(["ReadOnlyMethods", "Client.FullStatus"])
+  }
+}
@@ -8,8 +8,34 @@ resource "juju_credential" "this" {
   auth_type = "certificate"
 
   attributes = {
-    client-cert    = "/srv/cert.crt"
-    client-key     = "/srv/cert.key"
-    trust-password = "S0m3P@$$w0rd"
+    server-cert = file("/srv/server.crt")
+    client-cert = file("/srv/cert.crt")
+    client-key  = file("/srv/cert.key")
   }
 }
+
+# Use the instance profile of the controller machines on AWS.
+resource "juju_credential" "aws_instance_role" {
+  name = "ci-aws-instance-role"
+
+  cloud {
+    name = "aws"
+  }
+
+  auth_type = "instance-role"
+
+  attributes = {
+    instance-profile-name = "juju-controller"
+  }
+}
+
+# Build the credential from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+resource "juju_credential" "aws" {
+  name = "ci-aws"
+
+  cloud {
+    name = "aws"
+  }
+
+  from_environment = "aws"
+}
@@ -31,3 +31,19 @@ resource "juju_integration" "this" {
     ]
   }
 }
+
+# Integrate with an offer hosted on another controller, consumed in the
+# model as the "database" application.
+resource "juju_integration" "cross_controller" {
+  model = juju_model.development.name
+
+  application {
+    name     = juju_application.wordpress.name
+    endpoint = "db"
+  }
+
+  application {
+    offer_url = "production:admin/databases.mysql"
+    saas_name = "database"
+  }
+}
@@ -3,5 +3,13 @@ resource "juju_jaas_access_cloud" "development" {
   access           = "can_addmodel"
   users            = ["foo@domain.com"]
   groups           = [juju_jaas_group.development.uuid]
+  roles            = [juju_jaas_role.development.uuid]
   service_accounts = ["Client-ID-1", "Client-ID-2"]
 }
+
+# Let every user add models to the cloud.
+resource "juju_jaas_access_cloud" "everyone" {
+  cloud_name        = "aws"
+  access            = "can_addmodel"
+  grant_to_everyone = true
+}
@@ -1,3 +1,5 @@
 # JAAS controller access can be imported using the fixed JAAS controller name and access level
 # I.e. in this case jimm is the only valid controller name.
 $ terraform import juju_jaas_access_cloud.development jimm:administrator
+# or using the controller kind alone
+$ terraform import juju_jaas_access_controller.development controller:administrator
@@ -2,5 +2,6 @@ resource "juju_jaas_access_controller" "development" {
   access           = "administrator"
   users            = ["foo@domain.com"]
   groups           = [juju_jaas_group.development.uuid]
+  roles            = [juju_jaas_role.development.uuid]
   service_accounts = ["Client-ID-1", "Client-ID-2"]
 }
@@ -1,2 +1,4 @@
 # JAAS group access can be imported using the group UUID and access level
 $ terraform import juju_jaas_access_group.development UUID:member
+# or using the group name, which is resolved to the group UUID
+$ terraform import juju_jaas_access_group.development group:devops-team:member
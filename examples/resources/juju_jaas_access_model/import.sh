@@ -1,2 +1,4 @@
 # JAAS model access can be imported using the model name and access level
 $ terraform import juju_jaas_access_model.development development:can_addmodel
+# or using the model owner and name, which are resolved to the model UUID
+$ terraform import juju_jaas_access_model.development model:alice@canonical.com/development:admin
@@ -3,5 +3,6 @@ resource "juju_jaas_access_offer" "development" {
   access           = "consumer"
   users            = ["foo@domain.com"]
   groups           = [juju_jaas_group.development.uuid]
+  roles            = [juju_jaas_role.development.uuid]
   service_accounts = ["Client-ID-1", "Client-ID-2"]
 }
@@ -0,0 +1,5 @@
+# JAAS groups can be imported using the group UUID
+$ terraform import juju_jaas_group.development <group-uuid>
+
+# or the group name, prefixed with name:
+$ terraform import juju_jaas_group.development name:devops-team
@@ -0,0 +1,3 @@
+# JAAS group memberships can be imported using the group UUID, the
+# imported resource is authoritative.
+$ terraform import juju_jaas_group_membership.development <group-uuid>
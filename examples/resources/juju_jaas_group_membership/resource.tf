@@ -0,0 +1,12 @@
+resource "juju_jaas_group_membership" "development" {
+  group_id         = juju_jaas_group.development.uuid
+  users            = ["foo@domain.com"]
+  service_accounts = ["Client-ID-1"]
+}
+
+# Add a user to a group without removing the members managed elsewhere.
+resource "juju_jaas_group_membership" "on_call" {
+  group_id      = juju_jaas_group.development.uuid
+  users         = ["bar@domain.com"]
+  authoritative = false
+}
@@ -0,0 +1,3 @@
+resource "juju_jaas_role" "development" {
+  name = "devops-admin"
+}
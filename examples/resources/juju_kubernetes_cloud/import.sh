@@ -0,0 +1,2 @@
+# Kubernetes clouds can be imported by using their name
+$ terraform import juju_kubernetes_cloud.my-k8s-cloud my-k8s-cloud
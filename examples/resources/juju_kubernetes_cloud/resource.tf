@@ -0,0 +1,44 @@
+resource "juju_kubernetes_cloud" "my-k8s-cloud" {
+  name              = "my-k8s-cloud"
+  kubernetes_config = file("~/.kube/config")
+}
+
+resource "juju_model" "my-model" {
+  name       = "my-model"
+  credential = juju_kubernetes_cloud.my-k8s-cloud.credential
+  cloud {
+    name = juju_kubernetes_cloud.my-k8s-cloud.name
+  }
+}
+
+# JAAS requires the cloud and region hosting the cluster. The EKS
+# kubeconfig runs aws-iam-authenticator to get a short-lived token, which
+# the credential is refreshed with at least every hour.
+resource "time_rotating" "eks-token" {
+  rotation_minutes = 60
+}
+
+resource "juju_kubernetes_cloud" "my-eks-cloud" {
+  name                = "my-eks-cloud"
+  kubernetes_config   = file("~/.kube/eks-config")
+  parent_cloud_name   = "aws"
+  parent_cloud_region = "us-east-1"
+  credential_triggers = {
+    rotation = time_rotating.eks-token.id
+  }
+}
+
+# The kubeconfig output of a cluster module can be used directly,
+# picking one of its contexts.
+resource "juju_kubernetes_cloud" "my-gke-cloud" {
+  name              = "my-gke-cloud"
+  kubernetes_config = module.gke.kubeconfig_raw
+  context_name      = "gke-admin"
+}
+
+# When Terraform runs in a pod of the cluster, e.g. a self-hosted runner,
+# the cluster is added from the service account of the pod.
+resource "juju_kubernetes_cloud" "my-local-cloud" {
+  name       = "my-local-cloud"
+  in_cluster = true
+}
@@ -4,3 +4,8 @@
 # Here is an example to import a machine from the development model with 
 # machine ID 1 and a name "machine_one":
 $ terraform import juju_machine.machine_one `development:1:machine_one`
+
+# Machines can also be imported using their cloud instance ID, with the format
+# `model_name:instance-id:<instance id>`. The Juju machine ID is discovered
+# from the model and the machine is named "machine-<machine ID>":
+$ terraform import juju_machine.machine_one `development:instance-id:i-0abc1234def567890`
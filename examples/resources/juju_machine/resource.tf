@@ -3,4 +3,34 @@ resource "juju_machine" "this_machine" {
   base        = "ubuntu@22.04"
   name        = "this_machine"
   constraints = "tags=my-machine-tag"
-}
\ No newline at end of file
+}
+
+# Add two machines to each availability zone with a single call to Juju.
+resource "juju_machine" "workers" {
+  model          = juju_model.development.name
+  base           = "ubuntu@22.04"
+  constraints    = "mem=8G"
+  zones          = ["us-east-1a", "us-east-1b", "us-east-1c"]
+  count_per_zone = 2
+}
+
+# Allocate MAAS machines with a GPU, connected to the storage space.
+resource "juju_machine" "gpu" {
+  model          = juju_model.development.name
+  base           = "ubuntu@22.04"
+  zones          = ["rack-1"]
+  count_per_zone = 2
+  maas {
+    tags   = ["gpu", "^virtual"]
+    spaces = ["storage"]
+  }
+}
+
+# Allocate a specific MAAS machine.
+resource "juju_machine" "database" {
+  model = juju_model.development.name
+  base  = "ubuntu@22.04"
+  maas {
+    system_id = "abc123"
+  }
+}
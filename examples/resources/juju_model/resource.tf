@@ -1,5 +1,6 @@
 resource "juju_model" "this" {
-  name = "development"
+  name        = "development"
+  description = "Development environment, owned by the platform team"
 
   cloud {
     name   = "aws"
@@ -13,3 +14,13 @@ resource "juju_model" "this" {
     update-status-hook-interval = "5m"
   }
 }
+
+# Keys set on the model outside of Terraform are reset to their default.
+resource "juju_model" "production" {
+  name        = "production"
+  config_mode = "authoritative"
+
+  config = {
+    logging-config = "<root>=WARNING"
+  }
+}
@@ -0,0 +1,7 @@
+resource "juju_model_migration_target" "capacity" {
+  controller = "controller-b"
+  models = [
+    juju_model.development.id,
+  ]
+  accept_new_models = false
+}
@@ -2,6 +2,8 @@ resource "juju_offer" "this" {
   model            = juju_model.development.name
   application_name = juju_application.percona-cluster.name
   endpoint         = server
+  description      = "Shared percona database for the web teams"
+  consume_users    = ["web-team@external"]
 }
 
 // an offer can then be used in an integration as below:
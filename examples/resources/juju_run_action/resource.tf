@@ -0,0 +1,19 @@
+resource "juju_run_action" "create_admin" {
+  model       = juju_model.development.name
+  application = juju_application.database.name
+  action      = "set-password"
+  params = {
+    username = "operator"
+  }
+  timeout = "15m"
+
+  # Run the action again when the charm is refreshed.
+  triggers = {
+    revision = juju_application.database.charm[0].revision
+  }
+}
+
+output "operator_password" {
+  value     = juju_run_action.create_admin.results[0].output["password"]
+  sensitive = true
+}
@@ -0,0 +1,20 @@
+resource "juju_secret_backend" "vault" {
+  name                  = "vault"
+  backend_type          = "vault"
+  token_rotate_interval = "48h"
+
+  config = {
+    endpoint  = "https://vault.example.com:8200"
+    namespace = "juju"
+    token     = var.vault_token
+    ca-cert   = file("vault-ca.pem")
+  }
+}
+
+resource "juju_model" "development" {
+  name = "development"
+
+  config = {
+    secret-backend = juju_secret_backend.vault.name
+  }
+}
@@ -0,0 +1,2 @@
+# Spaces can be imported by using the model and space names
+$ terraform import juju_space.database development:database
@@ -0,0 +1,17 @@
+resource "juju_space" "database" {
+  model   = juju_model.development.name
+  name    = "database"
+  subnets = ["10.0.1.0/24", "10.0.2.0/24"]
+}
+
+resource "juju_application" "postgresql" {
+  model = juju_model.development.name
+
+  charm {
+    name = "postgresql"
+  }
+
+  endpoint_bindings = [{
+    space = juju_space.database.name
+  }]
+}
@@ -0,0 +1,2 @@
+# Storage pools can be imported by using the model and storage pool names
+$ terraform import juju_storage_pool.fast development:ebs-fast
@@ -0,0 +1,22 @@
+resource "juju_storage_pool" "fast" {
+  model            = juju_model.development.name
+  name             = "ebs-fast"
+  storage_provider = "ebs"
+
+  attributes = {
+    volume-type = "io1"
+    iops        = "30"
+  }
+}
+
+resource "juju_application" "postgresql" {
+  model = juju_model.development.name
+
+  charm {
+    name = "postgresql"
+  }
+
+  storage_directives = {
+    pgdata = "${juju_storage_pool.fast.name},10G"
+  }
+}
@@ -3,3 +3,9 @@ resource "juju_user" "this" {
   display_name = format("%s - terraform managed", "dev-user")
   password     = var.password
 }
+
+resource "juju_user" "former" {
+  name     = "former-user"
+  password = var.former_password
+  disabled = true
+}
@@ -0,0 +1,526 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+// Package ids encodes and decodes the IDs of the provider's resources.
+// Composite IDs are made of fields separated by colons, each resource
+// ID type has a String method to encode it and a Parse function to
+// decode it, which is also used to validate import IDs.
+package ids
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const separator = ":"
+
+// MalformedIDError is returned when an ID cannot be decoded.
+type MalformedIDError struct {
+	// ID is the malformed ID.
+	ID string
+	// Format is the expected format of the ID.
+	Format string
+	// Reason describes what is wrong with the ID.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *MalformedIDError) Error() string {
+	return fmt.Sprintf("ID %q is malformed, %s, please use the format %q", e.ID, e.Reason, e.Format)
+}
+
+// IsMalformedID returns true if the error is a MalformedIDError.
+func IsMalformedID(err error) bool {
+	_, ok := err.(*MalformedIDError)
+	return ok
+}
+
+// split splits the ID into exactly n non-empty fields. If n is
+// negative, the last field takes the remainder of the ID, colons
+// included, and at least -n fields are required.
+func split(id, format string, n int) ([]string, error) {
+	var fields []string
+	if n < 0 {
+		fields = strings.SplitN(id, separator, -n)
+		n = -n
+	} else {
+		fields = strings.Split(id, separator)
+	}
+	if len(fields) != n {
+		return nil, &MalformedIDError{
+			ID:     id,
+			Format: format,
+			Reason: fmt.Sprintf("expected %d fields separated by %q, got %d", n, separator, len(fields)),
+		}
+	}
+	for i, field := range fields {
+		if field == "" {
+			return nil, &MalformedIDError{
+				ID:     id,
+				Format: format,
+				Reason: fmt.Sprintf("field %d is empty", i+1),
+			}
+		}
+	}
+	return fields, nil
+}
+
+func join(fields ...string) string {
+	return strings.Join(fields, separator)
+}
+
+// ApplicationIDFormat is the format of an ApplicationID.
+const ApplicationIDFormat = "<model>:<application>"
+
+// ApplicationID identifies an application in a model.
+type ApplicationID struct {
+	Model       string
+	Application string
+}
+
+// String encodes the ID.
+func (id ApplicationID) String() string {
+	return join(id.Model, id.Application)
+}
+
+// ParseApplicationID decodes an ApplicationID.
+func ParseApplicationID(value string) (ApplicationID, error) {
+	fields, err := split(value, ApplicationIDFormat, 2)
+	if err != nil {
+		return ApplicationID{}, err
+	}
+	return ApplicationID{Model: fields[0], Application: fields[1]}, nil
+}
+
+// IntegrationIDFormat is the format of an IntegrationID.
+const IntegrationIDFormat = "<model>:<provider application>:<provider endpoint>:<requirer application>:<requirer endpoint>"
+
+// IntegrationID identifies an integration between the endpoints of
+// two applications in a model.
+type IntegrationID struct {
+	Model               string
+	ProviderApplication string
+	ProviderEndpoint    string
+	RequirerApplication string
+	RequirerEndpoint    string
+}
+
+// String encodes the ID.
+func (id IntegrationID) String() string {
+	return join(id.Model, id.ProviderApplication, id.ProviderEndpoint, id.RequirerApplication, id.RequirerEndpoint)
+}
+
+// Provider returns the provider endpoint as <application>:<endpoint>.
+func (id IntegrationID) Provider() string {
+	return join(id.ProviderApplication, id.ProviderEndpoint)
+}
+
+// Requirer returns the requirer endpoint as <application>:<endpoint>.
+func (id IntegrationID) Requirer() string {
+	return join(id.RequirerApplication, id.RequirerEndpoint)
+}
+
+// ParseIntegrationID decodes an IntegrationID.
+func ParseIntegrationID(value string) (IntegrationID, error) {
+	fields, err := split(value, IntegrationIDFormat, 5)
+	if err != nil {
+		return IntegrationID{}, err
+	}
+	return IntegrationID{
+		Model:               fields[0],
+		ProviderApplication: fields[1],
+		ProviderEndpoint:    fields[2],
+		RequirerApplication: fields[3],
+		RequirerEndpoint:    fields[4],
+	}, nil
+}
+
+// MachineIDFormat is the format of a MachineID.
+const MachineIDFormat = "<model>:<machine id>:<machine name>"
+
+// MachineID identifies a machine in a model.
+type MachineID struct {
+	Model     string
+	MachineID string
+	Name      string
+}
+
+// String encodes the ID.
+func (id MachineID) String() string {
+	return join(id.Model, id.MachineID, id.Name)
+}
+
+// ParseMachineID decodes a MachineID.
+func ParseMachineID(value string) (MachineID, error) {
+	fields, err := split(value, MachineIDFormat, 3)
+	if err != nil {
+		return MachineID{}, err
+	}
+	return MachineID{Model: fields[0], MachineID: fields[1], Name: fields[2]}, nil
+}
+
+// MachineInstanceImportIDFormat is the format of a
+// MachineInstanceImportID.
+const MachineInstanceImportIDFormat = "<model>:instance-id:<instance id>"
+
+// machineInstanceImportKey is the second field of a
+// MachineInstanceImportID.
+const machineInstanceImportKey = "instance-id"
+
+// MachineInstanceImportID identifies a machine to import by its cloud
+// instance ID. The instance ID may itself contain colons, e.g. for
+// manually provisioned machines.
+type MachineInstanceImportID struct {
+	Model      string
+	InstanceID string
+}
+
+// String encodes the ID.
+func (id MachineInstanceImportID) String() string {
+	return join(id.Model, machineInstanceImportKey, id.InstanceID)
+}
+
+// ParseMachineInstanceImportID decodes a MachineInstanceImportID.
+func ParseMachineInstanceImportID(value string) (MachineInstanceImportID, error) {
+	fields, err := split(value, MachineInstanceImportIDFormat, -3)
+	if err != nil {
+		return MachineInstanceImportID{}, err
+	}
+	if fields[1] != machineInstanceImportKey {
+		return MachineInstanceImportID{}, &MalformedIDError{
+			ID:     value,
+			Format: MachineInstanceImportIDFormat,
+			Reason: fmt.Sprintf("expected %q as the second field", machineInstanceImportKey),
+		}
+	}
+	return MachineInstanceImportID{Model: fields[0], InstanceID: fields[2]}, nil
+}
+
+// CredentialIDFormat is the format of a CredentialID.
+const CredentialIDFormat = "<credential>:<cloud>:<client credential>:<controller credential>"
+
+// CredentialID identifies a credential of a cloud, stored on the client,
+// the controller or both.
+type CredentialID struct {
+	Name       string
+	Cloud      string
+	Client     bool
+	Controller bool
+}
+
+// String encodes the ID.
+func (id CredentialID) String() string {
+	return join(id.Name, id.Cloud, strconv.FormatBool(id.Client), strconv.FormatBool(id.Controller))
+}
+
+// ParseCredentialID decodes a CredentialID.
+func ParseCredentialID(value string) (CredentialID, error) {
+	fields, err := split(value, CredentialIDFormat, 4)
+	if err != nil {
+		return CredentialID{}, err
+	}
+	client, err := strconv.ParseBool(fields[2])
+	if err != nil {
+		return CredentialID{}, &MalformedIDError{
+			ID:     value,
+			Format: CredentialIDFormat,
+			Reason: fmt.Sprintf("client credential %q is not a boolean", fields[2]),
+		}
+	}
+	controller, err := strconv.ParseBool(fields[3])
+	if err != nil {
+		return CredentialID{}, &MalformedIDError{
+			ID:     value,
+			Format: CredentialIDFormat,
+			Reason: fmt.Sprintf("controller credential %q is not a boolean", fields[3]),
+		}
+	}
+	return CredentialID{Name: fields[0], Cloud: fields[1], Client: client, Controller: controller}, nil
+}
+
+// AccessModelIDFormat is the format of an AccessModelID.
+const AccessModelIDFormat = "<model>:<access>:<user1,user2>"
+
+// AccessModelID identifies the access of users to a model.
+type AccessModelID struct {
+	Model  string
+	Access string
+	// Users is nil if the ID does not contain the users, which is
+	// the case for IDs created by the 0.8.0 version of the provider.
+	Users []string
+}
+
+// String encodes the ID.
+func (id AccessModelID) String() string {
+	return join(id.Model, id.Access, strings.Join(id.Users, ","))
+}
+
+// ParseAccessModelID decodes an AccessModelID. IDs without users are
+// accepted, see AccessModelID.Users.
+func ParseAccessModelID(value string) (AccessModelID, error) {
+	fields, err := split(value, AccessModelIDFormat, 3)
+	if err != nil {
+		legacyFields, legacyErr := split(value, AccessModelIDFormat, 2)
+		if legacyErr != nil {
+			return AccessModelID{}, err
+		}
+		return AccessModelID{Model: legacyFields[0], Access: legacyFields[1]}, nil
+	}
+	return AccessModelID{Model: fields[0], Access: fields[1], Users: strings.Split(fields[2], ",")}, nil
+}
+
+// ParseAccessModelImportID decodes an AccessModelID used to import the
+// resource, which must contain the users.
+func ParseAccessModelImportID(value string) (AccessModelID, error) {
+	fields, err := split(value, AccessModelIDFormat, 3)
+	if err != nil {
+		return AccessModelID{}, err
+	}
+	return AccessModelID{Model: fields[0], Access: fields[1], Users: strings.Split(fields[2], ",")}, nil
+}
+
+// AccessCloudIDFormat is the format of an AccessCloudID.
+const AccessCloudIDFormat = "<cloud>:<access>:<user1,user2>"
+
+// AccessCloudID identifies the access of users to a cloud.
+type AccessCloudID struct {
+	Cloud  string
+	Access string
+	Users  []string
+}
+
+// String encodes the ID.
+func (id AccessCloudID) String() string {
+	return join(id.Cloud, id.Access, strings.Join(id.Users, ","))
+}
+
+// ParseAccessCloudID decodes an AccessCloudID.
+func ParseAccessCloudID(value string) (AccessCloudID, error) {
+	fields, err := split(value, AccessCloudIDFormat, 3)
+	if err != nil {
+		return AccessCloudID{}, err
+	}
+	return AccessCloudID{Cloud: fields[0], Access: fields[1], Users: strings.Split(fields[2], ",")}, nil
+}
+
+// AccessControllerIDFormat is the format of an AccessControllerID.
+const AccessControllerIDFormat = "<access>:<user1,user2>"
+
+// AccessControllerID identifies the access of users to the controller.
+type AccessControllerID struct {
+	Access string
+	Users  []string
+}
+
+// String encodes the ID.
+func (id AccessControllerID) String() string {
+	return join(id.Access, strings.Join(id.Users, ","))
+}
+
+// ParseAccessControllerID decodes an AccessControllerID.
+func ParseAccessControllerID(value string) (AccessControllerID, error) {
+	fields, err := split(value, AccessControllerIDFormat, 2)
+	if err != nil {
+		return AccessControllerID{}, err
+	}
+	return AccessControllerID{Access: fields[0], Users: strings.Split(fields[1], ",")}, nil
+}
+
+// JaasAccessIDFormat is the format of a JaasAccessID.
+const JaasAccessIDFormat = "<target>:<access>"
+
+// JaasAccessID identifies a JAAS access relation to a target object.
+// The target is the ID of the object's tag, e.g. a model UUID, and may
+// contain colons.
+type JaasAccessID struct {
+	Target string
+	Access string
+}
+
+// String encodes the ID.
+func (id JaasAccessID) String() string {
+	return join(id.Target, id.Access)
+}
+
+// ParseJaasAccessID decodes a JaasAccessID. The access is read from
+// after the last colon.
+func ParseJaasAccessID(value string) (JaasAccessID, error) {
+	i := strings.LastIndex(value, separator)
+	if i == -1 {
+		return JaasAccessID{}, &MalformedIDError{
+			ID:     value,
+			Format: JaasAccessIDFormat,
+			Reason: fmt.Sprintf("expected fields separated by %q", separator),
+		}
+	}
+	id := JaasAccessID{Target: value[:i], Access: value[i+1:]}
+	if id.Target == "" || id.Access == "" {
+		return JaasAccessID{}, &MalformedIDError{
+			ID:     value,
+			Format: JaasAccessIDFormat,
+			Reason: "target and access must not be empty",
+		}
+	}
+	return id, nil
+}
+
+// SecretIDFormat is the format of a SecretID.
+const SecretIDFormat = "<model>:<secret>"
+
+// SecretID identifies a secret in a model. The secret is the secret
+// ID once created, or its name when importing.
+type SecretID struct {
+	Model  string
+	Secret string
+}
+
+// String encodes the ID.
+func (id SecretID) String() string {
+	return join(id.Model, id.Secret)
+}
+
+// ParseSecretID decodes a SecretID.
+func ParseSecretID(value string) (SecretID, error) {
+	fields, err := split(value, SecretIDFormat, 2)
+	if err != nil {
+		return SecretID{}, err
+	}
+	return SecretID{Model: fields[0], Secret: fields[1]}, nil
+}
+
+// SpaceIDFormat is the format of a SpaceID.
+const SpaceIDFormat = "<model>:<space>"
+
+// SpaceID identifies a network space in a model.
+type SpaceID struct {
+	Model string
+	Space string
+}
+
+// String encodes the ID.
+func (id SpaceID) String() string {
+	return join(id.Model, id.Space)
+}
+
+// ParseSpaceID decodes a SpaceID.
+func ParseSpaceID(value string) (SpaceID, error) {
+	fields, err := split(value, SpaceIDFormat, 2)
+	if err != nil {
+		return SpaceID{}, err
+	}
+	return SpaceID{Model: fields[0], Space: fields[1]}, nil
+}
+
+// SSHKeyIDFormat is the format of an SSHKeyID.
+const SSHKeyIDFormat = "sshkey:<model>:<key identifier>"
+
+// sshKeyIDPrefixes are the accepted first fields of an SSHKeyID, the
+// documentation used to refer to ssh_key.
+var sshKeyIDPrefixes = []string{"sshkey", "ssh_key"}
+
+// SSHKeyID identifies an ssh key in a model. The key identifier is
+// based on the comment of the key, e.g. user@hostname.
+type SSHKeyID struct {
+	Model         string
+	KeyIdentifier string
+}
+
+// String encodes the ID.
+func (id SSHKeyID) String() string {
+	return join(sshKeyIDPrefixes[0], id.Model, id.KeyIdentifier)
+}
+
+// ParseSSHKeyID decodes an SSHKeyID.
+func ParseSSHKeyID(value string) (SSHKeyID, error) {
+	fields, err := split(value, SSHKeyIDFormat, 3)
+	if err != nil {
+		return SSHKeyID{}, err
+	}
+	if fields[0] != sshKeyIDPrefixes[0] && fields[0] != sshKeyIDPrefixes[1] {
+		return SSHKeyID{}, &MalformedIDError{
+			ID:     value,
+			Format: SSHKeyIDFormat,
+			Reason: fmt.Sprintf("expected %q as the first field", sshKeyIDPrefixes[0]),
+		}
+	}
+	return SSHKeyID{Model: fields[1], KeyIdentifier: fields[2]}, nil
+}
+
+// StoragePoolIDFormat is the format of a StoragePoolID.
+const StoragePoolIDFormat = "<model>:<storage pool>"
+
+// StoragePoolID identifies a storage pool in a model.
+type StoragePoolID struct {
+	Model string
+	Pool  string
+}
+
+// String encodes the ID.
+func (id StoragePoolID) String() string {
+	return join(id.Model, id.Pool)
+}
+
+// ParseStoragePoolID decodes a StoragePoolID.
+func ParseStoragePoolID(value string) (StoragePoolID, error) {
+	fields, err := split(value, StoragePoolIDFormat, 2)
+	if err != nil {
+		return StoragePoolID{}, err
+	}
+	return StoragePoolID{Model: fields[0], Pool: fields[1]}, nil
+}
+
+// UserIDFormat is the format of a UserID.
+const UserIDFormat = "user:<user>"
+
+// userIDPrefix is the first field of a UserID.
+const userIDPrefix = "user"
+
+// UserID identifies a user.
+type UserID struct {
+	Name string
+}
+
+// String encodes the ID.
+func (id UserID) String() string {
+	return join(userIDPrefix, id.Name)
+}
+
+// ParseUserID decodes a UserID.
+func ParseUserID(value string) (UserID, error) {
+	fields, err := split(value, UserIDFormat, 2)
+	if err != nil {
+		return UserID{}, err
+	}
+	if fields[0] != userIDPrefix {
+		return UserID{}, &MalformedIDError{
+			ID:     value,
+			Format: UserIDFormat,
+			Reason: fmt.Sprintf("expected %q as the first field", userIDPrefix),
+		}
+	}
+	return UserID{Name: fields[1]}, nil
+}
+
+// AnnotationIDFormat is the format of an AnnotationID.
+const AnnotationIDFormat = "<model>:<entity tag>"
+
+// AnnotationID identifies the annotations of an entity in a model.
+type AnnotationID struct {
+	Model  string
+	Entity string
+}
+
+// String encodes the ID.
+func (id AnnotationID) String() string {
+	return join(id.Model, id.Entity)
+}
+
+// ParseAnnotationID decodes an AnnotationID.
+func ParseAnnotationID(value string) (AnnotationID, error) {
+	fields, err := split(value, AnnotationIDFormat, 2)
+	if err != nil {
+		return AnnotationID{}, err
+	}
+	return AnnotationID{Model: fields[0], Entity: fields[1]}, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package ids_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/juju/terraform-provider-juju/internal/ids"
+)
+
+// codecTest checks that an ID decodes to the expected value and, if
+// roundTrip is set, encodes back to the same string. A nil expected
+// value means the ID is malformed.
+type codecTest struct {
+	id        string
+	expected  interface{}
+	roundTrip bool
+}
+
+func runCodecTests(t *testing.T, tests []codecTest, parse func(string) (interface{}, error)) {
+	t.Helper()
+	for _, test := range tests {
+		got, err := parse(test.id)
+		if test.expected == nil {
+			if err == nil {
+				t.Errorf("%q: expected an error, got %+v", test.id, got)
+			} else if !ids.IsMalformedID(err) {
+				t.Errorf("%q: expected a malformed ID error, got %T: %v", test.id, err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.id, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%q: expected %+v, got %+v", test.id, test.expected, got)
+		}
+		if s, ok := got.(interface{ String() string }); test.roundTrip && ok && s.String() != test.id {
+			t.Errorf("%q: encodes back to %q", test.id, s.String())
+		}
+	}
+}
+
+func TestApplicationID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "development:wordpress", expected: ids.ApplicationID{Model: "development", Application: "wordpress"}, roundTrip: true},
+		{id: "development"},
+		{id: "development:"},
+		{id: ":wordpress"},
+		{id: "development:wordpress:extra"},
+		{id: ""},
+	}, func(s string) (interface{}, error) { return ids.ParseApplicationID(s) })
+}
+
+func TestIntegrationID(t *testing.T) {
+	expected := ids.IntegrationID{
+		Model:               "development",
+		ProviderApplication: "percona-cluster",
+		ProviderEndpoint:    "server",
+		RequirerApplication: "wordpress",
+		RequirerEndpoint:    "db",
+	}
+	runCodecTests(t, []codecTest{
+		{id: "development:percona-cluster:server:wordpress:db", expected: expected, roundTrip: true},
+		{id: "development:percona-cluster:server:wordpress"},
+		{id: "development:percona-cluster::wordpress:db"},
+		{id: "development:percona-cluster:server:wordpress:db:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseIntegrationID(s) })
+
+	if expected.Provider() != "percona-cluster:server" || expected.Requirer() != "wordpress:db" {
+		t.Errorf("unexpected endpoints %q and %q", expected.Provider(), expected.Requirer())
+	}
+}
+
+func TestMachineID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "development:0:machine-0", expected: ids.MachineID{Model: "development", MachineID: "0", Name: "machine-0"}, roundTrip: true},
+		{id: "development:0/lxd/1:machine-0-lxd-1", expected: ids.MachineID{Model: "development", MachineID: "0/lxd/1", Name: "machine-0-lxd-1"}, roundTrip: true},
+		{id: "development:0"},
+		{id: "development::machine-0"},
+		{id: "development:0:machine-0:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseMachineID(s) })
+}
+
+func TestMachineInstanceImportID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "development:instance-id:i-0abc", expected: ids.MachineInstanceImportID{Model: "development", InstanceID: "i-0abc"}, roundTrip: true},
+		{id: "development:instance-id:manual:10.0.0.1", expected: ids.MachineInstanceImportID{Model: "development", InstanceID: "manual:10.0.0.1"}, roundTrip: true},
+		{id: "development:0:machine-0"},
+		{id: "development:instance-id:"},
+		{id: ":instance-id:i-0abc"},
+		{id: "development:instance-id"},
+	}, func(s string) (interface{}, error) { return ids.ParseMachineInstanceImportID(s) })
+}
+
+func TestCredentialID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "creddev:localhost:false:true", expected: ids.CredentialID{Name: "creddev", Cloud: "localhost", Controller: true}, roundTrip: true},
+		{id: "creddev:localhost:true:false", expected: ids.CredentialID{Name: "creddev", Cloud: "localhost", Client: true}, roundTrip: true},
+		{id: "creddev:localhost:yes:true"},
+		{id: "creddev:localhost:false:no"},
+		{id: "creddev:localhost:false"},
+		{id: "creddev::false:true"},
+	}, func(s string) (interface{}, error) { return ids.ParseCredentialID(s) })
+}
+
+func TestAccessModelID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "development:read:user-one,user-two", expected: ids.AccessModelID{Model: "development", Access: "read", Users: []string{"user-one", "user-two"}}, roundTrip: true},
+		{id: "development:read:user-one", expected: ids.AccessModelID{Model: "development", Access: "read", Users: []string{"user-one"}}, roundTrip: true},
+		// IDs created by the 0.8.0 provider do not contain the users.
+		{id: "development:read", expected: ids.AccessModelID{Model: "development", Access: "read"}},
+		{id: "development:read:"},
+		{id: "development"},
+		{id: "development:read:user-one:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseAccessModelID(s) })
+}
+
+func TestAccessModelImportID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "development:read:user-one,user-two", expected: ids.AccessModelID{Model: "development", Access: "read", Users: []string{"user-one", "user-two"}}, roundTrip: true},
+		{id: "development:read"},
+		{id: "development:read:"},
+	}, func(s string) (interface{}, error) { return ids.ParseAccessModelImportID(s) })
+}
+
+func TestAccessCloudID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "aws:add-model:user-one,user-two", expected: ids.AccessCloudID{Cloud: "aws", Access: "add-model", Users: []string{"user-one", "user-two"}}, roundTrip: true},
+		{id: "aws:admin:user-one", expected: ids.AccessCloudID{Cloud: "aws", Access: "admin", Users: []string{"user-one"}}, roundTrip: true},
+		{id: "aws:admin"},
+		{id: "aws:admin:"},
+		{id: "aws:admin:user-one:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseAccessCloudID(s) })
+}
+
+func TestAccessControllerID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "login:user-one,user-two", expected: ids.AccessControllerID{Access: "login", Users: []string{"user-one", "user-two"}}, roundTrip: true},
+		{id: "superuser:user-one", expected: ids.AccessControllerID{Access: "superuser", Users: []string{"user-one"}}, roundTrip: true},
+		{id: "login"},
+		{id: "login:"},
+		{id: "login:user-one:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseAccessControllerID(s) })
+}
+
+func TestJaasAccessID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "aws:can_addmodel", expected: ids.JaasAccessID{Target: "aws", Access: "can_addmodel"}, roundTrip: true},
+		{id: "admin/db.mysql:consumer", expected: ids.JaasAccessID{Target: "admin/db.mysql", Access: "consumer"}, roundTrip: true},
+		{id: "mycontroller:admin/db.mysql:consumer", expected: ids.JaasAccessID{Target: "mycontroller:admin/db.mysql", Access: "consumer"}, roundTrip: true},
+		{id: "aws"},
+		{id: "aws:"},
+		{id: ":can_addmodel"},
+	}, func(s string) (interface{}, error) { return ids.ParseJaasAccessID(s) })
+}
+
+func TestSecretID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "testmodel:secret-name", expected: ids.SecretID{Model: "testmodel", Secret: "secret-name"}, roundTrip: true},
+		{id: "testmodel"},
+		{id: "testmodel:"},
+		{id: "testmodel:secret:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseSecretID(s) })
+}
+
+func TestSpaceID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "development:db-space", expected: ids.SpaceID{Model: "development", Space: "db-space"}, roundTrip: true},
+		{id: "development"},
+		{id: ":db-space"},
+		{id: "development:db-space:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseSpaceID(s) })
+}
+
+func TestSSHKeyID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "sshkey:development:dev-user@host", expected: ids.SSHKeyID{Model: "development", KeyIdentifier: "dev-user@host"}, roundTrip: true},
+		{id: "ssh_key:development:dev-user", expected: ids.SSHKeyID{Model: "development", KeyIdentifier: "dev-user"}},
+		{id: "key:development:dev-user"},
+		{id: "sshkey:development"},
+		{id: "sshkey::dev-user"},
+	}, func(s string) (interface{}, error) { return ids.ParseSSHKeyID(s) })
+}
+
+func TestStoragePoolID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "development:ebs-fast", expected: ids.StoragePoolID{Model: "development", Pool: "ebs-fast"}, roundTrip: true},
+		{id: "development"},
+		{id: "development:"},
+		{id: "development:ebs-fast:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseStoragePoolID(s) })
+}
+
+func TestUserID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "user:dev-user", expected: ids.UserID{Name: "dev-user"}, roundTrip: true},
+		{id: "dev-user"},
+		{id: "usr:dev-user"},
+		{id: "user:"},
+		{id: "user:dev:user"},
+	}, func(s string) (interface{}, error) { return ids.ParseUserID(s) })
+}
+
+func TestAnnotationID(t *testing.T) {
+	runCodecTests(t, []codecTest{
+		{id: "development:application-postgresql", expected: ids.AnnotationID{Model: "development", Entity: "application-postgresql"}, roundTrip: true},
+		{id: "development"},
+		{id: "development:"},
+		{id: "development:application-postgresql:extra"},
+	}, func(s string) (interface{}, error) { return ids.ParseAnnotationID(s) })
+}
+
+func TestMalformedIDError(t *testing.T) {
+	_, err := ids.ParseApplicationID("development")
+	expected := `ID "development" is malformed, expected 2 fields separated by ":", got 1, please use the format "<model>:<application>"`
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
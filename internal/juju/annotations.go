@@ -0,0 +1,112 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+
+	apiannotations "github.com/juju/juju/api/client/annotations"
+	"github.com/juju/juju/rpc/params"
+)
+
+// DescriptionAnnotation is the annotation holding the description of
+// models and applications.
+const DescriptionAnnotation = "description"
+
+var AnnotationEntityNotFoundError = &annotationEntityNotFoundError{}
+
+type annotationEntityNotFoundError struct {
+	entity string
+}
+
+func (ae *annotationEntityNotFoundError) Error() string {
+	return fmt.Sprintf("entity %q to annotate was not found", ae.entity)
+}
+
+type annotationsClient struct {
+	SharedClient
+}
+
+type SetAnnotationsInput struct {
+	ModelName string
+	// Entity is the tag of the annotated entity, e.g. application-postgresql.
+	Entity string
+	// Annotations are set on the entity, an empty value removes the
+	// annotation. Other annotations of the entity are left untouched.
+	Annotations map[string]string
+}
+
+type ReadAnnotationsInput struct {
+	ModelName string
+	Entity    string
+}
+
+type ReadAnnotationsResponse struct {
+	Annotations map[string]string
+}
+
+func newAnnotationsClient(sc SharedClient) *annotationsClient {
+	return &annotationsClient{
+		SharedClient: sc,
+	}
+}
+
+// SetAnnotations sets or removes annotations of an entity in a model.
+func (c *annotationsClient) SetAnnotations(ctx context.Context, input *SetAnnotationsInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apiannotations.NewClient(conn)
+	results, err := client.Set(map[string]map[string]string{input.Entity: input.Annotations})
+	if err != nil {
+		return err
+	}
+	// Only the failures are returned by the controller.
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+// ReadAnnotations returns all the annotations of an entity in a model.
+func (c *annotationsClient) ReadAnnotations(ctx context.Context, input *ReadAnnotationsInput) (*ReadAnnotationsResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apiannotations.NewClient(conn)
+	results, err := client.Get([]string{input.Entity})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected one result for entity %q, got %d", input.Entity, len(results))
+	}
+	if results[0].Error.Error != nil {
+		return nil, annotationsError(input.Entity, results[0].Error.Error)
+	}
+	annotations := results[0].Annotations
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	return &ReadAnnotationsResponse{Annotations: annotations}, nil
+}
+
+// annotationsError converts an error of the annotations facade reading
+// the given entity. Only entities which do not exist are reported as not
+// found, permission errors are returned unchanged.
+func annotationsError(entity string, err *params.Error) error {
+	if params.IsCodeNotFound(err) {
+		return &annotationEntityNotFoundError{entity: entity}
+	}
+	return err
+}
@@ -0,0 +1,22 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotationsError(t *testing.T) {
+	err := annotationsError("application-mysql", &params.Error{Code: params.CodeNotFound, Message: "not found"})
+	assert.True(t, errors.As(err, &AnnotationEntityNotFoundError))
+
+	// Permission errors are not hidden as missing entities.
+	err = annotationsError("application-mysql", &params.Error{Code: params.CodeUnauthorized, Message: "permission denied"})
+	assert.False(t, errors.As(err, &AnnotationEntityNotFoundError))
+	assert.True(t, params.IsCodeUnauthorized(err))
+}
@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
@@ -26,6 +27,7 @@ import (
 	jujuerrors "github.com/juju/errors"
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/api/base"
+	apiaction "github.com/juju/juju/api/client/action"
 	apiapplication "github.com/juju/juju/api/client/application"
 	apicharms "github.com/juju/juju/api/client/charms"
 	apiclient "github.com/juju/juju/api/client/client"
@@ -36,10 +38,13 @@ import (
 	"github.com/juju/juju/cmd/juju/application/utils"
 	resourcecmd "github.com/juju/juju/cmd/juju/resource"
 	corebase "github.com/juju/juju/core/base"
+	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
+	coreresources "github.com/juju/juju/core/resources"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/rpc/params"
 	jujustorage "github.com/juju/juju/storage"
@@ -83,10 +88,23 @@ func (e *retryReadError) Error() string {
 	return fmt.Sprintf("retrying: %s", e.msg)
 }
 
+var ActionFailedError = &actionFailedError{}
+
+// actionFailedError is returned when an action ran and did not complete,
+// e.g. it failed or was cancelled.
+type actionFailedError struct {
+	msg string
+}
+
+func (e *actionFailedError) Error() string {
+	return e.msg
+}
+
 type applicationsClient struct {
 	SharedClient
 	controllerVersion version.Number
 
+	getActionAPIClient      func(api.Connection) ActionAPIClient
 	getApplicationAPIClient func(base.APICallCloser) ApplicationAPIClient
 	getClientAPIClient      func(api.Connection) ClientAPIClient
 	getModelConfigAPIClient func(api.Connection) ModelConfigAPIClient
@@ -96,6 +114,9 @@ type applicationsClient struct {
 func newApplicationClient(sc SharedClient) *applicationsClient {
 	return &applicationsClient{
 		SharedClient: sc,
+		getActionAPIClient: func(conn api.Connection) ActionAPIClient {
+			return apiaction.NewClient(conn)
+		},
 		getApplicationAPIClient: func(closer base.APICallCloser) ApplicationAPIClient {
 			return apiapplication.NewClient(closer)
 		},
@@ -148,14 +169,50 @@ func ConfigEntryToString(input interface{}) string {
 	}
 }
 
+// ExposeModeAuto is the expose mode which only exposes the endpoints
+// the charm provides to other applications.
+const ExposeModeAuto = "auto"
+
+const (
+	// BaseSelectionLatestLTS selects the newest Ubuntu LTS base
+	// supported by the charm when no base is given.
+	BaseSelectionLatestLTS = "latest-lts"
+	// BaseSelectionCharmDefault selects the base suggested by the
+	// charm when no base is given, ignoring the model default base.
+	BaseSelectionCharmDefault = "charm-default"
+	// BaseSelectionExplicit requires a base or series to be given.
+	BaseSelectionExplicit = "explicit"
+)
+
+const (
+	// UpgradePolicyRefresh refreshes the charm of an application when
+	// its channel or revision changes.
+	UpgradePolicyRefresh = "refresh"
+	// UpgradePolicyForce refreshes the charm as UpgradePolicyRefresh
+	// does, forcing the refresh, as `juju refresh --force` does.
+	UpgradePolicyForce = "force"
+	// UpgradePolicyChannelOnly only sets the channel of the charm when
+	// it changes, keeping the current revision. The channel is used by
+	// future refreshes.
+	UpgradePolicyChannelOnly = "channel-only"
+)
+
 type CreateApplicationInput struct {
-	ApplicationName    string
-	ModelName          string
-	CharmName          string
-	CharmChannel       string
-	CharmBase          string
-	CharmSeries        string
-	CharmRevision      int
+	ApplicationName string
+	ModelName       string
+	CharmName       string
+	CharmChannel    string
+	CharmBase       string
+	CharmSeries     string
+	CharmRevision   int
+	// CharmPath is the path of a local charm, an archive or a
+	// directory, uploaded to the controller and deployed instead of a
+	// charm from Charmhub. CharmName defaults to the name of the charm.
+	CharmPath string
+	// BaseSelection is the policy used to select a base when neither
+	// CharmBase nor CharmSeries is set, one of the BaseSelection
+	// constants. If empty, the base is selected as juju would.
+	BaseSelection      string
 	Units              int
 	Trust              bool
 	Expose             map[string]interface{}
@@ -165,6 +222,24 @@ type CreateApplicationInput struct {
 	EndpointBindings   map[string]string
 	Resources          map[string]string
 	StorageConstraints map[string]jujustorage.Constraints
+	// ColocateWith is the name of an application whose machines
+	// are used to place the units.
+	ColocateWith string
+	// AntiAffinity are the names of applications whose machines
+	// the units must not be placed on.
+	AntiAffinity []string
+	// MapMachines maps the machine IDs used in the placement to the
+	// IDs of existing machines of the model.
+	MapMachines map[string]string
+	// UnitPlacement are placement directives for the units, in order:
+	// the first directive places the first unit and so on. Units
+	// without a directive are placed on new machines. Cannot be used
+	// with Placement.
+	UnitPlacement []string
+	// ReattachStorage attaches the storage retained when an application
+	// with the same name was destroyed to the first units, one unit for
+	// each unit the storage was attached to.
+	ReattachStorage bool
 }
 
 // validateAndTransform returns transformedCreateApplicationInput which
@@ -208,22 +283,26 @@ func (input CreateApplicationInput) validateAndTransform(conn api.Connection) (p
 			return
 		}
 	}
+	if input.BaseSelection == BaseSelectionExplicit && userSuppliedBase.Empty() {
+		err = fmt.Errorf("base selection %q requires a base or series", BaseSelectionExplicit)
+		return
+	}
 	parsed.charmBase = userSuppliedBase
 
-	placements := []*instance.Placement{}
-	if input.Placement == "" {
-		placements = nil
-	} else {
+	var placements []*instance.Placement
+	if len(input.UnitPlacement) > 0 {
+		placements, err = unitPlacements(conn, input.UnitPlacement, input.MapMachines, 0, input.Units)
+		if err != nil {
+			return parsed, err
+		}
+	} else if input.Placement != "" {
 		placementDirectives := strings.Split(input.Placement, ",")
 		// force this to be sorted
 		sort.Strings(placementDirectives)
 
-		for _, directive := range placementDirectives {
-			appPlacement, err := instance.ParsePlacement(directive)
-			if err != nil {
-				return parsed, err
-			}
-			placements = append(placements, appPlacement)
+		placements, err = parsePlacementDirectives(conn, placementDirectives)
+		if err != nil {
+			return parsed, err
 		}
 	}
 	parsed.placement = placements
@@ -279,6 +358,122 @@ type ReadApplicationInput struct {
 	AppName   string
 }
 
+type RunLeaderActionInput struct {
+	ModelName  string
+	AppName    string
+	ActionName string
+	// Params are parsed as YAML scalars, like `juju run` does, so that
+	// numbers and booleans reach the charm with their type.
+	Params map[string]string
+	// Timeout defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+type RunActionInput struct {
+	ModelName string
+	AppName   string
+	// Units are the names of the units to run the action on. The
+	// action runs on the leader unit of the application if empty.
+	Units      []string
+	ActionName string
+	// Params are parsed as YAML scalars, like `juju run` does, so that
+	// numbers and booleans reach the charm with their type.
+	Params map[string]string
+	// Timeout defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+type RunActionResponse struct {
+	OperationID string
+	// Results are the results of the action on each unit, in the
+	// order of the units.
+	Results []ActionResult
+}
+
+// ActionResult is the result of an action run on a unit.
+type ActionResult struct {
+	Unit   string
+	Status string
+	// Output is the output of the action flattened, the keys of
+	// nested values are joined with dots, e.g. `result.password`.
+	Output map[string]string
+}
+
+type ReadStatusHistoryInput struct {
+	ModelName string
+	AppName   string
+	// UnitName selects the history of a single unit of the
+	// application instead of the application itself.
+	UnitName string
+	// Kind is the kind of status to read, it defaults to
+	// "application" for an application and "unit" for a unit.
+	Kind string
+	// Size is the maximum number of entries to return.
+	Size int
+}
+
+// StatusHistoryEntry is a single status change of an application or
+// unit.
+type StatusHistoryEntry struct {
+	Kind    string
+	Status  string
+	Message string
+	Since   time.Time
+}
+
+type ReadStatusHistoryResponse struct {
+	// Entries are sorted from the most recent to the oldest.
+	Entries []StatusHistoryEntry
+}
+
+// UnitStatusDetail holds the status and addresses of a unit.
+type UnitStatusDetail struct {
+	Name            string
+	Machine         string
+	Leader          bool
+	WorkloadStatus  string
+	WorkloadMessage string
+	AgentStatus     string
+	PublicAddress   string
+	// PrivateAddress is the address of the pod of the unit in
+	// kubernetes models, the first address of its machine otherwise.
+	PrivateAddress string
+	OpenPorts      []string
+}
+
+type ReadApplicationStatusResponse struct {
+	Status  string
+	Message string
+	// Units are sorted by name.
+	Units []UnitStatusDetail
+}
+
+type ListApplicationsInput struct {
+	ModelName string
+	// NamePrefix only lists the applications whose name starts with it,
+	// if set.
+	NamePrefix string
+	// CharmName only lists the applications of the charm, if set.
+	CharmName string
+}
+
+// ApplicationSummary is an application listed by ListApplications.
+type ApplicationSummary struct {
+	Name        string
+	CharmName   string
+	Channel     string
+	Revision    int
+	Status      string
+	Subordinate bool
+	Exposed     bool
+	Units       int
+}
+
+type ListApplicationsResponse struct {
+	// Applications are sorted by name.
+	Applications []ApplicationSummary
+}
+
 type ReadApplicationResponse struct {
 	Name             string
 	Channel          string
@@ -294,7 +489,21 @@ type ReadApplicationResponse struct {
 	Placement        string
 	EndpointBindings map[string]string
 	Storage          map[string]jujustorage.Constraints
-	Resources        map[string]string
+	// Resources are the revisions of the resources fetched from
+	// Charmhub.
+	Resources map[string]string
+	// UploadedResources are the sorted names of the resources uploaded
+	// to the controller, from a file or an OCI image, which have no
+	// meaningful revision.
+	UploadedResources []string
+	// MinUnitAgentVersion and MaxUnitAgentVersion are the lowest and
+	// highest versions of the agents of the units, empty if no agent
+	// reported its version yet.
+	MinUnitAgentVersion string
+	MaxUnitAgentVersion string
+	// AgentUpgradePending is true if an agent of the units does not run
+	// the agent version of the model yet.
+	AgentUpgradePending bool
 }
 
 type UpdateApplicationInput struct {
@@ -304,8 +513,14 @@ type UpdateApplicationInput struct {
 	Units     *int
 	Revision  *int
 	Channel   string
-	Trust     *bool
-	Expose    map[string]interface{}
+	// CharmPath is the path of a local charm to upload and refresh the
+	// application to.
+	CharmPath string
+	// UpgradePolicy is how the charm is refreshed, one of the
+	// UpgradePolicy constants. It defaults to UpgradePolicyRefresh.
+	UpgradePolicy string
+	Trust         *bool
+	Expose        map[string]interface{}
 	// Unexpose indicates what endpoints to unexpose
 	Unexpose []string
 	Config   map[string]string
@@ -315,11 +530,68 @@ type UpdateApplicationInput struct {
 	EndpointBindings   map[string]string
 	StorageConstraints map[string]jujustorage.Constraints
 	Resources          map[string]string
+	// UnitPlacement are the placement directives of the units in
+	// order, see CreateApplicationInput. Added units are placed with
+	// the directives following those of the existing units.
+	UnitPlacement []string
+	// MapMachines maps the machine IDs used in UnitPlacement to the
+	// IDs of existing machines of the model.
+	MapMachines map[string]string
+}
+
+// WaitForApplicationReadyInput describes the application to wait for.
+type WaitForApplicationReadyInput struct {
+	ModelName string
+	AppName   string
+	// Units is the number of units expected to be ready. In
+	// kubernetes models the scale of the application is used
+	// instead.
+	Units int
+	// Subordinate applications have no units of their own, Units is
+	// ignored and the subordinate units deployed alongside the units
+	// of their principal applications are waited for.
+	Subordinate bool
+	// Active also waits for the workload of every unit to be active.
+	Active bool
+	// Timeout is how long to wait for, 20 minutes if not set.
+	Timeout time.Duration
+}
+
+type ReadCharmConfigOptionsInput struct {
+	ModelName string
+	AppName   string
+	// Revision is the charm revision the application is being
+	// refreshed to, if any.
+	Revision *int
+}
+
+// ResolveCharmInput describes a charm from Charmhub to resolve.
+type ResolveCharmInput struct {
+	ModelName string
+	CharmName string
+	Channel   string
+	// Revision is the revision of the charm, UnspecifiedRevision for
+	// the latest revision in the channel.
+	Revision int
+	// Base is the base the charm is deployed on, empty for any base
+	// supported by the charm.
+	Base string
+}
+
+// CharmConfigOption describes a config option defined by a charm.
+type CharmConfigOption struct {
+	Type        string
+	Description string
 }
 
 type DestroyApplicationInput struct {
 	ApplicationName string
 	ModelName       string
+	// RetainStorage detaches the persistent storage of the units rather
+	// than destroying it, and records it in an annotation of the model
+	// so that an application created with the same name and
+	// ReattachStorage can attach it.
+	RetainStorage bool
 }
 
 func resolveCharmURL(charmName string) (*charm.URL, error) {
@@ -336,28 +608,87 @@ func resolveCharmURL(charmName string) (*charm.URL, error) {
 }
 
 func (c applicationsClient) CreateApplication(ctx context.Context, input *CreateApplicationInput) (*CreateApplicationResponse, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = conn.Close() }()
 
+	if len(input.MapMachines) > 0 {
+		placement, err := MapPlacementMachines(input.Placement, input.MapMachines)
+		if err != nil {
+			return nil, err
+		}
+		withPlacement := *input
+		withPlacement.Placement = placement
+		input = &withPlacement
+	}
+
+	if input.ColocateWith != "" || len(input.AntiAffinity) > 0 {
+		placement, err := c.computeAffinityPlacement(conn, input)
+		if err != nil {
+			return nil, err
+		}
+		withPlacement := *input
+		withPlacement.Placement = placement
+		input = &withPlacement
+	}
+
+	var localCharm charm.Charm
+	if input.CharmPath != "" {
+		localCharm, err = charm.ReadCharm(input.CharmPath)
+		if err != nil {
+			return nil, jujuerrors.Annotatef(err, "reading local charm %q", input.CharmPath)
+		}
+		if input.CharmName == "" {
+			withName := *input
+			withName.CharmName = localCharm.Meta().Name
+			input = &withName
+		}
+	}
+
 	transformedInput, err := input.validateAndTransform(conn)
 	if err != nil {
 		return nil, err
 	}
 
+	if localCharm == nil && transformedInput.charmBase.Empty() &&
+		(input.BaseSelection == BaseSelectionLatestLTS || input.BaseSelection == BaseSelectionCharmDefault) {
+		transformedInput.charmBase, err = c.selectBase(conn, transformedInput, input.BaseSelection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The units reattaching retained storage are added one by one once
+	// the application is deployed, each with its placement directive.
+	var reattach, leftover [][]string
+	var reattachPlacement []*instance.Placement
+	if input.ReattachStorage {
+		retained, err := readRetainedStorage(conn, transformedInput.applicationName)
+		if err != nil {
+			return nil, err
+		}
+		count := min(len(retained), transformedInput.units)
+		reattach, leftover = retained[:count], retained[count:]
+		transformedInput.units -= count
+		if len(transformedInput.placement) > transformedInput.units {
+			reattachPlacement = transformedInput.placement[transformedInput.units:]
+			transformedInput.placement = transformedInput.placement[:transformedInput.units]
+		}
+	}
+
 	applicationAPIClient := apiapplication.NewClient(conn)
 	resourceAPIClient, err := apiresources.NewClient(conn)
 	if err != nil {
 		return nil, err
 	}
-	if applicationAPIClient.BestAPIVersion() >= 19 {
-		err := c.deployFromRepository(applicationAPIClient, resourceAPIClient, transformedInput)
-		if err != nil {
-			return nil, err
-		}
-	} else {
+	switch {
+	case localCharm != nil:
+		err = c.deployLocalCharm(conn, applicationAPIClient, transformedInput, input.CharmPath, localCharm, input.BaseSelection)
+	case applicationAPIClient.BestAPIVersion() >= 19:
+		err = c.deployFromRepository(applicationAPIClient, resourceAPIClient, transformedInput)
+	default:
 		err = c.legacyDeploy(ctx, conn, applicationAPIClient, transformedInput)
 		err = jujuerrors.Annotate(err, "legacy deploy method")
 	}
@@ -365,15 +696,216 @@ func (c applicationsClient) CreateApplication(ctx context.Context, input *Create
 		return nil, err
 	}
 
+	if len(reattach) > 0 {
+		err = c.reattachStorage(ctx, conn, applicationAPIClient, transformedInput.applicationName, reattach, reattachPlacement)
+		if err != nil {
+			return nil, err
+		}
+		// Storage retained for more units than created stays detached,
+		// it is kept in the annotation so that it can be found.
+		err = setModelAnnotation(conn, retainedStorageAnnotation(transformedInput.applicationName), encodeRetainedStorage(leftover))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// If we have managed to deploy something, now we have
 	// to check if we have to expose something
-	err = c.processExpose(applicationAPIClient, transformedInput.applicationName, transformedInput.expose)
+	err = c.processExpose(conn, applicationAPIClient, transformedInput.applicationName, transformedInput.expose)
 
 	return &CreateApplicationResponse{
 		AppName: transformedInput.applicationName,
 	}, err
 }
 
+// computeAffinityPlacement returns the placement directives of the
+// application to create, taking into account the applications it
+// must be colocated with or kept apart from. The units of colocated
+// applications are placed on the machines of the given application.
+// A placement targeting a machine, or a container on a machine, hosting
+// a unit of an anti-affinity application is rejected.
+func (c applicationsClient) computeAffinityPlacement(conn api.Connection, input *CreateApplicationInput) (string, error) {
+	if input.ColocateWith != "" && input.Placement != "" {
+		return "", errors.New("colocate with and placement cannot be used together")
+	}
+
+	status, err := c.getClientAPIClient(conn).Status(nil)
+	if err != nil {
+		return "", err
+	}
+
+	placement := input.Placement
+	if input.ColocateWith != "" {
+		machines, err := applicationMachines(status, input.ColocateWith)
+		if err != nil {
+			return "", err
+		}
+		if len(machines) == 0 {
+			return "", fmt.Errorf("application %q to colocate with has no machines", input.ColocateWith)
+		}
+		placement = strings.Join(machines, ",")
+	}
+	if placement == "" || len(input.AntiAffinity) == 0 {
+		return placement, nil
+	}
+
+	avoid := set.NewStrings()
+	for _, appName := range input.AntiAffinity {
+		machines, err := applicationMachines(status, appName)
+		if err != nil {
+			return "", err
+		}
+		avoid = avoid.Union(set.NewStrings(machines...))
+	}
+	for _, directive := range strings.Split(placement, ",") {
+		p, err := instance.ParsePlacement(directive)
+		if err != nil {
+			return "", err
+		}
+		// A new container on a machine, or an existing container,
+		// shares the host machine with its units.
+		host := strings.Split(p.Directive, "/")[0]
+		if avoid.Contains(p.Directive) || avoid.Contains(host) {
+			return "", fmt.Errorf("placement %q conflicts with the anti affinity applications %s",
+				directive, strings.Join(input.AntiAffinity, ", "))
+		}
+	}
+	return placement, nil
+}
+
+// MapPlacementMachines returns the placement directives with the machines
+// they target, or the machines hosting the containers they target,
+// replaced following the given mapping of machine IDs, like
+// `juju deploy --map-machines` does for the machines of a bundle.
+// Machines missing from the mapping are kept. Mapping several machines
+// to the same machine is an error, so that the mapping can be reversed.
+func MapPlacementMachines(placement string, mapMachines map[string]string) (string, error) {
+	mappedFrom := make(map[string]string, len(mapMachines))
+	for from, to := range mapMachines {
+		if other, ok := mappedFrom[to]; ok {
+			if other > from {
+				other, from = from, other
+			}
+			return "", fmt.Errorf("machines %q and %q are both mapped to machine %q", other, from, to)
+		}
+		mappedFrom[to] = from
+	}
+	if placement == "" || len(mapMachines) == 0 {
+		return placement, nil
+	}
+
+	directives := strings.Split(placement, ",")
+	for i, directive := range directives {
+		p, err := instance.ParsePlacement(directive)
+		if errors.Is(err, instance.ErrPlacementScopeMissing) {
+			// A directive for the provider, e.g. zone=us-east-1a.
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		switch {
+		case p.Scope == instance.MachineScope:
+			directives[i] = mapMachineID(p.Directive, mapMachines)
+		case p.Directive != "" && names.IsValidMachine(p.Directive):
+			// A new container on an existing machine, e.g. lxd:1.
+			directives[i] = p.Scope + ":" + mapMachineID(p.Directive, mapMachines)
+		}
+	}
+	return strings.Join(directives, ","), nil
+}
+
+// parsePlacementDirectives parses placement directives as juju deploy
+// --to does. Directives without a scope, e.g. zone=us-east-1a, are for
+// the provider of the model the connection is to.
+func parsePlacementDirectives(conn api.Connection, directives []string) ([]*instance.Placement, error) {
+	placements := make([]*instance.Placement, 0, len(directives))
+	for _, directive := range directives {
+		placement, err := instance.ParsePlacement(directive)
+		if errors.Is(err, instance.ErrPlacementScopeMissing) {
+			modelTag, ok := conn.ModelTag()
+			if !ok {
+				return nil, errors.New("placement directive for the provider requires a model connection")
+			}
+			placement = &instance.Placement{Scope: modelTag.Id(), Directive: directive}
+		} else if err != nil {
+			return nil, err
+		}
+		placements = append(placements, placement)
+	}
+	return placements, nil
+}
+
+// unitPlacements returns the parsed placement directives of count units
+// starting with the unit at index from, given the placement directives
+// of every unit in order. Machines are mapped with mapMachines first.
+// Fewer placements than units are returned when the directives run
+// out, the remaining units are placed on new machines.
+func unitPlacements(conn api.Connection, directives []string, mapMachines map[string]string, from, count int) ([]*instance.Placement, error) {
+	if from >= len(directives) || count <= 0 {
+		return nil, nil
+	}
+	directives = directives[from:min(from+count, len(directives))]
+	if len(mapMachines) > 0 {
+		mapped, err := MapPlacementMachines(strings.Join(directives, ","), mapMachines)
+		if err != nil {
+			return nil, err
+		}
+		directives = strings.Split(mapped, ",")
+	}
+	return parsePlacementDirectives(conn, directives)
+}
+
+// mapMachineID returns the mapped ID of a machine, or of a container
+// whose host machine is mapped.
+func mapMachineID(id string, mapMachines map[string]string) string {
+	host, container, isContainer := strings.Cut(id, "/")
+	if mapped, ok := mapMachines[host]; ok {
+		host = mapped
+	}
+	if isContainer {
+		return host + "/" + container
+	}
+	return host
+}
+
+// subordinateUnits returns the units of a subordinate application, found
+// among the subordinates of the units of the principal applications. The
+// machine of a subordinate unit is the machine of its principal unit.
+func subordinateUnits(status *params.FullStatus, appName string) map[string]params.UnitStatus {
+	units := make(map[string]params.UnitStatus)
+	for _, app := range status.Applications {
+		for _, principal := range app.Units {
+			for name, unit := range principal.Subordinates {
+				unitAppName, err := names.UnitApplication(name)
+				if err != nil || unitAppName != appName {
+					continue
+				}
+				if unit.Machine == "" {
+					unit.Machine = principal.Machine
+				}
+				units[name] = unit
+			}
+		}
+	}
+	return units
+}
+
+// applicationMachines returns the sorted IDs of the machines hosting
+// the units of the application.
+func applicationMachines(status *params.FullStatus, appName string) ([]string, error) {
+	appStatus, ok := status.Applications[appName]
+	if !ok {
+		return nil, jujuerrors.NotFoundf("application %q", appName)
+	}
+	machines := set.NewStrings()
+	for _, unit := range appStatus.Units {
+		if unit.Machine != "" {
+			machines.Add(unit.Machine)
+		}
+	}
+	return machines.SortedValues(), nil
+}
+
 func (c applicationsClient) deployFromRepository(applicationAPIClient ApplicationAPIClient, resourceAPIClient ResourceAPIClient, transformedInput transformedCreateApplicationInput) error {
 	settingsForYaml := map[interface{}]interface{}{transformedInput.applicationName: transformedInput.config}
 	configYaml, err := goyaml.Marshal(settingsForYaml)
@@ -411,6 +943,104 @@ func (c applicationsClient) deployFromRepository(applicationAPIClient Applicatio
 	return nil
 }
 
+// deployLocalCharm uploads the charm read from a local path to the
+// controller and deploys it, as `juju deploy ./my.charm` does. The
+// resources of a local charm cannot be fetched from Charmhub, they must
+// all be provided, as files or OCI images.
+func (c applicationsClient) deployLocalCharm(conn api.Connection, applicationAPIClient *apiapplication.Client, transformedInput transformedCreateApplicationInput, charmPath string, ch charm.Charm, selection string) error {
+	// Version needed for operating system selection and the upload.
+	c.controllerVersion, _ = conn.ServerVersion()
+
+	charmsAPIClient := apicharms.NewClient(conn)
+	modelconfigAPIClient := apimodelconfig.NewClient(conn)
+
+	for name := range ch.Meta().Resources {
+		if _, ok := transformedInput.resources[name]; !ok {
+			return fmt.Errorf("resource %q of local charm %q must be provided", name, ch.Meta().Name)
+		}
+	}
+
+	charmBases, err := corecharm.ComputedBases(ch)
+	if err != nil {
+		return err
+	}
+	baseToUse, err := c.baseToUse(modelconfigAPIClient, transformedInput.charmBase, corebase.Base{}, charmBases, selection)
+	if err != nil {
+		return err
+	}
+	ch, curl, err := newLocalCharm(charmPath, baseToUse)
+	if err != nil {
+		return err
+	}
+
+	localCharmClient, err := apicharms.NewLocalCharmClient(conn)
+	if err != nil {
+		return err
+	}
+	curl, err = localCharmClient.AddLocalCharm(curl, ch, false, c.controllerVersion)
+	if err != nil {
+		return typedError(err)
+	}
+	c.Tracef("AddLocalCharm returned", map[string]interface{}{"url": curl.String()})
+
+	platformCons, err := modelconfigAPIClient.GetModelConstraints()
+	if err != nil {
+		return err
+	}
+	platform := utils.MakePlatform(transformedInput.constraints, baseToUse, platformCons)
+	// Local charms don't need a channel.
+	origin, err := utils.MakeOrigin(charm.Local, curl.Revision, charm.Channel{}, platform)
+	if err != nil {
+		return err
+	}
+	charmID := apiapplication.CharmID{
+		URL:    curl.String(),
+		Origin: origin,
+	}
+
+	resources, err := c.processResources(charmsAPIClient, conn, charmID, transformedInput.applicationName, transformedInput.resources)
+	if err != nil {
+		return err
+	}
+
+	numUnits := transformedInput.units
+	if ch.Meta().Subordinate {
+		numUnits = 0
+	}
+	appConfig := make(map[string]string, len(transformedInput.config)+1)
+	for k, v := range transformedInput.config {
+		appConfig[k] = v
+	}
+	appConfig["trust"] = fmt.Sprintf("%v", transformedInput.trust)
+
+	args := apiapplication.DeployArgs{
+		CharmID:          charmID,
+		ApplicationName:  transformedInput.applicationName,
+		NumUnits:         numUnits,
+		CharmOrigin:      origin,
+		Config:           appConfig,
+		Cons:             transformedInput.constraints,
+		Resources:        resources,
+		Storage:          transformedInput.storage,
+		Placement:        transformedInput.placement,
+		EndpointBindings: transformedInput.endpointBindings,
+	}
+	c.Tracef("Calling Deploy", map[string]interface{}{"args": args})
+	return typedError(applicationAPIClient.Deploy(args))
+}
+
+// newLocalCharm reads the charm at the given path and returns it with
+// its local charm URL for the given base, which has been checked
+// against the bases of the charm.
+func newLocalCharm(charmPath string, b corebase.Base) (charm.Charm, *charm.URL, error) {
+	// Relative paths must start with a dot to be read as a charm.
+	charmPath, err := filepath.Abs(charmPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return corecharm.NewCharmAtPathForceBase(charmPath, b, true)
+}
+
 // TODO (hml) 23-Feb-2024
 // Remove the functionality associated with legacyDeploy
 // once the provider no longer supports a version of juju
@@ -478,7 +1108,7 @@ func (c applicationsClient) legacyDeploy(ctx context.Context, conn api.Connectio
 	}
 	c.Tracef("resolveCharm returned", map[string]interface{}{"resolvedURL": resolvedURL, "resolvedOrigin": resolvedOrigin, "supportedBases": supportedBases})
 
-	baseToUse, err := c.baseToUse(modelconfigAPIClient, userSuppliedBase, resolvedOrigin.Base, supportedBases)
+	baseToUse, err := c.baseToUse(modelconfigAPIClient, userSuppliedBase, resolvedOrigin.Base, supportedBases, "")
 	if err != nil {
 		c.Warnf("failed to get a suggested operating system from resolved charm response", map[string]interface{}{"err": err})
 	}
@@ -539,10 +1169,21 @@ func (c applicationsClient) legacyDeploy(ctx context.Context, conn api.Connectio
 				return err
 			}
 
+			// Unlike DeployFromRepository, Deploy refuses units for a
+			// subordinate application.
+			numUnits := transformedInput.units
+			charmInfo, err := charmsAPIClient.CharmInfo(charmID.URL)
+			if err != nil {
+				return err
+			}
+			if charmInfo.Meta != nil && charmInfo.Meta.Subordinate {
+				numUnits = 0
+			}
+
 			args := apiapplication.DeployArgs{
 				CharmID:          charmID,
 				ApplicationName:  transformedInput.applicationName,
-				NumUnits:         transformedInput.units,
+				NumUnits:         numUnits,
 				CharmOrigin:      resultOrigin,
 				Config:           appConfig,
 				Cons:             transformedInput.constraints,
@@ -608,6 +1249,9 @@ func (c applicationsClient) supportedWorkloadBase(imageStream string) ([]corebas
 //   - A user specified base must be supported by the charm and a valid juju
 //     supported workload base. If so, use that, otherwise if an input base
 //     is provided, return an error.
+//   - If the selection is BaseSelectionLatestLTS, use the newest supported
+//     Ubuntu LTS base. If the selection is BaseSelectionCharmDefault, use the
+//     suggested base if supported.
 //   - Next check DefaultBase from model config. If explicitly defined by the
 //     user, check against charm and juju supported workloads. Use that if in
 //     both lists.
@@ -621,8 +1265,8 @@ func (c applicationsClient) supportedWorkloadBase(imageStream string) ([]corebas
 //
 // Note, we are re-implementing the logic of base_selector in juju code as it's
 // a private object.
-func (c applicationsClient) baseToUse(modelconfigAPIClient *apimodelconfig.Client, inputBase, suggestedBase corebase.Base, charmBases []corebase.Base) (corebase.Base, error) {
-	c.Tracef("baseToUse", map[string]interface{}{"inputBase": inputBase, "suggestedBase": suggestedBase, "charmBases": charmBases})
+func (c applicationsClient) baseToUse(modelconfigAPIClient *apimodelconfig.Client, inputBase, suggestedBase corebase.Base, charmBases []corebase.Base, selection string) (corebase.Base, error) {
+	c.Tracef("baseToUse", map[string]interface{}{"inputBase": inputBase, "suggestedBase": suggestedBase, "charmBases": charmBases, "selection": selection})
 
 	attrs, err := modelconfigAPIClient.ModelGet()
 	if err != nil {
@@ -655,6 +1299,17 @@ func (c applicationsClient) baseToUse(modelconfigAPIClient *apimodelconfig.Clien
 			fmt.Sprintf("base %q either not supported by the charm, or an unsupported juju workload base with the current version of juju.", inputBase))
 	}
 
+	switch selection {
+	case BaseSelectionLatestLTS:
+		if lts, ok := latestLTSBase(supportedBases); ok {
+			return lts, nil
+		}
+	case BaseSelectionCharmDefault:
+		if basesContain(suggestedBase, supportedBases) {
+			return suggestedBase, nil
+		}
+	}
+
 	// If a default base is explicitly defined for the model,
 	// use that if a supportedBase.
 	defaultBaseString, explicit := modelConfig.DefaultBase()
@@ -683,12 +1338,48 @@ func (c applicationsClient) baseToUse(modelconfigAPIClient *apimodelconfig.Clien
 	return supportedBases[0], nil
 }
 
+// selectBase resolves the charm to find the bases it supports and
+// selects one of them according to the given base selection policy.
+func (c applicationsClient) selectBase(conn api.Connection, transformedInput transformedCreateApplicationInput, selection string) (corebase.Base, error) {
+	// Version needed for operating system selection.
+	c.controllerVersion, _ = conn.ServerVersion()
+
+	charmsAPIClient := apicharms.NewClient(conn)
+	modelconfigAPIClient := apimodelconfig.NewClient(conn)
+
+	channel, err := charm.ParseChannel(transformedInput.charmChannel)
+	if err != nil {
+		return corebase.Base{}, err
+	}
+	charmURL, err := resolveCharmURL(transformedInput.charmName)
+	if err != nil {
+		return corebase.Base{}, err
+	}
+	platformCons, err := modelconfigAPIClient.GetModelConstraints()
+	if err != nil {
+		return corebase.Base{}, err
+	}
+	platform := utils.MakePlatform(transformedInput.constraints, corebase.Base{}, platformCons)
+	origin, err := utils.MakeOrigin(charm.Schema(charmURL.Schema), transformedInput.charmRevision, channel, platform)
+	if err != nil {
+		return corebase.Base{}, err
+	}
+	_, resolvedOrigin, supportedBases, err := resolveCharm(charmsAPIClient, charmURL, origin)
+	if err != nil {
+		return corebase.Base{}, err
+	}
+	c.Tracef("selectBase resolved charm", map[string]interface{}{"resolvedOrigin": resolvedOrigin, "supportedBases": supportedBases, "selection": selection})
+
+	return c.baseToUse(modelconfigAPIClient, corebase.Base{}, resolvedOrigin.Base, supportedBases, selection)
+}
+
 // processExpose is a local function that executes an exposed request.
 // If the exposeConfig argument is nil it simply exits. If not,
 // an exposed request is done populating the request arguments with
 // the endpoints, spaces, and cidrs contained in the exposeConfig
-// map.
-func (c applicationsClient) processExpose(applicationAPIClient ApplicationAPIClient, applicationName string, expose map[string]interface{}) error {
+// map. If the mode is "auto", the endpoints are the ones the charm
+// provides to other applications.
+func (c applicationsClient) processExpose(conn base.APICallCloser, applicationAPIClient ApplicationAPIClient, applicationName string, expose map[string]interface{}) error {
 	// nothing to do
 	if expose == nil {
 		return nil
@@ -709,7 +1400,18 @@ func (c applicationsClient) processExpose(applicationAPIClient ApplicationAPICli
 	listSpaces := splitCommaDelimitedList(exposeConfig["spaces"])
 	listCIDRs := splitCommaDelimitedList(exposeConfig["cidrs"])
 
-	if len(listEndpoints)+len(listSpaces)+len(listCIDRs) == 0 {
+	if exposeConfig["mode"] == ExposeModeAuto {
+		endpoints, err := c.charmIngressEndpoints(conn, applicationAPIClient, applicationName)
+		if err != nil {
+			return err
+		}
+		if len(endpoints) == 0 {
+			return fmt.Errorf("charm of application %q does not provide any endpoint to expose", applicationName)
+		}
+		listEndpoints = endpoints
+	}
+
+	if len(listEndpoints)+len(listSpaces)+len(listCIDRs) == 0 {
 		c.Tracef(fmt.Sprintf("call expose application [%s]", applicationName))
 		return applicationAPIClient.Expose(applicationName, nil)
 	}
@@ -732,6 +1434,33 @@ func (c applicationsClient) processExpose(applicationAPIClient ApplicationAPICli
 	return applicationAPIClient.Expose(applicationName, requestParams)
 }
 
+// charmIngressEndpoints returns the endpoints the charm of the application
+// provides to other applications, sorted by name. Container scoped
+// endpoints and the implicit juju-info endpoint are internal to the
+// model and are left out.
+func (c applicationsClient) charmIngressEndpoints(conn base.APICallCloser, applicationAPIClient ApplicationAPIClient, applicationName string) ([]string, error) {
+	charmURL, _, err := applicationAPIClient.GetCharmURLOrigin("", applicationName)
+	if err != nil {
+		return nil, err
+	}
+	charmInfo, err := apicharms.NewClient(conn).CharmInfo(charmURL.String())
+	if err != nil {
+		return nil, err
+	}
+	if charmInfo.Meta == nil {
+		return nil, nil
+	}
+	endpoints := make([]string, 0, len(charmInfo.Meta.Provides))
+	for name, relation := range charmInfo.Meta.Provides {
+		if relation.Scope == charm.ScopeContainer || relation.Interface == "juju-info" {
+			continue
+		}
+		endpoints = append(endpoints, name)
+	}
+	sort.Strings(endpoints)
+	return endpoints, nil
+}
+
 func splitCommaDelimitedList(list string) []string {
 	items := make([]string, 0)
 	for _, token := range strings.Split(list, ",") {
@@ -777,7 +1506,7 @@ func (c applicationsClient) ReadApplicationWithRetryOnNotFound(ctx context.Conte
 	retryErr := retry.Call(retry.CallArgs{
 		Func: func() error {
 			var err error
-			output, err = c.ReadApplication(input)
+			output, err = c.ReadApplication(ctx, input)
 			if errors.As(err, &ApplicationNotFoundError) || errors.As(err, &StorageNotFoundError) {
 				return err
 			} else if err != nil {
@@ -902,8 +1631,8 @@ func getStorageLabel(storageTag string) string {
 	return strings.TrimSuffix(strings.TrimPrefix(storageTag, PrefixStorage), "-0")
 }
 
-func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadApplicationResponse, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c applicationsClient) ReadApplication(ctx context.Context, input *ReadApplicationInput) (*ReadApplicationResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, err
 	}
@@ -957,8 +1686,15 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 
 	storages := c.transformToStorageConstraints(status.Storage, status.Filesystems, status.Volumes)
 
+	// The units of a subordinate application are deployed alongside
+	// the units of the principal applications it is related to.
+	units := appStatus.Units
+	if !appInfo.Principal {
+		units = subordinateUnits(status, input.AppName)
+	}
+
 	allocatedMachines := set.NewStrings()
-	for _, v := range appStatus.Units {
+	for _, v := range units {
 		if v.Machine != "" {
 			allocatedMachines.Add(v.Machine)
 		}
@@ -969,7 +1705,7 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 		placement = strings.Join(allocatedMachines.SortedValues(), ",")
 	}
 
-	unitCount := len(appStatus.Units)
+	unitCount := len(units)
 	// if we have a CAAS we use scale instead of units length
 	modelType, err := c.ModelType(input.ModelName)
 	if err != nil {
@@ -1021,48 +1757,12 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 	}
 
 	// trust field which has to be included into the configuration
-	trustValue := false
-	if returnedConf.ApplicationConfig != nil {
-		aux, found := returnedConf.ApplicationConfig["trust"]
-		if found {
-			m := aux.(map[string]any)
-			target, found := m["value"]
-			if found {
-				trustValue = target.(bool)
-			}
-		}
+	trustValue, err := trustFromConfig(returnedConf.ApplicationConfig)
+	if err != nil {
+		return nil, err
 	}
 
-	// the expose field requires additional logic because
-	// the API returns populated cidrs by default. Additionally,
-	// we populate the unexpose field in the response structure
-	// to indicate endpoints that has to be removed by comparing
-	var exposed map[string]interface{} = nil
-	if appStatus.Exposed {
-		// rebuild
-		exposed = make(map[string]interface{}, 0)
-		endpoints := []string{""}
-		spaces := ""
-		cidrs := ""
-		for epName, value := range appStatus.ExposedEndpoints {
-			if epName != "" {
-				endpoints = append(endpoints, epName)
-			}
-			if len(spaces) == 0 {
-				spaces = strings.Join(value.ExposeToSpaces, ",")
-			}
-			if len(cidrs) == 0 {
-				// by default the API sets
-				// cidrs: "0.0.0.0/0,::/0"
-				// ignore them
-				aux := removeDefaultCidrs(value.ExposeToCIDRs)
-				cidrs = strings.Join(aux, ",")
-			}
-		}
-		exposed["endpoints"] = strings.Join(endpoints, ",")
-		exposed["spaces"] = spaces
-		exposed["cidrs"] = cidrs
-	}
+	exposed := exposeFromStatus(appStatus)
 	// ParseChannel to send back a base without the risk.
 	// Having the risk will cause issues with the provider
 	// saving a different value than the user did.
@@ -1102,34 +1802,634 @@ func (c applicationsClient) ReadApplication(input *ReadApplicationInput) (*ReadA
 	if err != nil {
 		return nil, jujuerrors.Annotate(err, "failed to list application resources")
 	}
-	usedResources := make(map[string]string)
-	for _, iResources := range resources {
-		for _, resource := range iResources.Resources {
-			usedResources[resource.Name] = strconv.Itoa(resource.Revision)
+	usedResources, uploadedResources := resourcesFromApplication(resources)
+	minAgentVersion, maxAgentVersion, upgradePending := unitAgentVersions(units, status.Model.Version)
+
+	response := &ReadApplicationResponse{
+		Name:              charmURL.Name,
+		Channel:           appInfo.Channel,
+		Revision:          charmURL.Revision,
+		Base:              fmt.Sprintf("%s@%s", appInfo.Base.Name, baseChannel.Track),
+		Series:            seriesString,
+		Units:             unitCount,
+		Trust:             trustValue,
+		Expose:            exposed,
+		Config:            conf,
+		Constraints:       appInfo.Constraints,
+		Principal:         appInfo.Principal,
+		Placement:         placement,
+		EndpointBindings:  endpointBindings,
+		Storage:           storages,
+		Resources:         usedResources,
+		UploadedResources: uploadedResources,
+
+		MinUnitAgentVersion: minAgentVersion,
+		MaxUnitAgentVersion: maxAgentVersion,
+		AgentUpgradePending: upgradePending,
+	}
+
+	return response, nil
+}
+
+// unitAgentVersions returns the lowest and highest versions of the agents
+// of the units, and whether one of them does not run the agent version of
+// the model yet, i.e. an upgrade of the model is in progress. Agents which
+// did not report a valid version yet are ignored.
+func unitAgentVersions(units map[string]params.UnitStatus, modelVersion string) (string, string, bool) {
+	target, targetErr := version.Parse(modelVersion)
+	var minVersion, maxVersion version.Number
+	var found, pending bool
+	for _, unit := range units {
+		v, err := version.Parse(unit.AgentStatus.Version)
+		if err != nil {
+			continue
+		}
+		if !found || v.Compare(minVersion) < 0 {
+			minVersion = v
+		}
+		if !found || v.Compare(maxVersion) > 0 {
+			maxVersion = v
+		}
+		found = true
+		if targetErr == nil && v.Compare(target) != 0 {
+			pending = true
 		}
 	}
+	if !found {
+		return "", "", false
+	}
+	return minVersion.String(), maxVersion.String(), pending
+}
 
-	response := &ReadApplicationResponse{
-		Name:             charmURL.Name,
-		Channel:          appInfo.Channel,
-		Revision:         charmURL.Revision,
-		Base:             fmt.Sprintf("%s@%s", appInfo.Base.Name, baseChannel.Track),
-		Series:           seriesString,
-		Units:            unitCount,
-		Trust:            trustValue,
-		Expose:           exposed,
-		Config:           conf,
-		Constraints:      appInfo.Constraints,
-		Principal:        appInfo.Principal,
-		Placement:        placement,
-		EndpointBindings: endpointBindings,
-		Storage:          storages,
-		Resources:        usedResources,
+// resourcesFromApplication returns the revisions of the resources of an
+// application fetched from Charmhub, and the sorted names of those
+// uploaded to the controller.
+func resourcesFromApplication(appResources []coreresources.ApplicationResources) (map[string]string, []string) {
+	revisions := make(map[string]string)
+	var uploaded []string
+	for _, appResource := range appResources {
+		for _, resource := range appResource.Resources {
+			if resource.Origin == charmresources.OriginUpload {
+				uploaded = append(uploaded, resource.Name)
+				continue
+			}
+			revisions[resource.Name] = strconv.Itoa(resource.Revision)
+		}
 	}
+	sort.Strings(uploaded)
+	return revisions, uploaded
+}
 
+// ReadStatusHistory returns the most recent status changes of an
+// application or one of its units.
+func (c applicationsClient) ReadStatusHistory(ctx context.Context, input *ReadStatusHistoryInput) (*ReadStatusHistoryResponse, error) {
+	var tag names.Tag
+	kind := status.HistoryKind(input.Kind)
+	if input.UnitName == "" {
+		if kind == "" {
+			kind = status.KindApplication
+		}
+		if kind != status.KindApplication {
+			return nil, fmt.Errorf("status history kind %q is not valid for an application", kind)
+		}
+		tag = names.NewApplicationTag(input.AppName)
+	} else {
+		if !names.IsValidUnit(input.UnitName) {
+			return nil, fmt.Errorf("invalid unit name %q", input.UnitName)
+		}
+		if unitApp, _ := names.UnitApplication(input.UnitName); unitApp != input.AppName {
+			return nil, fmt.Errorf("unit %q does not belong to application %q", input.UnitName, input.AppName)
+		}
+		if kind == "" {
+			kind = status.KindUnit
+		}
+		switch kind {
+		case status.KindUnit, status.KindUnitAgent, status.KindWorkload:
+		default:
+			return nil, fmt.Errorf("status history kind %q is not valid for a unit", kind)
+		}
+		tag = names.NewUnitTag(input.UnitName)
+	}
+
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	history, err := c.getClientAPIClient(conn).StatusHistory(kind, tag, status.StatusHistoryFilter{Size: input.Size})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]StatusHistoryEntry, 0, len(history))
+	for _, detail := range history {
+		entry := StatusHistoryEntry{
+			Kind:    string(detail.Kind),
+			Status:  string(detail.Status),
+			Message: detail.Info,
+		}
+		if detail.Since != nil {
+			entry.Since = detail.Since.UTC()
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Since.After(entries[j].Since)
+	})
+	// The kinds combining several statuses may return more entries
+	// than requested.
+	if input.Size > 0 && len(entries) > input.Size {
+		entries = entries[:input.Size]
+	}
+	return &ReadStatusHistoryResponse{Entries: entries}, nil
+}
+
+// ReadApplicationStatus returns the status of an application along
+// with the status, addresses and open ports of each of its units.
+func (c applicationsClient) ReadApplicationStatus(ctx context.Context, input *ReadApplicationInput) (*ReadApplicationStatusResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.getClientAPIClient(conn).Status(nil)
+	if err != nil {
+		return nil, err
+	}
+	return applicationStatusDetail(status, input.AppName)
+}
+
+// applicationStatusDetail extracts the status of the application and its
+// units from the full status of the model.
+func applicationStatusDetail(status *params.FullStatus, appName string) (*ReadApplicationStatusResponse, error) {
+	appStatus, ok := status.Applications[appName]
+	if !ok {
+		return nil, jujuerrors.NotFoundf("application %q", appName)
+	}
+	units := appStatus.Units
+	if len(appStatus.SubordinateTo) > 0 {
+		units = subordinateUnits(status, appName)
+	}
+
+	response := &ReadApplicationStatusResponse{
+		Status:  appStatus.Status.Status,
+		Message: appStatus.Status.Info,
+		Units:   make([]UnitStatusDetail, 0, len(units)),
+	}
+	for name, unit := range units {
+		detail := UnitStatusDetail{
+			Name:            name,
+			Machine:         unit.Machine,
+			Leader:          unit.Leader,
+			WorkloadStatus:  unit.WorkloadStatus.Status,
+			WorkloadMessage: unit.WorkloadStatus.Info,
+			AgentStatus:     unit.AgentStatus.Status,
+			PublicAddress:   unit.PublicAddress,
+			PrivateAddress:  unit.Address,
+			OpenPorts:       append([]string{}, unit.OpenedPorts...),
+		}
+		if detail.PrivateAddress == "" {
+			if machine, ok := machineStatus(status, unit.Machine); ok && len(machine.IPAddresses) > 0 {
+				detail.PrivateAddress = machine.IPAddresses[0]
+			}
+		}
+		sort.Strings(detail.OpenPorts)
+		response.Units = append(response.Units, detail)
+	}
+	sort.Slice(response.Units, func(i, j int) bool {
+		return response.Units[i].Name < response.Units[j].Name
+	})
 	return response, nil
 }
 
+// ListApplications returns the applications of a model matching the
+// filters of the input.
+func (c applicationsClient) ListApplications(ctx context.Context, input *ListApplicationsInput) (*ListApplicationsResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.getClientAPIClient(conn).Status(nil)
+	if err != nil {
+		return nil, err
+	}
+	applications, err := applicationSummaries(status, input)
+	if err != nil {
+		return nil, err
+	}
+	return &ListApplicationsResponse{Applications: applications}, nil
+}
+
+// applicationSummaries returns the applications of the full status of a
+// model matching the filters of the input, sorted by name.
+func applicationSummaries(status *params.FullStatus, input *ListApplicationsInput) ([]ApplicationSummary, error) {
+	applications := make([]ApplicationSummary, 0, len(status.Applications))
+	for name, appStatus := range status.Applications {
+		if !strings.HasPrefix(name, input.NamePrefix) {
+			continue
+		}
+		charmURL, err := charm.ParseURL(appStatus.Charm)
+		if err != nil {
+			return nil, jujuerrors.Annotatef(err, "parsing charm of application %q", name)
+		}
+		if input.CharmName != "" && charmURL.Name != input.CharmName {
+			continue
+		}
+		summary := ApplicationSummary{
+			Name:        name,
+			CharmName:   charmURL.Name,
+			Channel:     appStatus.CharmChannel,
+			Revision:    charmURL.Revision,
+			Status:      appStatus.Status.Status,
+			Subordinate: len(appStatus.SubordinateTo) > 0,
+			Exposed:     appStatus.Exposed,
+			Units:       len(appStatus.Units),
+		}
+		if summary.Subordinate {
+			summary.Units = len(subordinateUnits(status, name))
+		}
+		applications = append(applications, summary)
+	}
+	sort.Slice(applications, func(i, j int) bool {
+		return applications[i].Name < applications[j].Name
+	})
+	return applications, nil
+}
+
+// machineStatus returns the status of a machine or of a container.
+func machineStatus(status *params.FullStatus, id string) (params.MachineStatus, bool) {
+	if id == "" {
+		return params.MachineStatus{}, false
+	}
+	host, _, isContainer := strings.Cut(id, "/")
+	machine, ok := status.Machines[host]
+	if !ok || !isContainer {
+		return machine, ok
+	}
+	container, ok := machine.Containers[id]
+	return container, ok
+}
+
+// RunLeaderAction runs an action on the leader unit of the application and
+// waits for it to complete. An action which fails, or does not complete
+// before the timeout, is an error.
+func (c applicationsClient) RunLeaderAction(ctx context.Context, input *RunLeaderActionInput) error {
+	_, err := c.RunAction(ctx, &RunActionInput{
+		ModelName:  input.ModelName,
+		AppName:    input.AppName,
+		ActionName: input.ActionName,
+		Params:     input.Params,
+		Timeout:    input.Timeout,
+	})
+	return err
+}
+
+// RunAction runs an action on units of the application, or on its leader
+// unit, and waits for every unit to complete it. An action which fails on
+// a unit, or does not complete before the timeout, is an error.
+func (c applicationsClient) RunAction(ctx context.Context, input *RunActionInput) (*RunActionResponse, error) {
+	actionParams, err := leaderActionParams(input.Params)
+	if err != nil {
+		return nil, err
+	}
+	receivers := input.Units
+	if len(receivers) == 0 {
+		receivers = []string{input.AppName + "/leader"}
+	}
+
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+	client := c.getActionAPIClient(conn)
+
+	actions := make([]apiaction.Action, len(receivers))
+	for i, receiver := range receivers {
+		actions[i] = apiaction.Action{
+			Receiver:   receiver,
+			Name:       input.ActionName,
+			Parameters: actionParams,
+		}
+	}
+	enqueued, err := client.EnqueueOperation(actions)
+	if err != nil {
+		return nil, err
+	}
+	if len(enqueued.Actions) != len(receivers) {
+		return nil, fmt.Errorf("expected %d actions to be enqueued, got %d", len(receivers), len(enqueued.Actions))
+	}
+	actionIDs := make([]string, len(enqueued.Actions))
+	for i, action := range enqueued.Actions {
+		if action.Error != nil {
+			return nil, action.Error
+		}
+		if action.Action == nil {
+			return nil, fmt.Errorf("action %q was not enqueued", input.ActionName)
+		}
+		actionIDs[i] = action.Action.ID
+	}
+	c.Debugf(fmt.Sprintf("running action %q on %s", input.ActionName, strings.Join(receivers, ", ")), map[string]interface{}{"ids": actionIDs})
+
+	timeout := input.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Minute
+	}
+	var results []apiaction.ActionResult
+	err = retry.Call(retry.CallArgs{
+		Func: func() error {
+			var err error
+			results, err = client.Actions(actionIDs)
+			if err != nil {
+				return err
+			}
+			if len(results) != len(actionIDs) {
+				return fmt.Errorf("expected %d results for actions %s, got %d", len(actionIDs), strings.Join(actionIDs, ", "), len(results))
+			}
+			// Wait for every unit before reporting a failure, so
+			// that no action is left running.
+			var failed error
+			for i, result := range results {
+				err = actionCompleted(input.ActionName, result)
+				switch {
+				case errors.As(err, &RetryReadError):
+					return err
+				case err != nil && failed == nil && len(receivers) > 1:
+					failed = fmt.Errorf("%s: %w", receivers[i], err)
+				case err != nil && failed == nil:
+					failed = err
+				}
+			}
+			return failed
+		},
+		// Errors reading the results, e.g. a dropped connection,
+		// are retried until the timeout.
+		IsFatalError: func(err error) bool {
+			return errors.As(err, &ActionFailedError)
+		},
+		Delay:       2 * time.Second,
+		MaxDuration: timeout,
+		Clock:       clock.WallClock,
+		Stop:        ctx.Done(),
+	})
+	if retry.IsDurationExceeded(err) {
+		return nil, fmt.Errorf("action %q did not complete within %s: %w", input.ActionName, timeout, retry.LastError(err))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunActionResponse{OperationID: enqueued.OperationID}
+	for i, result := range results {
+		unit := receivers[i]
+		if result.Action != nil {
+			if tag, err := names.ParseUnitTag(result.Action.Receiver); err == nil {
+				unit = tag.Id()
+			}
+		}
+		response.Results = append(response.Results, ActionResult{
+			Unit:   unit,
+			Status: result.Status,
+			Output: flattenActionOutput(result.Output),
+		})
+	}
+	return response, nil
+}
+
+// flattenActionOutput returns the output of an action as strings, the
+// keys of nested values are joined with dots.
+func flattenActionOutput(output map[string]interface{}) map[string]string {
+	flattened := make(map[string]string)
+	var flatten func(prefix string, value interface{})
+	flatten = func(prefix string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for k, nested := range v {
+				flatten(prefix+"."+k, nested)
+			}
+		case map[interface{}]interface{}:
+			for k, nested := range v {
+				flatten(fmt.Sprintf("%s.%v", prefix, k), nested)
+			}
+		default:
+			flattened[prefix] = fmt.Sprint(v)
+		}
+	}
+	for k, v := range output {
+		flatten(k, v)
+	}
+	return flattened
+}
+
+// actionCompleted returns nil if the action completed, a retryReadError
+// while it is pending or running, an actionFailedError if it did not
+// complete, or the error reading its result.
+func actionCompleted(name string, result apiaction.ActionResult) error {
+	if result.Error != nil {
+		return result.Error
+	}
+	switch result.Status {
+	case params.ActionCompleted:
+		return nil
+	case params.ActionPending, params.ActionRunning:
+		return &retryReadError{msg: fmt.Sprintf("action %q is %s", name, result.Status)}
+	}
+	if result.Message != "" {
+		return &actionFailedError{msg: fmt.Sprintf("action %q %s: %s", name, result.Status, result.Message)}
+	}
+	return &actionFailedError{msg: fmt.Sprintf("action %q %s", name, result.Status)}
+}
+
+// leaderActionParams parses the values of the action parameters as YAML
+// scalars, e.g. `3` is an integer and `true` a boolean.
+func leaderActionParams(values map[string]string) (map[string]interface{}, error) {
+	actionParams := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		var value interface{}
+		if err := goyaml.Unmarshal([]byte(v), &value); err != nil {
+			return nil, jujuerrors.NotValidf("value %q of action parameter %q", v, k)
+		}
+		switch value.(type) {
+		case nil, map[interface{}]interface{}, []interface{}:
+			// Only scalars are converted, other values are kept
+			// as given.
+			value = v
+		}
+		actionParams[k] = value
+	}
+	return actionParams, nil
+}
+
+// WaitForApplicationReady blocks until every unit of the application
+// runs the charm of the application with an idle agent. In kubernetes
+// models the pod of each unit must also have an address. It returns an
+// error as soon as a unit or the application reports an error status,
+// e.g. when the OCI image of a kubernetes charm cannot be pulled.
+func (c applicationsClient) WaitForApplicationReady(ctx context.Context, input *WaitForApplicationReadyInput) error {
+	modelType, err := c.ModelType(input.ModelName)
+	if err != nil {
+		return jujuerrors.Annotatef(err, "getting model type")
+	}
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+	clientAPIClient := c.getClientAPIClient(conn)
+
+	timeout := input.Timeout
+	if timeout == 0 {
+		timeout = 20 * time.Minute
+	}
+	return retry.Call(retry.CallArgs{
+		Func: func() error {
+			fullStatus, err := clientAPIClient.Status(&apiclient.StatusArgs{
+				Patterns: []string{input.AppName},
+			})
+			if err != nil {
+				return err
+			}
+			appStatus, ok := fullStatus.Applications[input.AppName]
+			if !ok {
+				return &retryReadError{msg: fmt.Sprintf("application %q not found in status", input.AppName)}
+			}
+			units := input.Units
+			if input.Subordinate {
+				appStatus.Units = subordinateUnits(fullStatus, input.AppName)
+				units = len(appStatus.Units)
+			}
+			if err := applicationReady(appStatus, modelType, units); err != nil || !input.Active {
+				return err
+			}
+			return applicationActive(appStatus)
+		},
+		IsFatalError: func(err error) bool {
+			return !errors.As(err, &RetryReadError) && !strings.Contains(err.Error(), "connection refused")
+		},
+		NotifyFunc: func(err error, attempt int) {
+			if attempt%4 == 0 {
+				c.Debugf(fmt.Sprintf("waiting for application %q to be ready", input.AppName), map[string]interface{}{"err": err})
+			}
+		},
+		Delay:       5 * time.Second,
+		MaxDuration: timeout,
+		Clock:       clock.WallClock,
+		Stop:        ctx.Done(),
+	})
+}
+
+// applicationActive returns nil if the workload of every unit of the
+// application is active, a retryReadError otherwise.
+func applicationActive(appStatus params.ApplicationStatus) error {
+	for name, unit := range appStatus.Units {
+		if unit.WorkloadStatus.Status != string(status.Active) {
+			return &retryReadError{msg: fmt.Sprintf("unit %q workload is %s: %s", name, unit.WorkloadStatus.Status, unit.WorkloadStatus.Info)}
+		}
+	}
+	return nil
+}
+
+// applicationReady returns nil if the application and its units are
+// ready, a retryReadError if they are not ready yet and any other error
+// if they failed.
+func applicationReady(appStatus params.ApplicationStatus, modelType model.ModelType, units int) error {
+	if appStatus.Status.Status == string(status.Error) {
+		return fmt.Errorf("application is in error: %s", appStatus.Status.Info)
+	}
+	if modelType == model.CAAS {
+		units = appStatus.Scale
+	}
+	if len(appStatus.Units) != units {
+		return &retryReadError{msg: fmt.Sprintf("need %d units, have %d", units, len(appStatus.Units))}
+	}
+	for name, unit := range appStatus.Units {
+		if unit.WorkloadStatus.Status == string(status.Error) {
+			return fmt.Errorf("unit %q workload is in error: %s", name, unit.WorkloadStatus.Info)
+		}
+		if unit.AgentStatus.Status == string(status.Error) {
+			return fmt.Errorf("unit %q agent is in error: %s", name, unit.AgentStatus.Info)
+		}
+		// The charm of a unit is only reported when it differs from
+		// the charm of the application, e.g. during a refresh.
+		if unit.Charm != "" && unit.Charm != appStatus.Charm {
+			return &retryReadError{msg: fmt.Sprintf("unit %q runs charm %q, not %q", name, unit.Charm, appStatus.Charm)}
+		}
+		if modelType == model.CAAS && (unit.ProviderId == "" || unit.Address == "") {
+			return &retryReadError{msg: fmt.Sprintf("pod of unit %q is not ready", name)}
+		}
+		if unit.AgentStatus.Status != string(status.Idle) {
+			return &retryReadError{msg: fmt.Sprintf("unit %q agent is %s", name, unit.AgentStatus.Status)}
+		}
+	}
+	return nil
+}
+
+// trustFromConfig returns the trust of an application from its
+// application config, so that a change made with `juju trust` out of
+// band is read back. An application without a trust entry is not
+// trusted.
+func trustFromConfig(applicationConfig map[string]interface{}) (bool, error) {
+	entry, found := applicationConfig["trust"]
+	if !found {
+		return false, nil
+	}
+	attrs, ok := entry.(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("unexpected trust config entry %v", entry)
+	}
+	switch value := attrs["value"].(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return value, nil
+	case string:
+		trust, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, fmt.Errorf("parsing trust value %q: %w", value, err)
+		}
+		return trust, nil
+	default:
+		return false, fmt.Errorf("unexpected trust value %v", value)
+	}
+}
+
+// exposeFromStatus rebuilds the expose settings of an application from
+// its status, nil if the application is not exposed. The endpoints are
+// sorted so that the result is stable. The spaces and CIDRs are the same
+// for every endpoint exposed by the provider, they are read from the
+// endpoint standing for the whole application if there is one.
+func exposeFromStatus(appStatus params.ApplicationStatus) map[string]interface{} {
+	if !appStatus.Exposed {
+		return nil
+	}
+	endpoints := make([]string, 0, len(appStatus.ExposedEndpoints))
+	for name := range appStatus.ExposedEndpoints {
+		if name != "" {
+			endpoints = append(endpoints, name)
+		}
+	}
+	sort.Strings(endpoints)
+
+	var exposedEndpoint params.ExposedEndpoint
+	if value, ok := appStatus.ExposedEndpoints[""]; ok {
+		exposedEndpoint = value
+	} else if len(endpoints) > 0 {
+		exposedEndpoint = appStatus.ExposedEndpoints[endpoints[0]]
+	}
+	return map[string]interface{}{
+		"endpoints": strings.Join(endpoints, ","),
+		"spaces":    strings.Join(exposedEndpoint.ExposeToSpaces, ","),
+		// by default the API sets
+		// cidrs: "0.0.0.0/0,::/0"
+		// ignore them
+		"cidrs": strings.Join(removeDefaultCidrs(exposedEndpoint.ExposeToCIDRs), ","),
+	}
+}
+
 // removeDefaultCidrs is an auxiliar function to remove
 // the "0.0.0.0/0 and ::/0" strings from an array of
 // cidrs
@@ -1143,8 +2443,8 @@ func removeDefaultCidrs(cidrs []string) []string {
 	return toReturn
 }
 
-func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) error {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c applicationsClient) UpdateApplication(ctx context.Context, input *UpdateApplicationInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -1170,7 +2470,8 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 		return fmt.Errorf("no status returned for application: %s", input.AppName)
 	}
 
-	// process configuration
+	// process configuration, all the changed keys are sent
+	// in a single call.
 	var auxConfig map[string]string
 	if input.Config != nil {
 		auxConfig = make(map[string]string)
@@ -1179,33 +2480,56 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 		}
 	}
 
-	// trust goes inside the config
-	if input.Trust != nil {
-		if auxConfig == nil {
-			auxConfig = make(map[string]string)
-		}
-		auxConfig["trust"] = fmt.Sprintf("%v", *input.Trust)
-	}
-
 	// Use the revision and channel info to create the
 	// corresponding SetCharm info.
 	//
-	// Note: the operations with revisions should be done
-	// before the operations with config. Because the config params
-	// can be changed from one revision to another. So "Revision-Config"
-	// ordering will help to prevent issues with the configuration parsing.
-	if input.Revision != nil || input.Channel != "" || len(input.Resources) != 0 {
-		setCharmConfig, err := c.computeSetCharmConfig(input, applicationAPIClient, charmsAPIClient, resourcesAPIClient)
+	// Note: the charm config is sent along with the SetCharm call,
+	// because the config params can be changed from one revision
+	// to another. Applying both at once prevents issues with the
+	// configuration parsing and avoids a second round of hook
+	// executions on the units.
+	setCharmInput := input
+	if input.Revision == nil && input.Channel == "" && input.CharmPath == "" && len(input.Resources) != 0 {
+		// Only the resources have changed. Attach the uploaded ones
+		// directly, the charm is only refreshed for the resources
+		// specified by revision.
+		revisions, err := c.attachResources(input.AppName, input.Resources, resourcesAPIClient)
+		if err != nil {
+			return err
+		}
+		inputCopy := *input
+		inputCopy.Resources = revisions
+		setCharmInput = &inputCopy
+	}
+	if setCharmInput.Revision != nil || setCharmInput.Channel != "" || setCharmInput.CharmPath != "" || len(setCharmInput.Resources) != 0 {
+		var setCharmConfig *apiapplication.SetCharmConfig
+		if setCharmInput.CharmPath != "" {
+			setCharmConfig, err = c.computeSetLocalCharmConfig(conn, setCharmInput, applicationAPIClient, charmsAPIClient, resourcesAPIClient)
+		} else {
+			setCharmConfig, err = c.computeSetCharmConfig(setCharmInput, applicationAPIClient, charmsAPIClient, resourcesAPIClient)
+		}
 		if err != nil {
 			return err
 		}
 
 		setCharmConfig.StorageConstraints = input.StorageConstraints
+		setCharmConfig.ConfigSettings = auxConfig
+		setCharmConfig.Force = input.UpgradePolicy == UpgradePolicyForce
 
 		err = applicationAPIClient.SetCharm(model.GenerationMaster, *setCharmConfig)
 		if err != nil {
 			return err
 		}
+		auxConfig = nil
+	}
+
+	// trust is an application setting and not part of the
+	// charm config, so it cannot be set with SetCharm.
+	if input.Trust != nil {
+		if auxConfig == nil {
+			auxConfig = make(map[string]string)
+		}
+		auxConfig["trust"] = fmt.Sprintf("%v", *input.Trust)
 	}
 
 	if auxConfig != nil {
@@ -1243,7 +2567,7 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 	// expose endpoints if required
 	if input.Expose != nil {
 		c.Tracef("Expose endpoints", map[string]interface{}{"endpoints": input.Unexpose})
-		err := c.processExpose(applicationAPIClient, input.AppName, input.Expose)
+		err := c.processExpose(conn, applicationAPIClient, input.AppName, input.Expose)
 		if err != nil {
 			c.Errorf(err, "when trying to expose")
 			return err
@@ -1277,9 +2601,14 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 			unitDiff := *input.Units - len(appStatus.Units)
 
 			if unitDiff > 0 {
-				_, err := applicationAPIClient.AddUnits(apiapplication.AddUnitsParams{
+				placement, err := unitPlacements(conn, input.UnitPlacement, input.MapMachines, len(appStatus.Units), unitDiff)
+				if err != nil {
+					return err
+				}
+				_, err = applicationAPIClient.AddUnits(apiapplication.AddUnitsParams{
 					ApplicationName: input.AppName,
 					NumUnits:        unitDiff,
+					Placement:       placement,
 				})
 				if err != nil {
 					return err
@@ -1311,8 +2640,54 @@ func (c applicationsClient) UpdateApplication(input *UpdateApplicationInput) err
 	return nil
 }
 
-func (c applicationsClient) DestroyApplication(input *DestroyApplicationInput) error {
-	conn, err := c.GetConnection(&input.ModelName)
+// ReadCharmConfigOptions returns the config options defined by the charm
+// of the application. When a revision is provided and the charm with that
+// revision is known to the model, its options are returned instead.
+func (c applicationsClient) ReadCharmConfigOptions(ctx context.Context, input *ReadCharmConfigOptionsInput) (map[string]CharmConfigOption, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	applicationAPIClient := c.getApplicationAPIClient(conn)
+	charmsAPIClient := apicharms.NewClient(conn)
+
+	charmURL, _, err := applicationAPIClient.GetCharmURLOrigin("", input.AppName)
+	if err != nil {
+		return nil, err
+	}
+
+	charmInfo, err := charmsAPIClient.CharmInfo(charmURL.String())
+	if err != nil {
+		return nil, err
+	}
+	if input.Revision != nil && *input.Revision != charmURL.Revision {
+		// The charm with the new revision is only known to the model
+		// once it has been added, otherwise keep the current options.
+		newCharmInfo, err := charmsAPIClient.CharmInfo(charmURL.WithRevision(*input.Revision).String())
+		if err == nil {
+			charmInfo = newCharmInfo
+		} else {
+			c.Tracef("charm revision not available in the model", map[string]interface{}{"revision": *input.Revision, "error": err})
+		}
+	}
+
+	options := make(map[string]CharmConfigOption)
+	if charmInfo.Config == nil {
+		return options, nil
+	}
+	for name, option := range charmInfo.Config.Options {
+		options[name] = CharmConfigOption{
+			Type:        option.Type,
+			Description: option.Description,
+		}
+	}
+	return options, nil
+}
+
+func (c applicationsClient) DestroyApplication(ctx context.Context, input *DestroyApplicationInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -1320,11 +2695,17 @@ func (c applicationsClient) DestroyApplication(input *DestroyApplicationInput) e
 
 	applicationAPIClient := apiapplication.NewClient(conn)
 
+	if input.RetainStorage {
+		if err := c.retainStorage(conn, input.ApplicationName); err != nil {
+			return err
+		}
+	}
+
 	var destroyParams = apiapplication.DestroyApplicationsParams{
 		Applications: []string{
 			input.ApplicationName,
 		},
-		DestroyStorage: true,
+		DestroyStorage: !input.RetainStorage,
 	}
 
 	_, err = applicationAPIClient.DestroyApplications(destroyParams)
@@ -1349,24 +2730,17 @@ func (c applicationsClient) computeSetCharmConfig(
 		return nil, err
 	}
 
-	// You can only refresh on the revision OR the channel at once.
+	// A revision pins the charm, the channel is recorded in the origin
+	// and used by future refreshes.
+	revision := input.Revision
+	if revision == nil && input.Channel != "" && input.UpgradePolicy == UpgradePolicyChannelOnly {
+		// Keep the current charm, setting a charm with the same URL
+		// only updates the origin of the application.
+		revision = &oldURL.Revision
+	}
 	newURL := oldURL
 	newOrigin := oldOrigin
-	if input.Revision != nil {
-		newURL = oldURL.WithRevision(*input.Revision)
-		newOrigin.Revision = input.Revision
-		// If the charm has an ID and Hash, it's been deployed before.
-		// Remove to trick juju into finding the new revision the user
-		// has requested. If they exist, the charm will be resolved with
-		// the channel potentially causing the wrong charm revision to
-		// be installed.
-		//
-		// There is a risk if the charm has been renamed in charmhub that
-		// the resolve charm will fail as we're using the name instead of
-		// the ID. This needs to be fixed in Juju.
-		newOrigin.ID = ""
-		newOrigin.Hash = ""
-	} else if input.Channel != "" {
+	if input.Channel != "" {
 		parsedChannel, err := charm.ParseChannel(input.Channel)
 		if err != nil {
 			return nil, err
@@ -1379,6 +2753,21 @@ func (c applicationsClient) computeSetCharmConfig(
 			newOrigin.Branch = strPtr(parsedChannel.Branch)
 		}
 	}
+	if revision != nil {
+		newURL = oldURL.WithRevision(*revision)
+		newOrigin.Revision = revision
+		// If the charm has an ID and Hash, it's been deployed before.
+		// Remove to trick juju into finding the new revision the user
+		// has requested. If they exist, the charm will be resolved with
+		// the channel potentially causing the wrong charm revision to
+		// be installed.
+		//
+		// There is a risk if the charm has been renamed in charmhub that
+		// the resolve charm will fail as we're using the name instead of
+		// the ID. This needs to be fixed in Juju.
+		newOrigin.ID = ""
+		newOrigin.Hash = ""
+	}
 
 	resolvedURL, resolvedOrigin, supportedBases, err := resolveCharm(charmsAPIClient, newURL, newOrigin)
 	if err != nil {
@@ -1396,12 +2785,13 @@ func (c applicationsClient) computeSetCharmConfig(
 		return nil, errors.New(msg)
 	}
 
-	// Ensure the new revision or channel is contained
+	// Ensure the new revision and channel is contained
 	// in the origin to be saved by juju when AddCharm
 	// is called.
-	if input.Revision != nil {
-		oldOrigin.Revision = input.Revision
-	} else if input.Channel != "" {
+	if revision != nil {
+		oldOrigin.Revision = revision
+	}
+	if input.Channel != "" {
 		oldOrigin.Track = newOrigin.Track
 		oldOrigin.Risk = newOrigin.Risk
 		oldOrigin.Branch = newOrigin.Branch
@@ -1431,6 +2821,102 @@ func (c applicationsClient) computeSetCharmConfig(
 	return &toReturn, nil
 }
 
+// computeSetLocalCharmConfig uploads the local charm of the input, for
+// the base of the deployed application, and returns the config to
+// refresh the application to it.
+func (c applicationsClient) computeSetLocalCharmConfig(
+	conn api.Connection,
+	input *UpdateApplicationInput,
+	applicationAPIClient ApplicationAPIClient,
+	charmsAPIClient *apicharms.Client,
+	resourcesAPIClient ResourceAPIClient,
+) (*apiapplication.SetCharmConfig, error) {
+	_, oldOrigin, err := applicationAPIClient.GetCharmURLOrigin("", input.AppName)
+	if err != nil {
+		return nil, err
+	}
+	ch, curl, err := newLocalCharm(input.CharmPath, oldOrigin.Base)
+	if err != nil {
+		return nil, err
+	}
+
+	localCharmClient, err := apicharms.NewLocalCharmClient(conn)
+	if err != nil {
+		return nil, err
+	}
+	controllerVersion, _ := conn.ServerVersion()
+	curl, err = localCharmClient.AddLocalCharm(curl, ch, false, controllerVersion)
+	if err != nil {
+		return nil, typedError(err)
+	}
+
+	newOrigin := oldOrigin
+	newOrigin.Source = apicommoncharm.OriginLocal
+	newOrigin.Revision = &curl.Revision
+	apiCharmID := apiapplication.CharmID{
+		URL:    curl.String(),
+		Origin: newOrigin,
+	}
+
+	resourceIDs, err := c.updateResources(input.AppName, input.Resources, charmsAPIClient, apiCharmID, resourcesAPIClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiapplication.SetCharmConfig{
+		ApplicationName: input.AppName,
+		CharmID:         apiCharmID,
+		ResourceIDs:     resourceIDs,
+	}, nil
+}
+
+// ResolveCharm resolves a charm from Charmhub in the given channel, at
+// the given revision and for the given base, as when deploying it,
+// without deploying it. An error is returned if the charm cannot be
+// resolved, a ModelNotFoundError if the model does not exist yet.
+func (c applicationsClient) ResolveCharm(ctx context.Context, input *ResolveCharmInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if errors.Is(err, jujuerrors.NotFound) {
+		return jujuerrors.Wrap(err, &modelNotFoundError{name: input.ModelName})
+	} else if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	channel, err := charm.ParseChannel(input.Channel)
+	if err != nil {
+		return err
+	}
+	charmURL, err := resolveCharmURL(input.CharmName)
+	if err != nil {
+		return err
+	}
+	var base corebase.Base
+	if input.Base != "" {
+		base, err = corebase.ParseBaseFromString(input.Base)
+		if err != nil {
+			return err
+		}
+	}
+	platformCons, err := apimodelconfig.NewClient(conn).GetModelConstraints()
+	if err != nil {
+		return err
+	}
+	platform := utils.MakePlatform(constraints.Value{}, base, platformCons)
+	origin, err := utils.MakeOrigin(charm.Schema(charmURL.Schema), input.Revision, channel, platform)
+	if err != nil {
+		return err
+	}
+	_, resolvedOrigin, _, err := resolveCharm(apicharms.NewClient(conn), charmURL, origin)
+	if err != nil {
+		return err
+	}
+	if resolvedOrigin.Type == "bundle" {
+		return jujuerrors.NotSupportedf("deploying bundles")
+	}
+	return nil
+}
+
 func resolveCharm(charmsAPIClient *apicharms.Client, curl *charm.URL, origin apicommoncharm.Origin) (*charm.URL, apicommoncharm.Origin, []corebase.Base, error) {
 	// Charm or bundle has been supplied as a URL, so we resolve and
 	// deploy using the store but pass in the origin command line
@@ -1471,7 +2957,73 @@ func (c applicationsClient) updateResources(appName string, resources map[string
 		return nil, nil
 	}
 
-	return addPendingResources(appName, filtered, resources, charmID, resourcesAPIClient)
+	return addPendingResources(appName, filtered, resourcesForRefresh(filtered, resources), charmID, resourcesAPIClient)
+}
+
+// resourcesForRefresh returns the resources to use when the charm is
+// refreshed. The resources provided keep their value, the other ones to
+// upgrade are set to the revision required by the new charm, e.g. when
+// switching channels, so they are updated in the same SetCharm call
+// rather than left stale.
+func resourcesForRefresh(toUpgrade map[string]charmresources.Meta, provided map[string]string) map[string]string {
+	resources := make(map[string]string, len(toUpgrade))
+	for name := range toUpgrade {
+		if value, ok := provided[name]; ok {
+			resources[name] = value
+		} else {
+			resources[name] = "-1"
+		}
+	}
+	return resources
+}
+
+// attachResources uploads the resources specified by a file path or an
+// OCI image to the application, as `juju attach-resource` does, without
+// refreshing the charm. Resources specified by revision can only be
+// changed by refreshing the charm, they are returned.
+func (c applicationsClient) attachResources(appName string, resourcesToAttach map[string]string, resourceAPIClient ResourceAPIClient) (map[string]string, error) {
+	revisions := make(map[string]string)
+	uploads := make(map[string]string)
+	for name, value := range resourcesToAttach {
+		if _, err := strconv.Atoi(value); err == nil {
+			revisions[name] = value
+		} else {
+			uploads[name] = value
+		}
+	}
+	if len(uploads) == 0 {
+		return revisions, nil
+	}
+
+	appResources, err := resourceAPIClient.ListResources([]string{appName})
+	if err != nil {
+		return nil, typedError(err)
+	}
+	resourceTypes := make(map[string]charmresources.Type)
+	for _, appResource := range appResources {
+		for _, res := range appResource.Resources {
+			resourceTypes[res.Name] = res.Type
+		}
+	}
+
+	fileSystem := osFilesystem{}
+	for name, value := range uploads {
+		resourceType, ok := resourceTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("resource %q not found for application %q", name, appName)
+		}
+		r, err := resourcecmd.OpenResource(value, resourceType, fileSystem.Open)
+		if err != nil {
+			return nil, typedError(err)
+		}
+		err = resourceAPIClient.Upload(appName, name, value, "", r)
+		_ = r.Close()
+		if err != nil {
+			return nil, typedError(err)
+		}
+		c.Tracef("attached resource", map[string]interface{}{"application": appName, "resource": name})
+	}
+	return revisions, nil
 }
 
 func addPendingResources(appName string, charmResourcesToAdd map[string]charmresources.Meta, resourcesToUse map[string]string,
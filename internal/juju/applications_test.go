@@ -6,21 +6,27 @@ package juju
 // Basic imports
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	charmresources "github.com/juju/charm/v12/resource"
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/api/base"
+	apiaction "github.com/juju/juju/api/client/action"
 	apiapplication "github.com/juju/juju/api/client/application"
 	apicharm "github.com/juju/juju/api/common/charm"
 	corebase "github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/resources"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v4"
+	namesv5 "github.com/juju/names/v5"
 	"github.com/juju/utils/v3"
 	"github.com/juju/version/v2"
 	"github.com/stretchr/testify/suite"
@@ -35,6 +41,7 @@ type ApplicationSuite struct {
 
 	testModelName string
 
+	mockActionClient      *MockActionAPIClient
 	mockApplicationClient *MockApplicationAPIClient
 	mockClient            *MockClientAPIClient
 	mockResourceAPIClient *MockResourceAPIClient
@@ -49,6 +56,7 @@ func (s *ApplicationSuite) setupMocks(t *testing.T) *gomock.Controller {
 	s.testModelName = "testmodel"
 
 	ctlr := gomock.NewController(t)
+	s.mockActionClient = NewMockActionAPIClient(ctlr)
 	s.mockApplicationClient = NewMockApplicationAPIClient(ctlr)
 	s.mockClient = NewMockClientAPIClient(ctlr)
 
@@ -86,7 +94,7 @@ func (s *ApplicationSuite) setupMocks(t *testing.T) *gomock.Controller {
 	s.mockSharedClient.EXPECT().Errorf(gomock.Any(), gomock.Any()).Do(log).AnyTimes()
 	s.mockSharedClient.EXPECT().Tracef(gomock.Any(), gomock.Any()).Do(log).AnyTimes()
 	s.mockSharedClient.EXPECT().JujuLogger().Return(&jujuLoggerShim{}).AnyTimes()
-	s.mockSharedClient.EXPECT().GetConnection(&s.testModelName).Return(s.mockConnection, nil).AnyTimes()
+	s.mockSharedClient.EXPECT().GetConnection(gomock.Any(), &s.testModelName).Return(s.mockConnection, nil).AnyTimes()
 	return ctlr
 }
 
@@ -94,6 +102,9 @@ func (s *ApplicationSuite) getApplicationsClient() applicationsClient {
 	return applicationsClient{
 		SharedClient:      s.mockSharedClient,
 		controllerVersion: version.Number{},
+		getActionAPIClient: func(_ api.Connection) ActionAPIClient {
+			return s.mockActionClient
+		},
 		getApplicationAPIClient: func(_ base.APICallCloser) ApplicationAPIClient {
 			return s.mockApplicationClient
 		},
@@ -299,10 +310,28 @@ func (s *ApplicationSuite) TestReadApplicationRetrySubordinate() {
 		EndpointBindings:  nil,
 	}
 	aExp.Get("master", appName).Return(getResult, nil)
+	// The units of the subordinate application are found among the
+	// subordinates of the units of its principal application.
 	statusResult := &params.FullStatus{
-		Applications: map[string]params.ApplicationStatus{appName: {
-			Charm: "ch:amd64/jammy/testcharm-5",
-		}},
+		Applications: map[string]params.ApplicationStatus{
+			appName: {
+				Charm:         "ch:amd64/jammy/testcharm-5",
+				SubordinateTo: []string{"principal"},
+			},
+			"principal": {
+				Charm: "ch:amd64/jammy/principal-1",
+				Units: map[string]params.UnitStatus{
+					"principal/0": {
+						Machine:      "3",
+						Subordinates: map[string]params.UnitStatus{appName + "/1": {}},
+					},
+					"principal/1": {
+						Machine:      "1",
+						Subordinates: map[string]params.UnitStatus{appName + "/0": {}, "other/0": {}},
+					},
+				},
+			},
+		},
 	}
 	s.mockClient.EXPECT().Status(gomock.Any()).Return(statusResult, nil)
 
@@ -319,6 +348,9 @@ func (s *ApplicationSuite) TestReadApplicationRetrySubordinate() {
 	s.Assert().Equal("stable", resp.Channel)
 	s.Assert().Equal(5, resp.Revision)
 	s.Assert().Equal("ubuntu@22.04", resp.Base)
+	s.Assert().False(resp.Principal)
+	s.Assert().Equal(2, resp.Units)
+	s.Assert().Equal("1,3", resp.Placement)
 }
 
 // TestReadApplicationRetryNotFoundStorageNotFoundError tests the case where the first response is a storage not found error.
@@ -615,6 +647,20 @@ func (s *ApplicationSuite) TestAddPendingResourceOneRevisionProvidedMultipleChar
 	s.Assert().Equal(nil, err, "Error is not expected.")
 }
 
+func (s *ApplicationSuite) TestResourcesForRefresh() {
+	toUpgrade := map[string]charmresources.Meta{
+		"ausf-image": {Name: "ausf-image", Type: charmresources.TypeContainerImage},
+		"udm-image":  {Name: "udm-image", Type: charmresources.TypeContainerImage},
+	}
+
+	// Resources which are not provided follow the new charm.
+	resources := resourcesForRefresh(toUpgrade, map[string]string{"udm-image": "3", "other": "4"})
+	s.Assert().Equal(map[string]string{"ausf-image": "-1", "udm-image": "3"}, resources)
+
+	resources = resourcesForRefresh(toUpgrade, nil)
+	s.Assert().Equal(map[string]string{"ausf-image": "-1", "udm-image": "-1"}, resources)
+}
+
 // TestUploadExistingPendingResourcesUploadSuccessful tests the case where ResourceAPIClient.Upload is successful.
 // Error is not returned.
 func (s *ApplicationSuite) TestUploadExistingPendingResourcesUploadSuccessful() {
@@ -694,6 +740,669 @@ func (s *ApplicationSuite) TestUploadExistingPendingResourcesInvalidFileNameRetu
 	s.Assert().Equal("unable to open resource custom-image: filepath or registry path:  not valid", err.Error(), "Error is expected.")
 }
 
+// TestUpdateApplicationConfigSingleSetConfigCall tests that all the changed
+// config keys, including trust, are sent with a single SetConfig call.
+func (s *ApplicationSuite) TestUpdateApplicationConfigSingleSetConfigCall() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockSharedClient.EXPECT().ModelType(gomock.Any()).Return(model.IAAS, nil).AnyTimes()
+
+	s.mockConnection.EXPECT().BestFacadeVersion(gomock.Any()).Return(0).AnyTimes()
+
+	appName := "testapplication"
+	statusResult := &params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{appName: {
+			Charm: "ch:amd64/jammy/testcharm-5",
+		}},
+	}
+	s.mockClient.EXPECT().Status(gomock.Any()).Return(statusResult, nil)
+	s.mockApplicationClient.EXPECT().SetConfig("master", appName, "", map[string]string{
+		"key-a": "one",
+		"key-b": "2",
+		"trust": "true",
+	}).Return(nil).Times(1)
+
+	trust := true
+	client := s.getApplicationsClient()
+	err := client.UpdateApplication(context.Background(), &UpdateApplicationInput{
+		ModelName: s.testModelName,
+		AppName:   appName,
+		Config: map[string]string{
+			"key-a": "one",
+			"key-b": "2",
+		},
+		Trust: &trust,
+	})
+	s.Require().NoError(err, "error from UpdateApplication")
+}
+
+func (s *ApplicationSuite) affinityStatus() *params.FullStatus {
+	return &params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{
+			"database": {Units: map[string]params.UnitStatus{
+				"database/0": {Machine: "1"},
+				"database/1": {Machine: "0"},
+			}},
+			"cache": {Units: map[string]params.UnitStatus{
+				"cache/0": {Machine: "2"},
+			}},
+		},
+	}
+}
+
+func (s *ApplicationSuite) TestComputeAffinityPlacementColocateWith() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockClient.EXPECT().Status(gomock.Any()).Return(s.affinityStatus(), nil)
+
+	client := s.getApplicationsClient()
+	placement, err := client.computeAffinityPlacement(s.mockConnection, &CreateApplicationInput{
+		ColocateWith: "database",
+		AntiAffinity: []string{"cache"},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("0,1", placement)
+}
+
+func (s *ApplicationSuite) TestComputeAffinityPlacementAntiAffinityConflict() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockClient.EXPECT().Status(gomock.Any()).Return(s.affinityStatus(), nil)
+
+	client := s.getApplicationsClient()
+	_, err := client.computeAffinityPlacement(s.mockConnection, &CreateApplicationInput{
+		Placement:    "lxd:2",
+		AntiAffinity: []string{"cache"},
+	})
+	s.Require().ErrorContains(err, `placement "lxd:2" conflicts with the anti affinity applications cache`)
+}
+
+func (s *ApplicationSuite) TestComputeAffinityPlacementUnknownApplication() {
+	defer s.setupMocks(s.T()).Finish()
+	s.mockClient.EXPECT().Status(gomock.Any()).Return(s.affinityStatus(), nil)
+
+	client := s.getApplicationsClient()
+	_, err := client.computeAffinityPlacement(s.mockConnection, &CreateApplicationInput{
+		ColocateWith: "missing",
+	})
+	s.Require().ErrorContains(err, `application "missing" not found`)
+}
+
+// TestAttachResourcesUploadsImageReturnsRevisions tests that resources
+// specified by an OCI image are uploaded directly to the application while
+// the ones specified by revision are returned to be set with a charm refresh.
+func (s *ApplicationSuite) TestAttachResourcesUploadsImageReturnsRevisions() {
+	ctlr := s.setupMocks(s.T())
+	defer ctlr.Finish()
+
+	appName := "testapplication"
+	resourceAPIClient := NewMockResourceAPIClient(ctlr)
+	resourceAPIClient.EXPECT().ListResources([]string{appName}).Return([]resources.ApplicationResources{{
+		Resources: []resources.Resource{{
+			Resource: charmresources.Resource{
+				Meta: charmresources.Meta{Name: "ausf-image", Type: charmresources.TypeContainerImage},
+			},
+		}},
+	}}, nil)
+	resourceAPIClient.EXPECT().Upload(appName, "ausf-image", "gatici/sdcore-ausf:1.4", "", gomock.Any()).Return(nil)
+
+	client := s.getApplicationsClient()
+	revisions, err := client.attachResources(appName, map[string]string{
+		"ausf-image": "gatici/sdcore-ausf:1.4",
+		"udm-image":  "4",
+	}, resourceAPIClient)
+	s.Require().NoError(err)
+	s.Assert().Equal(map[string]string{"udm-image": "4"}, revisions)
+}
+
+func (s *ApplicationSuite) TestLatestLTSBase() {
+	bases := []corebase.Base{
+		corebase.MustParseBaseFromString("ubuntu@20.04"),
+		corebase.MustParseBaseFromString("ubuntu@24.04"),
+		corebase.MustParseBaseFromString("ubuntu@24.10"),
+		corebase.MustParseBaseFromString("ubuntu@22.04"),
+		corebase.MustParseBaseFromString("centos@7"),
+	}
+	lts, ok := latestLTSBase(bases)
+	s.Require().True(ok)
+	s.Assert().Equal("ubuntu@24.04", lts.DisplayString())
+
+	_, ok = latestLTSBase([]corebase.Base{corebase.MustParseBaseFromString("ubuntu@23.10")})
+	s.Assert().False(ok)
+}
+
+func (s *ApplicationSuite) TestTrackNewer() {
+	s.Assert().True(trackNewer("24.04", "22.04"))
+	s.Assert().False(trackNewer("22.04", "24.04"))
+	// Tracks are compared as versions, not as strings.
+	s.Assert().True(trackNewer("3.10", "3.9"))
+	s.Assert().False(trackNewer("3.9", "3.10"))
+	s.Assert().True(trackNewer("10", "9"))
+	s.Assert().False(trackNewer("22.04", "22.04"))
+}
+
+func (s *ApplicationSuite) TestReadStatusHistorySortsAndTruncates() {
+	defer s.setupMocks(s.T()).Finish()
+
+	older := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	s.mockClient.EXPECT().StatusHistory(status.KindUnit, gomock.Any(), status.StatusHistoryFilter{Size: 1}).DoAndReturn(
+		func(kind status.HistoryKind, tag interface{ String() string }, filter status.StatusHistoryFilter) (status.History, error) {
+			s.Assert().Equal("unit-testapplication-0", tag.String())
+			return status.History{
+				{Kind: status.KindWorkload, Status: status.Maintenance, Info: "installing", Since: &older},
+				{Kind: status.KindUnitAgent, Status: status.Idle, Since: &newer},
+			}, nil
+		})
+
+	client := s.getApplicationsClient()
+	resp, err := client.ReadStatusHistory(context.Background(), &ReadStatusHistoryInput{
+		ModelName: s.testModelName,
+		AppName:   "testapplication",
+		UnitName:  "testapplication/0",
+		Size:      1,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]StatusHistoryEntry{{
+		Kind:   "juju-unit",
+		Status: "idle",
+		Since:  newer,
+	}}, resp.Entries)
+}
+
+func (s *ApplicationSuite) TestReadStatusHistoryInvalidInput() {
+	client := s.getApplicationsClient()
+	_, err := client.ReadStatusHistory(context.Background(), &ReadStatusHistoryInput{
+		ModelName: s.testModelName,
+		AppName:   "testapplication",
+		Kind:      "workload",
+	})
+	s.Assert().ErrorContains(err, `kind "workload" is not valid for an application`)
+
+	_, err = client.ReadStatusHistory(context.Background(), &ReadStatusHistoryInput{
+		ModelName: s.testModelName,
+		AppName:   "testapplication",
+		UnitName:  "otherapplication/0",
+	})
+	s.Assert().ErrorContains(err, `does not belong to application "testapplication"`)
+}
+
+func (s *ApplicationSuite) TestApplicationReady() {
+	const charmURL = "ch:amd64/jammy/testcharm-5"
+	readyUnit := params.UnitStatus{
+		AgentStatus:    params.DetailedStatus{Status: "idle"},
+		WorkloadStatus: params.DetailedStatus{Status: "active"},
+		ProviderId:     "testapplication-0",
+		Address:        "10.1.1.1",
+	}
+	appStatus := func(scale int, units map[string]params.UnitStatus) params.ApplicationStatus {
+		return params.ApplicationStatus{
+			Charm:  charmURL,
+			Status: params.DetailedStatus{Status: "active"},
+			Scale:  scale,
+			Units:  units,
+		}
+	}
+
+	// All units are idle and their pods have an address.
+	err := applicationReady(appStatus(1, map[string]params.UnitStatus{"testapplication/0": readyUnit}), model.CAAS, 0)
+	s.Assert().NoError(err)
+
+	// The scale is not reached yet.
+	err = applicationReady(appStatus(2, map[string]params.UnitStatus{"testapplication/0": readyUnit}), model.CAAS, 0)
+	s.Assert().ErrorAs(err, &RetryReadError)
+
+	// The pod has no address yet.
+	pending := readyUnit
+	pending.Address = ""
+	err = applicationReady(appStatus(1, map[string]params.UnitStatus{"testapplication/0": pending}), model.CAAS, 0)
+	s.Assert().ErrorAs(err, &RetryReadError)
+
+	// The unit still runs the previous revision of the charm.
+	refreshing := readyUnit
+	refreshing.Charm = "ch:amd64/jammy/testcharm-4"
+	err = applicationReady(appStatus(1, map[string]params.UnitStatus{"testapplication/0": refreshing}), model.CAAS, 0)
+	s.Assert().ErrorAs(err, &RetryReadError)
+
+	// The image of the workload cannot be pulled.
+	failed := readyUnit
+	failed.WorkloadStatus = params.DetailedStatus{Status: "error", Info: "ImagePullBackOff"}
+	err = applicationReady(appStatus(1, map[string]params.UnitStatus{"testapplication/0": failed}), model.CAAS, 0)
+	s.Assert().ErrorContains(err, "ImagePullBackOff")
+	s.Assert().False(errors.As(err, &RetryReadError))
+
+	// Machine units do not have a pod, the expected number of units
+	// is given by the caller.
+	machineUnit := readyUnit
+	machineUnit.ProviderId, machineUnit.Address = "", ""
+	err = applicationReady(appStatus(0, map[string]params.UnitStatus{"testapplication/0": machineUnit}), model.IAAS, 1)
+	s.Assert().NoError(err)
+}
+
+func (s *ApplicationSuite) TestApplicationActive() {
+	active := params.UnitStatus{WorkloadStatus: params.DetailedStatus{Status: "active"}}
+	waiting := params.UnitStatus{WorkloadStatus: params.DetailedStatus{Status: "waiting", Info: "waiting for database"}}
+
+	err := applicationActive(params.ApplicationStatus{Units: map[string]params.UnitStatus{"testapplication/0": active}})
+	s.Assert().NoError(err)
+
+	err = applicationActive(params.ApplicationStatus{Units: map[string]params.UnitStatus{
+		"testapplication/0": active,
+		"testapplication/1": waiting,
+	}})
+	s.Assert().ErrorAs(err, &RetryReadError)
+	s.Assert().ErrorContains(err, "waiting for database")
+}
+
+func (s *ApplicationSuite) TestApplicationStatusDetail() {
+	status := &params.FullStatus{
+		Machines: map[string]params.MachineStatus{
+			"0": {IPAddresses: []string{"10.0.0.2", "192.168.1.2"}},
+			"1": {
+				IPAddresses: []string{"10.0.0.3"},
+				Containers: map[string]params.MachineStatus{
+					"1/lxd/0": {IPAddresses: []string{"10.0.1.4"}},
+				},
+			},
+		},
+		Applications: map[string]params.ApplicationStatus{
+			"testapplication": {
+				Status: params.DetailedStatus{Status: "active", Info: "ready"},
+				Units: map[string]params.UnitStatus{
+					"testapplication/1": {
+						Machine:        "1/lxd/0",
+						WorkloadStatus: params.DetailedStatus{Status: "waiting", Info: "waiting for database"},
+						AgentStatus:    params.DetailedStatus{Status: "executing"},
+					},
+					"testapplication/0": {
+						Machine:        "0",
+						Leader:         true,
+						WorkloadStatus: params.DetailedStatus{Status: "active"},
+						AgentStatus:    params.DetailedStatus{Status: "idle"},
+						PublicAddress:  "203.0.113.2",
+						OpenedPorts:    []string{"443/tcp", "80/tcp"},
+						Subordinates: map[string]params.UnitStatus{
+							"subordinate/0": {WorkloadStatus: params.DetailedStatus{Status: "active"}},
+						},
+					},
+				},
+			},
+			"subordinate": {SubordinateTo: []string{"testapplication"}},
+		},
+	}
+
+	response, err := applicationStatusDetail(status, "testapplication")
+	s.Require().NoError(err)
+	s.Assert().Equal("active", response.Status)
+	s.Assert().Equal("ready", response.Message)
+	s.Require().Len(response.Units, 2)
+	s.Assert().Equal(UnitStatusDetail{
+		Name:           "testapplication/0",
+		Machine:        "0",
+		Leader:         true,
+		WorkloadStatus: "active",
+		AgentStatus:    "idle",
+		PublicAddress:  "203.0.113.2",
+		PrivateAddress: "10.0.0.2",
+		OpenPorts:      []string{"443/tcp", "80/tcp"},
+	}, response.Units[0])
+	s.Assert().Equal("10.0.1.4", response.Units[1].PrivateAddress)
+	s.Assert().Equal("waiting for database", response.Units[1].WorkloadMessage)
+
+	// The units of a subordinate are found under their principal.
+	response, err = applicationStatusDetail(status, "subordinate")
+	s.Require().NoError(err)
+	s.Require().Len(response.Units, 1)
+	s.Assert().Equal("subordinate/0", response.Units[0].Name)
+	s.Assert().Equal("10.0.0.2", response.Units[0].PrivateAddress)
+
+	_, err = applicationStatusDetail(status, "missing")
+	s.Assert().ErrorContains(err, `application "missing" not found`)
+}
+
+func (s *ApplicationSuite) TestRunLeaderAction() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().EnqueueOperation([]apiaction.Action{{
+		Receiver:   "testapplication/leader",
+		Name:       "drain",
+		Parameters: map[string]interface{}{"grace-period": 30, "force": true, "reason": "decommission"},
+	}}).Return(apiaction.EnqueuedActions{
+		OperationID: "1",
+		Actions:     []apiaction.ActionResult{{Action: &apiaction.Action{ID: "2"}}},
+	}, nil)
+	s.mockActionClient.EXPECT().Actions([]string{"2"}).Return([]apiaction.ActionResult{{
+		Status: params.ActionCompleted,
+	}}, nil)
+
+	client := s.getApplicationsClient()
+	err := client.RunLeaderAction(context.Background(), &RunLeaderActionInput{
+		ModelName:  s.testModelName,
+		AppName:    "testapplication",
+		ActionName: "drain",
+		Params:     map[string]string{"grace-period": "30", "force": "true", "reason": "decommission"},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ApplicationSuite) TestRunLeaderActionFailed() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().EnqueueOperation(gomock.Any()).Return(apiaction.EnqueuedActions{
+		Actions: []apiaction.ActionResult{{Action: &apiaction.Action{ID: "2"}}},
+	}, nil)
+	s.mockActionClient.EXPECT().Actions([]string{"2"}).Return([]apiaction.ActionResult{{
+		Status:  params.ActionFailed,
+		Message: "backup target unreachable",
+	}}, nil)
+
+	client := s.getApplicationsClient()
+	err := client.RunLeaderAction(context.Background(), &RunLeaderActionInput{
+		ModelName:  s.testModelName,
+		AppName:    "testapplication",
+		ActionName: "backup",
+	})
+	s.Require().EqualError(err, `action "backup" failed: backup target unreachable`)
+}
+
+func (s *ApplicationSuite) TestRunAction() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().EnqueueOperation([]apiaction.Action{
+		{Receiver: "testapplication/0", Name: "add-admin-user", Parameters: map[string]interface{}{"name": "ops"}},
+		{Receiver: "testapplication/1", Name: "add-admin-user", Parameters: map[string]interface{}{"name": "ops"}},
+	}).Return(apiaction.EnqueuedActions{
+		OperationID: "1",
+		Actions: []apiaction.ActionResult{
+			{Action: &apiaction.Action{ID: "2"}},
+			{Action: &apiaction.Action{ID: "3"}},
+		},
+	}, nil)
+	// The results are read until every action completed.
+	s.mockActionClient.EXPECT().Actions([]string{"2", "3"}).Return([]apiaction.ActionResult{
+		{Status: params.ActionCompleted},
+		{Status: params.ActionRunning},
+	}, nil)
+	s.mockActionClient.EXPECT().Actions([]string{"2", "3"}).Return([]apiaction.ActionResult{
+		{
+			Action: &apiaction.Action{Receiver: "unit-testapplication-0"},
+			Status: params.ActionCompleted,
+			Output: map[string]interface{}{"result": map[string]interface{}{"password": "secret"}, "return-code": 0},
+		},
+		{
+			Action: &apiaction.Action{Receiver: "unit-testapplication-1"},
+			Status: params.ActionCompleted,
+		},
+	}, nil)
+
+	client := s.getApplicationsClient()
+	resp, err := client.RunAction(context.Background(), &RunActionInput{
+		ModelName:  s.testModelName,
+		AppName:    "testapplication",
+		Units:      []string{"testapplication/0", "testapplication/1"},
+		ActionName: "add-admin-user",
+		Params:     map[string]string{"name": "ops"},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(&RunActionResponse{
+		OperationID: "1",
+		Results: []ActionResult{
+			{Unit: "testapplication/0", Status: params.ActionCompleted, Output: map[string]string{"result.password": "secret", "return-code": "0"}},
+			{Unit: "testapplication/1", Status: params.ActionCompleted, Output: map[string]string{}},
+		},
+	}, resp)
+}
+
+func (s *ApplicationSuite) TestRunActionFailedOnUnit() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().EnqueueOperation(gomock.Any()).Return(apiaction.EnqueuedActions{
+		Actions: []apiaction.ActionResult{
+			{Action: &apiaction.Action{ID: "2"}},
+			{Action: &apiaction.Action{ID: "3"}},
+		},
+	}, nil)
+	s.mockActionClient.EXPECT().Actions([]string{"2", "3"}).Return([]apiaction.ActionResult{
+		{Status: params.ActionCompleted},
+		{Status: params.ActionFailed, Message: "disk full"},
+	}, nil)
+
+	client := s.getApplicationsClient()
+	_, err := client.RunAction(context.Background(), &RunActionInput{
+		ModelName:  s.testModelName,
+		AppName:    "testapplication",
+		Units:      []string{"testapplication/0", "testapplication/1"},
+		ActionName: "create-backup",
+	})
+	s.Require().EqualError(err, `testapplication/1: action "create-backup" failed: disk full`)
+}
+
+func (s *ApplicationSuite) TestRunActionRetriesReadErrors() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockActionClient.EXPECT().EnqueueOperation(gomock.Any()).Return(apiaction.EnqueuedActions{
+		OperationID: "1",
+		Actions:     []apiaction.ActionResult{{Action: &apiaction.Action{ID: "2"}}},
+	}, nil)
+	// A transient error reading the results does not fail the action.
+	s.mockActionClient.EXPECT().Actions([]string{"2"}).Return(nil, errors.New("connection reset by peer"))
+	s.mockActionClient.EXPECT().Actions([]string{"2"}).Return([]apiaction.ActionResult{
+		{Status: params.ActionCompleted},
+	}, nil)
+
+	client := s.getApplicationsClient()
+	resp, err := client.RunAction(context.Background(), &RunActionInput{
+		ModelName:  s.testModelName,
+		AppName:    "testapplication",
+		ActionName: "create-backup",
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal("1", resp.OperationID)
+}
+
+func (s *ApplicationSuite) TestActionCompleted() {
+	err := actionCompleted("drain", apiaction.ActionResult{Status: params.ActionRunning})
+	s.Assert().ErrorAs(err, &RetryReadError)
+	s.Assert().NoError(actionCompleted("drain", apiaction.ActionResult{Status: params.ActionCompleted}))
+	s.Assert().EqualError(actionCompleted("drain", apiaction.ActionResult{Status: params.ActionCancelled}), `action "drain" cancelled`)
+	s.Assert().ErrorAs(actionCompleted("drain", apiaction.ActionResult{Status: params.ActionFailed}), &ActionFailedError)
+}
+
+func (s *ApplicationSuite) TestApplicationSummaries() {
+	status := &params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{
+			"web-frontend": {
+				Charm:        "ch:amd64/jammy/nginx-ingress-12",
+				CharmChannel: "latest/stable",
+				Status:       params.DetailedStatus{Status: "active"},
+				Exposed:      true,
+				Units: map[string]params.UnitStatus{
+					"web-frontend/0": {Subordinates: map[string]params.UnitStatus{"logging/0": {}}},
+					"web-frontend/1": {Subordinates: map[string]params.UnitStatus{"logging/1": {}}},
+				},
+			},
+			"web-backend": {
+				Charm:  "ch:amd64/jammy/postgresql-363",
+				Status: params.DetailedStatus{Status: "blocked"},
+				Units:  map[string]params.UnitStatus{"web-backend/0": {}},
+			},
+			"logging": {
+				Charm:         "ch:amd64/jammy/grafana-agent-52",
+				SubordinateTo: []string{"web-frontend"},
+			},
+		},
+	}
+
+	applications, err := applicationSummaries(status, &ListApplicationsInput{})
+	s.Require().NoError(err)
+	s.Require().Len(applications, 3)
+	s.Assert().Equal(ApplicationSummary{
+		Name:        "logging",
+		CharmName:   "grafana-agent",
+		Revision:    52,
+		Subordinate: true,
+		Units:       2,
+	}, applications[0])
+	s.Assert().Equal(ApplicationSummary{
+		Name:      "web-frontend",
+		CharmName: "nginx-ingress",
+		Channel:   "latest/stable",
+		Revision:  12,
+		Status:    "active",
+		Exposed:   true,
+		Units:     2,
+	}, applications[2])
+
+	applications, err = applicationSummaries(status, &ListApplicationsInput{NamePrefix: "web-"})
+	s.Require().NoError(err)
+	s.Require().Len(applications, 2)
+	s.Assert().Equal("web-backend", applications[0].Name)
+
+	applications, err = applicationSummaries(status, &ListApplicationsInput{NamePrefix: "web-", CharmName: "postgresql"})
+	s.Require().NoError(err)
+	s.Require().Len(applications, 1)
+	s.Assert().Equal("web-backend", applications[0].Name)
+}
+
+func (s *ApplicationSuite) TestExposeFromStatus() {
+	s.Assert().Nil(exposeFromStatus(params.ApplicationStatus{}))
+
+	// Exposed without endpoints, spaces nor CIDRs.
+	expose := exposeFromStatus(params.ApplicationStatus{
+		Exposed: true,
+		ExposedEndpoints: map[string]params.ExposedEndpoint{
+			"": {ExposeToCIDRs: []string{"0.0.0.0/0", "::/0"}},
+		},
+	})
+	s.Assert().Equal(map[string]interface{}{"endpoints": "", "spaces": "", "cidrs": ""}, expose)
+
+	// The endpoints are sorted, without an entry for the whole application.
+	endpoint := params.ExposedEndpoint{ExposeToSpaces: []string{"public"}, ExposeToCIDRs: []string{"10.0.0.0/24"}}
+	expose = exposeFromStatus(params.ApplicationStatus{
+		Exposed: true,
+		ExposedEndpoints: map[string]params.ExposedEndpoint{
+			"website": endpoint,
+			"admin":   endpoint,
+		},
+	})
+	s.Assert().Equal(map[string]interface{}{"endpoints": "admin,website", "spaces": "public", "cidrs": "10.0.0.0/24"}, expose)
+}
+
+func (s *ApplicationSuite) TestTrustFromConfig() {
+	trust, err := trustFromConfig(nil)
+	s.Require().NoError(err)
+	s.Assert().False(trust)
+
+	// Set with `juju trust` out of band.
+	trust, err = trustFromConfig(map[string]interface{}{
+		"trust": map[string]interface{}{"value": true, "source": "user"},
+	})
+	s.Require().NoError(err)
+	s.Assert().True(trust)
+
+	trust, err = trustFromConfig(map[string]interface{}{
+		"trust": map[string]interface{}{"value": "true"},
+	})
+	s.Require().NoError(err)
+	s.Assert().True(trust)
+
+	trust, err = trustFromConfig(map[string]interface{}{
+		"trust": map[string]interface{}{"source": "default"},
+	})
+	s.Require().NoError(err)
+	s.Assert().False(trust)
+
+	_, err = trustFromConfig(map[string]interface{}{
+		"trust": map[string]interface{}{"value": 1},
+	})
+	s.Assert().Error(err)
+}
+
+func (s *ApplicationSuite) TestResourcesFromApplication() {
+	resource := func(name string, origin charmresources.Origin, revision int) resources.Resource {
+		return resources.Resource{Resource: charmresources.Resource{
+			Meta:     charmresources.Meta{Name: name},
+			Origin:   origin,
+			Revision: revision,
+		}}
+	}
+	revisions, uploaded := resourcesFromApplication([]resources.ApplicationResources{{
+		Resources: []resources.Resource{
+			resource("store-res", charmresources.OriginStore, 4),
+			resource("image-res", charmresources.OriginUpload, 0),
+			resource("file-res", charmresources.OriginUpload, 0),
+		},
+	}})
+	s.Assert().Equal(map[string]string{"store-res": "4"}, revisions)
+	s.Assert().Equal([]string{"file-res", "image-res"}, uploaded)
+}
+
+func (s *ApplicationSuite) TestUnitAgentVersions() {
+	unit := func(v string) params.UnitStatus {
+		return params.UnitStatus{AgentStatus: params.DetailedStatus{Version: v}}
+	}
+	units := map[string]params.UnitStatus{
+		"app/0": unit("3.5.3"),
+		"app/1": unit("3.4.5"),
+		"app/2": unit(""),
+	}
+	minVersion, maxVersion, pending := unitAgentVersions(units, "3.5.3")
+	s.Assert().Equal("3.4.5", minVersion)
+	s.Assert().Equal("3.5.3", maxVersion)
+	s.Assert().True(pending)
+
+	units["app/1"] = unit("3.5.3")
+	_, _, pending = unitAgentVersions(units, "3.5.3")
+	s.Assert().False(pending)
+
+	minVersion, maxVersion, pending = unitAgentVersions(map[string]params.UnitStatus{"app/0": unit("")}, "3.5.3")
+	s.Assert().Equal("", minVersion)
+	s.Assert().Equal("", maxVersion)
+	s.Assert().False(pending)
+}
+
+func (s *ApplicationSuite) TestMapPlacementMachines() {
+	mapMachines := map[string]string{"0": "4", "1": "7"}
+	placement, err := MapPlacementMachines("0,1/lxd/2,lxd:1,2,lxd,zone=us-east-1a", mapMachines)
+	s.Require().NoError(err)
+	s.Assert().Equal("4,7/lxd/2,lxd:7,2,lxd,zone=us-east-1a", placement)
+
+	placement, err = MapPlacementMachines("", mapMachines)
+	s.Require().NoError(err)
+	s.Assert().Equal("", placement)
+
+	_, err = MapPlacementMachines("0", map[string]string{"0": "4", "1": "4"})
+	s.Assert().EqualError(err, `machines "0" and "1" are both mapped to machine "4"`)
+}
+
+func (s *ApplicationSuite) TestUnitPlacements() {
+	defer s.setupMocks(s.T()).Finish()
+	modelUUID := "0a8a7a3b-0b8c-4a84-8f4d-2f5c4b0e2d10"
+	s.mockConnection.EXPECT().ModelTag().Return(namesv5.NewModelTag(modelUUID), true).AnyTimes()
+
+	directives := []string{"lxd:3", "zone=us-east-1a", "0", "lxd"}
+
+	// The directives of the first units keep their order.
+	placements, err := unitPlacements(s.mockConnection, directives, nil, 0, 3)
+	s.Require().NoError(err)
+	s.Assert().Equal([]*instance.Placement{
+		{Scope: "lxd", Directive: "3"},
+		{Scope: modelUUID, Directive: "zone=us-east-1a"},
+		{Scope: instance.MachineScope, Directive: "0"},
+	}, placements)
+
+	// Added units use the directives following the existing units,
+	// units past the last directive are placed on new machines.
+	placements, err = unitPlacements(s.mockConnection, directives, map[string]string{"3": "5"}, 3, 2)
+	s.Require().NoError(err)
+	s.Assert().Equal([]*instance.Placement{{Scope: "lxd"}}, placements)
+
+	placements, err = unitPlacements(s.mockConnection, directives, map[string]string{"3": "5"}, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().Equal([]*instance.Placement{{Scope: "lxd", Directive: "5"}}, placements)
+
+	placements, err = unitPlacements(s.mockConnection, directives, nil, 4, 1)
+	s.Require().NoError(err)
+	s.Assert().Nil(placements)
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestApplicationSuite(t *testing.T) {
@@ -3,7 +3,10 @@
 
 package juju
 
-import "github.com/juju/juju/core/base"
+import (
+	"github.com/juju/juju/core/base"
+	"github.com/juju/version/v2"
+)
 
 // basesContain returns true if the provide base is contained
 // in the provided slice of bases.
@@ -35,3 +38,38 @@ func intersectionOfBases(one, two []base.Base) []base.Base {
 	}
 	return result
 }
+
+// latestLTSBase returns the newest Ubuntu LTS base found in the
+// provided slice of bases, and whether one was found.
+func latestLTSBase(bases []base.Base) (base.Base, bool) {
+	var latest base.Base
+	found := false
+	for _, v := range bases {
+		if !v.IsUbuntuLTS() {
+			continue
+		}
+		if !found || trackNewer(v.Channel.Track, latest.Channel.Track) {
+			latest = v
+			found = true
+		}
+	}
+	return latest, found
+}
+
+// trackNewer returns whether the track of a base channel is a newer
+// version than another, e.g. 24.04 than 22.04 or 3.10 than 3.9. Tracks
+// which are not versions are compared as strings.
+func trackNewer(track, than string) bool {
+	major, minor, err := version.ParseMajorMinor(track)
+	if err != nil {
+		return track > than
+	}
+	thanMajor, thanMinor, err := version.ParseMajorMinor(than)
+	if err != nil {
+		return track > than
+	}
+	if major != thanMajor {
+		return major > thanMajor
+	}
+	return minor > thanMinor
+}
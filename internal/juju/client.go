@@ -6,17 +6,30 @@ package juju
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	jaasApi "github.com/canonical/jimm-go-sdk/v3/api"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/client/modelmanager"
 	"github.com/juju/juju/api/connector"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/proxy"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+	"github.com/juju/retry"
+	"github.com/juju/version/v2"
+	"gopkg.in/httprequest.v1"
+	"gopkg.in/macaroon.v2"
 )
 
 const (
@@ -29,6 +42,9 @@ const (
 	PrefixStorage       = "storage-"
 	UnspecifiedRevision = -1
 	connectionTimeout   = 30 * time.Second
+
+	upgradeRetryAttempts = 6
+	upgradeRetryDelay    = 10 * time.Second
 )
 
 type ControllerConfiguration struct {
@@ -38,22 +54,99 @@ type ControllerConfiguration struct {
 	CACert              string
 	ClientID            string
 	ClientSecret        string
+	// TolerateControllerUpgrades retries API calls rejected while the
+	// controller is upgrading, and lets resources keep their prior
+	// state on refresh if the upgrade does not finish in time.
+	TolerateControllerUpgrades bool
+	// Features are the experimental behaviours enabled by name.
+	Features map[string]bool
+	// ApplySummaryFile is the path of the file the summary of each
+	// operation performed on a resource is appended to, if set.
+	ApplySummaryFile string
+	// ConnectionPoolSize is the number of idle API connections kept
+	// open to be shared by the operations of the resources. Each
+	// operation dials its own connection if zero.
+	ConnectionPoolSize int
+	// ConnectionIdleTimeout is the duration an idle API connection is
+	// kept open in the pool.
+	ConnectionIdleTimeout time.Duration
+	// ReadOnly makes the resources refuse to create, update or delete
+	// anything, while reads are allowed.
+	ReadOnly bool
+	// DefaultCharmChannel is the channel applications are deployed
+	// from when they do not set one.
+	DefaultCharmChannel string
+	// DefaultBase is the base applications are deployed on when they
+	// set neither a base, a series nor a base selection policy.
+	DefaultBase string
+	// ControllerLookup finds the controllers selected by name with
+	// Client.ForController. Only the controller configured is used if
+	// nil.
+	ControllerLookup ControllerLookup
 }
 
 type Client struct {
-	Applications applicationsClient
-	Machines     machinesClient
-	Clouds       kubernetesCloudsClient
-	Credentials  credentialsClient
-	Integrations integrationsClient
-	Models       modelsClient
-	Offers       offersClient
-	SSHKeys      sshKeysClient
-	Users        usersClient
-	Secrets      secretsClient
-	Jaas         jaasClient
+	Annotations      annotationsClient
+	Applications     ApplicationsClient
+	Machines         MachinesClient
+	Clouds           cloudsClient
+	KubernetesClouds KubernetesCloudsClient
+	Controllers      controllersClient
+	Credentials      credentialsClient
+	Integrations     integrationsClient
+	Models           ModelsClient
+	Offers           offersClient
+	SSHKeys          sshKeysClient
+	Spaces           spacesClient
+	StoragePools     storagePoolsClient
+	Users            usersClient
+	Secrets          secretsClient
+	SecretBackends   secretBackendsClient
+	Jaas             JaasClient
 
 	isJAAS func() bool
+
+	tolerateControllerUpgrades bool
+	readOnly                   bool
+	defaultCharmChannel        string
+	defaultBase                string
+	features                   map[string]bool
+	redactedValues             []string
+	summary                    *applySummary
+
+	// controllers are the clients of the controllers selected by
+	// name, shared with those clients.
+	controllers *controllerClients
+}
+
+// TolerateControllerUpgrades returns a boolean to indicate whether resources
+// should keep their prior state when a refresh fails during a controller upgrade.
+func (c Client) TolerateControllerUpgrades() bool {
+	return c.tolerateControllerUpgrades
+}
+
+// ReadOnly returns a boolean to indicate whether resources must refuse
+// to create, update or delete anything.
+func (c Client) ReadOnly() bool {
+	return c.readOnly
+}
+
+// DefaultCharmChannel returns the channel applications are deployed from
+// when they do not set one, empty if the provider does not set it.
+func (c Client) DefaultCharmChannel() string {
+	return c.defaultCharmChannel
+}
+
+// DefaultBase returns the base applications are deployed on when they do
+// not select one, empty if the provider does not set it.
+func (c Client) DefaultBase() string {
+	return c.defaultBase
+}
+
+// FeatureEnabled returns a boolean to indicate whether the experimental
+// behaviour with the given name has been enabled in the provider.
+func (c Client) FeatureEnabled(name string) bool {
+	return c.features[name]
 }
 
 // IsJAAS returns a boolean to indicate whether the controller configured is a JAAS controller.
@@ -76,25 +169,45 @@ type sharedClient struct {
 
 	modelUUIDcache map[string]jujuModel
 	modelUUIDmu    sync.Mutex
+	// modelCacheFiller shares the fills of the model cache between
+	// concurrent lookups of models missing from it.
+	modelCacheFiller cacheFiller
 
 	// subCtx is the context created with the new tflog subsystem for applications.
 	subCtx context.Context
 
 	checkJAASOnce sync.Once
 	isJAAS        bool
+
+	// pool shares the API connections between operations, nil if
+	// connections are not pooled.
+	pool *connectionPool
 }
 
 // NewClient returns a client which can talk to the juju controller
 // represented by controllerConfig. A context is required for logging in the
 // terraform framework.
 func NewClient(ctx context.Context, config ControllerConfiguration) (*Client, error) {
+	return newClient(ctx, config, newControllerClients(config))
+}
+
+// newClient returns the client of the controller configured, sharing
+// the clients of the controllers selected by name.
+func newClient(ctx context.Context, config ControllerConfiguration, controllers *controllerClients) (*Client, error) {
 	if ctx == nil {
 		return nil, errors.NotValidf("missing context")
 	}
 	sc := &sharedClient{
 		controllerConfig: config,
 		modelUUIDcache:   make(map[string]jujuModel),
-		subCtx:           tflog.NewSubsystem(ctx, LogJujuClient),
+		subCtx:           newRedactedSubsystem(ctx, LogJujuClient, config.Password, config.ClientSecret),
+	}
+	if config.ConnectionPoolSize > 0 {
+		idleTimeout := config.ConnectionIdleTimeout
+		if idleTimeout <= 0 {
+			idleTimeout = DefaultConnectionIdleTimeout
+		}
+		sc.pool = newConnectionPool(config.ConnectionPoolSize, idleTimeout)
 	}
 	// Client ID and secret are only set when connecting to JAAS. Use this as a fallback
 	// value if connecting to the controller fails.
@@ -104,18 +217,33 @@ func NewClient(ctx context.Context, config ControllerConfiguration) (*Client, er
 	}
 
 	return &Client{
-		Applications: *newApplicationClient(sc),
-		Clouds:       *newKubernetesCloudsClient(sc),
-		Credentials:  *newCredentialsClient(sc),
-		Integrations: *newIntegrationsClient(sc),
-		Machines:     *newMachinesClient(sc),
-		Models:       *newModelsClient(sc),
-		Offers:       *newOffersClient(sc),
-		SSHKeys:      *newSSHKeysClient(sc),
-		Users:        *newUsersClient(sc),
-		Secrets:      *newSecretsClient(sc),
-		Jaas:         *newJaasClient(sc),
-		isJAAS:       func() bool { return sc.IsJAAS(defaultJAASCheck) },
+		Annotations:      *newAnnotationsClient(sc),
+		Applications:     newApplicationClient(sc),
+		Clouds:           *newCloudsClient(sc),
+		KubernetesClouds: newKubernetesCloudsClient(sc),
+		Controllers:      *newControllersClient(sc),
+		Credentials:      *newCredentialsClient(sc),
+		Integrations:     *newIntegrationsClient(sc),
+		Machines:         newMachinesClient(sc),
+		Models:           newModelsClient(sc),
+		Offers:           *newOffersClient(sc),
+		SSHKeys:          *newSSHKeysClient(sc),
+		Spaces:           *newSpacesClient(sc),
+		StoragePools:     *newStoragePoolsClient(sc),
+		Users:            *newUsersClient(sc),
+		Secrets:          *newSecretsClient(sc),
+		SecretBackends:   *newSecretBackendsClient(sc),
+		Jaas:             newJaasClient(sc),
+		isJAAS:           func() bool { return sc.IsJAAS(defaultJAASCheck) },
+
+		tolerateControllerUpgrades: config.TolerateControllerUpgrades,
+		readOnly:                   config.ReadOnly,
+		defaultCharmChannel:        config.DefaultCharmChannel,
+		defaultBase:                config.DefaultBase,
+		features:                   config.Features,
+		redactedValues:             []string{config.Password, config.ClientSecret},
+		summary:                    controllers.summary,
+		controllers:                controllers,
 	}, nil
 }
 
@@ -129,7 +257,7 @@ func NewClient(ctx context.Context, config ControllerConfiguration) (*Client, er
 func (sc *sharedClient) IsJAAS(defaultVal bool) bool {
 	sc.checkJAASOnce.Do(func() {
 		sc.isJAAS = defaultVal
-		conn, err := sc.GetConnection(nil)
+		conn, err := sc.GetConnection(context.Background(), nil)
 		if err != nil {
 			return
 		}
@@ -145,12 +273,20 @@ func (sc *sharedClient) IsJAAS(defaultVal bool) bool {
 }
 
 // GetConnection returns a juju connection for use creating juju
-// api clients given the provided model name.
-func (sc *sharedClient) GetConnection(modelName *string) (api.Connection, error) {
+// api clients given the provided model name. The connection is closed
+// when ctx is done, so that in-flight API calls are aborted when the
+// operation of the resource is cancelled or times out. When connections
+// are pooled, the connection may be shared with other operations and
+// closing it releases it to the pool.
+func (sc *sharedClient) GetConnection(ctx context.Context, modelName *string) (api.Connection, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	var modelUUID string
 	if modelName != nil {
 		var err error
-		modelUUID, err = sc.ModelUUID(*modelName)
+		modelUUID, err = sc.ModelUUID(ctx, *modelName)
 		if err != nil {
 			return nil, err
 		}
@@ -159,59 +295,438 @@ func (sc *sharedClient) GetConnection(modelName *string) (api.Connection, error)
 	dialOptions := func(do *api.DialOpts) {
 		//this is set as a const above, in case we need to use it elsewhere to manage connection timings
 		do.Timeout = connectionTimeout
+		// Do not keep dialing past the deadline of the operation.
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < do.Timeout {
+			do.Timeout = time.Until(deadline)
+		}
 		//default is 2 seconds, as we are changing the overall timeout it makes sense to reduce this as well
 		do.RetryDelay = 1 * time.Second
 	}
 
-	connr, err := connector.NewSimple(connector.SimpleConfig{
-		ControllerAddresses: sc.controllerConfig.ControllerAddresses,
-		Username:            sc.controllerConfig.Username,
-		Password:            sc.controllerConfig.Password,
-		ClientID:            sc.controllerConfig.ClientID,
-		ClientSecret:        sc.controllerConfig.ClientSecret,
-		CACert:              sc.controllerConfig.CACert,
-		ModelUUID:           modelUUID,
-	}, dialOptions)
+	dial := func() (api.Connection, error) {
+		connr, err := connector.NewSimple(connector.SimpleConfig{
+			ControllerAddresses: sc.controllerConfig.ControllerAddresses,
+			Username:            sc.controllerConfig.Username,
+			Password:            sc.controllerConfig.Password,
+			ClientID:            sc.controllerConfig.ClientID,
+			ClientSecret:        sc.controllerConfig.ClientSecret,
+			CACert:              sc.controllerConfig.CACert,
+			ModelUUID:           modelUUID,
+		}, dialOptions)
+		if err != nil {
+			return nil, err
+		}
+		return connectWithContext(ctx, connr)
+	}
+
+	connect := func() (api.Connection, error) {
+		var conn api.Connection
+		var err error
+		if sc.pool != nil {
+			conn, err = sc.pool.acquire(ctx, modelUUID, dial)
+		} else {
+			conn, err = dial()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return newContextConnection(ctx, conn), nil
+	}
+
+	conn, err := connect()
 	if err != nil {
+		sc.Errorf(err, "connection not established")
 		return nil, err
 	}
+	if sc.controllerConfig.ClientID != "" && sc.controllerConfig.ClientSecret != "" {
+		conn = newReloginConnection(conn, connect, sc)
+	}
+	if sc.controllerConfig.TolerateControllerUpgrades {
+		return &upgradeTolerantConnection{Connection: conn, sc: sc}, nil
+	}
+	return conn, nil
+}
+
+// connectWithContext connects to the controller, giving up as soon as
+// ctx is done. The juju api does not take a context when dialing, a
+// connection established after ctx is done is closed.
+func connectWithContext(ctx context.Context, connr connector.Connector) (api.Connection, error) {
+	type result struct {
+		conn api.Connection
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		conn, err := connr.Connect()
+		done <- result{conn: conn, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.conn, r.err
+	case <-ctx.Done():
+		go func() {
+			if r := <-done; r.err == nil {
+				_ = r.conn.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	}
+}
 
-	conn, err := connr.Connect()
+// contextConnection is a connection closed when its context is done.
+// The juju api does not take a context in API calls, closing the
+// connection is the only way to abort a call in flight. A pooled
+// connection is shared with other operations and is not closed to abort
+// a call: the call is abandoned, and the connection retired from the
+// pool so that it is closed once the other operations release it.
+type contextConnection struct {
+	api.Connection
+	ctx context.Context
+
+	closeOnce sync.Once
+	closeErr  error
+	stop      chan struct{}
+}
+
+// retirer is implemented by the connections of the pool.
+type retirer interface {
+	retire()
+}
+
+func newContextConnection(ctx context.Context, conn api.Connection) *contextConnection {
+	c := &contextConnection{
+		Connection: conn,
+		ctx:        ctx,
+		stop:       make(chan struct{}),
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = c.Close()
+		case <-c.stop:
+		}
+	}()
+	return c
+}
+
+// APICall implements base.APICaller. The error of a call aborted
+// because ctx is done is the error of ctx.
+func (c *contextConnection) APICall(objType string, version int, id, request string, params, response interface{}) error {
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	r, ok := c.Connection.(retirer)
+	if !ok {
+		err := c.Connection.APICall(objType, version, id, request, params, response)
+		if err != nil && c.ctx.Err() != nil {
+			return errors.Annotatef(c.ctx.Err(), "%s.%s", objType, request)
+		}
+		return err
+	}
+
+	// The call of a pooled connection runs until it completes, or the
+	// connection is closed, even once abandoned. Its response must not
+	// be used when it is abandoned.
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Connection.APICall(objType, version, id, request, params, response)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-c.ctx.Done():
+		r.retire()
+		return errors.Annotatef(c.ctx.Err(), "%s.%s", objType, request)
+	}
+}
+
+// Close closes the connection once, whether it is called by the
+// client or because ctx is done.
+func (c *contextConnection) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.stop)
+		c.closeErr = c.Connection.Close()
+	})
+	return c.closeErr
+}
+
+// upgradeTolerantConnection retries the API calls which fail because
+// the controller is being upgraded.
+type upgradeTolerantConnection struct {
+	api.Connection
+	sc *sharedClient
+}
+
+// APICall implements base.APICaller.
+func (c *upgradeTolerantConnection) APICall(objType string, version int, id, request string, params, response interface{}) error {
+	return retry.Call(retry.CallArgs{
+		Func: func() error {
+			return c.Connection.APICall(objType, version, id, request, params, response)
+		},
+		IsFatalError: func(err error) bool {
+			return !IsControllerUpgradeError(err)
+		},
+		NotifyFunc: func(err error, attempt int) {
+			c.sc.Warnf("controller upgrade in progress, retrying", map[string]interface{}{
+				"request": fmt.Sprintf("%s.%s", objType, request),
+				"attempt": attempt,
+			})
+		},
+		Attempts: upgradeRetryAttempts,
+		Delay:    upgradeRetryDelay,
+		Clock:    clock.WallClock,
+	})
+}
+
+// Ensure reloginConnection forwards every method of the connection.
+var _ api.Connection = (*reloginConnection)(nil)
+
+// reloginConnection logs in again when an API call is rejected because
+// the session of the service account authenticated with client
+// credentials has expired, e.g. once the access token JAAS obtained for
+// it is no longer valid, and retries the call once on the new
+// connection. Logging in with the client credentials obtains a new
+// token. Every method of the connection is forwarded to the current
+// connection, so that it is not used once the session expired.
+type reloginConnection struct {
+	sc      *sharedClient
+	connect func() (api.Connection, error)
+
+	mu      sync.Mutex
+	current api.Connection
+}
+
+func newReloginConnection(conn api.Connection, connect func() (api.Connection, error), sc *sharedClient) *reloginConnection {
+	return &reloginConnection{
+		sc:      sc,
+		connect: connect,
+		current: conn,
+	}
+}
+
+// conn returns the connection the API calls are made on.
+func (c *reloginConnection) conn() api.Connection {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current
+}
+
+// APICall implements base.APICaller.
+func (c *reloginConnection) APICall(objType string, version int, id, request string, params, response interface{}) error {
+	conn := c.conn()
+	err := conn.APICall(objType, version, id, request, params, response)
+	if !isSessionExpiredError(err) {
+		return err
+	}
+	c.sc.Debugf("session expired, logging in again", map[string]interface{}{
+		"request": fmt.Sprintf("%s.%s", objType, request),
+		"err":     err,
+	})
+	newConn, loginErr := c.relogin(conn)
+	if loginErr != nil {
+		c.sc.Errorf(loginErr, "logging in again")
+		return err
+	}
+	return newConn.APICall(objType, version, id, request, params, response)
+}
+
+// relogin replaces the expired connection with a new one, unless a
+// concurrent call already did. A connection of the pool is retired, so
+// that the other operations log in again too once they are done with it.
+func (c *reloginConnection) relogin(expired api.Connection) (api.Connection, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.current != expired {
+		return c.current, nil
+	}
+	if ctxConn, ok := expired.(*contextConnection); ok {
+		if r, ok := ctxConn.Connection.(retirer); ok {
+			r.retire()
+		}
+	}
+	conn, err := c.connect()
 	if err != nil {
-		sc.Errorf(err, "connection not established")
 		return nil, err
 	}
+	_ = expired.Close()
+	c.current = conn
 	return conn, nil
 }
 
-func (sc *sharedClient) ModelUUID(modelName string) (string, error) {
-	sc.modelUUIDmu.Lock()
-	defer sc.modelUUIDmu.Unlock()
-	dataMap := make(map[string]interface{})
-	// How to tell if logging level is Trace?
-	for k, v := range sc.modelUUIDcache {
-		dataMap[k] = v.String()
+// IsBroken implements api.Connection.
+func (c *reloginConnection) IsBroken() bool {
+	return c.conn().IsBroken()
+}
+
+// Broken implements api.Connection.
+func (c *reloginConnection) Broken() <-chan struct{} {
+	return c.conn().Broken()
+}
+
+// Close closes the connection the API calls are made on.
+func (c *reloginConnection) Close() error {
+	return c.conn().Close()
+}
+
+// Addr implements api.Connection.
+func (c *reloginConnection) Addr() string {
+	return c.conn().Addr()
+}
+
+// IPAddr implements api.Connection.
+func (c *reloginConnection) IPAddr() string {
+	return c.conn().IPAddr()
+}
+
+// APIHostPorts implements api.Connection.
+func (c *reloginConnection) APIHostPorts() []network.MachineHostPorts {
+	return c.conn().APIHostPorts()
+}
+
+// IsProxied implements api.Connection.
+func (c *reloginConnection) IsProxied() bool {
+	return c.conn().IsProxied()
+}
+
+// Proxy implements api.Connection.
+func (c *reloginConnection) Proxy() proxy.Proxier {
+	return c.conn().Proxy()
+}
+
+// PublicDNSName implements api.Connection.
+func (c *reloginConnection) PublicDNSName() string {
+	return c.conn().PublicDNSName()
+}
+
+// Login implements api.Connection.
+func (c *reloginConnection) Login(name names.Tag, password, nonce string, ms []macaroon.Slice) error {
+	return c.conn().Login(name, password, nonce, ms)
+}
+
+// ServerVersion implements api.Connection.
+func (c *reloginConnection) ServerVersion() (version.Number, bool) {
+	return c.conn().ServerVersion()
+}
+
+// BestFacadeVersion implements base.APICaller.
+func (c *reloginConnection) BestFacadeVersion(facade string) int {
+	return c.conn().BestFacadeVersion(facade)
+}
+
+// ModelTag implements base.APICaller.
+func (c *reloginConnection) ModelTag() (names.ModelTag, bool) {
+	return c.conn().ModelTag()
+}
+
+// HTTPClient implements base.APICaller.
+func (c *reloginConnection) HTTPClient() (*httprequest.Client, error) {
+	return c.conn().HTTPClient()
+}
+
+// RootHTTPClient implements base.APICaller.
+func (c *reloginConnection) RootHTTPClient() (*httprequest.Client, error) {
+	return c.conn().RootHTTPClient()
+}
+
+// BakeryClient implements base.APICaller.
+func (c *reloginConnection) BakeryClient() base.MacaroonDischarger {
+	return c.conn().BakeryClient()
+}
+
+// Context implements base.APICaller.
+func (c *reloginConnection) Context() context.Context {
+	return c.conn().Context()
+}
+
+// ConnectStream implements base.StreamConnector.
+func (c *reloginConnection) ConnectStream(path string, attrs url.Values) (base.Stream, error) {
+	return c.conn().ConnectStream(path, attrs)
+}
+
+// ConnectControllerStream implements base.ControllerStreamConnector.
+func (c *reloginConnection) ConnectControllerStream(path string, attrs url.Values, headers http.Header) (base.Stream, error) {
+	return c.conn().ConnectControllerStream(path, attrs, headers)
+}
+
+// ControllerTag implements api.Connection.
+func (c *reloginConnection) ControllerTag() names.ControllerTag {
+	return c.conn().ControllerTag()
+}
+
+// AuthTag implements api.Connection.
+func (c *reloginConnection) AuthTag() names.Tag {
+	return c.conn().AuthTag()
+}
+
+// ControllerAccess implements api.Connection.
+func (c *reloginConnection) ControllerAccess() string {
+	return c.conn().ControllerAccess()
+}
+
+// CookieURL implements api.Connection.
+func (c *reloginConnection) CookieURL() *url.URL {
+	return c.conn().CookieURL()
+}
+
+// isSessionExpiredError returns true if the API call was rejected
+// because the login of the connection has expired. Other unauthorized
+// errors are permission errors, which logging in again does not fix.
+func isSessionExpiredError(err error) bool {
+	if err == nil {
+		return false
 	}
-	sc.Tracef(fmt.Sprintf("ModelUUID cache looking for %q", modelName), dataMap)
-	if modelWithName, ok := sc.modelUUIDcache[modelName]; ok {
+	return params.ErrCode(errors.Cause(err)) == params.CodeLoginExpired
+}
+
+// IsControllerUpgradeError returns true if the error was returned
+// because the controller is being upgraded.
+func IsControllerUpgradeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	err = retry.LastError(err)
+	return params.IsCodeUpgradeInProgress(errors.Cause(err)) ||
+		strings.Contains(err.Error(), params.CodeUpgradeInProgress)
+}
+
+func (sc *sharedClient) ModelUUID(ctx context.Context, modelName string) (string, error) {
+	if uuid, ok := sc.cachedModelUUID(modelName); ok {
 		sc.Tracef(fmt.Sprintf("Found uuid for %q in cache", modelName))
-		return modelWithName.uuid, nil
+		return uuid, nil
 	}
-	if err := sc.fillModelCache(); err != nil {
+	// The lock is not held while the controller is asked for the
+	// models, so that the operations on models already cached are
+	// not held up. Concurrent lookups of missing models share a
+	// single fill of the cache.
+	if err := sc.modelCacheFiller.fill(ctx, sc.fillModelCache); err != nil {
 		return "", err
 	}
-	if modelWithName, ok := sc.modelUUIDcache[modelName]; ok {
+	if uuid, ok := sc.cachedModelUUID(modelName); ok {
 		sc.Tracef(fmt.Sprintf("Found uuid for %q in cache on 2nd attempt", modelName))
-		return modelWithName.uuid, nil
+		return uuid, nil
 	}
 	return "", errors.NotFoundf("model %q", modelName)
 }
 
+// cachedModelUUID returns the UUID of the model from the model cache.
+func (sc *sharedClient) cachedModelUUID(modelName string) (string, bool) {
+	sc.modelUUIDmu.Lock()
+	defer sc.modelUUIDmu.Unlock()
+	dataMap := make(map[string]interface{})
+	// How to tell if logging level is Trace?
+	for k, v := range sc.modelUUIDcache {
+		dataMap[k] = v.String()
+	}
+	sc.Tracef(fmt.Sprintf("ModelUUID cache looking for %q", modelName), dataMap)
+	modelWithName, ok := sc.modelUUIDcache[modelName]
+	return modelWithName.uuid, ok
+}
+
 // fillModelCache checks with the juju controller for all
 // models and puts the relevant data in the model info cache.
-// Callers are expected to hold the modelUUIDmu lock.
-func (sc *sharedClient) fillModelCache() error {
-	conn, err := sc.GetConnection(nil)
+func (sc *sharedClient) fillModelCache(ctx context.Context) error {
+	conn, err := sc.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -225,6 +740,8 @@ func (sc *sharedClient) fillModelCache() error {
 	if err != nil {
 		return err
 	}
+	sc.modelUUIDmu.Lock()
+	defer sc.modelUUIDmu.Unlock()
 	for _, modelSummary := range modelSummaries {
 		modelWithName := jujuModel{
 			uuid:      modelSummary.UUID,
@@ -235,6 +752,53 @@ func (sc *sharedClient) fillModelCache() error {
 	return nil
 }
 
+// cacheFiller shares a fill of a cache between the callers asking for
+// it while it is in flight, rather than filling the cache once for
+// each of them in turn.
+type cacheFiller struct {
+	mu       sync.Mutex
+	inFlight *cacheFill
+}
+
+// cacheFill is a fill of a cache in flight.
+type cacheFill struct {
+	done chan struct{}
+	err  error
+}
+
+// fill calls fillFunc, unless a call is already in flight in which
+// case its result is waited for. A fill given up by the caller which
+// started it is started again for callers whose context is not done.
+func (f *cacheFiller) fill(ctx context.Context, fillFunc func(context.Context) error) error {
+	for {
+		f.mu.Lock()
+		inFlight := f.inFlight
+		if inFlight == nil {
+			inFlight = &cacheFill{done: make(chan struct{})}
+			f.inFlight = inFlight
+			f.mu.Unlock()
+
+			inFlight.err = fillFunc(ctx)
+			f.mu.Lock()
+			f.inFlight = nil
+			f.mu.Unlock()
+			close(inFlight.done)
+			return inFlight.err
+		}
+		f.mu.Unlock()
+
+		select {
+		case <-inFlight.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if isContextError(inFlight.err) && ctx.Err() == nil {
+			continue
+		}
+		return inFlight.err
+	}
+}
+
 func (sc *sharedClient) ModelType(modelName string) (model.ModelType, error) {
 	sc.modelUUIDmu.Lock()
 	defer sc.modelUUIDmu.Unlock()
@@ -0,0 +1,180 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestContextConnectionClosedOnCancel(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	mockConn := NewMockConnection(ctlr)
+	closed := make(chan struct{})
+	mockConn.EXPECT().Close().DoAndReturn(func() error {
+		close(closed)
+		return nil
+	}).Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	conn := newContextConnection(ctx, mockConn)
+	cancel()
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection not closed when the context was cancelled")
+	}
+	// Calls after cancellation fail without reaching the controller.
+	err := conn.APICall("Client", 1, "", "FullStatus", nil, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	// The connection is only closed once.
+	assert.NoError(t, conn.Close())
+}
+
+func TestContextConnectionAbortedCall(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	mockConn := NewMockConnection(ctlr)
+	mockConn.EXPECT().Close().Return(nil).Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	conn := newContextConnection(ctx, mockConn)
+	mockConn.EXPECT().APICall("Client", 1, "", "FullStatus", nil, nil).DoAndReturn(
+		func(string, int, string, string, interface{}, interface{}) error {
+			cancel()
+			return errors.New("connection is shut down")
+		})
+
+	err := conn.APICall("Client", 1, "", "FullStatus", nil, nil)
+	assert.ErrorIs(t, errors.Cause(err), context.Canceled)
+	assert.NoError(t, conn.Close())
+}
+
+func TestContextConnectionClose(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	mockConn := NewMockConnection(ctlr)
+	mockConn.EXPECT().Close().Return(nil).Times(1)
+	mockConn.EXPECT().APICall("Client", 1, "", "FullStatus", nil, nil).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	conn := newContextConnection(ctx, mockConn)
+	assert.NoError(t, conn.APICall("Client", 1, "", "FullStatus", nil, nil))
+	assert.NoError(t, conn.Close())
+}
+
+func TestCacheFillerSharesFillInFlight(t *testing.T) {
+	var filler cacheFiller
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls int32
+	fillFunc := func(context.Context) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+		}
+		<-release
+		return nil
+	}
+
+	errs := make(chan error, 5)
+	go func() { errs <- filler.fill(context.Background(), fillFunc) }()
+	<-started
+	for i := 0; i < 4; i++ {
+		go func() { errs <- filler.fill(context.Background(), fillFunc) }()
+	}
+	// Let the waiters find the fill in flight before it completes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, <-errs)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// A later fill calls the function again.
+	assert.NoError(t, filler.fill(context.Background(), func(context.Context) error { return nil }))
+}
+
+func TestCacheFillerRetriesFillGivenUp(t *testing.T) {
+	var filler cacheFiller
+	started := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		leaderErr <- filler.fill(ctx, func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}()
+	<-started
+
+	waiterErr := make(chan error, 1)
+	go func() {
+		waiterErr <- filler.fill(context.Background(), func(context.Context) error { return errors.New("fill failed") })
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	// The waiter fills the cache itself rather than failing with the
+	// context error of the caller which gave up.
+	assert.EqualError(t, <-waiterErr, "fill failed")
+}
+
+func TestReloginConnectionRetriesExpiredSession(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	expiredConn := NewMockConnection(ctlr)
+	newConn := NewMockConnection(ctlr)
+	expiredConn.EXPECT().APICall("Client", 1, "", "FullStatus", nil, nil).Return(
+		&params.Error{Code: params.CodeLoginExpired, Message: "login expired"})
+	expiredConn.EXPECT().Close().Return(nil)
+	newConn.EXPECT().APICall("Client", 1, "", "FullStatus", nil, nil).Return(nil).Times(2)
+	newConn.EXPECT().BestFacadeVersion("Client").Return(7)
+	newConn.EXPECT().ModelTag().Return(names.NewModelTag("0a8a7a3b-0b8c-4a84-8f4d-2f5c4b0e2d10"), true)
+	newConn.EXPECT().Close().Return(nil)
+
+	var dials int
+	connect := func() (api.Connection, error) {
+		dials++
+		return newConn, nil
+	}
+	conn := newReloginConnection(expiredConn, connect, &sharedClient{subCtx: newRedactedSubsystem(context.Background(), LogJujuClient)})
+	assert.NoError(t, conn.APICall("Client", 1, "", "FullStatus", nil, nil))
+	// Later calls are made on the new connection.
+	assert.NoError(t, conn.APICall("Client", 1, "", "FullStatus", nil, nil))
+	assert.Equal(t, 1, dials)
+	// So are the other methods.
+	assert.Equal(t, 7, conn.BestFacadeVersion("Client"))
+	modelTag, ok := conn.ModelTag()
+	assert.True(t, ok)
+	assert.Equal(t, "0a8a7a3b-0b8c-4a84-8f4d-2f5c4b0e2d10", modelTag.Id())
+	assert.NoError(t, conn.Close())
+}
+
+func TestReloginConnectionOtherErrors(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	mockConn := NewMockConnection(ctlr)
+	mockConn.EXPECT().APICall("Client", 1, "", "FullStatus", nil, nil).Return(errors.New("boom"))
+
+	connect := func() (api.Connection, error) {
+		t.Fatal("unexpected login")
+		return nil, nil
+	}
+	conn := newReloginConnection(mockConn, connect, &sharedClient{subCtx: newRedactedSubsystem(context.Background(), LogJujuClient)})
+	assert.EqualError(t, conn.APICall("Client", 1, "", "FullStatus", nil, nil), "boom")
+
+	// A permission error is not an expired session.
+	mockConn.EXPECT().APICall("Client", 1, "", "FullStatus", nil, nil).Return(
+		&params.Error{Code: params.CodeUnauthorized, Message: "permission denied"})
+	assert.EqualError(t, conn.APICall("Client", 1, "", "FullStatus", nil, nil), "permission denied")
+}
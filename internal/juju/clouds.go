@@ -0,0 +1,241 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+
+	cloudapi "github.com/juju/juju/api/client/cloud"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+)
+
+var CloudNotFoundError = &cloudNotFoundError{}
+
+type cloudNotFoundError struct {
+	name string
+}
+
+func (ce *cloudNotFoundError) Error() string {
+	return fmt.Sprintf("cloud %q was not found", ce.name)
+}
+
+type cloudsClient struct {
+	SharedClient
+}
+
+// CloudRegion is a region of a cloud, with its own endpoints if they
+// differ from the endpoints of the cloud.
+type CloudRegion struct {
+	Name             string
+	Endpoint         string
+	IdentityEndpoint string
+	StorageEndpoint  string
+}
+
+type CreateCloudInput struct {
+	Name             string
+	Type             string
+	Description      string
+	AuthTypes        []string
+	Endpoint         string
+	IdentityEndpoint string
+	StorageEndpoint  string
+	Regions          []CloudRegion
+	CACertificates   []string
+}
+
+type ReadCloudResponse struct {
+	Name             string
+	Type             string
+	Description      string
+	AuthTypes        []string
+	Endpoint         string
+	IdentityEndpoint string
+	StorageEndpoint  string
+	Regions          []CloudRegion
+	CACertificates   []string
+}
+
+// CloudAccessInput is the access of users to a cloud.
+type CloudAccessInput struct {
+	Cloud  string
+	Access string
+	Users  []string
+}
+
+// UpdateCloudInput holds the full definition of the cloud, its name
+// and type cannot be changed.
+type UpdateCloudInput CreateCloudInput
+
+func newCloudsClient(sc SharedClient) *cloudsClient {
+	return &cloudsClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateCloud adds a cloud to the controller, as done by `juju add-cloud
+// --controller`.
+func (c *cloudsClient) CreateCloud(ctx context.Context, input *CreateCloudInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return cloudapi.NewClient(conn).AddCloud(cloudFromInput(*input), false)
+}
+
+// ReadCloud returns the definition of a cloud of the controller.
+func (c *cloudsClient) ReadCloud(ctx context.Context, name string) (*ReadCloudResponse, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	cloud, err := cloudapi.NewClient(conn).Cloud(names.NewCloudTag(name))
+	if params.IsCodeNotFound(err) {
+		return nil, &cloudNotFoundError{name: name}
+	} else if err != nil {
+		return nil, err
+	}
+	return cloudResponse(cloud), nil
+}
+
+// UpdateCloud replaces the definition of a cloud of the controller, as
+// done by `juju update-cloud --controller`.
+func (c *cloudsClient) UpdateCloud(ctx context.Context, input *UpdateCloudInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return cloudapi.NewClient(conn).UpdateCloud(cloudFromInput(CreateCloudInput(*input)))
+}
+
+// DestroyCloud removes a cloud from the controller. The controller
+// refuses to remove a cloud used by models.
+func (c *cloudsClient) DestroyCloud(ctx context.Context, name string) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return cloudapi.NewClient(conn).RemoveCloud(name)
+}
+
+// ReadCloudAccess returns the access to the cloud of each user who has
+// access to it, keyed by user name.
+func (c *cloudsClient) ReadCloudAccess(ctx context.Context, name string) (map[string]string, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	infos, err := cloudapi.NewClient(conn).CloudInfo([]names.CloudTag{names.NewCloudTag(name)})
+	if params.IsCodeNotFound(err) {
+		return nil, &cloudNotFoundError{name: name}
+	} else if err != nil {
+		return nil, err
+	}
+	if len(infos) != 1 {
+		return nil, fmt.Errorf("expected one result for cloud %q, got %d", name, len(infos))
+	}
+	access := make(map[string]string, len(infos[0].Users))
+	for user, info := range infos[0].Users {
+		access[user] = info.Access
+	}
+	return access, nil
+}
+
+// GrantCloudAccess grants the access to the cloud to the users, as done
+// by `juju grant-cloud`.
+func (c *cloudsClient) GrantCloudAccess(ctx context.Context, input CloudAccessInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+	for _, user := range input.Users {
+		if err := client.GrantCloud(user, input.Access, input.Cloud); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeCloudAccess removes any access to the cloud from the users.
+// Note we revoke `add-model`, revoking `admin` would leave the users
+// with `add-model` access.
+func (c *cloudsClient) RevokeCloudAccess(ctx context.Context, input CloudAccessInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+	for _, user := range input.Users {
+		if err := client.RevokeCloud(user, "add-model", input.Cloud); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cloudFromInput(input CreateCloudInput) jujucloud.Cloud {
+	cloud := jujucloud.Cloud{
+		Name:             input.Name,
+		Type:             input.Type,
+		Description:      input.Description,
+		Endpoint:         input.Endpoint,
+		IdentityEndpoint: input.IdentityEndpoint,
+		StorageEndpoint:  input.StorageEndpoint,
+		CACertificates:   input.CACertificates,
+	}
+	for _, authType := range input.AuthTypes {
+		cloud.AuthTypes = append(cloud.AuthTypes, jujucloud.AuthType(authType))
+	}
+	for _, region := range input.Regions {
+		cloud.Regions = append(cloud.Regions, jujucloud.Region{
+			Name:             region.Name,
+			Endpoint:         region.Endpoint,
+			IdentityEndpoint: region.IdentityEndpoint,
+			StorageEndpoint:  region.StorageEndpoint,
+		})
+	}
+	return cloud
+}
+
+func cloudResponse(cloud jujucloud.Cloud) *ReadCloudResponse {
+	response := &ReadCloudResponse{
+		Name:             cloud.Name,
+		Type:             cloud.Type,
+		Description:      cloud.Description,
+		Endpoint:         cloud.Endpoint,
+		IdentityEndpoint: cloud.IdentityEndpoint,
+		StorageEndpoint:  cloud.StorageEndpoint,
+		CACertificates:   cloud.CACertificates,
+	}
+	for _, authType := range cloud.AuthTypes {
+		response.AuthTypes = append(response.AuthTypes, string(authType))
+	}
+	for _, region := range cloud.Regions {
+		response.Regions = append(response.Regions, CloudRegion{
+			Name:             region.Name,
+			Endpoint:         region.Endpoint,
+			IdentityEndpoint: region.IdentityEndpoint,
+			StorageEndpoint:  region.StorageEndpoint,
+		})
+	}
+	return response
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudFromInput(t *testing.T) {
+	input := CreateCloudInput{
+		Name:             "my-openstack",
+		Type:             "openstack",
+		Description:      "private cloud",
+		AuthTypes:        []string{"userpass", "access-key"},
+		Endpoint:         "https://keystone.example.com:5000/v3",
+		IdentityEndpoint: "https://keystone.example.com:5000/v3",
+		Regions: []CloudRegion{
+			{Name: "region-one"},
+			{Name: "region-two", Endpoint: "https://keystone-two.example.com:5000/v3"},
+		},
+		CACertificates: []string{"-----BEGIN CERTIFICATE-----"},
+	}
+
+	cloud := cloudFromInput(input)
+	assert.Equal(t, jujucloud.AuthTypes{jujucloud.UserPassAuthType, jujucloud.AccessKeyAuthType}, cloud.AuthTypes)
+	assert.Equal(t, []jujucloud.Region{
+		{Name: "region-one"},
+		{Name: "region-two", Endpoint: "https://keystone-two.example.com:5000/v3"},
+	}, cloud.Regions)
+
+	response := cloudResponse(cloud)
+	assert.Equal(t, ReadCloudResponse(input), *response)
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/juju/juju/api"
+)
+
+const (
+	// DefaultConnectionPoolSize is the default number of idle API
+	// connections kept open for reuse.
+	DefaultConnectionPoolSize = 10
+	// DefaultConnectionIdleTimeout is the default duration an API
+	// connection is kept open while it is not used.
+	DefaultConnectionIdleTimeout = 30 * time.Second
+)
+
+// connectionPool shares the API connections to the controller and its
+// models between the operations of the resources, so that refreshing
+// many resources does not dial the controller for each of them. The
+// connections are keyed by model UUID, the empty UUID standing for the
+// controller, and are reference counted: a connection is used by any
+// number of operations at once, and closed once it has been idle for
+// idleTimeout or when more than size connections are idle.
+type connectionPool struct {
+	size        int
+	idleTimeout time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*poolEntry
+}
+
+// poolEntry is a connection of the pool, or the dial of one in
+// progress.
+type poolEntry struct {
+	key string
+
+	// ready is closed once the connection has been dialed, conn and
+	// err are only set then.
+	ready chan struct{}
+	conn  api.Connection
+	err   error
+
+	refs      int
+	idleSince time.Time
+	idleTimer *time.Timer
+	closeOnce sync.Once
+}
+
+func newConnectionPool(size int, idleTimeout time.Duration) *connectionPool {
+	return &connectionPool{
+		size:        size,
+		idleTimeout: idleTimeout,
+		entries:     make(map[string]*poolEntry),
+	}
+}
+
+// acquire returns a connection for the key, dialing it with dial if
+// the pool has no usable connection for the key. Concurrent acquires
+// of a key wait for the same dial. The connection returned must be
+// closed to release it.
+func (p *connectionPool) acquire(ctx context.Context, key string, dial func() (api.Connection, error)) (api.Connection, error) {
+	for {
+		e := p.reference(key, dial)
+
+		select {
+		case <-e.ready:
+		case <-ctx.Done():
+			p.release(e)
+			return nil, ctx.Err()
+		}
+		if e.err == nil {
+			return &pooledConnection{Connection: e.conn, pool: p, entry: e}, nil
+		}
+		p.release(e)
+		// The dial was given up by the operation which started it,
+		// dial again for this one.
+		if isContextError(e.err) && ctx.Err() == nil {
+			continue
+		}
+		return nil, e.err
+	}
+}
+
+// reference returns the entry of the key with a new reference to it,
+// adding an entry and dialing its connection if needed.
+func (p *connectionPool) reference(key string, dial func() (api.Connection, error)) *poolEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if e, ok := p.entries[key]; ok && !e.broken() {
+		e.refs++
+		if e.idleTimer != nil {
+			e.idleTimer.Stop()
+			e.idleTimer = nil
+		}
+		return e
+	} else if ok {
+		p.removeLocked(e)
+		if e.refs == 0 {
+			e.close()
+		}
+	}
+
+	e := &poolEntry{key: key, ready: make(chan struct{}), refs: 1}
+	p.entries[key] = e
+	go func() {
+		conn, err := dial()
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		e.conn, e.err = conn, err
+		if err != nil {
+			p.removeLocked(e)
+		}
+		close(e.ready)
+	}()
+	return e
+}
+
+// release releases a reference to the entry. The connection of an
+// entry no longer referenced is closed if it has been removed from the
+// pool, otherwise it is kept open until it has been idle for too long.
+func (p *connectionPool) release(e *poolEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e.refs--
+	if e.refs > 0 {
+		return
+	}
+	if p.entries[e.key] != e {
+		e.close()
+		return
+	}
+	e.idleSince = time.Now()
+	e.idleTimer = time.AfterFunc(p.idleTimeout, func() { p.expire(e) })
+	p.evictLocked()
+}
+
+// expire closes the connection of the entry if it is still idle.
+func (p *connectionPool) expire(e *poolEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if e.refs == 0 && p.entries[e.key] == e {
+		p.removeLocked(e)
+		e.close()
+	}
+}
+
+// retire removes the entry from the pool without closing its
+// connection, which is closed once released by the operations using it.
+// New acquires of its key dial another connection.
+func (p *connectionPool) retire(e *poolEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.removeLocked(e)
+}
+
+// evictLocked closes the connections idle for the longest time while
+// more than size connections are idle. Callers are expected to hold
+// the mu lock.
+func (p *connectionPool) evictLocked() {
+	for {
+		var idle int
+		var oldest *poolEntry
+		for _, e := range p.entries {
+			if e.refs > 0 {
+				continue
+			}
+			idle++
+			if oldest == nil || e.idleSince.Before(oldest.idleSince) {
+				oldest = e
+			}
+		}
+		if idle <= p.size {
+			return
+		}
+		p.removeLocked(oldest)
+		oldest.close()
+	}
+}
+
+// removeLocked removes the entry from the pool, new acquires of its key
+// dial another connection. Callers are expected to hold the mu lock.
+func (p *connectionPool) removeLocked(e *poolEntry) {
+	if p.entries[e.key] == e {
+		delete(p.entries, e.key)
+	}
+	if e.idleTimer != nil {
+		e.idleTimer.Stop()
+		e.idleTimer = nil
+	}
+}
+
+// broken returns whether the connection of the entry can no longer be
+// used. Entries being dialed are not broken.
+func (e *poolEntry) broken() bool {
+	select {
+	case <-e.ready:
+		return e.err != nil || e.conn.IsBroken()
+	default:
+		return false
+	}
+}
+
+// close closes the connection of the entry once, if it was dialed.
+func (e *poolEntry) close() {
+	e.closeOnce.Do(func() {
+		select {
+		case <-e.ready:
+			if e.err == nil {
+				_ = e.conn.Close()
+			}
+		default:
+			// Close the connection once dialed.
+			go func() {
+				<-e.ready
+				if e.err == nil {
+					_ = e.conn.Close()
+				}
+			}()
+		}
+	})
+}
+
+// pooledConnection is a connection of the pool. Closing it releases the
+// connection to the pool rather than closing it.
+type pooledConnection struct {
+	api.Connection
+	pool  *connectionPool
+	entry *poolEntry
+
+	closeOnce sync.Once
+}
+
+// Close releases the connection to the pool once.
+func (c *pooledConnection) Close() error {
+	c.closeOnce.Do(func() {
+		c.pool.release(c.entry)
+	})
+	return nil
+}
+
+// retire retires the connection from the pool, see connectionPool.retire.
+func (c *pooledConnection) retire() {
+	c.pool.retire(c.entry)
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// countingDial returns a dial function returning the given connections
+// in turn, and the number of dials made.
+func countingDial(conns ...api.Connection) (func() (api.Connection, error), func() int) {
+	var mu sync.Mutex
+	var dials int
+	dial := func() (api.Connection, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		conn := conns[dials]
+		dials++
+		return conn, nil
+	}
+	count := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return dials
+	}
+	return dial, count
+}
+
+func TestConnectionPoolShared(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	mockConn := NewMockConnection(ctlr)
+	mockConn.EXPECT().IsBroken().Return(false).AnyTimes()
+	mockConn.EXPECT().Close().Times(0)
+
+	ctx := context.Background()
+	pool := newConnectionPool(1, time.Minute)
+	dial, dials := countingDial(mockConn)
+
+	conn1, err := pool.acquire(ctx, "model-uuid", dial)
+	require.NoError(t, err)
+	conn2, err := pool.acquire(ctx, "model-uuid", dial)
+	require.NoError(t, err)
+	assert.NoError(t, conn1.Close())
+	assert.NoError(t, conn2.Close())
+	// Closing twice only releases the connection once.
+	assert.NoError(t, conn2.Close())
+
+	conn3, err := pool.acquire(ctx, "model-uuid", dial)
+	require.NoError(t, err)
+	assert.NoError(t, conn3.Close())
+	assert.Equal(t, 1, dials())
+}
+
+func TestConnectionPoolIdleTimeout(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	mockConn := NewMockConnection(ctlr)
+	closed := make(chan struct{})
+	mockConn.EXPECT().Close().DoAndReturn(func() error {
+		close(closed)
+		return nil
+	}).Times(1)
+
+	pool := newConnectionPool(1, 10*time.Millisecond)
+	dial, _ := countingDial(mockConn)
+	conn, err := pool.acquire(context.Background(), "", dial)
+	require.NoError(t, err)
+	assert.NoError(t, conn.Close())
+
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("idle connection not closed")
+	}
+}
+
+func TestConnectionPoolEvictsIdleConnections(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	first, second := NewMockConnection(ctlr), NewMockConnection(ctlr)
+	first.EXPECT().Close().Return(nil).Times(1)
+	second.EXPECT().Close().Times(0)
+
+	ctx := context.Background()
+	pool := newConnectionPool(1, time.Minute)
+	dial, _ := countingDial(first, second)
+	conn1, err := pool.acquire(ctx, "a", dial)
+	require.NoError(t, err)
+	conn2, err := pool.acquire(ctx, "b", dial)
+	require.NoError(t, err)
+	assert.NoError(t, conn1.Close())
+	assert.NoError(t, conn2.Close())
+}
+
+func TestConnectionPoolBrokenConnection(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	broken, fresh := NewMockConnection(ctlr), NewMockConnection(ctlr)
+	broken.EXPECT().IsBroken().Return(true)
+	broken.EXPECT().Close().Return(nil).Times(1)
+
+	ctx := context.Background()
+	pool := newConnectionPool(1, time.Minute)
+	dial, dials := countingDial(broken, fresh)
+	conn, err := pool.acquire(ctx, "", dial)
+	require.NoError(t, err)
+	assert.NoError(t, conn.Close())
+
+	conn, err = pool.acquire(ctx, "", dial)
+	require.NoError(t, err)
+	assert.Same(t, fresh, conn.(*pooledConnection).Connection)
+	assert.Equal(t, 2, dials())
+}
+
+func TestConnectionPoolRetire(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	mockConn := NewMockConnection(ctlr)
+	mockConn.EXPECT().IsBroken().Return(false).AnyTimes()
+
+	ctx := context.Background()
+	pool := newConnectionPool(1, time.Minute)
+	newConn := NewMockConnection(ctlr)
+	dial, dials := countingDial(mockConn, newConn)
+	conn1, err := pool.acquire(ctx, "", dial)
+	require.NoError(t, err)
+	conn2, err := pool.acquire(ctx, "", dial)
+	require.NoError(t, err)
+
+	// The retired connection is still usable by the operations which
+	// acquired it, new acquires dial another connection.
+	conn1.(*pooledConnection).retire()
+	conn3, err := pool.acquire(ctx, "", dial)
+	require.NoError(t, err)
+	assert.Same(t, newConn, conn3.(*pooledConnection).Connection)
+	assert.Equal(t, 2, dials())
+
+	// It is closed once released by all of them.
+	assert.NoError(t, conn1.Close())
+	mockConn.EXPECT().Close().Return(nil).Times(1)
+	assert.NoError(t, conn2.Close())
+}
+
+func TestConnectionPoolDialError(t *testing.T) {
+	ctx := context.Background()
+	pool := newConnectionPool(1, time.Minute)
+	var dials int
+	dial := func() (api.Connection, error) {
+		dials++
+		return nil, errors.New("connection refused")
+	}
+	_, err := pool.acquire(ctx, "", dial)
+	assert.EqualError(t, err, "connection refused")
+	// Errors are not kept in the pool.
+	_, err = pool.acquire(ctx, "", dial)
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 2, dials)
+}
+
+func TestContextConnectionAbortsOnlyCancelledCall(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	mockConn := NewMockConnection(ctlr)
+	mockConn.EXPECT().IsBroken().Return(false).AnyTimes()
+
+	pool := newConnectionPool(1, time.Minute)
+	newConn := NewMockConnection(ctlr)
+	dial, dials := countingDial(mockConn, newConn)
+	pooled1, err := pool.acquire(context.Background(), "", dial)
+	require.NoError(t, err)
+	pooled2, err := pool.acquire(context.Background(), "", dial)
+	require.NoError(t, err)
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	conn1 := newContextConnection(ctx1, pooled1)
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	conn2 := newContextConnection(ctx2, pooled2)
+
+	// The call of the first operation hangs until it is cancelled.
+	abandoned := make(chan struct{})
+	mockConn.EXPECT().APICall("Client", 1, "", "FullStatus", nil, nil).DoAndReturn(
+		func(string, int, string, string, interface{}, interface{}) error {
+			cancel1()
+			<-abandoned
+			return nil
+		})
+	err = conn1.APICall("Client", 1, "", "FullStatus", nil, nil)
+	assert.ErrorIs(t, errors.Cause(err), context.Canceled)
+	assert.NoError(t, conn1.Close())
+
+	// The call of the other operation sharing the connection works.
+	mockConn.EXPECT().APICall("Client", 1, "", "ModelInfo", nil, nil).Return(nil)
+	assert.NoError(t, conn2.APICall("Client", 1, "", "ModelInfo", nil, nil))
+	close(abandoned)
+
+	// The connection is retired: new acquires dial another connection,
+	// and it is closed once released by the other operation.
+	pooled3, err := pool.acquire(context.Background(), "", dial)
+	require.NoError(t, err)
+	assert.Same(t, newConn, pooled3.(*pooledConnection).Connection)
+	assert.Equal(t, 2, dials())
+	mockConn.EXPECT().Close().Return(nil).Times(1)
+	assert.NoError(t, conn2.Close())
+}
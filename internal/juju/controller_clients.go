@@ -0,0 +1,89 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// ControllerLookup returns the addresses and credentials of the named
+// controller. Only the ControllerAddresses, Username, Password, CACert,
+// ClientID and ClientSecret of the configuration returned are used.
+type ControllerLookup func(name string) (ControllerConfiguration, error)
+
+// controllerClients are the clients of the controllers selected by
+// name, keyed by controller name. They share the settings of the
+// configuration of the provider, only the controller they connect to
+// and the credentials used differ.
+type controllerClients struct {
+	config ControllerConfiguration
+	// summary is shared by the clients, which append to the same
+	// file.
+	summary *applySummary
+
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+func newControllerClients(config ControllerConfiguration) *controllerClients {
+	cc := &controllerClients{
+		config:  config,
+		clients: make(map[string]*Client),
+	}
+	if config.ApplySummaryFile != "" {
+		cc.summary = &applySummary{path: config.ApplySummaryFile}
+	}
+	return cc
+}
+
+// get returns the client of the named controller, creating it on first
+// use. Lookup errors are not kept, the lookup is made again on the next
+// use of the controller.
+func (cc *controllerClients) get(ctx context.Context, name string) (*Client, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if client, ok := cc.clients[name]; ok {
+		return client, nil
+	}
+	if cc.config.ControllerLookup == nil {
+		return nil, errors.NotSupportedf("selecting controller %q", name)
+	}
+	found, err := cc.config.ControllerLookup(name)
+	if err != nil {
+		return nil, errors.Annotatef(err, "controller %q", name)
+	}
+	config := cc.config
+	config.ControllerAddresses = found.ControllerAddresses
+	config.Username = found.Username
+	config.Password = found.Password
+	config.CACert = found.CACert
+	config.ClientID = found.ClientID
+	config.ClientSecret = found.ClientSecret
+	client, err := newClient(ctx, config, cc)
+	if err != nil {
+		return nil, errors.Annotatef(err, "controller %q", name)
+	}
+	cc.clients[name] = client
+	return client, nil
+}
+
+// ForController returns the client of the named controller, which the
+// resources select instead of the controller of the provider. The
+// client of a controller is created once, with the addresses and
+// credentials found by the ControllerLookup of the configuration, and
+// shared by all the resources selecting it. The empty name is the
+// controller of the client itself.
+func (c *Client) ForController(ctx context.Context, name string) (*Client, error) {
+	if name == "" {
+		return c, nil
+	}
+	if c.controllers == nil {
+		return nil, errors.NotSupportedf("selecting controller %q", name)
+	}
+	return c.controllers.get(ctx, name)
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForController(t *testing.T) {
+	ctx := context.Background()
+	lookups := map[string]int{}
+	client, err := NewClient(ctx, ControllerConfiguration{
+		ControllerAddresses: []string{"10.0.0.1:17070"},
+		Username:            "admin",
+		Password:            "staging-secret",
+		ReadOnly:            true,
+		ControllerLookup: func(name string) (ControllerConfiguration, error) {
+			lookups[name]++
+			if name != "production" {
+				return ControllerConfiguration{}, errors.NotFoundf("controller %q", name)
+			}
+			return ControllerConfiguration{
+				ControllerAddresses: []string{"10.0.1.1:17070"},
+				Username:            "admin",
+				Password:            "production-secret",
+				// Only the connection settings of the lookup are used.
+				ReadOnly: false,
+			}, nil
+		},
+	})
+	require.NoError(t, err)
+
+	// The empty name is the controller of the client.
+	same, err := client.ForController(ctx, "")
+	require.NoError(t, err)
+	assert.Same(t, client, same)
+
+	production, err := client.ForController(ctx, "production")
+	require.NoError(t, err)
+	assert.NotSame(t, client, production)
+	assert.True(t, production.ReadOnly())
+	assert.Equal(t, []string{"production-secret", ""}, production.redactedValues)
+
+	// The client of a controller is created once, and shared by the
+	// clients of the other controllers.
+	again, err := client.ForController(ctx, "production")
+	require.NoError(t, err)
+	assert.Same(t, production, again)
+	again, err = production.ForController(ctx, "production")
+	require.NoError(t, err)
+	assert.Same(t, production, again)
+	assert.Equal(t, 1, lookups["production"])
+
+	// Lookup errors are not kept.
+	_, err = client.ForController(ctx, "unknown")
+	assert.True(t, errors.Is(err, errors.NotFound), err)
+	_, err = client.ForController(ctx, "unknown")
+	assert.Error(t, err)
+	assert.Equal(t, 2, lookups["unknown"])
+}
+
+func TestForControllerWithoutLookup(t *testing.T) {
+	client, err := NewClient(context.Background(), ControllerConfiguration{})
+	require.NoError(t, err)
+	_, err = client.ForController(context.Background(), "production")
+	assert.True(t, errors.Is(err, errors.NotSupported), err)
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/juju/api/client/usermanager"
+	apicontroller "github.com/juju/juju/api/controller/controller"
+	"github.com/juju/juju/controller"
+	"gopkg.in/juju/environschema.v1"
+	"gopkg.in/yaml.v2"
+)
+
+type controllersClient struct {
+	SharedClient
+}
+
+type UpdateControllerConfigInput struct {
+	Config map[string]string
+	Reset  []string
+}
+
+// ControllerAccessInput is the access of users to the controller.
+type ControllerAccessInput struct {
+	Access string
+	Users  []string
+}
+
+func newControllersClient(sc SharedClient) *controllersClient {
+	return &controllersClient{
+		SharedClient: sc,
+	}
+}
+
+// ReadControllerConfig returns the configuration of the controller.
+// Values which are not strings, such as booleans, numbers and lists, are
+// encoded as JSON.
+func (c *controllersClient) ReadControllerConfig(ctx context.Context) (map[string]string, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+	current, err := client.ControllerConfig()
+	if err != nil {
+		return nil, err
+	}
+	config := make(map[string]string, len(current))
+	for k, v := range current {
+		if config[k], err = configValueString(v); err != nil {
+			return nil, errors.Annotatef(err, "controller config %q", k)
+		}
+	}
+	return config, nil
+}
+
+// UpdateControllerConfig sets the given controller config keys, then
+// resets the keys to reset to their default value. Keys without a
+// default value in juju keep their current value, as controller config
+// cannot be unset.
+func (c *controllersClient) UpdateControllerConfig(ctx context.Context, input UpdateControllerConfigInput) error {
+	values, err := CoerceControllerConfig(input.Config)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+	if len(input.Reset) > 0 {
+		current, err := client.ControllerConfig()
+		if err != nil {
+			return err
+		}
+		caCert, _ := current.CACert()
+		defaults, err := controller.NewConfig(current.ControllerUUID(), caCert, map[string]interface{}{})
+		if err != nil {
+			return errors.Annotate(err, "computing controller config defaults")
+		}
+		for _, k := range input.Reset {
+			if _, ok := values[k]; ok {
+				continue
+			}
+			if value, ok := defaults[k]; ok {
+				values[k] = value
+			} else {
+				c.Warnf(fmt.Sprintf("controller config %q has no default value, keeping its current value", k))
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return client.ConfigSet(values)
+}
+
+// ReadControllerAccess returns the access to the controller of each
+// local user, keyed by user name.
+func (c *controllersClient) ReadControllerAccess(ctx context.Context) (map[string]string, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	users, err := usermanager.NewClient(conn).UserInfo(nil, usermanager.AllUsers)
+	if err != nil {
+		return nil, err
+	}
+	access := make(map[string]string, len(users))
+	for _, user := range users {
+		access[user.Username] = user.Access
+	}
+	return access, nil
+}
+
+// GrantControllerAccess grants the access to the controller to the
+// users, as done by `juju grant`.
+func (c *controllersClient) GrantControllerAccess(ctx context.Context, input ControllerAccessInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+	for _, user := range input.Users {
+		if err := client.GrantController(user, input.Access); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeControllerAccess removes any access to the controller from the
+// users. Note we revoke `login`, revoking `superuser` would leave the
+// users with `login` access.
+func (c *controllersClient) RevokeControllerAccess(ctx context.Context, input ControllerAccessInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apicontroller.NewClient(conn)
+	for _, user := range input.Users {
+		if err := client.RevokeController(user, "login"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CoerceControllerConfig converts the given controller config values to
+// the type expected by juju. Lists are given in YAML or JSON, e.g.
+// `[a, b]`, as with `juju controller-config`. Keys which cannot be
+// updated once the controller is bootstrapped are rejected.
+func CoerceControllerConfig(config map[string]string) (map[string]interface{}, error) {
+	fields, _, err := controller.ConfigSchema.ValidationSchema()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var readOnly []string
+	values := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		if !controller.AllowedUpdateConfigAttributes.Contains(k) {
+			readOnly = append(readOnly, k)
+			continue
+		}
+		var value interface{} = v
+		if controller.ConfigSchema[k].Type == environschema.Tlist {
+			if err := yaml.Unmarshal([]byte(v), &value); err != nil {
+				return nil, errors.NotValidf("value %q for controller config %q", v, k)
+			}
+		}
+		if field, ok := fields[k]; ok {
+			if value, err = field.Coerce(value, []string{k}); err != nil {
+				return nil, err
+			}
+		}
+		values[k] = value
+	}
+	if len(readOnly) > 0 {
+		sort.Strings(readOnly)
+		return nil, errors.Errorf("read-only controller config values cannot be updated: %s", strings.Join(readOnly, ", "))
+	}
+	return values, nil
+}
+
+// ControllerConfigValueEqual returns true if both values of the given
+// controller config key have the same meaning for juju, e.g. the lists
+// `[a, b]` and `["a","b"]`.
+func ControllerConfigValueEqual(key, a, b string) bool {
+	if a == b {
+		return true
+	}
+	values, err := CoerceControllerConfig(map[string]string{key: a})
+	if err != nil {
+		return false
+	}
+	others, err := CoerceControllerConfig(map[string]string{key: b})
+	if err != nil {
+		return false
+	}
+	valueA, errA := configValueString(values[key])
+	valueB, errB := configValueString(others[key])
+	return errA == nil && errB == nil && valueA == valueB
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoerceControllerConfig(t *testing.T) {
+	values, err := CoerceControllerConfig(map[string]string{
+		"audit-log-max-size":  "200M",
+		"auditing-enabled":    "true",
+		"agent-ratelimit-max": "10",
+		"features":            "[a, b]",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "200M", values["audit-log-max-size"])
+	assert.Equal(t, true, values["auditing-enabled"])
+	assert.EqualValues(t, 10, values["agent-ratelimit-max"])
+	assert.Equal(t, []interface{}{"a", "b"}, values["features"])
+
+	_, err = CoerceControllerConfig(map[string]string{"auditing-enabled": "maybe"})
+	assert.Error(t, err)
+
+	_, err = CoerceControllerConfig(map[string]string{"state-port": "37017", "api-port": "17070"})
+	assert.EqualError(t, err, "read-only controller config values cannot be updated: api-port, state-port")
+}
+
+func TestControllerConfigValueEqual(t *testing.T) {
+	assert.True(t, ControllerConfigValueEqual("features", "[a, b]", `["a","b"]`))
+	assert.True(t, ControllerConfigValueEqual("auditing-enabled", "True", "true"))
+	assert.False(t, ControllerConfigValueEqual("features", "[a]", `["a","b"]`))
+	assert.False(t, ControllerConfigValueEqual("audit-log-max-size", "200M", "300M"))
+}
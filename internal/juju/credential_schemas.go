@@ -0,0 +1,198 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
+	jujucloud "github.com/juju/juju/cloud"
+)
+
+// credentialSchemas holds the credential schemas of the juju providers,
+// keyed by cloud type. The providers themselves are not linked into the
+// terraform provider, their schemas are mirrored here.
+var credentialSchemas = map[string]map[jujucloud.AuthType]jujucloud.CredentialSchema{
+	"azure": {
+		jujucloud.InteractiveAuthType: {
+			{Name: "subscription-id", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+		},
+		"service-principal-secret": {
+			{Name: "application-id"},
+			{Name: "application-object-id", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+			{Name: "subscription-id"},
+			{Name: "managed-subscription-id", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+			{Name: "application-password"},
+		},
+	},
+	"ec2": {
+		jujucloud.AccessKeyAuthType: {
+			{Name: "access-key"},
+			{Name: "secret-key"},
+		},
+		jujucloud.InstanceRoleAuthType: {
+			{Name: "instance-profile-name"},
+		},
+	},
+	"equinix": {
+		jujucloud.AccessKeyAuthType: {
+			{Name: "project-id"},
+			{Name: "api-token"},
+		},
+	},
+	"gce": {
+		jujucloud.OAuth2AuthType: {
+			{Name: "client-id"},
+			{Name: "client-email"},
+			{Name: "private-key"},
+			{Name: "project-id"},
+		},
+		jujucloud.JSONFileAuthType: {
+			{Name: "file"},
+		},
+	},
+	"kubernetes": kubernetesCredentialSchemas(),
+	"lxd": {
+		jujucloud.CertificateAuthType: {
+			{Name: "server-cert"},
+			{Name: "client-cert"},
+			{Name: "client-key"},
+		},
+		jujucloud.InteractiveAuthType: {
+			{Name: "trust-password", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+		},
+	},
+	"maas": {
+		jujucloud.OAuth1AuthType: {
+			{Name: "maas-oauth"},
+		},
+	},
+	"manual": {
+		jujucloud.EmptyAuthType: {},
+	},
+	"oci": {
+		jujucloud.HTTPSigAuthType: {
+			{Name: "user"},
+			{Name: "tenancy"},
+			{Name: "key"},
+			{Name: "pass-phrase"},
+			{Name: "fingerprint"},
+			{Name: "region"},
+		},
+	},
+	"openstack": {
+		jujucloud.UserPassAuthType: {
+			{Name: "username"},
+			{Name: "password"},
+			{Name: "tenant-name", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+			{Name: "tenant-id", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+			{Name: "version", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+			{Name: "domain-name", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+			{Name: "project-domain-name", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+			{Name: "user-domain-name", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+		},
+		jujucloud.AccessKeyAuthType: {
+			{Name: "access-key"},
+			{Name: "secret-key"},
+			{Name: "tenant-name", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+			{Name: "tenant-id", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+		},
+	},
+	"vsphere": {
+		jujucloud.UserPassAuthType: {
+			{Name: "user"},
+			{Name: "password"},
+			{Name: "vmfolder", CredentialAttr: jujucloud.CredentialAttr{Optional: true}},
+		},
+	},
+}
+
+// kubernetesCredentialSchemas returns the credential schemas of the
+// kubernetes provider, including the legacy ones still accepted.
+func kubernetesCredentialSchemas() map[jujucloud.AuthType]jujucloud.CredentialSchema {
+	schemas := make(map[jujucloud.AuthType]jujucloud.CredentialSchema)
+	for k, v := range k8scloud.SupportedCredentialSchemas {
+		schemas[k] = v
+	}
+	for k, v := range k8scloud.LegacyCredentialSchemas {
+		schemas[k] = v
+	}
+	return schemas
+}
+
+// validateCredentialAttributes checks the attributes of a credential
+// with the given auth type against the credential schema of the cloud
+// type. Unknown cloud types are not validated. The errors satisfy
+// errors.NotSupported for an unknown auth type, and errors.NotValid
+// for invalid attributes.
+func validateCredentialAttributes(cloudType, authType string, attributes map[string]string) error {
+	schemas, ok := credentialSchemas[cloudType]
+	if !ok {
+		return nil
+	}
+	schema, ok := schemas[jujucloud.AuthType(authType)]
+	if !ok {
+		authTypes := make([]string, 0, len(schemas))
+		for k := range schemas {
+			authTypes = append(authTypes, string(k))
+		}
+		sort.Strings(authTypes)
+		return errors.WithType(fmt.Errorf("auth-type %q is not supported by %s clouds, expected one of %s",
+			authType, cloudType, quoteAll(authTypes)), errors.NotSupported)
+	}
+
+	var missing, unknown []string
+	known := make(map[string]bool)
+	for _, attr := range schema {
+		known[attr.Name] = true
+		if attr.FileAttr != "" {
+			known[attr.FileAttr] = true
+		}
+		if attr.Optional || attributes[attr.Name] != "" {
+			continue
+		}
+		if attr.FileAttr != "" && attributes[attr.FileAttr] != "" {
+			continue
+		}
+		missing = append(missing, attr.Name)
+	}
+	for name := range attributes {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(missing) == 0 && len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing %s", quoteAll(missing)))
+	}
+	if len(unknown) > 0 {
+		expected := make([]string, 0, len(schema))
+		for _, attr := range schema {
+			expected = append(expected, attr.Name)
+		}
+		problems = append(problems, fmt.Sprintf("unknown %s", quoteAll(unknown)))
+		if len(expected) > 0 {
+			problems[len(problems)-1] += fmt.Sprintf(", expected %s", quoteAll(expected))
+		}
+	}
+	return errors.WithType(fmt.Errorf("invalid attributes for auth-type %q of %s clouds: %s",
+		authType, cloudType, strings.Join(problems, "; ")), errors.NotValid)
+}
+
+// quoteAll returns the quoted values separated by commas.
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
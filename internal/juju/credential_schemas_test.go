@@ -0,0 +1,41 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCredentialAttributes(t *testing.T) {
+	assert.NoError(t, validateCredentialAttributes("ec2", "access-key", map[string]string{
+		"access-key": "AKIA",
+		"secret-key": "secret",
+	}))
+	assert.NoError(t, validateCredentialAttributes("ec2", "instance-role", map[string]string{
+		"instance-profile-name": "juju-controller",
+	}))
+	assert.NoError(t, validateCredentialAttributes("openstack", "userpass", map[string]string{
+		"username": "admin",
+		"password": "secret",
+	}))
+	assert.NoError(t, validateCredentialAttributes("manual", "empty", nil))
+	assert.NoError(t, validateCredentialAttributes("kubernetes", "oauth2", map[string]string{"Token": "token"}))
+	// Clouds of unknown types are not validated.
+	assert.NoError(t, validateCredentialAttributes("other", "anything", map[string]string{"a": "b"}))
+
+	err := validateCredentialAttributes("ec2", "access-key", map[string]string{
+		"access-key": "AKIA",
+		"secret":     "secret",
+	})
+	assert.EqualError(t, err, `invalid attributes for auth-type "access-key" of ec2 clouds: missing "secret-key"; `+
+		`unknown "secret", expected "access-key", "secret-key"`)
+
+	err = validateCredentialAttributes("gce", "userpass", nil)
+	assert.EqualError(t, err, `auth-type "userpass" is not supported by gce clouds, expected one of "jsonfile", "oauth2"`)
+
+	err = validateCredentialAttributes("manual", "empty", map[string]string{"a": "b"})
+	assert.EqualError(t, err, `invalid attributes for auth-type "empty" of manual clouds: unknown "a"`)
+}
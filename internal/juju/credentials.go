@@ -4,12 +4,17 @@
 package juju
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/juju/errors"
 	cloudapi "github.com/juju/juju/api/client/cloud"
+	"github.com/juju/juju/api/client/modelmanager"
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v5"
 )
 
@@ -40,6 +45,9 @@ type ReadCredentialInput struct {
 
 type ReadCredentialResponse struct {
 	CloudCredential jujucloud.Credential
+	// Models contains the names of the models using the credential.
+	// Only controller credentials are used by models.
+	Models []string
 }
 
 type UpdateCredentialInput struct {
@@ -58,6 +66,29 @@ type DestroyCredentialInput struct {
 	Name                 string
 }
 
+type ValidateCredentialInput struct {
+	Attributes map[string]string
+	AuthType   string
+	CloudName  string
+}
+
+type CheckModelCredentialInput struct {
+	ModelName string
+}
+
+type CheckModelCredentialResponse struct {
+	ModelUUID  string
+	Cloud      string
+	Credential string
+	Owner      string
+	// Valid is true if the controller could use the credential for the
+	// model.
+	Valid bool
+	// Errors holds the errors found by the controller while checking the
+	// credential for the model.
+	Errors []string
+}
+
 func newCredentialsClient(sc SharedClient) *credentialsClient {
 	return &credentialsClient{
 		SharedClient: sc,
@@ -84,8 +115,13 @@ func supportedAuth(cloud jujucloud.Cloud, authTypeReceived string) bool {
 	return false
 }
 
-func (c *credentialsClient) ValidateCredentialForCloud(cloudName, authTypeReceived string) error {
-	conn, err := c.GetConnection(nil)
+// ValidateCredential checks the auth type and the attributes of a
+// credential against the cloud, as known by the controller, and the
+// credential schema of the cloud type. The errors satisfy
+// errors.NotSupported for an auth type not supported by the cloud,
+// and errors.NotValid for invalid attributes.
+func (c *credentialsClient) ValidateCredential(ctx context.Context, input ValidateCredentialInput) error {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -93,20 +129,23 @@ func (c *credentialsClient) ValidateCredentialForCloud(cloudName, authTypeReceiv
 
 	client := cloudapi.NewClient(conn)
 
-	cloudTag := names.NewCloudTag(cloudName)
-
-	cloud, err := client.Cloud(cloudTag)
+	cloud, err := client.Cloud(names.NewCloudTag(input.CloudName))
 	if err != nil {
 		return err
 	}
 
-	if !supportedAuth(cloud, authTypeReceived) {
-		return errors.NotSupportedf("supported auth-types %q, %q", cloud.AuthTypes, authTypeReceived)
+	if !supportedAuth(cloud, input.AuthType) {
+		authTypes := make([]string, len(cloud.AuthTypes))
+		for i, authType := range cloud.AuthTypes {
+			authTypes[i] = string(authType)
+		}
+		return errors.WithType(fmt.Errorf("auth-type %q is not supported by cloud %q, expected one of %s",
+			input.AuthType, input.CloudName, quoteAll(authTypes)), errors.NotSupported)
 	}
-	return nil
+	return validateCredentialAttributes(cloud.Type, input.AuthType, input.Attributes)
 }
 
-func (c *credentialsClient) CreateCredential(input CreateCredentialInput) (*CreateCredentialResponse, error) {
+func (c *credentialsClient) CreateCredential(ctx context.Context, input CreateCredentialInput) (*CreateCredentialResponse, error) {
 	if !input.ControllerCredential && !input.ClientCredential {
 		// Just in case none of them are set
 		return nil, fmt.Errorf("controller_credential or/and client_credential must be set to true")
@@ -119,11 +158,15 @@ func (c *credentialsClient) CreateCredential(input CreateCredentialInput) (*Crea
 
 	cloudName := input.CloudName
 
-	if err := c.ValidateCredentialForCloud(cloudName, input.AuthType); err != nil {
+	if err := c.ValidateCredential(ctx, ValidateCredentialInput{
+		Attributes: input.Attributes,
+		AuthType:   input.AuthType,
+		CloudName:  cloudName,
+	}); err != nil {
 		return nil, err
 	}
 
-	conn, err := c.GetConnection(nil)
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -160,13 +203,13 @@ func (c *credentialsClient) CreateCredential(input CreateCredentialInput) (*Crea
 	return &CreateCredentialResponse{CloudCredential: cloudCredential, CloudName: cloudName}, nil
 }
 
-func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCredentialResponse, error) {
+func (c *credentialsClient) ReadCredential(ctx context.Context, input ReadCredentialInput) (*ReadCredentialResponse, error) {
 	clientCredential := input.ClientCredential
 	cloudName := input.CloudName
 	controllerCredential := input.ControllerCredential
 	credentialName := input.Name
 
-	conn, err := c.GetConnection(nil)
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -184,6 +227,7 @@ func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCred
 	}
 
 	var controllerCredentialFound jujucloud.Credential
+	var models []string
 	if controllerCredential {
 		credentialContents, err := client.CredentialContents(cloudName, credentialName, true)
 		if err != nil {
@@ -202,6 +246,9 @@ func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCred
 					remoteCredential.Attributes,
 					false, //  CredentialContents does not provides this field
 				)
+				for _, model := range content.Result.Models {
+					models = append(models, model.Model)
+				}
 				break
 			}
 		}
@@ -218,6 +265,7 @@ func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCred
 	if controllerCredential {
 		return &ReadCredentialResponse{
 			CloudCredential: controllerCredentialFound,
+			Models:          models,
 		}, nil
 	}
 
@@ -230,7 +278,7 @@ func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCred
 	return nil, fmt.Errorf("credential %s not found for cloud %s", credentialName, cloudName)
 }
 
-func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error {
+func (c *credentialsClient) UpdateCredential(ctx context.Context, input UpdateCredentialInput) error {
 	if !input.ControllerCredential && !input.ClientCredential {
 		// Just in case none of them are set
 		return fmt.Errorf("controller_credential or/and client_credential must be set to true")
@@ -243,11 +291,15 @@ func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error
 
 	cloudName := input.CloudName
 
-	if err := c.ValidateCredentialForCloud(cloudName, input.AuthType); err != nil {
+	if err := c.ValidateCredential(ctx, ValidateCredentialInput{
+		Attributes: input.Attributes,
+		AuthType:   input.AuthType,
+		CloudName:  cloudName,
+	}); err != nil {
 		return err
 	}
 
-	conn, err := c.GetConnection(nil)
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -284,6 +336,90 @@ func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error
 	return nil
 }
 
+// CheckModelCredential triggers the controller's validity check of the
+// cloud credential used by a model, as done when updating a credential:
+// the stored content of the credential is submitted again and the
+// controller checks that it can still be used by the models using it.
+// The credential must be owned by the user of the provider.
+func (c *credentialsClient) CheckModelCredential(ctx context.Context, input CheckModelCredentialInput) (*CheckModelCredentialResponse, error) {
+	modelConn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = modelConn.Close() }()
+	modelTag, ok := modelConn.ModelTag()
+	if !ok {
+		return nil, errors.Errorf("not connected to model %q", input.ModelName)
+	}
+
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	models, err := modelmanager.NewClient(conn).ModelInfo([]names.ModelTag{modelTag})
+	if err != nil {
+		return nil, err
+	}
+	if len(models) != 1 {
+		return nil, errors.Errorf("expected 1 model for UUID %s, got %d", modelTag.Id(), len(models))
+	}
+	if models[0].Error != nil {
+		return nil, models[0].Error
+	}
+	if models[0].Result.CloudCredentialTag == "" {
+		return nil, errors.Errorf("model %q has no cloud credential", input.ModelName)
+	}
+	credentialTag, err := names.ParseCloudCredentialTag(models[0].Result.CloudCredentialTag)
+	if err != nil {
+		return nil, err
+	}
+
+	client := cloudapi.NewClient(conn)
+	contents, err := client.CredentialContents(credentialTag.Cloud().Id(), credentialTag.Name(), true)
+	if err != nil {
+		return nil, err
+	}
+	if contents[0].Error != nil {
+		return nil, errors.Annotatef(contents[0].Error, "reading credential %q", credentialTag.Id())
+	}
+	content := contents[0].Result.Content
+	credential := jujucloud.NewCredential(jujucloud.AuthType(content.AuthType), content.Attributes)
+
+	results, err := client.UpdateCredentialsCheckModels(credentialTag, credential)
+	checkErrors := modelCredentialErrors(modelTag.Id(), results)
+	if err != nil && len(checkErrors) == 0 {
+		// The check failed without reporting errors for the model.
+		return nil, err
+	}
+	return &CheckModelCredentialResponse{
+		ModelUUID:  modelTag.Id(),
+		Cloud:      credentialTag.Cloud().Id(),
+		Credential: credentialTag.Name(),
+		Owner:      credentialTag.Owner().Id(),
+		Valid:      len(checkErrors) == 0,
+		Errors:     checkErrors,
+	}, nil
+}
+
+// modelCredentialErrors returns the messages of the errors found for a
+// model while checking a credential.
+func modelCredentialErrors(modelUUID string, results []params.UpdateCredentialModelResult) []string {
+	var messages []string
+	for _, result := range results {
+		if result.ModelUUID != modelUUID {
+			continue
+		}
+		for _, e := range result.Errors {
+			if e.Error != nil {
+				messages = append(messages, e.Error.Message)
+			}
+		}
+	}
+	return messages
+}
+
 func getExistingClientCredential(cloudName string) (*jujucloud.CloudCredential, error) {
 	store := jujuclient.NewFileClientStore()
 	existingCredentials, err := store.CredentialForCloud(cloudName)
@@ -310,11 +446,11 @@ func updateClientCredential(cloudName string, credentialName string, cloudCreden
 	return nil
 }
 
-func (c *credentialsClient) DestroyCredential(input DestroyCredentialInput) error {
+func (c *credentialsClient) DestroyCredential(ctx context.Context, input DestroyCredentialInput) error {
 	cloudName := input.CloudName
 	credentialName := input.Name
 
-	conn, err := c.GetConnection(nil)
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -359,3 +495,100 @@ func destroyClientCredential(cloudName string, credentialName string) error {
 	}
 	return nil
 }
+
+const (
+	// CredentialEnvironmentAWS reads an access key from the standard
+	// AWS environment variables.
+	CredentialEnvironmentAWS = "aws"
+	// CredentialEnvironmentGCP reads a service account key from the
+	// file referenced by GOOGLE_APPLICATION_CREDENTIALS.
+	CredentialEnvironmentGCP = "gcp"
+	// CredentialEnvironmentAzure reads a service principal from the
+	// standard Azure environment variables.
+	CredentialEnvironmentAzure = "azure"
+
+	azureServicePrincipalAuthType = "service-principal-secret"
+)
+
+// EnvironmentCredentialAuthType returns the auth type of the credentials
+// read from the environment variables of the given cloud provider.
+func EnvironmentCredentialAuthType(provider string) (string, error) {
+	switch provider {
+	case CredentialEnvironmentAWS:
+		return string(jujucloud.AccessKeyAuthType), nil
+	case CredentialEnvironmentGCP:
+		return string(jujucloud.OAuth2AuthType), nil
+	case CredentialEnvironmentAzure:
+		return azureServicePrincipalAuthType, nil
+	}
+	return "", errors.NotSupportedf("credentials from the environment for %q", provider)
+}
+
+// EnvironmentCredentialAttributes builds the credential attributes for
+// the given cloud provider from its standard environment variables.
+// Unlike `juju autoload-credentials`, credential files such as
+// ~/.aws/credentials and instance metadata are not read.
+func EnvironmentCredentialAttributes(provider string) (map[string]string, error) {
+	switch provider {
+	case CredentialEnvironmentAWS:
+		accessKey := firstEnvVar("AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY")
+		secretKey := firstEnvVar("AWS_SECRET_ACCESS_KEY", "AWS_SECRET_KEY")
+		if accessKey == "" || secretKey == "" {
+			return nil, errors.NotFoundf("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+		}
+		return map[string]string{
+			"access-key": accessKey,
+			"secret-key": secretKey,
+		}, nil
+	case CredentialEnvironmentGCP:
+		return gcpEnvironmentCredentialAttributes()
+	case CredentialEnvironmentAzure:
+		appID := os.Getenv("AZURE_CLIENT_ID")
+		appPassword := os.Getenv("AZURE_CLIENT_SECRET")
+		subscriptionID := os.Getenv("AZURE_SUBSCRIPTION_ID")
+		if appID == "" || appPassword == "" || subscriptionID == "" {
+			return nil, errors.NotFoundf("AZURE_CLIENT_ID, AZURE_CLIENT_SECRET and AZURE_SUBSCRIPTION_ID")
+		}
+		return map[string]string{
+			"application-id":       appID,
+			"application-password": appPassword,
+			"subscription-id":      subscriptionID,
+		}, nil
+	}
+	return nil, errors.NotSupportedf("credentials from the environment for %q", provider)
+}
+
+// gcpEnvironmentCredentialAttributes reads the service account key file
+// referenced by GOOGLE_APPLICATION_CREDENTIALS into oauth2 attributes.
+func gcpEnvironmentCredentialAttributes() (map[string]string, error) {
+	keyFile := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	if keyFile == "" {
+		return nil, errors.NotFoundf("GOOGLE_APPLICATION_CREDENTIALS")
+	}
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, errors.Annotatef(err, "reading GCP credentials file %s", keyFile)
+	}
+	var key map[string]string
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, errors.Annotatef(err, "invalid json credential file %s", keyFile)
+	}
+	if key["type"] != "service_account" {
+		return nil, errors.NotSupportedf("JSON key type %q", key["type"])
+	}
+	return map[string]string{
+		"client-id":    key["client_id"],
+		"client-email": key["client_email"],
+		"private-key":  key["private_key"],
+		"project-id":   key["project_id"],
+	}, nil
+}
+
+func firstEnvVar(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelCredentialErrors(t *testing.T) {
+	results := []params.UpdateCredentialModelResult{{
+		ModelUUID: "other-uuid",
+		Errors:    []params.ErrorResult{{Error: &params.Error{Message: "not this model"}}},
+	}, {
+		ModelUUID: "model-uuid",
+		Errors: []params.ErrorResult{
+			{Error: &params.Error{Message: "credential not authorized"}},
+			{},
+			{Error: &params.Error{Message: "instance not found"}},
+		},
+	}}
+	assert.Equal(t, []string{"credential not authorized", "instance not found"}, modelCredentialErrors("model-uuid", results))
+	assert.Empty(t, modelCredentialErrors("model-uuid", []params.UpdateCredentialModelResult{{ModelUUID: "model-uuid"}}))
+	assert.Empty(t, modelCredentialErrors("model-uuid", nil))
+}
@@ -8,6 +8,8 @@ package juju
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -58,6 +60,10 @@ type IntegrationInput struct {
 	Apps      []string
 	Endpoints []string
 	ViaCIDRs  string
+	// AdoptExisting makes CreateIntegration return an integration
+	// which already exists between the same endpoints rather than
+	// failing.
+	AdoptExisting bool
 }
 
 type CreateIntegrationResponse struct {
@@ -85,8 +91,8 @@ func newIntegrationsClient(sc SharedClient) *integrationsClient {
 	}
 }
 
-func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateIntegrationResponse, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c integrationsClient) CreateIntegration(ctx context.Context, input *IntegrationInput) (*CreateIntegrationResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, err
 	}
@@ -95,10 +101,11 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 	client := apiapplication.NewClient(conn)
 
 	// wait for the apps to be available
-	ctx, cancel := context.WithTimeout(context.Background(), IntegrationAppAvailableTimeout)
+	// The wait is bounded, and stops when terraform is interrupted.
+	waitCtx, cancel := context.WithTimeout(ctx, IntegrationAppAvailableTimeout)
 	defer cancel()
 
-	err = WaitForAppsAvailable(ctx, client, input.Apps, IntegrationApiTickWait)
+	err = WaitForAppsAvailable(waitCtx, client, input.Apps, IntegrationApiTickWait)
 	if err != nil {
 		return nil, errors.New("the applications were not available to be integrated")
 	}
@@ -108,7 +115,7 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 		input.Endpoints,
 		listViaCIDRs,
 	)
-	if err != nil {
+	if err != nil && !(input.AdoptExisting && errors.Is(typedError(err), errors.AlreadyExists)) {
 		return nil, err
 	}
 
@@ -118,15 +125,56 @@ func (c integrationsClient) CreateIntegration(input *IntegrationInput) (*CreateI
 		return nil, err
 	}
 
-	applications := parseApplications(status.RemoteApplications, response.Endpoints)
+	var endpoints interface{}
+	if response != nil {
+		endpoints = response.Endpoints
+	} else {
+		existing, ok := findIntegration(status, input.Endpoints)
+		if !ok {
+			return nil, errors.Errorf("integration between %q already exists with different endpoints", input.Endpoints)
+		}
+		c.Tracef("adopting existing integration", map[string]interface{}{"key": existing.Key})
+		endpoints = existing.Endpoints
+	}
+
+	applications := parseApplications(status.RemoteApplications, endpoints)
 
 	return &CreateIntegrationResponse{
 		Applications: applications,
 	}, nil
 }
 
-func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadIntegrationResponse, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+// findIntegration returns the integration of the status between exactly the
+// given endpoints, formatted as "<application>[:<endpoint>]". An endpoint
+// without a name matches any endpoint of its application.
+func findIntegration(status *params.FullStatus, endpoints []string) (params.RelationStatus, bool) {
+	for _, relation := range status.Relations {
+		if len(relation.Endpoints) != len(endpoints) {
+			continue
+		}
+		matched := make([]bool, len(relation.Endpoints))
+		for _, endpoint := range endpoints {
+			appName, endpointName, _ := strings.Cut(endpoint, ":")
+			for i, relationEndpoint := range relation.Endpoints {
+				if matched[i] || relationEndpoint.ApplicationName != appName {
+					continue
+				}
+				if endpointName != "" && relationEndpoint.Name != endpointName {
+					continue
+				}
+				matched[i] = true
+				break
+			}
+		}
+		if !slices.Contains(matched, false) {
+			return relation, true
+		}
+	}
+	return params.RelationStatus{}, false
+}
+
+func (c integrationsClient) ReadIntegration(ctx context.Context, input *IntegrationInput) (*ReadIntegrationResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, err
 	}
@@ -186,8 +234,8 @@ func (c integrationsClient) ReadIntegration(input *IntegrationInput) (*ReadInteg
 	}, nil
 }
 
-func (c integrationsClient) UpdateIntegration(input *UpdateIntegrationInput) (*UpdateIntegrationResponse, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c integrationsClient) UpdateIntegration(ctx context.Context, input *UpdateIntegrationInput) (*UpdateIntegrationResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, err
 	}
@@ -236,8 +284,8 @@ func (c integrationsClient) UpdateIntegration(input *UpdateIntegrationInput) (*U
 	}, nil
 }
 
-func (c integrationsClient) DestroyIntegration(input *IntegrationInput) error {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c integrationsClient) DestroyIntegration(ctx context.Context, input *IntegrationInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -330,3 +378,93 @@ func parseApplications(remoteApplications map[string]params.RemoteApplicationSta
 
 	return applications
 }
+
+type ReadApplicationIntegrationsInput struct {
+	ModelName string
+	AppName   string
+}
+
+// ApplicationIntegration is an integration of an application, seen from
+// the application.
+type ApplicationIntegration struct {
+	ID        int
+	Endpoint  string
+	Interface string
+	Role      string
+	Scope     string
+	// RelatedApplication is the application at the other end of the
+	// integration, or the application itself for a peer integration.
+	RelatedApplication string
+	RelatedEndpoint    string
+	// RelatedOfferURL is the URL of the offer consumed when the related
+	// application is a remote application, empty otherwise.
+	RelatedOfferURL string
+}
+
+type ReadApplicationIntegrationsResponse struct {
+	Integrations []ApplicationIntegration
+}
+
+// ReadApplicationIntegrations returns all the integrations of an
+// application, sorted by id.
+func (c integrationsClient) ReadApplicationIntegrations(ctx context.Context, input *ReadApplicationIntegrationsInput) (*ReadApplicationIntegrationsResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	status, err := c.getStatus(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	integrations, err := applicationIntegrations(status, input.AppName)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadApplicationIntegrationsResponse{Integrations: integrations}, nil
+}
+
+// applicationIntegrations returns the integrations of an application
+// found in the status of its model, sorted by id.
+func applicationIntegrations(status *params.FullStatus, appName string) ([]ApplicationIntegration, error) {
+	if _, exists := status.Applications[appName]; !exists {
+		return nil, fmt.Errorf("application %q not found in the model", appName)
+	}
+
+	integrations := make([]ApplicationIntegration, 0)
+	for _, relation := range status.Relations {
+		var local, related *params.EndpointStatus
+		for i := range relation.Endpoints {
+			endpoint := &relation.Endpoints[i]
+			if endpoint.ApplicationName == appName && local == nil {
+				local = endpoint
+			} else {
+				related = endpoint
+			}
+		}
+		if local == nil {
+			continue
+		}
+		// A peer integration has a single endpoint.
+		if related == nil {
+			related = local
+		}
+		integration := ApplicationIntegration{
+			ID:                 relation.Id,
+			Endpoint:           local.Name,
+			Interface:          relation.Interface,
+			Role:               local.Role,
+			Scope:              relation.Scope,
+			RelatedApplication: related.ApplicationName,
+			RelatedEndpoint:    related.Name,
+		}
+		if remote, ok := status.RemoteApplications[related.ApplicationName]; ok {
+			integration.RelatedOfferURL = remote.OfferURL
+		}
+		integrations = append(integrations, integration)
+	}
+	sort.Slice(integrations, func(i, j int) bool { return integrations[i].ID < integrations[j].ID })
+	return integrations, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/suite"
+)
+
+type IntegrationSuite struct {
+	suite.Suite
+}
+
+func (s *IntegrationSuite) TestApplicationIntegrations() {
+	status := &params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{
+			"postgresql": {},
+			"wordpress":  {},
+		},
+		RemoteApplications: map[string]params.RemoteApplicationStatus{
+			"mysql": {OfferURL: "admin/other.mysql"},
+		},
+		Relations: []params.RelationStatus{{
+			Id:        3,
+			Interface: "mysql",
+			Scope:     "global",
+			Endpoints: []params.EndpointStatus{
+				{ApplicationName: "wordpress", Name: "db", Role: "requirer"},
+				{ApplicationName: "mysql", Name: "db", Role: "provider"},
+			},
+		}, {
+			Id:        1,
+			Interface: "pgsql",
+			Scope:     "global",
+			Endpoints: []params.EndpointStatus{
+				{ApplicationName: "postgresql", Name: "db", Role: "provider"},
+				{ApplicationName: "wordpress", Name: "database", Role: "requirer"},
+			},
+		}, {
+			Id:        2,
+			Interface: "wordpress-replica",
+			Scope:     "global",
+			Endpoints: []params.EndpointStatus{
+				{ApplicationName: "wordpress", Name: "replicas", Role: "peer"},
+			},
+		}},
+	}
+
+	integrations, err := applicationIntegrations(status, "wordpress")
+	s.Require().NoError(err)
+	s.Assert().Equal([]ApplicationIntegration{{
+		ID:                 1,
+		Endpoint:           "database",
+		Interface:          "pgsql",
+		Role:               "requirer",
+		Scope:              "global",
+		RelatedApplication: "postgresql",
+		RelatedEndpoint:    "db",
+	}, {
+		ID:                 2,
+		Endpoint:           "replicas",
+		Interface:          "wordpress-replica",
+		Role:               "peer",
+		Scope:              "global",
+		RelatedApplication: "wordpress",
+		RelatedEndpoint:    "replicas",
+	}, {
+		ID:                 3,
+		Endpoint:           "db",
+		Interface:          "mysql",
+		Role:               "requirer",
+		Scope:              "global",
+		RelatedApplication: "mysql",
+		RelatedEndpoint:    "db",
+		RelatedOfferURL:    "admin/other.mysql",
+	}}, integrations)
+
+	// An application without integrations has none.
+	integrations, err = applicationIntegrations(&params.FullStatus{
+		Applications: map[string]params.ApplicationStatus{"postgresql": {}},
+	}, "postgresql")
+	s.Require().NoError(err)
+	s.Assert().Empty(integrations)
+
+	_, err = applicationIntegrations(status, "missing")
+	s.Assert().EqualError(err, `application "missing" not found in the model`)
+}
+
+func (s *IntegrationSuite) TestFindIntegration() {
+	status := &params.FullStatus{
+		Relations: []params.RelationStatus{{
+			Key: "wordpress:replicas",
+			Endpoints: []params.EndpointStatus{
+				{ApplicationName: "wordpress", Name: "replicas", Role: "peer"},
+			},
+		}, {
+			Key: "wordpress:database postgresql:db",
+			Endpoints: []params.EndpointStatus{
+				{ApplicationName: "postgresql", Name: "db", Role: "provider"},
+				{ApplicationName: "wordpress", Name: "database", Role: "requirer"},
+			},
+		}},
+	}
+
+	integration, ok := findIntegration(status, []string{"wordpress:database", "postgresql:db"})
+	s.Require().True(ok)
+	s.Assert().Equal("wordpress:database postgresql:db", integration.Key)
+
+	// An endpoint without a name matches any endpoint of its application.
+	integration, ok = findIntegration(status, []string{"wordpress", "postgresql"})
+	s.Require().True(ok)
+	s.Assert().Equal("wordpress:database postgresql:db", integration.Key)
+
+	_, ok = findIntegration(status, []string{"wordpress:db", "postgresql:db"})
+	s.Assert().False(ok)
+	_, ok = findIntegration(status, []string{"wordpress", "mysql"})
+	s.Assert().False(ok)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationSuite))
+}
@@ -4,12 +4,14 @@
 package juju
 
 import (
+	"context"
 	"io"
 
 	jaasparams "github.com/canonical/jimm-go-sdk/v3/api/params"
 	"github.com/juju/charm/v12"
 	charmresources "github.com/juju/charm/v12/resource"
 	"github.com/juju/juju/api"
+	apiaction "github.com/juju/juju/api/client/action"
 	apiapplication "github.com/juju/juju/api/client/application"
 	apiclient "github.com/juju/juju/api/client/client"
 	apiresources "github.com/juju/juju/api/client/resources"
@@ -19,15 +21,16 @@ import (
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/resources"
 	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v5"
 )
 
 type SharedClient interface {
 	AddModel(modelName, modelUUID string, modelType model.ModelType)
-	GetConnection(modelName *string) (api.Connection, error)
+	GetConnection(ctx context.Context, modelName *string) (api.Connection, error)
 	ModelType(modelName string) (model.ModelType, error)
-	ModelUUID(modelName string) (string, error)
+	ModelUUID(ctx context.Context, modelName string) (string, error)
 	RemoveModel(modelUUID string)
 
 	Debugf(msg string, additionalFields ...map[string]interface{})
@@ -38,8 +41,14 @@ type SharedClient interface {
 	JujuLogger() *jujuLoggerShim
 }
 
+type ActionAPIClient interface {
+	EnqueueOperation(actions []apiaction.Action) (apiaction.EnqueuedActions, error)
+	Actions(actionIDs []string) ([]apiaction.ActionResult, error)
+}
+
 type ClientAPIClient interface {
 	Status(args *apiclient.StatusArgs) (*params.FullStatus, error)
+	StatusHistory(kind status.HistoryKind, tag names.Tag, filter status.StatusHistoryFilter) (status.History, error)
 }
 
 type ApplicationAPIClient interface {
@@ -91,6 +100,92 @@ type JaasAPIClient interface {
 	RemoveRelation(req *jaasparams.RemoveRelationRequest) error
 	AddGroup(req *jaasparams.AddGroupRequest) (jaasparams.AddGroupResponse, error)
 	GetGroup(req *jaasparams.GetGroupRequest) (jaasparams.GetGroupResponse, error)
+	ListGroups(req *jaasparams.ListGroupsRequest) ([]jaasparams.Group, error)
 	RenameGroup(req *jaasparams.RenameGroupRequest) error
 	RemoveGroup(req *jaasparams.RemoveGroupRequest) error
+	AddRole(req *AddRoleRequest) (RoleResponse, error)
+	GetRole(req *GetRoleRequest) (RoleResponse, error)
+	RenameRole(req *RenameRoleRequest) error
+	RemoveRole(req *RemoveRoleRequest) error
+	ListControllers() ([]jaasparams.ControllerInfo, error)
+	SetControllerDeprecated(req *jaasparams.SetControllerDeprecatedRequest) (jaasparams.ControllerInfo, error)
+	MigrateModel(req *jaasparams.MigrateModelRequest) (*params.InitiateMigrationResults, error)
+}
+
+// ApplicationsClient defines the set of methods the provider uses to
+// manage applications.
+type ApplicationsClient interface {
+	CreateApplication(ctx context.Context, input *CreateApplicationInput) (*CreateApplicationResponse, error)
+	ReadApplicationWithRetryOnNotFound(ctx context.Context, input *ReadApplicationInput) (*ReadApplicationResponse, error)
+	ReadApplication(ctx context.Context, input *ReadApplicationInput) (*ReadApplicationResponse, error)
+	ReadStatusHistory(ctx context.Context, input *ReadStatusHistoryInput) (*ReadStatusHistoryResponse, error)
+	ReadApplicationStatus(ctx context.Context, input *ReadApplicationInput) (*ReadApplicationStatusResponse, error)
+	ListApplications(ctx context.Context, input *ListApplicationsInput) (*ListApplicationsResponse, error)
+	WaitForApplicationReady(ctx context.Context, input *WaitForApplicationReadyInput) error
+	UpdateApplication(ctx context.Context, input *UpdateApplicationInput) error
+	ReadCharmConfigOptions(ctx context.Context, input *ReadCharmConfigOptionsInput) (map[string]CharmConfigOption, error)
+	ResolveCharm(ctx context.Context, input *ResolveCharmInput) error
+	RunLeaderAction(ctx context.Context, input *RunLeaderActionInput) error
+	RunAction(ctx context.Context, input *RunActionInput) (*RunActionResponse, error)
+	DestroyApplication(ctx context.Context, input *DestroyApplicationInput) error
+}
+
+// MachinesClient defines the set of methods the provider uses to
+// manage machines.
+type MachinesClient interface {
+	CreateMachine(ctx context.Context, input *CreateMachineInput) (*CreateMachineResponse, error)
+	ReadMachine(ctx context.Context, input ReadMachineInput) (ReadMachineResponse, error)
+	ReadMachines(ctx context.Context, input ReadMachinesInput) ([]ReadMachineResponse, error)
+	ReadMachineNetworkInterfaces(ctx context.Context, input ReadMachineInput) ([]MachineNetworkInterface, error)
+	MachineIDFromInstanceID(ctx context.Context, modelName, instanceID string) (string, error)
+	DestroyMachine(ctx context.Context, input *DestroyMachineInput) error
+}
+
+// KubernetesCloudsClient defines the set of methods the provider uses
+// to manage kubernetes clouds.
+type KubernetesCloudsClient interface {
+	CreateKubernetesCloud(ctx context.Context, input *CreateKubernetesCloudInput) (*CreateKubernetesCloudOutput, error)
+	ReadKubernetesCloud(ctx context.Context, input *ReadKubernetesCloudInput) (*ReadKubernetesCloudOutput, error)
+	UpdateKubernetesCloud(ctx context.Context, input *UpdateKubernetesCloudInput) (*UpdateKubernetesCloudOutput, error)
+	DestroyKubernetesCloud(ctx context.Context, input *DestroyKubernetesCloudInput) error
+}
+
+// ModelsClient defines the set of methods the provider uses to manage
+// models and model access.
+type ModelsClient interface {
+	GetConnection(ctx context.Context, modelName *string) (api.Connection, error)
+	GetModelByName(ctx context.Context, name string) (*params.ModelInfo, error)
+	CreateModel(ctx context.Context, input CreateModelInput) (CreateModelResponse, error)
+	ReadModel(ctx context.Context, name string) (*ReadModelResponse, error)
+	ReadModelUUID(ctx context.Context, owner, name string) (string, error)
+	ReadModelConfig(ctx context.Context, input ReadModelConfigInput) (*ReadModelConfigResponse, error)
+	UnknownConfigKeys(ctx context.Context, cloudName string, keys []string) ([]string, error)
+	UpdateModel(ctx context.Context, input UpdateModelInput) error
+	DestroyModel(ctx context.Context, input DestroyModelInput) error
+	GrantModel(ctx context.Context, input GrantModelInput) error
+	UpdateAccessModel(ctx context.Context, input UpdateAccessModelInput) error
+	DestroyAccessModel(ctx context.Context, input DestroyAccessModelInput) error
+}
+
+// JaasClient defines the set of methods the provider uses to manage
+// JAAS relations, groups and controllers.
+type JaasClient interface {
+	AddRelations(ctx context.Context, tuples []JaasTuple) error
+	DeleteRelations(ctx context.Context, tuples []JaasTuple) error
+	ReadRelations(ctx context.Context, tuple *JaasTuple) ([]JaasTuple, error)
+	AddGroup(ctx context.Context, name string) (string, error)
+	ReadGroup(ctx context.Context, uuid string) (*JaasGroup, error)
+	ReadGroupByName(ctx context.Context, name string) (*JaasGroup, error)
+	RenameGroup(ctx context.Context, name, newName string) error
+	RemoveGroup(ctx context.Context, name string) error
+	AddRole(ctx context.Context, name string) (string, error)
+	ReadRole(ctx context.Context, uuid string) (*JaasRole, error)
+	ReadRoleByName(ctx context.Context, name string) (*JaasRole, error)
+	RenameRole(ctx context.Context, name, newName string) error
+	RemoveRole(ctx context.Context, name string) error
+	ReadLoginInfo(ctx context.Context) (*JaasLoginInfo, error)
+	ListControllers(ctx context.Context) ([]JaasController, error)
+	ReadController(ctx context.Context, name string) (*JaasController, error)
+	SetControllerDeprecated(ctx context.Context, name string, deprecated bool) error
+	MigrateModels(ctx context.Context, targetController string, modelUUIDs []string) error
 }
@@ -6,10 +6,13 @@ package juju
 import (
 	"context"
 	"errors"
+	"sort"
 
-	"github.com/canonical/jimm-go-sdk/v3/api"
 	"github.com/canonical/jimm-go-sdk/v3/api/params"
+	jimmnames "github.com/canonical/jimm-go-sdk/v3/names"
+	jujuerrors "github.com/juju/errors"
 	jujuapi "github.com/juju/juju/api"
+	"github.com/juju/names/v5"
 )
 
 type jaasClient struct {
@@ -21,7 +24,7 @@ func newJaasClient(sc SharedClient) *jaasClient {
 	return &jaasClient{
 		SharedClient: sc,
 		getJaasApiClient: func(conn jujuapi.Connection) JaasAPIClient {
-			return api.NewClient(conn)
+			return newJimmAPIClient(conn, conn.BestFacadeVersion("JIMM"))
 		},
 	}
 }
@@ -76,11 +79,11 @@ type JaasGroup struct {
 
 // AddRelations attempts to create the provided slice of relationship tuples.
 // An empty slice of tuples will return an error.
-func (jc *jaasClient) AddRelations(tuples []JaasTuple) error {
+func (jc *jaasClient) AddRelations(ctx context.Context, tuples []JaasTuple) error {
 	if len(tuples) == 0 {
 		return errors.New("empty slice of tuples")
 	}
-	conn, err := jc.GetConnection(nil)
+	conn, err := jc.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -94,11 +97,11 @@ func (jc *jaasClient) AddRelations(tuples []JaasTuple) error {
 
 // DeleteRelations attempts to delete the provided slice of relationship tuples.
 // An empty slice of tuples will return an error.
-func (jc *jaasClient) DeleteRelations(tuples []JaasTuple) error {
+func (jc *jaasClient) DeleteRelations(ctx context.Context, tuples []JaasTuple) error {
 	if len(tuples) == 0 {
 		return errors.New("empty slice of tuples")
 	}
-	conn, err := jc.GetConnection(nil)
+	conn, err := jc.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -117,13 +120,19 @@ func (jc *jaasClient) ReadRelations(ctx context.Context, tuple *JaasTuple) ([]Ja
 		return nil, errors.New("read relation tuple is nil")
 	}
 
-	conn, err := jc.GetConnection(nil)
+	conn, err := jc.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = conn.Close() }()
 
 	client := jc.getJaasApiClient(conn)
+	return jc.listRelations(ctx, client, tuple)
+}
+
+// listRelations lists all the relations that match the criteria defined
+// by `tuple`, following the continuation tokens returned by JAAS.
+func (jc *jaasClient) listRelations(ctx context.Context, client JaasAPIClient, tuple *JaasTuple) ([]JaasTuple, error) {
 	relations := make([]JaasTuple, 0)
 	req := &params.ListRelationshipTuplesRequest{Tuple: toAPITuple(*tuple)}
 	for {
@@ -151,7 +160,7 @@ func (jc *jaasClient) ReadRelations(ctx context.Context, tuple *JaasTuple) ([]Ja
 
 // AddGroup attempts to create a new group with the provided name.
 func (jc *jaasClient) AddGroup(ctx context.Context, name string) (string, error) {
-	conn, err := jc.GetConnection(nil)
+	conn, err := jc.GetConnection(ctx, nil)
 	if err != nil {
 		return "", err
 	}
@@ -169,7 +178,7 @@ func (jc *jaasClient) AddGroup(ctx context.Context, name string) (string, error)
 
 // ReadGroup attempts to read a group that matches the provided UUID.
 func (jc *jaasClient) ReadGroup(ctx context.Context, uuid string) (*JaasGroup, error) {
-	conn, err := jc.GetConnection(nil)
+	conn, err := jc.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -184,9 +193,38 @@ func (jc *jaasClient) ReadGroup(ctx context.Context, uuid string) (*JaasGroup, e
 	return &JaasGroup{Name: resp.Name, UUID: resp.UUID}, nil
 }
 
+// groupsPageSize is the number of groups listed at once when looking
+// for a group by name.
+const groupsPageSize = 100
+
+// ReadGroupByName attempts to read a group that matches the provided name.
+func (jc *jaasClient) ReadGroupByName(ctx context.Context, name string) (*JaasGroup, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	for offset := 0; ; offset += groupsPageSize {
+		groups, err := client.ListGroups(&params.ListGroupsRequest{Limit: groupsPageSize, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			if group.Name == name {
+				return &JaasGroup{Name: group.Name, UUID: group.UUID}, nil
+			}
+		}
+		if len(groups) < groupsPageSize {
+			return nil, jujuerrors.NotFoundf("group %q", name)
+		}
+	}
+}
+
 // RenameGroup attempts to rename a group that matches the provided name.
 func (jc *jaasClient) RenameGroup(ctx context.Context, name, newName string) error {
-	conn, err := jc.GetConnection(nil)
+	conn, err := jc.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -199,7 +237,7 @@ func (jc *jaasClient) RenameGroup(ctx context.Context, name, newName string) err
 
 // RemoveGroup attempts to remove a group that matches the provided name.
 func (jc *jaasClient) RemoveGroup(ctx context.Context, name string) error {
-	conn, err := jc.GetConnection(nil)
+	conn, err := jc.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -209,3 +247,182 @@ func (jc *jaasClient) RemoveGroup(ctx context.Context, name string) error {
 	req := params.RemoveGroupRequest{Name: name}
 	return client.RemoveGroup(&req)
 }
+
+// JaasLoginInfo represents the identity authenticated with JAAS.
+type JaasLoginInfo struct {
+	// Identity is the email of a user or the client ID of a service account.
+	Identity string
+	// ControllerAccess is the access level of the identity to the controller.
+	ControllerAccess string
+	// Groups are the UUIDs of the groups the identity is a direct member of.
+	Groups []string
+}
+
+// ReadLoginInfo returns the identity used to authenticate with JAAS
+// along with its controller access and group memberships.
+func (jc *jaasClient) ReadLoginInfo(ctx context.Context) (*JaasLoginInfo, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	authTag := conn.AuthTag()
+	client := jc.getJaasApiClient(conn)
+	memberships, err := jc.listRelations(ctx, client, &JaasTuple{
+		Object:   authTag.String(),
+		Relation: "member",
+		Target:   jimmnames.GroupTagKind,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		tag, err := jimmnames.ParseGroupTag(membership.Target)
+		if err != nil {
+			return nil, jujuerrors.Annotatef(err, "parsing group %q", membership.Target)
+		}
+		groups = append(groups, tag.Id())
+	}
+
+	return &JaasLoginInfo{
+		Identity:         authTag.Id(),
+		ControllerAccess: conn.ControllerAccess(),
+		Groups:           groups,
+	}, nil
+}
+
+// JaasController represents a controller attached to JAAS.
+type JaasController struct {
+	Name   string
+	UUID   string
+	Status string
+	// PublicAddress is the DNS name and port of the controller, if it
+	// has one.
+	PublicAddress string
+	// Cloud and CloudRegion are where the controller is running.
+	Cloud        string
+	CloudRegion  string
+	AgentVersion string
+	// APIAddresses are the addresses of the API servers of the
+	// controller known to JAAS.
+	APIAddresses []string
+	// CACertificate is the certificate of the authority which signed
+	// the certificate of the controller, it changes when the controller
+	// is rebuilt.
+	CACertificate string
+}
+
+func toJaasController(controller params.ControllerInfo) JaasController {
+	var cloud string
+	if tag, err := names.ParseCloudTag(controller.CloudTag); err == nil {
+		cloud = tag.Id()
+	}
+	return JaasController{
+		Name:          controller.Name,
+		UUID:          controller.UUID,
+		Status:        string(controller.Status.Status),
+		PublicAddress: controller.PublicAddress,
+		Cloud:         cloud,
+		CloudRegion:   controller.CloudRegion,
+		AgentVersion:  controller.AgentVersion,
+		APIAddresses:  controller.APIAddresses,
+		CACertificate: controller.CACertificate,
+	}
+}
+
+// ControllerStatusDeprecated is the status of a controller JAAS will
+// not place new models on.
+const ControllerStatusDeprecated = "deprecated"
+
+// ListControllers returns the controllers attached to JAAS, sorted by
+// name.
+func (jc *jaasClient) ListControllers(ctx context.Context) ([]JaasController, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	controllers, err := client.ListControllers()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]JaasController, 0, len(controllers))
+	for _, controller := range controllers {
+		out = append(out, toJaasController(controller))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+// ReadController attempts to read the controller attached to JAAS that
+// matches the provided name.
+func (jc *jaasClient) ReadController(ctx context.Context, name string) (*JaasController, error) {
+	controllers, err := jc.ListControllers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, controller := range controllers {
+		if controller.Name == name {
+			return &controller, nil
+		}
+	}
+	return nil, jujuerrors.NotFoundf("controller %q", name)
+}
+
+// SetControllerDeprecated marks the controller that matches the provided
+// name as deprecated, JAAS does not place new models on deprecated
+// controllers.
+func (jc *jaasClient) SetControllerDeprecated(ctx context.Context, name string, deprecated bool) error {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	req := params.SetControllerDeprecatedRequest{Name: name, Deprecated: deprecated}
+	_, err = client.SetControllerDeprecated(&req)
+	return err
+}
+
+// MigrateModels attempts to migrate the models that match the provided
+// UUIDs to the controller that matches the provided name.
+// An empty slice of model UUIDs will return an error.
+func (jc *jaasClient) MigrateModels(ctx context.Context, targetController string, modelUUIDs []string) error {
+	if len(modelUUIDs) == 0 {
+		return errors.New("empty slice of models")
+	}
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	req := params.MigrateModelRequest{
+		Specs: make([]params.MigrateModelInfo, 0, len(modelUUIDs)),
+	}
+	for _, uuid := range modelUUIDs {
+		req.Specs = append(req.Specs, params.MigrateModelInfo{
+			ModelTag:         names.NewModelTag(uuid).String(),
+			TargetController: targetController,
+		})
+	}
+	resp, err := client.MigrateModel(&req)
+	if err != nil {
+		return err
+	}
+	for _, result := range resp.Results {
+		if result.Error != nil {
+			return jujuerrors.Annotatef(result.Error, "migrating %s", result.ModelTag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+
+	"github.com/canonical/jimm-go-sdk/v3/api"
+	"github.com/juju/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// The role calls of the JIMM facade are not part of the jimm-go-sdk
+// version used by the provider yet. The request and response types below
+// match the ones of the JIMM facade.
+
+// AddRoleRequest holds a request to add a role.
+type AddRoleRequest struct {
+	// Name holds the name of the role.
+	Name string `json:"name"`
+}
+
+// GetRoleRequest holds a request to get a role by UUID or by name.
+type GetRoleRequest struct {
+	// UUID holds the UUID of the role to be retrieved.
+	UUID string `json:"uuid,omitempty"`
+	// Name holds the name of the role to be retrieved.
+	Name string `json:"name,omitempty"`
+}
+
+// RenameRoleRequest holds a request to rename a role.
+type RenameRoleRequest struct {
+	// Name holds the name of the role.
+	Name string `json:"name"`
+	// NewName holds the new name of the role.
+	NewName string `json:"new-name"`
+}
+
+// RemoveRoleRequest holds a request to remove a role.
+type RemoveRoleRequest struct {
+	// Name holds the name of the role.
+	Name string `json:"name"`
+}
+
+// RoleResponse holds the details of a role residing in JIMM.
+type RoleResponse struct {
+	UUID      string `json:"uuid"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// jimmFacadeVersion is the version of the JIMM facade the role calls,
+// like the calls of the jimm-go-sdk, are made with.
+const jimmFacadeVersion = 4
+
+// jimmAPIClient extends the JIMM API client of the jimm-go-sdk with the
+// role calls of the JIMM facade.
+type jimmAPIClient struct {
+	*api.Client
+	caller        api.APICaller
+	facadeVersion int
+}
+
+// newJimmAPIClient returns a client of the JIMM facade, facadeVersion is
+// the best version of the facade offered by the JAAS controller.
+func newJimmAPIClient(caller api.APICaller, facadeVersion int) *jimmAPIClient {
+	return &jimmAPIClient{
+		Client:        api.NewClient(caller),
+		caller:        caller,
+		facadeVersion: facadeVersion,
+	}
+}
+
+// roleCall makes a role call of the JIMM facade, failing clearly when the
+// JAAS controller does not support roles.
+func (c *jimmAPIClient) roleCall(request string, args, response interface{}) error {
+	if c.facadeVersion < jimmFacadeVersion {
+		return errors.NotSupportedf("roles with JIMM facade version %d, version %d is required", c.facadeVersion, jimmFacadeVersion)
+	}
+	err := c.caller.APICall("JIMM", jimmFacadeVersion, "", request, args, response)
+	if params.IsCodeNotImplemented(err) {
+		return errors.NotSupportedf("roles by this JAAS controller, upgrade it to manage roles (%s)", err)
+	}
+	return err
+}
+
+// AddRole adds the role to JIMM.
+func (c *jimmAPIClient) AddRole(req *AddRoleRequest) (RoleResponse, error) {
+	var resp RoleResponse
+	err := c.roleCall("AddRole", req, &resp)
+	return resp, err
+}
+
+// GetRole returns the role with the given UUID or name.
+func (c *jimmAPIClient) GetRole(req *GetRoleRequest) (RoleResponse, error) {
+	var resp RoleResponse
+	err := c.roleCall("GetRole", req, &resp)
+	return resp, err
+}
+
+// RenameRole renames a role in JIMM.
+func (c *jimmAPIClient) RenameRole(req *RenameRoleRequest) error {
+	return c.roleCall("RenameRole", req, nil)
+}
+
+// RemoveRole removes a role from JIMM.
+func (c *jimmAPIClient) RemoveRole(req *RemoveRoleRequest) error {
+	return c.roleCall("RemoveRole", req, nil)
+}
+
+// JaasRole represents a JAAS role used for permissions management.
+type JaasRole struct {
+	Name string
+	UUID string
+}
+
+// AddRole attempts to create a new role with the provided name.
+func (jc *jaasClient) AddRole(ctx context.Context, name string) (string, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	resp, err := client.AddRole(&AddRoleRequest{Name: name})
+	if err != nil {
+		return "", err
+	}
+	return resp.UUID, nil
+}
+
+// ReadRole attempts to read a role that matches the provided UUID.
+func (jc *jaasClient) ReadRole(ctx context.Context, uuid string) (*JaasRole, error) {
+	return jc.getRole(ctx, &GetRoleRequest{UUID: uuid})
+}
+
+// ReadRoleByName attempts to read a role that matches the provided name.
+func (jc *jaasClient) ReadRoleByName(ctx context.Context, name string) (*JaasRole, error) {
+	return jc.getRole(ctx, &GetRoleRequest{Name: name})
+}
+
+func (jc *jaasClient) getRole(ctx context.Context, req *GetRoleRequest) (*JaasRole, error) {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	resp, err := client.GetRole(req)
+	if err != nil {
+		return nil, err
+	}
+	return &JaasRole{Name: resp.Name, UUID: resp.UUID}, nil
+}
+
+// RenameRole attempts to rename a role that matches the provided name.
+func (jc *jaasClient) RenameRole(ctx context.Context, name, newName string) error {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	return client.RenameRole(&RenameRoleRequest{Name: name, NewName: newName})
+}
+
+// RemoveRole attempts to remove a role that matches the provided name.
+func (jc *jaasClient) RemoveRole(ctx context.Context, name string) error {
+	conn, err := jc.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := jc.getJaasApiClient(conn)
+	return client.RemoveRole(&RemoveRoleRequest{Name: name})
+}
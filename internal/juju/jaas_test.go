@@ -6,10 +6,14 @@ package juju
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/canonical/jimm-go-sdk/v3/api/params"
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/juju/api"
+	jujuparams "github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 )
@@ -53,14 +57,14 @@ func (s *JaasSuite) TestAddRelations() {
 	).Return(nil)
 
 	client := s.getJaasClient()
-	err := client.AddRelations(tuples)
+	err := client.AddRelations(context.Background(), tuples)
 	s.Require().NoError(err)
 }
 
 func (s *JaasSuite) TestAddRelationsEmptySlice() {
 	expectedErr := errors.New("empty slice of tuples")
 	client := s.getJaasClient()
-	err := client.AddRelations([]JaasTuple{})
+	err := client.AddRelations(context.Background(), []JaasTuple{})
 	s.Require().Error(err)
 	s.Assert().Equal(expectedErr, err)
 }
@@ -81,14 +85,14 @@ func (s *JaasSuite) TestDeleteRelations() {
 	).Return(nil)
 
 	client := s.getJaasClient()
-	err := client.DeleteRelations(tuples)
+	err := client.DeleteRelations(context.Background(), tuples)
 	s.Require().NoError(err)
 }
 
 func (s *JaasSuite) TestDeleteRelationsEmptySlice() {
 	expectedErr := errors.New("empty slice of tuples")
 	client := s.getJaasClient()
-	err := client.DeleteRelations([]JaasTuple{})
+	err := client.DeleteRelations(context.Background(), []JaasTuple{})
 	s.Require().Error(err)
 	s.Assert().Equal(expectedErr, err)
 }
@@ -198,6 +202,34 @@ func (s *JaasSuite) TestGetGroupNotFound() {
 	s.Require().Nil(gotGroup)
 }
 
+func (s *JaasSuite) TestReadGroupByName() {
+	defer s.setupMocks(s.T()).Finish()
+
+	firstPage := make([]params.Group, groupsPageSize)
+	for i := range firstPage {
+		firstPage[i] = params.Group{UUID: fmt.Sprintf("uuid-%d", i), Name: fmt.Sprintf("group-%d", i)}
+	}
+	s.mockJaasClient.EXPECT().ListGroups(&params.ListGroupsRequest{Limit: groupsPageSize}).Return(firstPage, nil)
+	s.mockJaasClient.EXPECT().ListGroups(&params.ListGroupsRequest{Limit: groupsPageSize, Offset: groupsPageSize}).Return(
+		[]params.Group{{UUID: "uuid", Name: "group"}}, nil)
+
+	client := s.getJaasClient()
+	gotGroup, err := client.ReadGroupByName(context.Background(), "group")
+	s.Require().NoError(err)
+	s.Require().Equal(JaasGroup{UUID: "uuid", Name: "group"}, *gotGroup)
+}
+
+func (s *JaasSuite) TestReadGroupByNameNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockJaasClient.EXPECT().ListGroups(gomock.Any()).Return([]params.Group{{UUID: "uuid", Name: "other"}}, nil)
+
+	client := s.getJaasClient()
+	gotGroup, err := client.ReadGroupByName(context.Background(), "group")
+	s.Require().True(jujuerrors.Is(err, jujuerrors.NotFound), err)
+	s.Require().Nil(gotGroup)
+}
+
 func (s *JaasSuite) TestRenameGroup() {
 	defer s.setupMocks(s.T()).Finish()
 
@@ -223,6 +255,276 @@ func (s *JaasSuite) TestRemoveGroup() {
 	s.Require().NoError(err)
 }
 
+func (s *JaasSuite) TestAddRole() {
+	defer s.setupMocks(s.T()).Finish()
+
+	name := "role"
+	req := &AddRoleRequest{Name: name}
+	resp := RoleResponse{UUID: "uuid", Name: name}
+	s.mockJaasClient.EXPECT().AddRole(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	uuid, err := client.AddRole(context.Background(), name)
+	s.Require().NoError(err)
+	s.Require().Equal(resp.UUID, uuid)
+}
+
+func (s *JaasSuite) TestReadRole() {
+	defer s.setupMocks(s.T()).Finish()
+
+	uuid := "uuid"
+	name := "role"
+	req := &GetRoleRequest{UUID: uuid}
+	s.mockJaasClient.EXPECT().GetRole(req).Return(RoleResponse{UUID: uuid, Name: name}, nil)
+
+	client := s.getJaasClient()
+	gotRole, err := client.ReadRole(context.Background(), uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(JaasRole{UUID: uuid, Name: name}, *gotRole)
+}
+
+func (s *JaasSuite) TestReadRoleByName() {
+	defer s.setupMocks(s.T()).Finish()
+
+	uuid := "uuid"
+	name := "role"
+	req := &GetRoleRequest{Name: name}
+	s.mockJaasClient.EXPECT().GetRole(req).Return(RoleResponse{UUID: uuid, Name: name}, nil)
+
+	client := s.getJaasClient()
+	gotRole, err := client.ReadRoleByName(context.Background(), name)
+	s.Require().NoError(err)
+	s.Require().Equal(JaasRole{UUID: uuid, Name: name}, *gotRole)
+}
+
+func (s *JaasSuite) TestReadRoleNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+
+	uuid := "uuid"
+	req := &GetRoleRequest{UUID: uuid}
+	s.mockJaasClient.EXPECT().GetRole(req).Return(RoleResponse{}, errors.New("role not found"))
+
+	client := s.getJaasClient()
+	gotRole, err := client.ReadRole(context.Background(), uuid)
+	s.Require().Error(err)
+	s.Require().Nil(gotRole)
+}
+
+func (s *JaasSuite) TestRenameRole() {
+	defer s.setupMocks(s.T()).Finish()
+
+	req := &RenameRoleRequest{Name: "name", NewName: "new-name"}
+	s.mockJaasClient.EXPECT().RenameRole(req).Return(nil)
+
+	client := s.getJaasClient()
+	err := client.RenameRole(context.Background(), "name", "new-name")
+	s.Require().NoError(err)
+}
+
+func (s *JaasSuite) TestRemoveRole() {
+	defer s.setupMocks(s.T()).Finish()
+
+	req := &RemoveRoleRequest{Name: "role"}
+	s.mockJaasClient.EXPECT().RemoveRole(req).Return(nil)
+
+	client := s.getJaasClient()
+	err := client.RemoveRole(context.Background(), "role")
+	s.Require().NoError(err)
+}
+
+type fakeJimmCaller struct {
+	facade  string
+	version int
+	request string
+	params  interface{}
+	err     error
+}
+
+func (c *fakeJimmCaller) APICall(objType string, version int, id, request string, params, response interface{}) error {
+	c.facade, c.version, c.request, c.params = objType, version, request, params
+	if c.err != nil {
+		return c.err
+	}
+	if resp, ok := response.(*RoleResponse); ok {
+		*resp = RoleResponse{UUID: "uuid", Name: "role"}
+	}
+	return nil
+}
+
+func (s *JaasSuite) TestJimmAPIClientRoleCalls() {
+	caller := &fakeJimmCaller{}
+	client := newJimmAPIClient(caller, 4)
+
+	resp, err := client.GetRole(&GetRoleRequest{Name: "role"})
+	s.Require().NoError(err)
+	s.Equal(RoleResponse{UUID: "uuid", Name: "role"}, resp)
+	s.Equal("JIMM", caller.facade)
+	s.Equal(4, caller.version)
+	s.Equal("GetRole", caller.request)
+	s.Equal(&GetRoleRequest{Name: "role"}, caller.params)
+
+	s.Require().NoError(client.RenameRole(&RenameRoleRequest{Name: "role", NewName: "new-role"}))
+	s.Equal("RenameRole", caller.request)
+}
+
+func (s *JaasSuite) TestJimmAPIClientRolesNotSupported() {
+	// The JAAS controller offers an older JIMM facade.
+	caller := &fakeJimmCaller{}
+	_, err := newJimmAPIClient(caller, 3).AddRole(&AddRoleRequest{Name: "role"})
+	s.True(jujuerrors.Is(err, jujuerrors.NotSupported), err)
+	s.Empty(caller.request)
+
+	// The JAAS controller does not implement the role calls.
+	caller = &fakeJimmCaller{err: &jujuparams.Error{Code: jujuparams.CodeNotImplemented, Message: "no such request - method JIMM(4).AddRole is not implemented"}}
+	_, err = newJimmAPIClient(caller, 4).AddRole(&AddRoleRequest{Name: "role"})
+	s.True(jujuerrors.Is(err, jujuerrors.NotSupported), err)
+	s.ErrorContains(err, "upgrade it to manage roles")
+}
+
+func (s *JaasSuite) TestReadLoginInfo() {
+	defer s.setupMocks(s.T()).Finish()
+
+	userTag := names.NewUserTag("alice@canonical.com")
+	s.mockConnection.EXPECT().AuthTag().Return(userTag)
+	s.mockConnection.EXPECT().ControllerAccess().Return("superuser")
+
+	req := &params.ListRelationshipTuplesRequest{Tuple: params.RelationshipTuple{
+		Object:       userTag.String(),
+		Relation:     "member",
+		TargetObject: "group",
+	}}
+	resp := &params.ListRelationshipTuplesResponse{Tuples: []params.RelationshipTuple{
+		{Object: userTag.String(), Relation: "member", TargetObject: "group-00000000-0000-0000-0000-000000000001"},
+	}}
+	s.mockJaasClient.EXPECT().ListRelationshipTuples(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	info, err := client.ReadLoginInfo(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(JaasLoginInfo{
+		Identity:         "alice@canonical.com",
+		ControllerAccess: "superuser",
+		Groups:           []string{"00000000-0000-0000-0000-000000000001"},
+	}, *info)
+}
+
+func (s *JaasSuite) TestReadController() {
+	defer s.setupMocks(s.T()).Finish()
+
+	controllers := []params.ControllerInfo{
+		{Name: "controller-a", UUID: "uuid-a", Status: jujuparams.EntityStatus{Status: "available"}},
+		{Name: "controller-b", UUID: "uuid-b", Status: jujuparams.EntityStatus{Status: "deprecated"}},
+	}
+	s.mockJaasClient.EXPECT().ListControllers().Return(controllers, nil)
+
+	client := s.getJaasClient()
+	gotController, err := client.ReadController(context.Background(), "controller-b")
+	s.Require().NoError(err)
+	s.Require().Equal(JaasController{Name: "controller-b", UUID: "uuid-b", Status: ControllerStatusDeprecated}, *gotController)
+}
+
+func (s *JaasSuite) TestListControllers() {
+	defer s.setupMocks(s.T()).Finish()
+
+	controllers := []params.ControllerInfo{{
+		Name:          "controller-b",
+		UUID:          "uuid-b",
+		PublicAddress: "jimm.example.com:443",
+		CloudTag:      names.NewCloudTag("aws").String(),
+		CloudRegion:   "eu-west-1",
+		AgentVersion:  "3.5.3",
+		APIAddresses:  []string{"10.0.0.1:17070"},
+		CACertificate: "ca-cert",
+		Status:        jujuparams.EntityStatus{Status: "available"},
+	}, {
+		Name:     "controller-a",
+		UUID:     "uuid-a",
+		CloudTag: "not-a-tag",
+		Status:   jujuparams.EntityStatus{Status: "unavailable"},
+	}}
+	s.mockJaasClient.EXPECT().ListControllers().Return(controllers, nil)
+
+	client := s.getJaasClient()
+	gotControllers, err := client.ListControllers(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal([]JaasController{{
+		Name:   "controller-a",
+		UUID:   "uuid-a",
+		Status: "unavailable",
+	}, {
+		Name:          "controller-b",
+		UUID:          "uuid-b",
+		Status:        "available",
+		PublicAddress: "jimm.example.com:443",
+		Cloud:         "aws",
+		CloudRegion:   "eu-west-1",
+		AgentVersion:  "3.5.3",
+		APIAddresses:  []string{"10.0.0.1:17070"},
+		CACertificate: "ca-cert",
+	}}, gotControllers)
+}
+
+func (s *JaasSuite) TestReadControllerNotFound() {
+	defer s.setupMocks(s.T()).Finish()
+
+	s.mockJaasClient.EXPECT().ListControllers().Return(nil, nil)
+
+	client := s.getJaasClient()
+	gotController, err := client.ReadController(context.Background(), "controller-a")
+	s.Require().Error(err)
+	s.Require().True(jujuerrors.Is(err, jujuerrors.NotFound))
+	s.Require().Nil(gotController)
+}
+
+func (s *JaasSuite) TestSetControllerDeprecated() {
+	defer s.setupMocks(s.T()).Finish()
+
+	name := "controller"
+	req := &params.SetControllerDeprecatedRequest{Name: name, Deprecated: true}
+	s.mockJaasClient.EXPECT().SetControllerDeprecated(req).Return(params.ControllerInfo{}, nil)
+
+	client := s.getJaasClient()
+	err := client.SetControllerDeprecated(context.Background(), name, true)
+	s.Require().NoError(err)
+}
+
+func (s *JaasSuite) TestMigrateModels() {
+	defer s.setupMocks(s.T()).Finish()
+
+	uuid := "00000000-0000-0000-0000-000000000001"
+	req := &params.MigrateModelRequest{Specs: []params.MigrateModelInfo{
+		{ModelTag: "model-" + uuid, TargetController: "controller"},
+	}}
+	resp := &jujuparams.InitiateMigrationResults{Results: []jujuparams.InitiateMigrationResult{
+		{ModelTag: "model-" + uuid, MigrationId: "migration-id"},
+	}}
+	s.mockJaasClient.EXPECT().MigrateModel(req).Return(resp, nil)
+
+	client := s.getJaasClient()
+	err := client.MigrateModels(context.Background(), "controller", []string{uuid})
+	s.Require().NoError(err)
+}
+
+func (s *JaasSuite) TestMigrateModelsResultError() {
+	defer s.setupMocks(s.T()).Finish()
+
+	uuid := "00000000-0000-0000-0000-000000000001"
+	resp := &jujuparams.InitiateMigrationResults{Results: []jujuparams.InitiateMigrationResult{
+		{ModelTag: "model-" + uuid, Error: &jujuparams.Error{Message: "migration failed"}},
+	}}
+	s.mockJaasClient.EXPECT().MigrateModel(gomock.Any()).Return(resp, nil)
+
+	client := s.getJaasClient()
+	err := client.MigrateModels(context.Background(), "controller", []string{uuid})
+	s.Require().ErrorContains(err, "migration failed")
+}
+
+func (s *JaasSuite) TestMigrateModelsEmptySlice() {
+	client := s.getJaasClient()
+	err := client.MigrateModels(context.Background(), "controller", nil)
+	s.Require().Error(err)
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestJaasSuite(t *testing.T) {
@@ -3,26 +3,95 @@
 
 package juju
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	cloudapi "github.com/juju/juju/api/client/cloud"
+	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
+	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+	clientauthv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var KubernetesCloudNotFoundError = &kubernetesCloudNotFoundError{}
+
+type kubernetesCloudNotFoundError struct {
+	name string
+}
+
+func (ke *kubernetesCloudNotFoundError) Error() string {
+	return fmt.Sprintf("kubernetes cloud %q was not found", ke.name)
+}
+
 type kubernetesCloudsClient struct {
 	SharedClient
 }
 
 type CreateKubernetesCloudInput struct {
+	Name string
+	// KubernetesConfig is the content of a kubeconfig file, the
+	// cluster and user of its current context are used.
+	KubernetesConfig string
+	// ContextName is the context of the kubeconfig to use instead of
+	// its current context, if set.
+	ContextName string
+	// InCluster builds the cloud from the service account of the pod
+	// the provider runs in, instead of KubernetesConfig.
+	InCluster         bool
+	ParentCloudName   string
+	ParentCloudRegion string
 }
 
 type CreateKubernetesCloudOutput struct {
+	Name           string
+	CredentialName string
+	// CredentialExpiry is when the token of the credential expires, as
+	// reported by the exec plugin of the kubeconfig, if any.
+	CredentialExpiry time.Time
 }
 
 type ReadKubernetesCloudInput struct {
+	Name string
 }
 
 type ReadKubernetesCloudOutput struct {
+	Name string
+	// CredentialName is the name of the credential added with the
+	// cloud, owned by the user of the provider.
+	CredentialName  string
+	Endpoint        string
+	HostCloudRegion string
+	Regions         []string
 }
 
 type UpdateKubernetesCloudInput struct {
+	Name             string
+	KubernetesConfig string
+	ContextName      string
+	InCluster        bool
+}
+
+type UpdateKubernetesCloudOutput struct {
+	// CredentialExpiry is when the token of the updated credential
+	// expires, as reported by the exec plugin of the kubeconfig, if any.
+	CredentialExpiry time.Time
 }
 
 type DestroyKubernetesCloudInput struct {
+	Name string
 }
 
 func newKubernetesCloudsClient(sc SharedClient) *kubernetesCloudsClient {
@@ -31,22 +100,352 @@ func newKubernetesCloudsClient(sc SharedClient) *kubernetesCloudsClient {
 	}
 }
 
-// CreateKubernetesCloud creates a new Kubernetes cloud with juju cloud facade.
-func (c *kubernetesCloudsClient) CreateKubernetesCloud(input *CreateKubernetesCloudInput) (*CreateKubernetesCloudOutput, error) {
-	return nil, nil
+// CreateKubernetesCloud creates a new Kubernetes cloud with juju cloud
+// facade, like `juju add-k8s` does. A credential with the name of the
+// cloud is added from the user of the kubeconfig.
+func (c *kubernetesCloudsClient) CreateKubernetesCloud(ctx context.Context, input *CreateKubernetesCloudInput) (*CreateKubernetesCloudOutput, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	cloudParams := k8scloud.CloudParamaters{Name: input.Name}
+	if input.ParentCloudName != "" {
+		// The host cloud region of a kubernetes cloud is given by the
+		// type of the parent cloud, as with `juju add-k8s --cloud`.
+		parentCloud, err := client.Cloud(names.NewCloudTag(input.ParentCloudName))
+		if err != nil {
+			return nil, errors.Annotatef(err, "reading parent cloud %q", input.ParentCloudName)
+		}
+		cloudParams.HostCloudRegion = jujucloud.BuildHostCloudRegion(parentCloud.Type, input.ParentCloudRegion)
+		if input.ParentCloudRegion != "" {
+			cloudParams.Regions = []jujucloud.Region{{Name: input.ParentCloudRegion}}
+		}
+	}
+	newCloud, credential, expiry, err := kubernetesCloud(ctx, input.KubernetesConfig, input.ContextName, input.InCluster, cloudParams)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.AddCloud(newCloud, false); err != nil {
+		return nil, err
+	}
+
+	credentialTag, err := GetCloudCredentialTag(input.Name, getCurrentJujuUser(conn), input.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.AddCredential(credentialTag.String(), credential); err != nil {
+		return nil, errors.Annotatef(err, "adding credential of kubernetes cloud %q", input.Name)
+	}
+	return &CreateKubernetesCloudOutput{Name: input.Name, CredentialName: input.Name, CredentialExpiry: expiry}, nil
 }
 
 // ReadKubernetesCloud reads a Kubernetes cloud with juju cloud facade.
-func (c *kubernetesCloudsClient) ReadKubernetesCloud(input *ReadKubernetesCloudInput) (*ReadKubernetesCloudOutput, error) {
-	return nil, nil
+func (c *kubernetesCloudsClient) ReadKubernetesCloud(ctx context.Context, input *ReadKubernetesCloudInput) (*ReadKubernetesCloudOutput, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	cloud, err := client.Cloud(names.NewCloudTag(input.Name))
+	if params.IsCodeNotFound(err) {
+		return nil, &kubernetesCloudNotFoundError{name: input.Name}
+	} else if err != nil {
+		return nil, err
+	}
+	if cloud.Type != k8sconstants.CAASProviderType {
+		return nil, errors.Errorf("cloud %q is a %s cloud, not a kubernetes cloud", input.Name, cloud.Type)
+	}
+
+	output := &ReadKubernetesCloudOutput{
+		Name:            cloud.Name,
+		Endpoint:        cloud.Endpoint,
+		HostCloudRegion: cloud.HostCloudRegion,
+	}
+	for _, region := range cloud.Regions {
+		output.Regions = append(output.Regions, region.Name)
+	}
+
+	credentialTags, err := client.UserCredentials(names.NewUserTag(getCurrentJujuUser(conn)), names.NewCloudTag(input.Name))
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range credentialTags {
+		if tag.Name() == input.Name {
+			output.CredentialName = tag.Name()
+		}
+	}
+	return output, nil
+}
+
+// UpdateKubernetesCloud updates the endpoint and the credential of a
+// Kubernetes cloud with juju cloud facade, e.g. to rotate the token of
+// the kubeconfig. The exec plugin of the kubeconfig, if any, is run
+// again to get a new token.
+func (c *kubernetesCloudsClient) UpdateKubernetesCloud(ctx context.Context, input *UpdateKubernetesCloudInput) (*UpdateKubernetesCloudOutput, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	current, err := client.Cloud(names.NewCloudTag(input.Name))
+	if err != nil {
+		return nil, err
+	}
+	updatedCloud, credential, expiry, err := kubernetesCloud(ctx, input.KubernetesConfig, input.ContextName, input.InCluster, k8scloud.CloudParamaters{
+		Name:            input.Name,
+		Description:     current.Description,
+		HostCloudRegion: current.HostCloudRegion,
+		Regions:         current.Regions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := client.UpdateCloud(updatedCloud); err != nil {
+		return nil, err
+	}
+
+	credentialTag, err := GetCloudCredentialTag(input.Name, getCurrentJujuUser(conn), input.Name)
+	if err != nil {
+		return nil, err
+	}
+	results, err := client.UpdateCloudsCredentials(map[string]jujucloud.Credential{
+		credentialTag.String(): credential,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			return nil, errors.Annotatef(result.Error, "updating credential of kubernetes cloud %q", input.Name)
+		}
+	}
+	return &UpdateKubernetesCloudOutput{CredentialExpiry: expiry}, nil
+}
+
+// DestroyKubernetesCloud destroys a Kubernetes cloud with juju cloud
+// facade, along with the credential added with it.
+func (c *kubernetesCloudsClient) DestroyKubernetesCloud(ctx context.Context, input *DestroyKubernetesCloudInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := cloudapi.NewClient(conn)
+
+	credentialTag, err := GetCloudCredentialTag(input.Name, getCurrentJujuUser(conn), input.Name)
+	if err != nil {
+		return err
+	}
+	// The controller refuses to remove a credential used by models, so
+	// that the cloud is not removed either.
+	if err := client.RevokeCredential(*credentialTag, false); err != nil && !params.IsCodeNotFound(err) {
+		return errors.Annotatef(err, "removing credential of kubernetes cloud %q", input.Name)
+	}
+	return client.RemoveCloud(input.Name)
+}
+
+// kubernetesCloud returns the cloud and the credential of the cluster
+// the provider runs in if inCluster is set, otherwise of the kubeconfig,
+// along with when the token of the credential expires if it is known.
+func kubernetesCloud(ctx context.Context, kubernetesConfig, contextName string, inCluster bool, cloudParams k8scloud.CloudParamaters) (jujucloud.Cloud, jujucloud.Credential, time.Time, error) {
+	if !inCluster {
+		return kubernetesCloudFromConfig(ctx, kubernetesConfig, contextName, cloudParams)
+	}
+	config, err := inClusterKubernetesConfig()
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, time.Time{}, err
+	}
+	return kubernetesCloudFromContext(ctx, config, inClusterContextName, cloudParams)
+}
+
+// kubernetesCloudFromConfig returns the cloud and the credential
+// described by the given context of the kubeconfig, or by its current
+// context if contextName is empty.
+func kubernetesCloudFromConfig(ctx context.Context, kubernetesConfig, contextName string, cloudParams k8scloud.CloudParamaters) (jujucloud.Cloud, jujucloud.Credential, time.Time, error) {
+	config, err := k8scloud.ConfigFromReader(strings.NewReader(kubernetesConfig))
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, time.Time{}, err
+	}
+	contextName, err = kubernetesConfigContext(config, contextName)
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, time.Time{}, err
+	}
+	return kubernetesCloudFromContext(ctx, config, contextName, cloudParams)
+}
+
+// kubernetesCloudFromContext returns the cloud and the credential
+// described by the given context of the kubeconfig. The exec plugin of
+// the user of the context, if any, is run to get its token.
+func kubernetesCloudFromContext(ctx context.Context, config *clientcmdapi.Config, contextName string, cloudParams k8scloud.CloudParamaters) (jujucloud.Cloud, jujucloud.Credential, time.Time, error) {
+	cloud, err := k8scloud.CloudFromKubeConfigContext(contextName, config, cloudParams)
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, time.Time{}, err
+	}
+	expiry, err := resolveExecCredential(ctx, config, contextName)
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, time.Time{}, err
+	}
+	credential, err := k8scloud.CredentialFromKubeConfigContext(contextName, config)
+	if err != nil {
+		return jujucloud.Cloud{}, jujucloud.Credential{}, time.Time{}, err
+	}
+	return cloud, credential, expiry, nil
+}
+
+// resolveExecCredential runs the exec plugin of the user of the context,
+// e.g. aws-iam-authenticator for EKS or gke-gcloud-auth-plugin for GKE,
+// and sets the token or client certificate it returns on the user, as
+// juju cannot run the plugin itself. It returns when the credential
+// expires, the zero time if the user has no exec plugin or the plugin
+// does not say.
+func resolveExecCredential(ctx context.Context, config *clientcmdapi.Config, contextName string) (time.Time, error) {
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return time.Time{}, nil
+	}
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok || authInfo.Exec == nil || authInfo.Token != "" || authInfo.TokenFile != "" {
+		return time.Time{}, nil
+	}
+	status, err := runExecCredentialPlugin(ctx, authInfo.Exec, config.Clusters[kubeContext.Cluster])
+	if err != nil {
+		return time.Time{}, errors.Annotatef(err, "running exec plugin of kubeconfig user %q", kubeContext.AuthInfo)
+	}
+	authInfo.Token = status.Token
+	authInfo.ClientCertificateData = []byte(status.ClientCertificateData)
+	authInfo.ClientKeyData = []byte(status.ClientKeyData)
+	if status.ExpirationTimestamp == nil {
+		return time.Time{}, nil
+	}
+	return status.ExpirationTimestamp.Time, nil
+}
+
+// execCredentialAPIVersion is the version of the ExecCredential API used
+// when the kubeconfig does not say.
+const execCredentialAPIVersion = "client.authentication.k8s.io/v1"
+
+// runExecCredentialPlugin runs the exec plugin non interactively, as
+// kubectl does, and returns the credential it writes to its output.
+func runExecCredentialPlugin(ctx context.Context, execConfig *clientcmdapi.ExecConfig, cluster *clientcmdapi.Cluster) (*clientauthv1.ExecCredentialStatus, error) {
+	apiVersion := execConfig.APIVersion
+	if apiVersion == "" {
+		apiVersion = execCredentialAPIVersion
+	}
+	request := clientauthv1.ExecCredential{Spec: clientauthv1.ExecCredentialSpec{Interactive: false}}
+	request.APIVersion = apiVersion
+	request.Kind = "ExecCredential"
+	if execConfig.ProvideClusterInfo && cluster != nil {
+		request.Spec.Cluster = &clientauthv1.Cluster{
+			Server:                   cluster.Server,
+			TLSServerName:            cluster.TLSServerName,
+			InsecureSkipTLSVerify:    cluster.InsecureSkipTLSVerify,
+			CertificateAuthorityData: cluster.CertificateAuthorityData,
+			ProxyURL:                 cluster.ProxyURL,
+		}
+	}
+	execInfo, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, execConfig.Command, execConfig.Args...)
+	cmd.Env = append(os.Environ(), "KUBERNETES_EXEC_INFO="+string(execInfo))
+	for _, env := range execConfig.Env {
+		cmd.Env = append(cmd.Env, env.Name+"="+env.Value)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) && execConfig.InstallHint != "" {
+			return nil, errors.Errorf("%v: %s", err, execConfig.InstallHint)
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, errors.Errorf("%v: %s", err, message)
+		}
+		return nil, err
+	}
+
+	var response clientauthv1.ExecCredential
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, errors.Annotate(err, "decoding ExecCredential")
+	}
+	if response.Status == nil || (response.Status.Token == "" && response.Status.ClientCertificateData == "") {
+		return nil, errors.New("the ExecCredential returned has neither a token nor a client certificate")
+	}
+	return response.Status, nil
 }
 
-// UpdateKubernetesCloud updates a Kubernetes cloud with juju cloud facade.
-func (c *kubernetesCloudsClient) UpdateKubernetesCloud(input *UpdateKubernetesCloudInput) error {
-	return nil
+// inClusterContextName is the name of the context of the kubeconfig
+// built from the service account of the pod.
+const inClusterContextName = "in-cluster"
+
+// serviceAccountDir is the directory the token and the CA certificate
+// of the service account are mounted in by kubernetes.
+var serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// inClusterKubernetesConfig returns a kubeconfig for the cluster the
+// provider runs in, from the environment and the service account of its
+// pod, like the in-cluster configuration of kubernetes clients.
+func inClusterKubernetesConfig() (*clientcmdapi.Config, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("not running in a kubernetes cluster, KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT are not set")
+	}
+	token, err := os.ReadFile(filepath.Join(serviceAccountDir, "token"))
+	if err != nil {
+		return nil, errors.Annotate(err, "reading service account token")
+	}
+	caCert, err := os.ReadFile(filepath.Join(serviceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, errors.Annotate(err, "reading service account CA certificate")
+	}
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[inClusterContextName] = &clientcmdapi.Cluster{
+		Server:                   "https://" + net.JoinHostPort(host, port),
+		CertificateAuthorityData: caCert,
+	}
+	config.AuthInfos[inClusterContextName] = &clientcmdapi.AuthInfo{
+		Token: strings.TrimSpace(string(token)),
+	}
+	config.Contexts[inClusterContextName] = &clientcmdapi.Context{
+		Cluster:  inClusterContextName,
+		AuthInfo: inClusterContextName,
+	}
+	config.CurrentContext = inClusterContextName
+	return config, nil
 }
 
-// DestroyKubernetesCloud destroys a Kubernetes cloud with juju cloud facade.
-func (c *kubernetesCloudsClient) DestroyKubernetesCloud(input *DestroyKubernetesCloudInput) error {
-	return nil
+// kubernetesConfigContext returns the name of the given context of the
+// kubeconfig, checking that it exists, otherwise the name of its current
+// context, or of its only context.
+func kubernetesConfigContext(config *clientcmdapi.Config, contextName string) (string, error) {
+	if contextName != "" {
+		if _, ok := config.Contexts[contextName]; !ok {
+			return "", errors.NotFoundf("context %q in kubeconfig", contextName)
+		}
+		return contextName, nil
+	}
+	if config.CurrentContext != "" {
+		return config.CurrentContext, nil
+	}
+	if len(config.Contexts) != 1 {
+		return "", errors.NotValidf("kubeconfig without current context and with %d contexts", len(config.Contexts))
+	}
+	for name := range config.Contexts {
+		return name, nil
+	}
+	return "", nil
 }
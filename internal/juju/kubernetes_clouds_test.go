@@ -0,0 +1,178 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
+	jujucloud "github.com/juju/juju/cloud"
+)
+
+const testKubeConfig = `
+apiVersion: v1
+kind: Config
+clusters:
+- name: microk8s-cluster
+  cluster:
+    server: https://10.0.0.1:16443
+    certificate-authority-data: Q0EtQ0VSVA==
+- name: other-cluster
+  cluster:
+    server: https://10.0.0.2:6443
+    insecure-skip-tls-verify: true
+users:
+- name: admin
+  user:
+    token: secret-token
+contexts:
+- name: microk8s
+  context:
+    cluster: microk8s-cluster
+    user: admin
+- name: other
+  context:
+    cluster: other-cluster
+    user: admin
+current-context: microk8s
+`
+
+func TestKubernetesCloudFromConfig(t *testing.T) {
+	cloud, credential, _, err := kubernetesCloudFromConfig(context.Background(), testKubeConfig, "", k8scloud.CloudParamaters{
+		Name:            "my-k8s",
+		HostCloudRegion: "ec2/us-east-1",
+		Regions:         []jujucloud.Region{{Name: "us-east-1"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "my-k8s", cloud.Name)
+	assert.Equal(t, "kubernetes", cloud.Type)
+	assert.Equal(t, "https://10.0.0.1:16443", cloud.Endpoint)
+	assert.Equal(t, []string{"CA-CERT"}, cloud.CACertificates)
+	assert.Equal(t, "ec2/us-east-1", cloud.HostCloudRegion)
+	assert.Equal(t, jujucloud.OAuth2AuthType, credential.AuthType())
+	assert.Equal(t, "secret-token", credential.Attributes()["Token"])
+}
+
+func TestKubernetesCloudFromConfigContext(t *testing.T) {
+	// Without current context, the only context is used.
+	config := `
+apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://10.0.0.3:6443
+users:
+- name: admin
+  user:
+    token: secret-token
+contexts:
+- name: only
+  context:
+    cluster: cluster
+    user: admin
+`
+	cloud, _, _, err := kubernetesCloudFromConfig(context.Background(), config, "", k8scloud.CloudParamaters{Name: "my-k8s"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.3:6443", cloud.Endpoint)
+
+	// Several contexts require a current context.
+	_, _, _, err = kubernetesCloudFromConfig(context.Background(),
+		testKubeConfig[:len(testKubeConfig)-len("current-context: microk8s\n")], "",
+		k8scloud.CloudParamaters{Name: "my-k8s"},
+	)
+	assert.ErrorContains(t, err, "with 2 contexts")
+
+	_, _, _, err = kubernetesCloudFromConfig(context.Background(), "not a kubeconfig", "", k8scloud.CloudParamaters{Name: "my-k8s"})
+	assert.Error(t, err)
+}
+
+func TestKubernetesCloudFromConfigContextName(t *testing.T) {
+	cloud, _, _, err := kubernetesCloudFromConfig(context.Background(), testKubeConfig, "other", k8scloud.CloudParamaters{Name: "my-k8s"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.2:6443", cloud.Endpoint)
+	assert.True(t, cloud.SkipTLSVerify)
+
+	_, _, _, err = kubernetesCloudFromConfig(context.Background(), testKubeConfig, "missing", k8scloud.CloudParamaters{Name: "my-k8s"})
+	assert.ErrorContains(t, err, `context "missing" in kubeconfig not found`)
+}
+
+func TestKubernetesCloudInCluster(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte("sa-token\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.crt"), []byte("CA-CERT"), 0600))
+	defer func(d string) { serviceAccountDir = d }(serviceAccountDir)
+	serviceAccountDir = dir
+
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "")
+	_, _, _, err := kubernetesCloud(context.Background(), "", "", true, k8scloud.CloudParamaters{Name: "my-k8s"})
+	assert.ErrorContains(t, err, "not running in a kubernetes cluster")
+
+	t.Setenv("KUBERNETES_SERVICE_HOST", "10.152.183.1")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "443")
+	cloud, credential, _, err := kubernetesCloud(context.Background(), "", "", true, k8scloud.CloudParamaters{Name: "my-k8s"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://10.152.183.1:443", cloud.Endpoint)
+	assert.Equal(t, []string{"CA-CERT"}, cloud.CACertificates)
+	assert.Equal(t, jujucloud.OAuth2AuthType, credential.AuthType())
+	assert.Equal(t, "sa-token", credential.Attributes()["Token"])
+}
+
+func TestKubernetesCloudFromConfigExecPlugin(t *testing.T) {
+	// The plugin checks it is given the cluster and the env of the
+	// kubeconfig before returning a token.
+	config := `
+apiVersion: v1
+kind: Config
+clusters:
+- name: eks
+  cluster:
+    server: https://eks.example.com
+users:
+- name: aws
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: sh
+      args:
+      - -c
+      - |
+        case "$KUBERNETES_EXEC_INFO" in *https://eks.example.com*) ;; *) echo "no cluster info" >&2; exit 1;; esac
+        echo '{"apiVersion": "client.authentication.k8s.io/v1beta1", "kind": "ExecCredential", "status": {"token": "'$TOKEN'", "expirationTimestamp": "2024-06-01T10:15:00Z"}}'
+      env:
+      - name: TOKEN
+        value: eks-token
+      provideClusterInfo: true
+contexts:
+- name: eks
+  context:
+    cluster: eks
+    user: aws
+current-context: eks
+`
+	_, credential, expiry, err := kubernetesCloudFromConfig(context.Background(), config, "", k8scloud.CloudParamaters{Name: "my-k8s"})
+	require.NoError(t, err)
+	assert.Equal(t, jujucloud.OAuth2AuthType, credential.AuthType())
+	assert.Equal(t, "eks-token", credential.Attributes()["Token"])
+	assert.Equal(t, time.Date(2024, 6, 1, 10, 15, 0, 0, time.UTC), expiry.UTC())
+
+	// The errors of the plugin are returned.
+	failing := strings.Replace(config, "provideClusterInfo: true", "provideClusterInfo: false", 1)
+	_, _, _, err = kubernetesCloudFromConfig(context.Background(), failing, "", k8scloud.CloudParamaters{Name: "my-k8s"})
+	assert.ErrorContains(t, err, "no cluster info")
+
+	// A static token does not expire.
+	_, _, expiry, err = kubernetesCloudFromConfig(context.Background(), testKubeConfig, "", k8scloud.CloudParamaters{Name: "my-k8s"})
+	require.NoError(t, err)
+	assert.True(t, expiry.IsZero())
+}
@@ -6,7 +6,10 @@ package juju
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -16,11 +19,13 @@ import (
 	apiclient "github.com/juju/juju/api/client/client"
 	apimachinemanager "github.com/juju/juju/api/client/machinemanager"
 	apimodelconfig "github.com/juju/juju/api/client/modelconfig"
+	apispaces "github.com/juju/juju/api/client/spaces"
 	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/manual"
 	"github.com/juju/juju/environs/manual/sshprovisioner"
@@ -51,12 +56,45 @@ type CreateMachineInput struct {
 
 	// PrivateKey is the file path to read the private key from
 	PrivateKeyFile string
+
+	// Zones are the availability zones to add machines to, if any.
+	Zones []string
+
+	// CountPerZone is the number of machines to add to each zone, or
+	// in total without zones. Defaults to one.
+	CountPerZone int
+
+	// Containers are added to each of the machines once they are
+	// provisioned.
+	Containers []CreateContainerInput
+
+	// Tags and Spaces are added to the tags and spaces constraints of
+	// the machines, e.g. to select MAAS machines. A value prefixed by ^
+	// excludes the machines with the tag or in the space.
+	Tags   []string
+	Spaces []string
+}
+
+// CreateContainerInput describes containers to add to a machine.
+type CreateContainerInput struct {
+	// Type is the type of the containers, lxd or kvm.
+	Type string
+	// Count is the number of containers to add. Defaults to one.
+	Count int
+	// Constraints are the constraints of the containers, if any.
+	Constraints string
 }
 
 type CreateMachineResponse struct {
-	ID     string
-	Base   string
-	Series string
+	// ID is the id of the first machine added.
+	ID string
+	// IDs are the ids of all the machines added.
+	IDs []string
+	// ContainerIDs are the ids of the containers added to the
+	// machines, in the order of the machines and containers.
+	ContainerIDs []string
+	Base         string
+	Series       string
 }
 
 type ReadMachineInput struct {
@@ -71,9 +109,30 @@ type ReadMachineResponse struct {
 	Series      string
 }
 
+// MachineNetworkInterface describes a network interface of a machine,
+// the subnets its addresses belong to and the space it is in.
+type MachineNetworkInterface struct {
+	Name           string
+	MACAddress     string
+	IPAddresses    []string
+	Subnets        []string
+	Gateway        string
+	DNSNameservers []string
+	Space          string
+	IsUp           bool
+}
+
+type ReadMachinesInput struct {
+	ModelName string
+	IDs       []string
+}
+
 type DestroyMachineInput struct {
 	ModelName string
 	ID        string
+	// ExtraIDs are the ids of other machines to destroy along with the
+	// machine, for resources adding several machines at once.
+	ExtraIDs []string
 }
 
 func newMachinesClient(sc SharedClient) *machinesClient {
@@ -83,7 +142,7 @@ func newMachinesClient(sc SharedClient) *machinesClient {
 }
 
 func (c machinesClient) CreateMachine(ctx context.Context, input *CreateMachineInput) (*CreateMachineResponse, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +171,7 @@ func (c machinesClient) CreateMachine(ctx context.Context, input *CreateMachineI
 	if placement != "" {
 		machineParams.Placement, err = instance.ParsePlacement(placement)
 		if err == instance.ErrPlacementScopeMissing {
-			modelUUID, err := c.ModelUUID(input.ModelName)
+			modelUUID, err := c.ModelUUID(ctx, input.ModelName)
 			if err != nil {
 				return nil, err
 			}
@@ -138,6 +197,9 @@ func (c machinesClient) CreateMachine(ctx context.Context, input *CreateMachineI
 		machineParams.Constraints = userConstraints
 	}
 
+	machineParams.Constraints.Tags = appendConstraintValues(machineParams.Constraints.Tags, input.Tags)
+	machineParams.Constraints.Spaces = appendConstraintValues(machineParams.Constraints.Spaces, input.Spaces)
+
 	if input.Disks != "" {
 		userDisks, err := storage.ParseConstraints(input.Disks)
 		if err != nil {
@@ -163,25 +225,176 @@ func (c machinesClient) CreateMachine(ctx context.Context, input *CreateMachineI
 	machineParams.Base = paramsBase
 
 	addMachineArgs := []params.AddMachineParams{machineParams}
+	if len(input.Zones) > 0 || input.CountPerZone > 1 {
+		var modelUUID string
+		if len(input.Zones) > 0 {
+			modelUUID, err = c.ModelUUID(ctx, input.ModelName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		addMachineArgs = machineParamsPerZone(machineParams, modelUUID, input.Zones, input.CountPerZone)
+	}
+	// All the machines are added in a single call, which is much faster
+	// than one call per machine for large deployments.
 	machines, err := machineAPIClient.AddMachines(addMachineArgs)
 	if err != nil {
 		return nil, err
 	}
-	if machines[0].Error != nil {
-		return nil, machines[0].Error
+	machineIDs := make([]string, 0, len(machines))
+	var addErr error
+	for _, machine := range machines {
+		if machine.Error != nil {
+			if addErr == nil {
+				addErr = machine.Error
+			}
+			continue
+		}
+		machineIDs = append(machineIDs, machine.Machine)
 	}
-	machineID := machines[0].Machine
 
-	// Read the machine to ensure we have a base and series. It's
-	// not a required field in a minimal machine config.
-	readResponse, err := c.readMachineWithRetryOnNotFound(ctx,
-		ReadMachineInput{ModelName: input.ModelName, ID: machineID})
+	// Wait for the machines to be provisioned, this also ensures we have
+	// a base and series. It's not a required field in a minimal machine
+	// config.
+	var readResponses []ReadMachineResponse
+	if addErr == nil {
+		readResponses, err = c.waitForMachinesProvisioned(ctx, input.ModelName, machineIDs)
+	} else {
+		err = addErr
+	}
+	if err != nil {
+		// Only machines which failed to be added or provisioned are
+		// removed, others may still be provisioned and are left for the
+		// operator to inspect.
+		if len(machineIDs) == 0 || (addErr == nil && !errors.As(err, &MachineProvisioningError)) {
+			return nil, err
+		}
+		// The machines are not saved in the terraform state when Create
+		// fails, remove them rather than leaving them behind half created.
+		if destroyErr := forceDestroyMachines(machineAPIClient, machineIDs); destroyErr != nil {
+			return nil, fmt.Errorf("%w, and removing machines %s failed: %v", err, strings.Join(machineIDs, ", "), destroyErr)
+		}
+		c.Warnf(fmt.Sprintf("removed machines %s which failed to be provisioned", strings.Join(machineIDs, ", ")))
+		return nil, err
+	}
+
+	containerIDs, err := c.addContainers(ctx, machineAPIClient, input.ModelName, machineIDs, readResponses[0].Base, input.Containers)
+	if err != nil {
+		// The containers are removed along with their machines.
+		if destroyErr := forceDestroyMachines(machineAPIClient, machineIDs); destroyErr != nil {
+			return nil, fmt.Errorf("%w, and removing machines %s failed: %v", err, strings.Join(machineIDs, ", "), destroyErr)
+		}
+		c.Warnf(fmt.Sprintf("removed machines %s whose containers failed to be provisioned", strings.Join(machineIDs, ", ")))
+		return nil, err
+	}
 
 	return &CreateMachineResponse{
-		ID:     machineID,
-		Base:   readResponse.Base,
-		Series: readResponse.Series,
-	}, err
+		ID:           machineIDs[0],
+		IDs:          machineIDs,
+		ContainerIDs: containerIDs,
+		Base:         readResponses[0].Base,
+		Series:       readResponses[0].Series,
+	}, nil
+}
+
+// addContainers adds the containers to each of the parent machines in a
+// single call and waits for them to be provisioned.
+func (c machinesClient) addContainers(ctx context.Context, machineAPIClient *apimachinemanager.Client, modelName string,
+	parentIDs []string, parentBase string, containers []CreateContainerInput) ([]string, error) {
+	if len(containers) == 0 {
+		return nil, nil
+	}
+	args, err := containerParams(containers, parentIDs, parentBase)
+	if err != nil {
+		return nil, err
+	}
+	results, err := machineAPIClient.AddMachines(args)
+	if err != nil {
+		return nil, err
+	}
+	containerIDs := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		containerIDs = append(containerIDs, result.Machine)
+	}
+	if _, err := c.waitForMachinesProvisioned(ctx, modelName, containerIDs); err != nil {
+		return nil, err
+	}
+	return containerIDs, nil
+}
+
+// containerParams returns the parameters to add the containers to each
+// of the parent machines. The containers run the base of their parent.
+func containerParams(containers []CreateContainerInput, parentIDs []string, parentBase string) ([]params.AddMachineParams, error) {
+	paramsBase, err := baseFromOperatingSystem(parentBase)
+	if err != nil {
+		return nil, err
+	}
+	var args []params.AddMachineParams
+	for _, parentID := range parentIDs {
+		for _, container := range containers {
+			containerType, err := instance.ParseContainerType(container.Type)
+			if err != nil {
+				return nil, err
+			}
+			cons, err := constraints.Parse(container.Constraints)
+			if err != nil {
+				return nil, err
+			}
+			containerArgs := params.AddMachineParams{
+				Placement:   &instance.Placement{Scope: string(containerType), Directive: parentID},
+				Constraints: cons,
+				Base:        paramsBase,
+				Jobs:        []model.MachineJob{model.JobHostUnits},
+			}
+			for i := 0; i < max(container.Count, 1); i++ {
+				args = append(args, containerArgs)
+			}
+		}
+	}
+	return args, nil
+}
+
+// appendConstraintValues returns the values of a list constraint, e.g.
+// tags, with the extra values not already in it appended.
+func appendConstraintValues(values *[]string, extra []string) *[]string {
+	if len(extra) == 0 {
+		return values
+	}
+	var merged []string
+	if values != nil {
+		merged = append(merged, *values...)
+	}
+	for _, value := range extra {
+		if !slices.Contains(merged, value) {
+			merged = append(merged, value)
+		}
+	}
+	return &merged
+}
+
+// machineParamsPerZone returns the parameters to add count machines, to
+// each of the zones if any, based on machineParams.
+func machineParamsPerZone(machineParams params.AddMachineParams, modelUUID string, zones []string, count int) []params.AddMachineParams {
+	if count < 1 {
+		count = 1
+	}
+	if len(zones) == 0 {
+		zones = []string{""}
+	}
+	args := make([]params.AddMachineParams, 0, len(zones)*count)
+	for _, zone := range zones {
+		zoneParams := machineParams
+		if zone != "" {
+			zoneParams.Placement = &instance.Placement{Scope: modelUUID, Directive: "zone=" + zone}
+		}
+		for i := 0; i < count; i++ {
+			args = append(args, zoneParams)
+		}
+	}
+	return args
 }
 
 func baseAndSeriesFromParams(machineBase *params.Base) (baseStr, seriesStr string, err error) {
@@ -298,16 +511,44 @@ func manualProvision(client manual.ProvisioningClientAPI,
 
 	return &CreateMachineResponse{
 		ID:     machineId,
+		IDs:    []string{machineId},
 		Base:   baseStr,
 		Series: machineSeries,
 	}, nil
 }
 
-func (c machinesClient) ReadMachine(input ReadMachineInput) (ReadMachineResponse, error) {
-	var response ReadMachineResponse
-	conn, err := c.GetConnection(&input.ModelName)
+func (c machinesClient) ReadMachine(ctx context.Context, input ReadMachineInput) (ReadMachineResponse, error) {
+	machineStatus, err := c.readMachineStatus(ctx, input)
 	if err != nil {
-		return response, err
+		return ReadMachineResponse{}, err
+	}
+	return machineResponseFromStatus(machineStatus)
+}
+
+// readMachineStatus returns the status of the machine, or container,
+// with the given ID.
+func (c machinesClient) readMachineStatus(ctx context.Context, input ReadMachineInput) (params.MachineStatus, error) {
+	statuses, err := c.readMachineStatuses(ctx, input.ModelName, []string{input.ID})
+	if err != nil {
+		return params.MachineStatus{}, err
+	}
+	machineStatus, exists := statuses[input.ID]
+	if !exists {
+		if strings.Contains(input.ID, "/") {
+			return params.MachineStatus{}, fmt.Errorf("no status returned for container in machine: %s", input.ID)
+		}
+		return params.MachineStatus{}, fmt.Errorf("no status returned for machine: %s", input.ID)
+	}
+	return machineStatus, nil
+}
+
+// readMachineStatuses returns the status of the machines, or containers,
+// with the given IDs with a single status call. Machines which do not
+// exist are left out.
+func (c machinesClient) readMachineStatuses(ctx context.Context, modelName string, machineIDs []string) (map[string]params.MachineStatus, error) {
+	conn, err := c.GetConnection(ctx, &modelName)
+	if err != nil {
+		return nil, err
 	}
 	defer func() { _ = conn.Close() }()
 
@@ -315,22 +556,134 @@ func (c machinesClient) ReadMachine(input ReadMachineInput) (ReadMachineResponse
 
 	status, err := clientAPIClient.Status(nil)
 	if err != nil {
-		return response, err
+		return nil, err
 	}
 
-	machineIDParts := strings.Split(input.ID, "/")
-	machineStatus, exists := status.Machines[machineIDParts[0]]
-	if !exists {
-		return response, fmt.Errorf("no status returned for machine: %s", input.ID)
+	statuses := make(map[string]params.MachineStatus, len(machineIDs))
+	for _, machineID := range machineIDs {
+		machineIDParts := strings.Split(machineID, "/")
+		machineStatus, exists := status.Machines[machineIDParts[0]]
+		if !exists {
+			continue
+		}
+		c.Tracef("ReadMachine:Machine status result", map[string]interface{}{"machineStatus": machineStatus})
+		if len(machineIDParts) > 1 {
+			// check for containers
+			machineStatus, exists = machineStatus.Containers[machineID]
+			if !exists {
+				continue
+			}
+		}
+		statuses[machineID] = machineStatus
+	}
+	return statuses, nil
+}
+
+// ReadMachines returns the machines, or containers, with the given IDs
+// which still exist in the model.
+func (c machinesClient) ReadMachines(ctx context.Context, input ReadMachinesInput) ([]ReadMachineResponse, error) {
+	statuses, err := c.readMachineStatuses(ctx, input.ModelName, input.IDs)
+	if err != nil {
+		return nil, err
 	}
-	c.Tracef("ReadMachine:Machine status result", map[string]interface{}{"machineStatus": machineStatus})
-	if len(machineIDParts) > 1 {
-		// check for containers
-		machineStatus, exists = machineStatus.Containers[input.ID]
+	responses := make([]ReadMachineResponse, 0, len(statuses))
+	for _, machineID := range input.IDs {
+		machineStatus, exists := statuses[machineID]
 		if !exists {
-			return response, fmt.Errorf("no status returned for container in machine: %s", input.ID)
+			continue
+		}
+		response, err := machineResponseFromStatus(machineStatus)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// ReadMachineNetworkInterfaces returns the network interfaces of the
+// machine, or container, with the given ID, sorted by name. The subnets
+// of each interface are found by matching its addresses against the
+// subnets known to the model's spaces.
+func (c machinesClient) ReadMachineNetworkInterfaces(ctx context.Context, input ReadMachineInput) ([]MachineNetworkInterface, error) {
+	machineStatus, err := c.readMachineStatus(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	spaces, err := apispaces.NewAPI(conn).ListSpaces()
+	if err != nil {
+		return nil, err
+	}
+	c.Tracef("ReadMachineNetworkInterfaces", map[string]interface{}{"interfaces": machineStatus.NetworkInterfaces})
+	return machineNetworkInterfaces(machineStatus.NetworkInterfaces, spaces), nil
+}
+
+// machineNetworkInterfaces converts the network interfaces of a machine
+// status into MachineNetworkInterfaces, sorted by name. An address is
+// in a subnet when the subnet's CIDR contains it. When juju has not
+// reported the space of an interface, the space of its first matching
+// subnet is used.
+func machineNetworkInterfaces(interfaces map[string]params.NetworkInterface, spaces []params.Space) []MachineNetworkInterface {
+	type spaceSubnet struct {
+		cidr  string
+		ipNet *net.IPNet
+		space string
+	}
+	var subnets []spaceSubnet
+	for _, space := range spaces {
+		for _, subnet := range space.Subnets {
+			_, ipNet, err := net.ParseCIDR(subnet.CIDR)
+			if err != nil {
+				continue
+			}
+			subnets = append(subnets, spaceSubnet{cidr: subnet.CIDR, ipNet: ipNet, space: space.Name})
+		}
+	}
+
+	result := make([]MachineNetworkInterface, 0, len(interfaces))
+	for name, nic := range interfaces {
+		networkInterface := MachineNetworkInterface{
+			Name:           name,
+			MACAddress:     nic.MACAddress,
+			IPAddresses:    nic.IPAddresses,
+			Gateway:        nic.Gateway,
+			DNSNameservers: nic.DNSNameservers,
+			Space:          nic.Space,
+			IsUp:           nic.IsUp,
+		}
+		for _, address := range nic.IPAddresses {
+			ip := net.ParseIP(address)
+			if ip == nil {
+				continue
+			}
+			for _, subnet := range subnets {
+				if !subnet.ipNet.Contains(ip) || slices.Contains(networkInterface.Subnets, subnet.cidr) {
+					continue
+				}
+				networkInterface.Subnets = append(networkInterface.Subnets, subnet.cidr)
+				if networkInterface.Space == "" {
+					networkInterface.Space = subnet.space
+				}
+			}
 		}
+		result = append(result, networkInterface)
 	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// machineResponseFromStatus converts the status of a machine to a
+// ReadMachineResponse.
+func machineResponseFromStatus(machineStatus params.MachineStatus) (ReadMachineResponse, error) {
+	var response ReadMachineResponse
+	var err error
 	response.ID = machineStatus.Id
 	response.Base, response.Series, err = baseAndSeriesFromParams(&machineStatus.Base)
 	if err != nil {
@@ -340,41 +693,128 @@ func (c machinesClient) ReadMachine(input ReadMachineInput) (ReadMachineResponse
 	return response, nil
 }
 
-// readMachineWithRetryOnNotFound calls ReadMachine until
-// successful, or the count is exceeded when the error is of type
-// not found. Delay indicates how long to wait between attempts.
-func (c machinesClient) readMachineWithRetryOnNotFound(ctx context.Context, input ReadMachineInput) (ReadMachineResponse, error) {
-	var output ReadMachineResponse
+// MachineIDFromInstanceID returns the Juju machine ID of the machine, or
+// container, in the model whose cloud instance ID matches instanceID.
+func (c machinesClient) MachineIDFromInstanceID(ctx context.Context, modelName, instanceID string) (string, error) {
+	conn, err := c.GetConnection(ctx, &modelName)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = conn.Close() }()
+
+	clientAPIClient := apiclient.NewClient(conn, c.JujuLogger())
+
+	status, err := clientAPIClient.Status(nil)
+	if err != nil {
+		return "", err
+	}
+
+	for id, machineStatus := range status.Machines {
+		if string(machineStatus.InstanceId) == instanceID {
+			return id, nil
+		}
+		for containerID, containerStatus := range machineStatus.Containers {
+			if string(containerStatus.InstanceId) == instanceID {
+				return containerID, nil
+			}
+		}
+	}
+	return "", errors.NotFoundf("machine with instance ID %q in model %q", instanceID, modelName)
+}
+
+// waitForMachinesProvisioned blocks until every machine has a cloud
+// instance. It returns an error as soon as the provisioning of a machine
+// fails, with the message reported by the provisioner.
+func (c machinesClient) waitForMachinesProvisioned(ctx context.Context, modelName string, machineIDs []string) ([]ReadMachineResponse, error) {
+	var output []ReadMachineResponse
 	err := retry.Call(retry.CallArgs{
 		Func: func() error {
-			var err error
-			output, err = c.ReadMachine(input)
-			typedErr := typedError(err)
-			if errors.Is(typedErr, errors.NotFound) {
-				return nil
+			statuses, err := c.readMachineStatuses(ctx, modelName, machineIDs)
+			if err != nil {
+				return err
+			}
+			output = make([]ReadMachineResponse, 0, len(machineIDs))
+			for _, machineID := range machineIDs {
+				machineStatus, exists := statuses[machineID]
+				if !exists {
+					return &retryReadError{msg: fmt.Sprintf("no status returned for machine: %s", machineID)}
+				}
+				if err := machineProvisioned(machineStatus); err != nil {
+					return err
+				}
+				response, err := machineResponseFromStatus(machineStatus)
+				if err != nil {
+					return err
+				}
+				output = append(output, response)
 			}
-			return err
+			return nil
+		},
+		// Other errors, e.g. a controller being restarted or upgraded,
+		// are retried until the provisioning fails or times out.
+		IsFatalError: func(err error) bool {
+			return errors.As(err, &MachineProvisioningError)
 		},
 		NotifyFunc: func(err error, attempt int) {
 			if attempt%4 == 0 {
-				message := fmt.Sprintf("waiting for machine %q", input.ID)
+				message := fmt.Sprintf("waiting for machines %s", strings.Join(machineIDs, ", "))
 				if attempt != 4 {
 					message = "still " + message
 				}
-				c.Debugf(message)
+				c.Debugf(message, map[string]interface{}{"err": err})
 			}
 		},
-		BackoffFunc: retry.DoubleDelay,
-		Attempts:    30,
-		Delay:       time.Second,
+		Delay:       5 * time.Second,
+		MaxDuration: 20 * time.Minute,
 		Clock:       clock.WallClock,
 		Stop:        ctx.Done(),
 	})
 	return output, err
 }
 
-func (c machinesClient) DestroyMachine(input *DestroyMachineInput) error {
-	conn, err := c.GetConnection(&input.ModelName)
+// MachineProvisioningError is returned when the provisioner fails to
+// create the cloud instance of a machine.
+var MachineProvisioningError = &machineProvisioningError{}
+
+type machineProvisioningError struct {
+	machineID string
+	info      string
+}
+
+func (e *machineProvisioningError) Error() string {
+	return fmt.Sprintf("machine %q failed to be provisioned: %s", e.machineID, e.info)
+}
+
+// machineProvisioned returns nil if the machine has a cloud instance, a
+// retryReadError if it is still being provisioned and a
+// machineProvisioningError if its provisioning failed.
+func machineProvisioned(machineStatus params.MachineStatus) error {
+	if machineStatus.InstanceStatus.Status == string(status.ProvisioningError) {
+		return &machineProvisioningError{machineID: machineStatus.Id, info: machineStatus.InstanceStatus.Info}
+	}
+	if machineStatus.InstanceId == "" {
+		return &retryReadError{msg: fmt.Sprintf("machine %q is %s", machineStatus.Id, machineStatus.InstanceStatus.Status)}
+	}
+	return nil
+}
+
+// forceDestroyMachines removes machines, even if their cloud instance
+// could not be created.
+func forceDestroyMachines(machineAPIClient *apimachinemanager.Client, machineIDs []string) error {
+	results, err := machineAPIClient.DestroyMachinesWithParams(true, false, false, (*time.Duration)(nil), machineIDs...)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+func (c machinesClient) DestroyMachine(ctx context.Context, input *DestroyMachineInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -382,7 +822,8 @@ func (c machinesClient) DestroyMachine(input *DestroyMachineInput) error {
 
 	machineAPIClient := apimachinemanager.NewClient(conn)
 
-	_, err = machineAPIClient.DestroyMachinesWithParams(false, false, false, (*time.Duration)(nil), input.ID)
+	machineIDs := append([]string{input.ID}, input.ExtraIDs...)
+	_, err = machineAPIClient.DestroyMachinesWithParams(false, false, false, (*time.Duration)(nil), machineIDs...)
 
 	if err != nil {
 		return err
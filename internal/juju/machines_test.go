@@ -0,0 +1,223 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type MachineSuite struct {
+	suite.Suite
+}
+
+func (s *MachineSuite) TestMachineProvisioned() {
+	// The machine has a cloud instance.
+	err := machineProvisioned(params.MachineStatus{
+		Id:             "0",
+		InstanceId:     "juju-0",
+		InstanceStatus: params.DetailedStatus{Status: "running"},
+	})
+	s.Assert().NoError(err)
+
+	// The cloud instance is still being allocated.
+	err = machineProvisioned(params.MachineStatus{
+		Id:             "0",
+		InstanceStatus: params.DetailedStatus{Status: "allocating"},
+	})
+	s.Assert().ErrorAs(err, &RetryReadError)
+
+	// The provisioner failed, its message is surfaced.
+	err = machineProvisioned(params.MachineStatus{
+		Id:             "0",
+		InstanceStatus: params.DetailedStatus{Status: "provisioning error", Info: "no matching image"},
+	})
+	s.Require().Error(err)
+	s.Assert().False(errors.As(err, &RetryReadError))
+	s.Assert().True(errors.As(err, &MachineProvisioningError))
+	s.Assert().Equal(`machine "0" failed to be provisioned: no matching image`, err.Error())
+}
+
+func (s *MachineSuite) TestMachineParamsPerZone() {
+	machineParams := params.AddMachineParams{Constraints: constraints.MustParse("mem=4G")}
+
+	// Without zones, count machines are added without placement.
+	args := machineParamsPerZone(machineParams, "", nil, 3)
+	s.Require().Len(args, 3)
+	for _, arg := range args {
+		s.Assert().Nil(arg.Placement)
+		s.Assert().Equal(machineParams.Constraints, arg.Constraints)
+	}
+
+	// With zones, count machines are added to each zone.
+	modelUUID := "0a8a7a3b-0b8c-4a84-8f4d-2f5c4b0e2d10"
+	args = machineParamsPerZone(machineParams, modelUUID, []string{"zone-a", "zone-b"}, 2)
+	s.Require().Len(args, 4)
+	placements := make([]instance.Placement, 0, len(args))
+	for _, arg := range args {
+		s.Require().NotNil(arg.Placement)
+		placements = append(placements, *arg.Placement)
+	}
+	s.Assert().Equal([]instance.Placement{
+		{Scope: modelUUID, Directive: "zone=zone-a"},
+		{Scope: modelUUID, Directive: "zone=zone-a"},
+		{Scope: modelUUID, Directive: "zone=zone-b"},
+		{Scope: modelUUID, Directive: "zone=zone-b"},
+	}, placements)
+
+	// A count below one adds a single machine to each zone.
+	args = machineParamsPerZone(machineParams, modelUUID, []string{"zone-a"}, 0)
+	s.Assert().Len(args, 1)
+}
+
+func (s *MachineSuite) TestContainerParams() {
+	containers := []CreateContainerInput{
+		{Type: "lxd", Count: 2},
+		{Type: "kvm", Constraints: "mem=2G"},
+	}
+	args, err := containerParams(containers, []string{"0", "1"}, "ubuntu@22.04")
+	s.Require().NoError(err)
+	s.Require().Len(args, 6)
+	placements := make([]instance.Placement, 0, len(args))
+	for _, arg := range args {
+		s.Require().NotNil(arg.Placement)
+		placements = append(placements, *arg.Placement)
+		s.Assert().Equal(&params.Base{Name: "ubuntu", Channel: "22.04/stable"}, arg.Base)
+	}
+	s.Assert().Equal([]instance.Placement{
+		{Scope: "lxd", Directive: "0"},
+		{Scope: "lxd", Directive: "0"},
+		{Scope: "kvm", Directive: "0"},
+		{Scope: "lxd", Directive: "1"},
+		{Scope: "lxd", Directive: "1"},
+		{Scope: "kvm", Directive: "1"},
+	}, placements)
+	s.Assert().Equal(constraints.MustParse("mem=2G"), args[2].Constraints)
+
+	_, err = containerParams([]CreateContainerInput{{Type: "docker"}}, []string{"0"}, "ubuntu@22.04")
+	s.Assert().Error(err)
+}
+
+func (s *MachineSuite) TestAppendConstraintValues() {
+	// Without extra values, the constraint is kept as it is.
+	s.Assert().Nil(appendConstraintValues(nil, nil))
+
+	tags := []string{"ssd"}
+	merged := appendConstraintValues(&tags, []string{"gpu", "ssd", "^virtual"})
+	s.Require().NotNil(merged)
+	s.Assert().Equal([]string{"ssd", "gpu", "^virtual"}, *merged)
+	s.Assert().Equal([]string{"ssd"}, tags)
+
+	merged = appendConstraintValues(nil, []string{"storage"})
+	s.Require().NotNil(merged)
+	s.Assert().Equal([]string{"storage"}, *merged)
+}
+
+func (s *MachineSuite) TestMachineNetworkInterfaces() {
+	spaces := []params.Space{
+		{Name: "alpha", Subnets: []params.Subnet{{CIDR: "10.0.0.0/24"}}},
+		{Name: "internal", Subnets: []params.Subnet{{CIDR: "192.168.1.0/24"}, {CIDR: "fd00::/64"}}},
+	}
+	interfaces := map[string]params.NetworkInterface{
+		"eth1": {
+			IPAddresses: []string{"192.168.1.10", "192.168.1.11", "fd00::10"},
+			MACAddress:  "00:16:3e:00:00:02",
+			IsUp:        true,
+		},
+		"eth0": {
+			IPAddresses:    []string{"10.0.0.5"},
+			MACAddress:     "00:16:3e:00:00:01",
+			Gateway:        "10.0.0.1",
+			DNSNameservers: []string{"10.0.0.1"},
+			Space:          "alpha",
+			IsUp:           true,
+		},
+		"lo": {
+			IPAddresses: []string{"127.0.0.1"},
+		},
+	}
+
+	result := machineNetworkInterfaces(interfaces, spaces)
+	s.Assert().Equal([]MachineNetworkInterface{
+		{
+			Name:           "eth0",
+			MACAddress:     "00:16:3e:00:00:01",
+			IPAddresses:    []string{"10.0.0.5"},
+			Subnets:        []string{"10.0.0.0/24"},
+			Gateway:        "10.0.0.1",
+			DNSNameservers: []string{"10.0.0.1"},
+			Space:          "alpha",
+			IsUp:           true,
+		},
+		{
+			// The space is taken from the matching subnets.
+			Name:        "eth1",
+			MACAddress:  "00:16:3e:00:00:02",
+			IPAddresses: []string{"192.168.1.10", "192.168.1.11", "fd00::10"},
+			Subnets:     []string{"192.168.1.0/24", "fd00::/64"},
+			Space:       "internal",
+			IsUp:        true,
+		},
+		{
+			// Addresses outside of known subnets have no space.
+			Name:        "lo",
+			IPAddresses: []string{"127.0.0.1"},
+		},
+	}, result)
+}
+
+func (s *MachineSuite) TestMachineIDFromInstanceID() {
+	ctlr := gomock.NewController(s.T())
+	defer ctlr.Finish()
+	modelName := "development"
+	conn := NewMockConnection(ctlr)
+	conn.EXPECT().BestFacadeVersion("Client").Return(7).AnyTimes()
+	conn.EXPECT().APICall("Client", 7, "", "FullStatus", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ string, _ int, _, _ string, _, response interface{}) error {
+			*response.(*params.FullStatus) = params.FullStatus{Machines: map[string]params.MachineStatus{
+				"0": {
+					InstanceId: "i-0abc",
+					Containers: map[string]params.MachineStatus{
+						"0/lxd/0": {InstanceId: "juju-1234-0-lxd-0"},
+					},
+				},
+				"1": {InstanceId: "manual:10.0.0.2"},
+			}}
+			return nil
+		}).Times(4)
+	conn.EXPECT().Close().Return(nil).Times(4)
+	sharedClient := NewMockSharedClient(ctlr)
+	sharedClient.EXPECT().JujuLogger().Return(&jujuLoggerShim{}).AnyTimes()
+	sharedClient.EXPECT().GetConnection(gomock.Any(), &modelName).Return(conn, nil).Times(4)
+	client := newMachinesClient(sharedClient)
+
+	id, err := client.MachineIDFromInstanceID(context.Background(), modelName, "i-0abc")
+	s.Require().NoError(err)
+	s.Assert().Equal("0", id)
+
+	id, err = client.MachineIDFromInstanceID(context.Background(), modelName, "juju-1234-0-lxd-0")
+	s.Require().NoError(err)
+	s.Assert().Equal("0/lxd/0", id)
+
+	id, err = client.MachineIDFromInstanceID(context.Background(), modelName, "manual:10.0.0.2")
+	s.Require().NoError(err)
+	s.Assert().Equal("1", id)
+
+	_, err = client.MachineIDFromInstanceID(context.Background(), modelName, "i-missing")
+	s.Assert().True(jujuerrors.Is(err, jujuerrors.NotFound), err)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestMachineSuite(t *testing.T) {
+	suite.Run(t, new(MachineSuite))
+}
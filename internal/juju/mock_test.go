@@ -1,15 +1,16 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/terraform-provider-juju/internal/juju (interfaces: SharedClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,ResourceAPIClient,SecretAPIClient,JaasAPIClient)
+// Source: github.com/juju/terraform-provider-juju/internal/juju (interfaces: SharedClient,ActionAPIClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,ResourceAPIClient,SecretAPIClient,JaasAPIClient)
 //
 // Generated by this command:
 //
-//	mockgen -package juju -destination mock_test.go github.com/juju/terraform-provider-juju/internal/juju SharedClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,ResourceAPIClient,SecretAPIClient,JaasAPIClient
+//	mockgen -package juju -destination mock_test.go github.com/juju/terraform-provider-juju/internal/juju SharedClient,ActionAPIClient,ClientAPIClient,ApplicationAPIClient,ModelConfigAPIClient,ResourceAPIClient,SecretAPIClient,JaasAPIClient
 //
 
 // Package juju is a generated GoMock package.
 package juju
 
 import (
+	context "context"
 	io "io"
 	reflect "reflect"
 
@@ -17,6 +18,7 @@ import (
 	charm "github.com/juju/charm/v12"
 	resource "github.com/juju/charm/v12/resource"
 	api "github.com/juju/juju/api"
+	action "github.com/juju/juju/api/client/action"
 	application "github.com/juju/juju/api/client/application"
 	client "github.com/juju/juju/api/client/client"
 	resources "github.com/juju/juju/api/client/resources"
@@ -26,6 +28,7 @@ import (
 	model "github.com/juju/juju/core/model"
 	resources0 "github.com/juju/juju/core/resources"
 	secrets0 "github.com/juju/juju/core/secrets"
+	status "github.com/juju/juju/core/status"
 	params0 "github.com/juju/juju/rpc/params"
 	names "github.com/juju/names/v5"
 	gomock "go.uber.org/mock/gomock"
@@ -96,18 +99,18 @@ func (mr *MockSharedClientMockRecorder) Errorf(arg0, arg1 any) *gomock.Call {
 }
 
 // GetConnection mocks base method.
-func (m *MockSharedClient) GetConnection(arg0 *string) (api.Connection, error) {
+func (m *MockSharedClient) GetConnection(arg0 context.Context, arg1 *string) (api.Connection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConnection", arg0)
+	ret := m.ctrl.Call(m, "GetConnection", arg0, arg1)
 	ret0, _ := ret[0].(api.Connection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetConnection indicates an expected call of GetConnection.
-func (mr *MockSharedClientMockRecorder) GetConnection(arg0 any) *gomock.Call {
+func (mr *MockSharedClientMockRecorder) GetConnection(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockSharedClient)(nil).GetConnection), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockSharedClient)(nil).GetConnection), arg0, arg1)
 }
 
 // JujuLogger mocks base method.
@@ -140,18 +143,18 @@ func (mr *MockSharedClientMockRecorder) ModelType(arg0 any) *gomock.Call {
 }
 
 // ModelUUID mocks base method.
-func (m *MockSharedClient) ModelUUID(arg0 string) (string, error) {
+func (m *MockSharedClient) ModelUUID(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModelUUID", arg0)
+	ret := m.ctrl.Call(m, "ModelUUID", arg0, arg1)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModelUUID indicates an expected call of ModelUUID.
-func (mr *MockSharedClientMockRecorder) ModelUUID(arg0 any) *gomock.Call {
+func (mr *MockSharedClientMockRecorder) ModelUUID(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelUUID", reflect.TypeOf((*MockSharedClient)(nil).ModelUUID), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelUUID", reflect.TypeOf((*MockSharedClient)(nil).ModelUUID), arg0, arg1)
 }
 
 // RemoveModel mocks base method.
@@ -200,6 +203,59 @@ func (mr *MockSharedClientMockRecorder) Warnf(arg0 any, arg1 ...any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warnf", reflect.TypeOf((*MockSharedClient)(nil).Warnf), varargs...)
 }
 
+// MockActionAPIClient is a mock of ActionAPIClient interface.
+type MockActionAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockActionAPIClientMockRecorder
+}
+
+// MockActionAPIClientMockRecorder is the mock recorder for MockActionAPIClient.
+type MockActionAPIClientMockRecorder struct {
+	mock *MockActionAPIClient
+}
+
+// NewMockActionAPIClient creates a new mock instance.
+func NewMockActionAPIClient(ctrl *gomock.Controller) *MockActionAPIClient {
+	mock := &MockActionAPIClient{ctrl: ctrl}
+	mock.recorder = &MockActionAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActionAPIClient) EXPECT() *MockActionAPIClientMockRecorder {
+	return m.recorder
+}
+
+// Actions mocks base method.
+func (m *MockActionAPIClient) Actions(arg0 []string) ([]action.ActionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Actions", arg0)
+	ret0, _ := ret[0].([]action.ActionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Actions indicates an expected call of Actions.
+func (mr *MockActionAPIClientMockRecorder) Actions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Actions", reflect.TypeOf((*MockActionAPIClient)(nil).Actions), arg0)
+}
+
+// EnqueueOperation mocks base method.
+func (m *MockActionAPIClient) EnqueueOperation(arg0 []action.Action) (action.EnqueuedActions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueOperation", arg0)
+	ret0, _ := ret[0].(action.EnqueuedActions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueOperation indicates an expected call of EnqueueOperation.
+func (mr *MockActionAPIClientMockRecorder) EnqueueOperation(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueOperation", reflect.TypeOf((*MockActionAPIClient)(nil).EnqueueOperation), arg0)
+}
+
 // MockClientAPIClient is a mock of ClientAPIClient interface.
 type MockClientAPIClient struct {
 	ctrl     *gomock.Controller
@@ -238,6 +294,21 @@ func (mr *MockClientAPIClientMockRecorder) Status(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockClientAPIClient)(nil).Status), arg0)
 }
 
+// StatusHistory mocks base method.
+func (m *MockClientAPIClient) StatusHistory(arg0 status.HistoryKind, arg1 names.Tag, arg2 status.StatusHistoryFilter) (status.History, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatusHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(status.History)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatusHistory indicates an expected call of StatusHistory.
+func (mr *MockClientAPIClientMockRecorder) StatusHistory(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatusHistory", reflect.TypeOf((*MockClientAPIClient)(nil).StatusHistory), arg0, arg1, arg2)
+}
+
 // MockApplicationAPIClient is a mock of ApplicationAPIClient interface.
 type MockApplicationAPIClient struct {
 	ctrl     *gomock.Controller
@@ -783,6 +854,21 @@ func (mr *MockJaasAPIClientMockRecorder) AddRelation(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelation", reflect.TypeOf((*MockJaasAPIClient)(nil).AddRelation), arg0)
 }
 
+// AddRole mocks base method.
+func (m *MockJaasAPIClient) AddRole(arg0 *AddRoleRequest) (RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRole", arg0)
+	ret0, _ := ret[0].(RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRole indicates an expected call of AddRole.
+func (mr *MockJaasAPIClientMockRecorder) AddRole(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRole", reflect.TypeOf((*MockJaasAPIClient)(nil).AddRole), arg0)
+}
+
 // GetGroup mocks base method.
 func (m *MockJaasAPIClient) GetGroup(arg0 *params.GetGroupRequest) (params.GetGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -798,6 +884,51 @@ func (mr *MockJaasAPIClientMockRecorder) GetGroup(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockJaasAPIClient)(nil).GetGroup), arg0)
 }
 
+// GetRole mocks base method.
+func (m *MockJaasAPIClient) GetRole(arg0 *GetRoleRequest) (RoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", arg0)
+	ret0, _ := ret[0].(RoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockJaasAPIClientMockRecorder) GetRole(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockJaasAPIClient)(nil).GetRole), arg0)
+}
+
+// ListControllers mocks base method.
+func (m *MockJaasAPIClient) ListControllers() ([]params.ControllerInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListControllers")
+	ret0, _ := ret[0].([]params.ControllerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListControllers indicates an expected call of ListControllers.
+func (mr *MockJaasAPIClientMockRecorder) ListControllers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListControllers", reflect.TypeOf((*MockJaasAPIClient)(nil).ListControllers))
+}
+
+// ListGroups mocks base method.
+func (m *MockJaasAPIClient) ListGroups(arg0 *params.ListGroupsRequest) ([]params.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroups", arg0)
+	ret0, _ := ret[0].([]params.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroups indicates an expected call of ListGroups.
+func (mr *MockJaasAPIClientMockRecorder) ListGroups(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroups", reflect.TypeOf((*MockJaasAPIClient)(nil).ListGroups), arg0)
+}
+
 // ListRelationshipTuples mocks base method.
 func (m *MockJaasAPIClient) ListRelationshipTuples(arg0 *params.ListRelationshipTuplesRequest) (*params.ListRelationshipTuplesResponse, error) {
 	m.ctrl.T.Helper()
@@ -813,6 +944,21 @@ func (mr *MockJaasAPIClientMockRecorder) ListRelationshipTuples(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelationshipTuples", reflect.TypeOf((*MockJaasAPIClient)(nil).ListRelationshipTuples), arg0)
 }
 
+// MigrateModel mocks base method.
+func (m *MockJaasAPIClient) MigrateModel(arg0 *params.MigrateModelRequest) (*params0.InitiateMigrationResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateModel", arg0)
+	ret0, _ := ret[0].(*params0.InitiateMigrationResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateModel indicates an expected call of MigrateModel.
+func (mr *MockJaasAPIClientMockRecorder) MigrateModel(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateModel", reflect.TypeOf((*MockJaasAPIClient)(nil).MigrateModel), arg0)
+}
+
 // RemoveGroup mocks base method.
 func (m *MockJaasAPIClient) RemoveGroup(arg0 *params.RemoveGroupRequest) error {
 	m.ctrl.T.Helper()
@@ -841,6 +987,20 @@ func (mr *MockJaasAPIClientMockRecorder) RemoveRelation(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRelation", reflect.TypeOf((*MockJaasAPIClient)(nil).RemoveRelation), arg0)
 }
 
+// RemoveRole mocks base method.
+func (m *MockJaasAPIClient) RemoveRole(arg0 *RemoveRoleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRole", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRole indicates an expected call of RemoveRole.
+func (mr *MockJaasAPIClientMockRecorder) RemoveRole(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRole", reflect.TypeOf((*MockJaasAPIClient)(nil).RemoveRole), arg0)
+}
+
 // RenameGroup mocks base method.
 func (m *MockJaasAPIClient) RenameGroup(arg0 *params.RenameGroupRequest) error {
 	m.ctrl.T.Helper()
@@ -854,3 +1014,32 @@ func (mr *MockJaasAPIClientMockRecorder) RenameGroup(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameGroup", reflect.TypeOf((*MockJaasAPIClient)(nil).RenameGroup), arg0)
 }
+
+// RenameRole mocks base method.
+func (m *MockJaasAPIClient) RenameRole(arg0 *RenameRoleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameRole", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameRole indicates an expected call of RenameRole.
+func (mr *MockJaasAPIClientMockRecorder) RenameRole(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameRole", reflect.TypeOf((*MockJaasAPIClient)(nil).RenameRole), arg0)
+}
+
+// SetControllerDeprecated mocks base method.
+func (m *MockJaasAPIClient) SetControllerDeprecated(arg0 *params.SetControllerDeprecatedRequest) (params.ControllerInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetControllerDeprecated", arg0)
+	ret0, _ := ret[0].(params.ControllerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetControllerDeprecated indicates an expected call of SetControllerDeprecated.
+func (mr *MockJaasAPIClientMockRecorder) SetControllerDeprecated(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetControllerDeprecated", reflect.TypeOf((*MockJaasAPIClient)(nil).SetControllerDeprecated), arg0)
+}
@@ -0,0 +1,910 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/terraform-provider-juju/internal/juju (interfaces: ApplicationsClient,MachinesClient,KubernetesCloudsClient,ModelsClient,JaasClient)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination clients_mock.go github.com/juju/terraform-provider-juju/internal/juju ApplicationsClient,MachinesClient,KubernetesCloudsClient,ModelsClient,JaasClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	api "github.com/juju/juju/api"
+	params "github.com/juju/juju/rpc/params"
+	juju "github.com/juju/terraform-provider-juju/internal/juju"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationsClient is a mock of ApplicationsClient interface.
+type MockApplicationsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationsClientMockRecorder
+}
+
+// MockApplicationsClientMockRecorder is the mock recorder for MockApplicationsClient.
+type MockApplicationsClientMockRecorder struct {
+	mock *MockApplicationsClient
+}
+
+// NewMockApplicationsClient creates a new mock instance.
+func NewMockApplicationsClient(ctrl *gomock.Controller) *MockApplicationsClient {
+	mock := &MockApplicationsClient{ctrl: ctrl}
+	mock.recorder = &MockApplicationsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationsClient) EXPECT() *MockApplicationsClientMockRecorder {
+	return m.recorder
+}
+
+// CreateApplication mocks base method.
+func (m *MockApplicationsClient) CreateApplication(arg0 context.Context, arg1 *juju.CreateApplicationInput) (*juju.CreateApplicationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplication", arg0, arg1)
+	ret0, _ := ret[0].(*juju.CreateApplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApplication indicates an expected call of CreateApplication.
+func (mr *MockApplicationsClientMockRecorder) CreateApplication(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplication", reflect.TypeOf((*MockApplicationsClient)(nil).CreateApplication), arg0, arg1)
+}
+
+// DestroyApplication mocks base method.
+func (m *MockApplicationsClient) DestroyApplication(arg0 context.Context, arg1 *juju.DestroyApplicationInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyApplication", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyApplication indicates an expected call of DestroyApplication.
+func (mr *MockApplicationsClientMockRecorder) DestroyApplication(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyApplication", reflect.TypeOf((*MockApplicationsClient)(nil).DestroyApplication), arg0, arg1)
+}
+
+// ListApplications mocks base method.
+func (m *MockApplicationsClient) ListApplications(arg0 context.Context, arg1 *juju.ListApplicationsInput) (*juju.ListApplicationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplications", arg0, arg1)
+	ret0, _ := ret[0].(*juju.ListApplicationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplications indicates an expected call of ListApplications.
+func (mr *MockApplicationsClientMockRecorder) ListApplications(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplications", reflect.TypeOf((*MockApplicationsClient)(nil).ListApplications), arg0, arg1)
+}
+
+// ReadApplication mocks base method.
+func (m *MockApplicationsClient) ReadApplication(arg0 context.Context, arg1 *juju.ReadApplicationInput) (*juju.ReadApplicationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadApplication", arg0, arg1)
+	ret0, _ := ret[0].(*juju.ReadApplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadApplication indicates an expected call of ReadApplication.
+func (mr *MockApplicationsClientMockRecorder) ReadApplication(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadApplication", reflect.TypeOf((*MockApplicationsClient)(nil).ReadApplication), arg0, arg1)
+}
+
+// ReadApplicationStatus mocks base method.
+func (m *MockApplicationsClient) ReadApplicationStatus(arg0 context.Context, arg1 *juju.ReadApplicationInput) (*juju.ReadApplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadApplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*juju.ReadApplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadApplicationStatus indicates an expected call of ReadApplicationStatus.
+func (mr *MockApplicationsClientMockRecorder) ReadApplicationStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadApplicationStatus", reflect.TypeOf((*MockApplicationsClient)(nil).ReadApplicationStatus), arg0, arg1)
+}
+
+// ReadApplicationWithRetryOnNotFound mocks base method.
+func (m *MockApplicationsClient) ReadApplicationWithRetryOnNotFound(arg0 context.Context, arg1 *juju.ReadApplicationInput) (*juju.ReadApplicationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadApplicationWithRetryOnNotFound", arg0, arg1)
+	ret0, _ := ret[0].(*juju.ReadApplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadApplicationWithRetryOnNotFound indicates an expected call of ReadApplicationWithRetryOnNotFound.
+func (mr *MockApplicationsClientMockRecorder) ReadApplicationWithRetryOnNotFound(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadApplicationWithRetryOnNotFound", reflect.TypeOf((*MockApplicationsClient)(nil).ReadApplicationWithRetryOnNotFound), arg0, arg1)
+}
+
+// ReadCharmConfigOptions mocks base method.
+func (m *MockApplicationsClient) ReadCharmConfigOptions(arg0 context.Context, arg1 *juju.ReadCharmConfigOptionsInput) (map[string]juju.CharmConfigOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCharmConfigOptions", arg0, arg1)
+	ret0, _ := ret[0].(map[string]juju.CharmConfigOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCharmConfigOptions indicates an expected call of ReadCharmConfigOptions.
+func (mr *MockApplicationsClientMockRecorder) ReadCharmConfigOptions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCharmConfigOptions", reflect.TypeOf((*MockApplicationsClient)(nil).ReadCharmConfigOptions), arg0, arg1)
+}
+
+// ReadStatusHistory mocks base method.
+func (m *MockApplicationsClient) ReadStatusHistory(arg0 context.Context, arg1 *juju.ReadStatusHistoryInput) (*juju.ReadStatusHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStatusHistory", arg0, arg1)
+	ret0, _ := ret[0].(*juju.ReadStatusHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStatusHistory indicates an expected call of ReadStatusHistory.
+func (mr *MockApplicationsClientMockRecorder) ReadStatusHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStatusHistory", reflect.TypeOf((*MockApplicationsClient)(nil).ReadStatusHistory), arg0, arg1)
+}
+
+// ResolveCharm mocks base method.
+func (m *MockApplicationsClient) ResolveCharm(arg0 context.Context, arg1 *juju.ResolveCharmInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveCharm", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveCharm indicates an expected call of ResolveCharm.
+func (mr *MockApplicationsClientMockRecorder) ResolveCharm(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveCharm", reflect.TypeOf((*MockApplicationsClient)(nil).ResolveCharm), arg0, arg1)
+}
+
+// RunAction mocks base method.
+func (m *MockApplicationsClient) RunAction(arg0 context.Context, arg1 *juju.RunActionInput) (*juju.RunActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunAction", arg0, arg1)
+	ret0, _ := ret[0].(*juju.RunActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunAction indicates an expected call of RunAction.
+func (mr *MockApplicationsClientMockRecorder) RunAction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAction", reflect.TypeOf((*MockApplicationsClient)(nil).RunAction), arg0, arg1)
+}
+
+// RunLeaderAction mocks base method.
+func (m *MockApplicationsClient) RunLeaderAction(arg0 context.Context, arg1 *juju.RunLeaderActionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunLeaderAction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunLeaderAction indicates an expected call of RunLeaderAction.
+func (mr *MockApplicationsClientMockRecorder) RunLeaderAction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunLeaderAction", reflect.TypeOf((*MockApplicationsClient)(nil).RunLeaderAction), arg0, arg1)
+}
+
+// UpdateApplication mocks base method.
+func (m *MockApplicationsClient) UpdateApplication(arg0 context.Context, arg1 *juju.UpdateApplicationInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication.
+func (mr *MockApplicationsClientMockRecorder) UpdateApplication(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockApplicationsClient)(nil).UpdateApplication), arg0, arg1)
+}
+
+// WaitForApplicationReady mocks base method.
+func (m *MockApplicationsClient) WaitForApplicationReady(arg0 context.Context, arg1 *juju.WaitForApplicationReadyInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForApplicationReady", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForApplicationReady indicates an expected call of WaitForApplicationReady.
+func (mr *MockApplicationsClientMockRecorder) WaitForApplicationReady(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForApplicationReady", reflect.TypeOf((*MockApplicationsClient)(nil).WaitForApplicationReady), arg0, arg1)
+}
+
+// MockMachinesClient is a mock of MachinesClient interface.
+type MockMachinesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMachinesClientMockRecorder
+}
+
+// MockMachinesClientMockRecorder is the mock recorder for MockMachinesClient.
+type MockMachinesClientMockRecorder struct {
+	mock *MockMachinesClient
+}
+
+// NewMockMachinesClient creates a new mock instance.
+func NewMockMachinesClient(ctrl *gomock.Controller) *MockMachinesClient {
+	mock := &MockMachinesClient{ctrl: ctrl}
+	mock.recorder = &MockMachinesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMachinesClient) EXPECT() *MockMachinesClientMockRecorder {
+	return m.recorder
+}
+
+// CreateMachine mocks base method.
+func (m *MockMachinesClient) CreateMachine(arg0 context.Context, arg1 *juju.CreateMachineInput) (*juju.CreateMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMachine", arg0, arg1)
+	ret0, _ := ret[0].(*juju.CreateMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMachine indicates an expected call of CreateMachine.
+func (mr *MockMachinesClientMockRecorder) CreateMachine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMachine", reflect.TypeOf((*MockMachinesClient)(nil).CreateMachine), arg0, arg1)
+}
+
+// DestroyMachine mocks base method.
+func (m *MockMachinesClient) DestroyMachine(arg0 context.Context, arg1 *juju.DestroyMachineInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyMachine", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyMachine indicates an expected call of DestroyMachine.
+func (mr *MockMachinesClientMockRecorder) DestroyMachine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyMachine", reflect.TypeOf((*MockMachinesClient)(nil).DestroyMachine), arg0, arg1)
+}
+
+// MachineIDFromInstanceID mocks base method.
+func (m *MockMachinesClient) MachineIDFromInstanceID(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MachineIDFromInstanceID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MachineIDFromInstanceID indicates an expected call of MachineIDFromInstanceID.
+func (mr *MockMachinesClientMockRecorder) MachineIDFromInstanceID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MachineIDFromInstanceID", reflect.TypeOf((*MockMachinesClient)(nil).MachineIDFromInstanceID), arg0, arg1, arg2)
+}
+
+// ReadMachine mocks base method.
+func (m *MockMachinesClient) ReadMachine(arg0 context.Context, arg1 juju.ReadMachineInput) (juju.ReadMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMachine", arg0, arg1)
+	ret0, _ := ret[0].(juju.ReadMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMachine indicates an expected call of ReadMachine.
+func (mr *MockMachinesClientMockRecorder) ReadMachine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMachine", reflect.TypeOf((*MockMachinesClient)(nil).ReadMachine), arg0, arg1)
+}
+
+// ReadMachineNetworkInterfaces mocks base method.
+func (m *MockMachinesClient) ReadMachineNetworkInterfaces(arg0 context.Context, arg1 juju.ReadMachineInput) ([]juju.MachineNetworkInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMachineNetworkInterfaces", arg0, arg1)
+	ret0, _ := ret[0].([]juju.MachineNetworkInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMachineNetworkInterfaces indicates an expected call of ReadMachineNetworkInterfaces.
+func (mr *MockMachinesClientMockRecorder) ReadMachineNetworkInterfaces(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMachineNetworkInterfaces", reflect.TypeOf((*MockMachinesClient)(nil).ReadMachineNetworkInterfaces), arg0, arg1)
+}
+
+// ReadMachines mocks base method.
+func (m *MockMachinesClient) ReadMachines(arg0 context.Context, arg1 juju.ReadMachinesInput) ([]juju.ReadMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMachines", arg0, arg1)
+	ret0, _ := ret[0].([]juju.ReadMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMachines indicates an expected call of ReadMachines.
+func (mr *MockMachinesClientMockRecorder) ReadMachines(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMachines", reflect.TypeOf((*MockMachinesClient)(nil).ReadMachines), arg0, arg1)
+}
+
+// MockKubernetesCloudsClient is a mock of KubernetesCloudsClient interface.
+type MockKubernetesCloudsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockKubernetesCloudsClientMockRecorder
+}
+
+// MockKubernetesCloudsClientMockRecorder is the mock recorder for MockKubernetesCloudsClient.
+type MockKubernetesCloudsClientMockRecorder struct {
+	mock *MockKubernetesCloudsClient
+}
+
+// NewMockKubernetesCloudsClient creates a new mock instance.
+func NewMockKubernetesCloudsClient(ctrl *gomock.Controller) *MockKubernetesCloudsClient {
+	mock := &MockKubernetesCloudsClient{ctrl: ctrl}
+	mock.recorder = &MockKubernetesCloudsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKubernetesCloudsClient) EXPECT() *MockKubernetesCloudsClientMockRecorder {
+	return m.recorder
+}
+
+// CreateKubernetesCloud mocks base method.
+func (m *MockKubernetesCloudsClient) CreateKubernetesCloud(arg0 context.Context, arg1 *juju.CreateKubernetesCloudInput) (*juju.CreateKubernetesCloudOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKubernetesCloud", arg0, arg1)
+	ret0, _ := ret[0].(*juju.CreateKubernetesCloudOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKubernetesCloud indicates an expected call of CreateKubernetesCloud.
+func (mr *MockKubernetesCloudsClientMockRecorder) CreateKubernetesCloud(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKubernetesCloud", reflect.TypeOf((*MockKubernetesCloudsClient)(nil).CreateKubernetesCloud), arg0, arg1)
+}
+
+// DestroyKubernetesCloud mocks base method.
+func (m *MockKubernetesCloudsClient) DestroyKubernetesCloud(arg0 context.Context, arg1 *juju.DestroyKubernetesCloudInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyKubernetesCloud", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyKubernetesCloud indicates an expected call of DestroyKubernetesCloud.
+func (mr *MockKubernetesCloudsClientMockRecorder) DestroyKubernetesCloud(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyKubernetesCloud", reflect.TypeOf((*MockKubernetesCloudsClient)(nil).DestroyKubernetesCloud), arg0, arg1)
+}
+
+// ReadKubernetesCloud mocks base method.
+func (m *MockKubernetesCloudsClient) ReadKubernetesCloud(arg0 context.Context, arg1 *juju.ReadKubernetesCloudInput) (*juju.ReadKubernetesCloudOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadKubernetesCloud", arg0, arg1)
+	ret0, _ := ret[0].(*juju.ReadKubernetesCloudOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadKubernetesCloud indicates an expected call of ReadKubernetesCloud.
+func (mr *MockKubernetesCloudsClientMockRecorder) ReadKubernetesCloud(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadKubernetesCloud", reflect.TypeOf((*MockKubernetesCloudsClient)(nil).ReadKubernetesCloud), arg0, arg1)
+}
+
+// UpdateKubernetesCloud mocks base method.
+func (m *MockKubernetesCloudsClient) UpdateKubernetesCloud(arg0 context.Context, arg1 *juju.UpdateKubernetesCloudInput) (*juju.UpdateKubernetesCloudOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKubernetesCloud", arg0, arg1)
+	ret0, _ := ret[0].(*juju.UpdateKubernetesCloudOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateKubernetesCloud indicates an expected call of UpdateKubernetesCloud.
+func (mr *MockKubernetesCloudsClientMockRecorder) UpdateKubernetesCloud(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKubernetesCloud", reflect.TypeOf((*MockKubernetesCloudsClient)(nil).UpdateKubernetesCloud), arg0, arg1)
+}
+
+// MockModelsClient is a mock of ModelsClient interface.
+type MockModelsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelsClientMockRecorder
+}
+
+// MockModelsClientMockRecorder is the mock recorder for MockModelsClient.
+type MockModelsClientMockRecorder struct {
+	mock *MockModelsClient
+}
+
+// NewMockModelsClient creates a new mock instance.
+func NewMockModelsClient(ctrl *gomock.Controller) *MockModelsClient {
+	mock := &MockModelsClient{ctrl: ctrl}
+	mock.recorder = &MockModelsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelsClient) EXPECT() *MockModelsClientMockRecorder {
+	return m.recorder
+}
+
+// CreateModel mocks base method.
+func (m *MockModelsClient) CreateModel(arg0 context.Context, arg1 juju.CreateModelInput) (juju.CreateModelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModel", arg0, arg1)
+	ret0, _ := ret[0].(juju.CreateModelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModel indicates an expected call of CreateModel.
+func (mr *MockModelsClientMockRecorder) CreateModel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModel", reflect.TypeOf((*MockModelsClient)(nil).CreateModel), arg0, arg1)
+}
+
+// DestroyAccessModel mocks base method.
+func (m *MockModelsClient) DestroyAccessModel(arg0 context.Context, arg1 juju.DestroyAccessModelInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyAccessModel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyAccessModel indicates an expected call of DestroyAccessModel.
+func (mr *MockModelsClientMockRecorder) DestroyAccessModel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyAccessModel", reflect.TypeOf((*MockModelsClient)(nil).DestroyAccessModel), arg0, arg1)
+}
+
+// DestroyModel mocks base method.
+func (m *MockModelsClient) DestroyModel(arg0 context.Context, arg1 juju.DestroyModelInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyModel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyModel indicates an expected call of DestroyModel.
+func (mr *MockModelsClientMockRecorder) DestroyModel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyModel", reflect.TypeOf((*MockModelsClient)(nil).DestroyModel), arg0, arg1)
+}
+
+// GetConnection mocks base method.
+func (m *MockModelsClient) GetConnection(arg0 context.Context, arg1 *string) (api.Connection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnection", arg0, arg1)
+	ret0, _ := ret[0].(api.Connection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnection indicates an expected call of GetConnection.
+func (mr *MockModelsClientMockRecorder) GetConnection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockModelsClient)(nil).GetConnection), arg0, arg1)
+}
+
+// GetModelByName mocks base method.
+func (m *MockModelsClient) GetModelByName(arg0 context.Context, arg1 string) (*params.ModelInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelByName", arg0, arg1)
+	ret0, _ := ret[0].(*params.ModelInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelByName indicates an expected call of GetModelByName.
+func (mr *MockModelsClientMockRecorder) GetModelByName(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelByName", reflect.TypeOf((*MockModelsClient)(nil).GetModelByName), arg0, arg1)
+}
+
+// GrantModel mocks base method.
+func (m *MockModelsClient) GrantModel(arg0 context.Context, arg1 juju.GrantModelInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantModel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantModel indicates an expected call of GrantModel.
+func (mr *MockModelsClientMockRecorder) GrantModel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantModel", reflect.TypeOf((*MockModelsClient)(nil).GrantModel), arg0, arg1)
+}
+
+// ReadModel mocks base method.
+func (m *MockModelsClient) ReadModel(arg0 context.Context, arg1 string) (*juju.ReadModelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadModel", arg0, arg1)
+	ret0, _ := ret[0].(*juju.ReadModelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadModel indicates an expected call of ReadModel.
+func (mr *MockModelsClientMockRecorder) ReadModel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadModel", reflect.TypeOf((*MockModelsClient)(nil).ReadModel), arg0, arg1)
+}
+
+// ReadModelConfig mocks base method.
+func (m *MockModelsClient) ReadModelConfig(arg0 context.Context, arg1 juju.ReadModelConfigInput) (*juju.ReadModelConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadModelConfig", arg0, arg1)
+	ret0, _ := ret[0].(*juju.ReadModelConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadModelConfig indicates an expected call of ReadModelConfig.
+func (mr *MockModelsClientMockRecorder) ReadModelConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadModelConfig", reflect.TypeOf((*MockModelsClient)(nil).ReadModelConfig), arg0, arg1)
+}
+
+// ReadModelUUID mocks base method.
+func (m *MockModelsClient) ReadModelUUID(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadModelUUID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadModelUUID indicates an expected call of ReadModelUUID.
+func (mr *MockModelsClientMockRecorder) ReadModelUUID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadModelUUID", reflect.TypeOf((*MockModelsClient)(nil).ReadModelUUID), arg0, arg1, arg2)
+}
+
+// UnknownConfigKeys mocks base method.
+func (m *MockModelsClient) UnknownConfigKeys(arg0 context.Context, arg1 string, arg2 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnknownConfigKeys", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnknownConfigKeys indicates an expected call of UnknownConfigKeys.
+func (mr *MockModelsClientMockRecorder) UnknownConfigKeys(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnknownConfigKeys", reflect.TypeOf((*MockModelsClient)(nil).UnknownConfigKeys), arg0, arg1, arg2)
+}
+
+// UpdateAccessModel mocks base method.
+func (m *MockModelsClient) UpdateAccessModel(arg0 context.Context, arg1 juju.UpdateAccessModelInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccessModel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAccessModel indicates an expected call of UpdateAccessModel.
+func (mr *MockModelsClientMockRecorder) UpdateAccessModel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccessModel", reflect.TypeOf((*MockModelsClient)(nil).UpdateAccessModel), arg0, arg1)
+}
+
+// UpdateModel mocks base method.
+func (m *MockModelsClient) UpdateModel(arg0 context.Context, arg1 juju.UpdateModelInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateModel indicates an expected call of UpdateModel.
+func (mr *MockModelsClientMockRecorder) UpdateModel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModel", reflect.TypeOf((*MockModelsClient)(nil).UpdateModel), arg0, arg1)
+}
+
+// MockJaasClient is a mock of JaasClient interface.
+type MockJaasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockJaasClientMockRecorder
+}
+
+// MockJaasClientMockRecorder is the mock recorder for MockJaasClient.
+type MockJaasClientMockRecorder struct {
+	mock *MockJaasClient
+}
+
+// NewMockJaasClient creates a new mock instance.
+func NewMockJaasClient(ctrl *gomock.Controller) *MockJaasClient {
+	mock := &MockJaasClient{ctrl: ctrl}
+	mock.recorder = &MockJaasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJaasClient) EXPECT() *MockJaasClientMockRecorder {
+	return m.recorder
+}
+
+// AddGroup mocks base method.
+func (m *MockJaasClient) AddGroup(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroup", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGroup indicates an expected call of AddGroup.
+func (mr *MockJaasClientMockRecorder) AddGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroup", reflect.TypeOf((*MockJaasClient)(nil).AddGroup), arg0, arg1)
+}
+
+// AddRelations mocks base method.
+func (m *MockJaasClient) AddRelations(arg0 context.Context, arg1 []juju.JaasTuple) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRelations", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRelations indicates an expected call of AddRelations.
+func (mr *MockJaasClientMockRecorder) AddRelations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelations", reflect.TypeOf((*MockJaasClient)(nil).AddRelations), arg0, arg1)
+}
+
+// AddRole mocks base method.
+func (m *MockJaasClient) AddRole(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRole", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRole indicates an expected call of AddRole.
+func (mr *MockJaasClientMockRecorder) AddRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRole", reflect.TypeOf((*MockJaasClient)(nil).AddRole), arg0, arg1)
+}
+
+// DeleteRelations mocks base method.
+func (m *MockJaasClient) DeleteRelations(arg0 context.Context, arg1 []juju.JaasTuple) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRelations", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRelations indicates an expected call of DeleteRelations.
+func (mr *MockJaasClientMockRecorder) DeleteRelations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRelations", reflect.TypeOf((*MockJaasClient)(nil).DeleteRelations), arg0, arg1)
+}
+
+// ListControllers mocks base method.
+func (m *MockJaasClient) ListControllers(arg0 context.Context) ([]juju.JaasController, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListControllers", arg0)
+	ret0, _ := ret[0].([]juju.JaasController)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListControllers indicates an expected call of ListControllers.
+func (mr *MockJaasClientMockRecorder) ListControllers(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListControllers", reflect.TypeOf((*MockJaasClient)(nil).ListControllers), arg0)
+}
+
+// MigrateModels mocks base method.
+func (m *MockJaasClient) MigrateModels(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateModels", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateModels indicates an expected call of MigrateModels.
+func (mr *MockJaasClientMockRecorder) MigrateModels(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateModels", reflect.TypeOf((*MockJaasClient)(nil).MigrateModels), arg0, arg1, arg2)
+}
+
+// ReadController mocks base method.
+func (m *MockJaasClient) ReadController(arg0 context.Context, arg1 string) (*juju.JaasController, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadController", arg0, arg1)
+	ret0, _ := ret[0].(*juju.JaasController)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadController indicates an expected call of ReadController.
+func (mr *MockJaasClientMockRecorder) ReadController(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadController", reflect.TypeOf((*MockJaasClient)(nil).ReadController), arg0, arg1)
+}
+
+// ReadGroup mocks base method.
+func (m *MockJaasClient) ReadGroup(arg0 context.Context, arg1 string) (*juju.JaasGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGroup", arg0, arg1)
+	ret0, _ := ret[0].(*juju.JaasGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGroup indicates an expected call of ReadGroup.
+func (mr *MockJaasClientMockRecorder) ReadGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGroup", reflect.TypeOf((*MockJaasClient)(nil).ReadGroup), arg0, arg1)
+}
+
+// ReadGroupByName mocks base method.
+func (m *MockJaasClient) ReadGroupByName(arg0 context.Context, arg1 string) (*juju.JaasGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGroupByName", arg0, arg1)
+	ret0, _ := ret[0].(*juju.JaasGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGroupByName indicates an expected call of ReadGroupByName.
+func (mr *MockJaasClientMockRecorder) ReadGroupByName(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGroupByName", reflect.TypeOf((*MockJaasClient)(nil).ReadGroupByName), arg0, arg1)
+}
+
+// ReadLoginInfo mocks base method.
+func (m *MockJaasClient) ReadLoginInfo(arg0 context.Context) (*juju.JaasLoginInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadLoginInfo", arg0)
+	ret0, _ := ret[0].(*juju.JaasLoginInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadLoginInfo indicates an expected call of ReadLoginInfo.
+func (mr *MockJaasClientMockRecorder) ReadLoginInfo(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadLoginInfo", reflect.TypeOf((*MockJaasClient)(nil).ReadLoginInfo), arg0)
+}
+
+// ReadRelations mocks base method.
+func (m *MockJaasClient) ReadRelations(arg0 context.Context, arg1 *juju.JaasTuple) ([]juju.JaasTuple, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRelations", arg0, arg1)
+	ret0, _ := ret[0].([]juju.JaasTuple)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRelations indicates an expected call of ReadRelations.
+func (mr *MockJaasClientMockRecorder) ReadRelations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRelations", reflect.TypeOf((*MockJaasClient)(nil).ReadRelations), arg0, arg1)
+}
+
+// ReadRole mocks base method.
+func (m *MockJaasClient) ReadRole(arg0 context.Context, arg1 string) (*juju.JaasRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRole", arg0, arg1)
+	ret0, _ := ret[0].(*juju.JaasRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRole indicates an expected call of ReadRole.
+func (mr *MockJaasClientMockRecorder) ReadRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRole", reflect.TypeOf((*MockJaasClient)(nil).ReadRole), arg0, arg1)
+}
+
+// ReadRoleByName mocks base method.
+func (m *MockJaasClient) ReadRoleByName(arg0 context.Context, arg1 string) (*juju.JaasRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRoleByName", arg0, arg1)
+	ret0, _ := ret[0].(*juju.JaasRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRoleByName indicates an expected call of ReadRoleByName.
+func (mr *MockJaasClientMockRecorder) ReadRoleByName(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRoleByName", reflect.TypeOf((*MockJaasClient)(nil).ReadRoleByName), arg0, arg1)
+}
+
+// RemoveGroup mocks base method.
+func (m *MockJaasClient) RemoveGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroup indicates an expected call of RemoveGroup.
+func (mr *MockJaasClientMockRecorder) RemoveGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroup", reflect.TypeOf((*MockJaasClient)(nil).RemoveGroup), arg0, arg1)
+}
+
+// RemoveRole mocks base method.
+func (m *MockJaasClient) RemoveRole(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRole indicates an expected call of RemoveRole.
+func (mr *MockJaasClientMockRecorder) RemoveRole(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRole", reflect.TypeOf((*MockJaasClient)(nil).RemoveRole), arg0, arg1)
+}
+
+// RenameGroup mocks base method.
+func (m *MockJaasClient) RenameGroup(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameGroup indicates an expected call of RenameGroup.
+func (mr *MockJaasClientMockRecorder) RenameGroup(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameGroup", reflect.TypeOf((*MockJaasClient)(nil).RenameGroup), arg0, arg1, arg2)
+}
+
+// RenameRole mocks base method.
+func (m *MockJaasClient) RenameRole(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameRole indicates an expected call of RenameRole.
+func (mr *MockJaasClientMockRecorder) RenameRole(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameRole", reflect.TypeOf((*MockJaasClient)(nil).RenameRole), arg0, arg1, arg2)
+}
+
+// SetControllerDeprecated mocks base method.
+func (m *MockJaasClient) SetControllerDeprecated(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetControllerDeprecated", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetControllerDeprecated indicates an expected call of SetControllerDeprecated.
+func (mr *MockJaasClientMockRecorder) SetControllerDeprecated(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetControllerDeprecated", reflect.TypeOf((*MockJaasClient)(nil).SetControllerDeprecated), arg0, arg1, arg2)
+}
@@ -0,0 +1,11 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+// Package mocks contains mocks of the interfaces the provider uses to talk
+// to juju, for testing the resources of the provider without a controller.
+// Like the interfaces they mock, they are internal to this module and
+// cannot be imported by code embedding the provider, see
+// project-docs/decisions/0007-internal-client-interfaces.md.
+package mocks
+
+//go:generate go run go.uber.org/mock/mockgen -package mocks -destination clients_mock.go github.com/juju/terraform-provider-juju/internal/juju ApplicationsClient,MachinesClient,KubernetesCloudsClient,ModelsClient,JaasClient
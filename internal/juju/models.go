@@ -4,13 +4,17 @@
 package juju
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/juju/api/client/modelconfig"
 	"github.com/juju/juju/api/client/modelmanager"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v5"
 )
@@ -80,6 +84,21 @@ type UpdateAccessModelInput struct {
 	Access    string
 }
 
+type ReadModelConfigInput struct {
+	Name string
+	UUID string
+}
+
+type ReadModelConfigResponse struct {
+	Name string
+	UUID string
+	// Config maps each config key to its value, as a string.
+	Config map[string]string
+	// Sources maps each config key to the source of its value, e.g.
+	// default, controller, region or model.
+	Sources map[string]string
+}
+
 type DestroyModelInput struct {
 	UUID string
 }
@@ -97,8 +116,8 @@ func newModelsClient(sc SharedClient) *modelsClient {
 }
 
 // GetModelByName retrieves a model by name
-func (c *modelsClient) GetModelByName(name string) (*params.ModelInfo, error) {
-	conn, err := c.GetConnection(nil)
+func (c *modelsClient) GetModelByName(ctx context.Context, name string) (*params.ModelInfo, error) {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +125,7 @@ func (c *modelsClient) GetModelByName(name string) (*params.ModelInfo, error) {
 
 	client := modelmanager.NewClient(conn)
 
-	modelUUID, err := c.ModelUUID(name)
+	modelUUID, err := c.ModelUUID(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +147,28 @@ func (c *modelsClient) GetModelByName(name string) (*params.ModelInfo, error) {
 	return modelInfo, nil
 }
 
-func (c *modelsClient) CreateModel(input CreateModelInput) (CreateModelResponse, error) {
+// ReadModelUUID returns the UUID of the model with the given owner and
+// name, among the models the user of the provider has access to.
+func (c *modelsClient) ReadModelUUID(ctx context.Context, owner, name string) (string, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = conn.Close() }()
+
+	modelSummaries, err := modelmanager.NewClient(conn).ListModelSummaries(conn.AuthTag().Id(), false)
+	if err != nil {
+		return "", err
+	}
+	for _, modelSummary := range modelSummaries {
+		if modelSummary.Owner == owner && modelSummary.Name == name {
+			return modelSummary.UUID, nil
+		}
+	}
+	return "", errors.NotFoundf("model %s/%s", owner, name)
+}
+
+func (c *modelsClient) CreateModel(ctx context.Context, input CreateModelInput) (CreateModelResponse, error) {
 	resp := CreateModelResponse{}
 
 	modelName := input.Name
@@ -136,7 +176,7 @@ func (c *modelsClient) CreateModel(input CreateModelInput) (CreateModelResponse,
 		return resp, fmt.Errorf("%q is not a valid name: model names may only contain lowercase letters, digits and hyphens", modelName)
 	}
 
-	conn, err := c.GetConnection(nil)
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return resp, err
 	}
@@ -185,7 +225,7 @@ func (c *modelsClient) CreateModel(input CreateModelInput) (CreateModelResponse,
 
 	// we have to set constraints ...
 	// establish a new connection with the created model through the modelconfig api to set constraints
-	connModel, err := c.GetConnection(&modelName)
+	connModel, err := c.GetConnection(ctx, &modelName)
 	if err != nil {
 		return resp, err
 	}
@@ -200,14 +240,14 @@ func (c *modelsClient) CreateModel(input CreateModelInput) (CreateModelResponse,
 	return resp, nil
 }
 
-func (c *modelsClient) ReadModel(name string) (*ReadModelResponse, error) {
-	modelmanagerConn, err := c.GetConnection(nil)
+func (c *modelsClient) ReadModel(ctx context.Context, name string) (*ReadModelResponse, error) {
+	modelmanagerConn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = modelmanagerConn.Close() }()
 
-	modelconfigConn, err := c.GetConnection(&name)
+	modelconfigConn, err := c.GetConnection(ctx, &name)
 	if err != nil {
 		return nil, errors.Wrap(err, &modelNotFoundError{uuid: name})
 	}
@@ -255,8 +295,132 @@ func (c *modelsClient) ReadModel(name string) (*ReadModelResponse, error) {
 	}, nil
 }
 
-func (c *modelsClient) UpdateModel(input UpdateModelInput) error {
-	conn, err := c.GetConnection(&input.Name)
+// ReadModelConfig returns the effective configuration of a model, with
+// the values inherited from the controller and cloud defaults, and the
+// source of each value. The model is found by name, or by UUID when the
+// name is empty.
+func (c *modelsClient) ReadModelConfig(ctx context.Context, input ReadModelConfigInput) (*ReadModelConfigResponse, error) {
+	name := input.Name
+	if name == "" {
+		modelInfo, err := c.modelInfoByUUID(ctx, input.UUID)
+		if err != nil {
+			return nil, err
+		}
+		name = modelInfo.Name
+	}
+
+	conn, err := c.GetConnection(ctx, &name)
+	if err != nil {
+		return nil, errors.Wrap(err, &modelNotFoundError{name: name})
+	}
+	defer func() { _ = conn.Close() }()
+
+	modelUUIDTag, modelOk := conn.ModelTag()
+	if !modelOk {
+		return nil, errors.Errorf("Not connected to model %q", name)
+	}
+
+	values, err := modelconfig.NewClient(conn).ModelGetWithMetadata()
+	if err != nil {
+		return nil, err
+	}
+	response := &ReadModelConfigResponse{
+		Name:    name,
+		UUID:    modelUUIDTag.Id(),
+		Config:  make(map[string]string, len(values)),
+		Sources: make(map[string]string, len(values)),
+	}
+	for key, value := range values {
+		serialised, err := configValueString(value.Value)
+		if err != nil {
+			return nil, errors.Annotatef(err, "model config %q", key)
+		}
+		response.Config[key] = serialised
+		response.Sources[key] = value.Source
+	}
+	return response, nil
+}
+
+// modelInfoByUUID returns the information of the model with the given UUID.
+func (c *modelsClient) modelInfoByUUID(ctx context.Context, uuid string) (*params.ModelInfo, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	results, err := modelmanager.NewClient(conn).ModelInfo([]names.ModelTag{names.NewModelTag(uuid)})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, &modelNotFoundError{uuid: uuid}
+	}
+	if results[0].Error != nil {
+		if params.IsCodeNotFound(results[0].Error) {
+			return nil, &modelNotFoundError{uuid: uuid}
+		}
+		return nil, results[0].Error
+	}
+	return results[0].Result, nil
+}
+
+// configValueString serialises a model config value as a string. Values
+// which are not strings, such as booleans and numbers, are encoded as
+// JSON.
+func configValueString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
+
+// UnknownConfigKeys returns the given model config keys which are not
+// known to the controller for models on the cloud, sorted. Juju accepts
+// and stores such keys without using them, e.g. a misspelled
+// "loggin-config".
+func (c *modelsClient) UnknownConfigKeys(ctx context.Context, cloudName string, keys []string) ([]string, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	known, err := modelmanager.NewClient(conn).ModelDefaults(cloudName)
+	if err != nil {
+		return nil, err
+	}
+	return unknownConfigKeys(known, keys), nil
+}
+
+// unknownConfigKeys returns the keys missing from the model defaults of
+// the controller, which hold every key of the model config schema, like
+// `juju model-defaults` does when warning about possible misspellings.
+func unknownConfigKeys(known config.ModelDefaultAttributes, keys []string) []string {
+	var unknown []string
+	for _, key := range keys {
+		// The authorized keys are valid but not part of the defaults.
+		if key == config.AuthorizedKeysKey {
+			continue
+		}
+		if _, ok := known[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+func (c *modelsClient) UpdateModel(ctx context.Context, input UpdateModelInput) error {
+	conn, err := c.GetConnection(ctx, &input.Name)
 	if err != nil {
 		return err
 	}
@@ -290,23 +454,42 @@ func (c *modelsClient) UpdateModel(input UpdateModelInput) error {
 	}
 
 	if input.Credential != "" {
-		cloudName := input.CloudName
-		currentUser := getCurrentJujuUser(conn)
-		cloudCredTag, err := GetCloudCredentialTag(cloudName, currentUser, input.Credential)
-		if err != nil {
-			return err
-		}
 		// open new connection to get facade versions correctly
-		connModelManager, err := c.GetConnection(nil)
+		connModelManager, err := c.GetConnection(ctx, nil)
 		if err != nil {
 			return err
 		}
-		defer func() { _ = conn.Close() }()
+		defer func() { _ = connModelManager.Close() }()
 		modelUUIDTag, modelOk := conn.ModelTag()
 		if !modelOk {
 			return errors.Errorf("Not connected to model %q", input.Name)
 		}
 		clientModelManager := modelmanager.NewClient(connModelManager)
+
+		// The cloud of the model is read when the resource does not
+		// set it, the credential must be of the same cloud.
+		cloudName := input.CloudName
+		if cloudName == "" {
+			results, err := clientModelManager.ModelInfo([]names.ModelTag{modelUUIDTag})
+			if err != nil {
+				return err
+			}
+			if len(results) != 1 {
+				return errors.Errorf("expected one result for model %q, got %d", input.Name, len(results))
+			}
+			if results[0].Error != nil {
+				return results[0].Error
+			}
+			cloudTag, err := names.ParseCloudTag(results[0].Result.CloudTag)
+			if err != nil {
+				return err
+			}
+			cloudName = cloudTag.Id()
+		}
+		cloudCredTag, err := GetCloudCredentialTag(cloudName, getCurrentJujuUser(conn), input.Credential)
+		if err != nil {
+			return err
+		}
 		if err := clientModelManager.ChangeModelCredential(modelUUIDTag, *cloudCredTag); err != nil {
 			return err
 		}
@@ -315,8 +498,8 @@ func (c *modelsClient) UpdateModel(input UpdateModelInput) error {
 	return nil
 }
 
-func (c *modelsClient) DestroyModel(input DestroyModelInput) error {
-	conn, err := c.GetConnection(nil)
+func (c *modelsClient) DestroyModel(ctx context.Context, input DestroyModelInput) error {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -341,8 +524,8 @@ func (c *modelsClient) DestroyModel(input DestroyModelInput) error {
 	return nil
 }
 
-func (c *modelsClient) GrantModel(input GrantModelInput) error {
-	conn, err := c.GetConnection(nil)
+func (c *modelsClient) GrantModel(ctx context.Context, input GrantModelInput) error {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -350,7 +533,7 @@ func (c *modelsClient) GrantModel(input GrantModelInput) error {
 
 	client := modelmanager.NewClient(conn)
 
-	modelUUID, err := c.ModelUUID(input.ModelName)
+	modelUUID, err := c.ModelUUID(ctx, input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -366,16 +549,16 @@ func (c *modelsClient) GrantModel(input GrantModelInput) error {
 // Note we do a revoke against `read` to remove the user from the model access
 // If a user has had `write`, then removing that access would decrease their
 // access to `read` and the user will remain part of the model access.
-func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
+func (c *modelsClient) UpdateAccessModel(ctx context.Context, input UpdateAccessModelInput) error {
 	model := input.ModelName
 	access := input.OldAccess
 
-	uuid, err := c.ModelUUID(model)
+	uuid, err := c.ModelUUID(ctx, model)
 	if err != nil {
 		return err
 	}
 
-	conn, err := c.GetConnection(nil)
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -403,8 +586,8 @@ func (c *modelsClient) UpdateAccessModel(input UpdateAccessModelInput) error {
 // Note we do a revoke against `read` to remove the user from the model access
 // If a user has had `write`, then removing that access would decrease their
 // access to `read` and the user will remain part of the model access.
-func (c *modelsClient) DestroyAccessModel(input DestroyAccessModelInput) error {
-	conn, err := c.GetConnection(nil)
+func (c *modelsClient) DestroyAccessModel(ctx context.Context, input DestroyAccessModelInput) error {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -412,7 +595,7 @@ func (c *modelsClient) DestroyAccessModel(input DestroyAccessModelInput) error {
 
 	client := modelmanager.NewClient(conn)
 
-	uuid, err := c.ModelUUID(input.ModelName)
+	uuid, err := c.ModelUUID(ctx, input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,50 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/juju/environs/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModelSuite struct {
+	suite.Suite
+}
+
+func (s *ModelSuite) TestConfigValueString() {
+	for _, test := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{value: nil, expected: ""},
+		{value: "ubuntu@22.04", expected: "ubuntu@22.04"},
+		{value: true, expected: "true"},
+		{value: float64(30), expected: "30"},
+		{value: []interface{}{"a", "b"}, expected: `["a","b"]`},
+	} {
+		got, err := configValueString(test.value)
+		s.Require().NoError(err)
+		s.Assert().Equal(test.expected, got)
+	}
+}
+
+func (s *ModelSuite) TestUnknownConfigKeys() {
+	known := config.ModelDefaultAttributes{
+		"logging-config":              {Default: "<root>=INFO"},
+		"update-status-hook-interval": {Default: "5m"},
+	}
+	unknown := unknownConfigKeys(known, []string{
+		"update-status-hook-interval", "loggin-config", config.AuthorizedKeysKey, "foo",
+	})
+	s.Assert().Equal([]string{"foo", "loggin-config"}, unknown)
+	s.Assert().Empty(unknownConfigKeys(known, nil))
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestModelSuite(t *testing.T) {
+	suite.Run(t, new(ModelSuite))
+}
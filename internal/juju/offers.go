@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -37,11 +38,13 @@ type CreateOfferInput struct {
 	ModelName       string
 	ModelOwner      string
 	Name            string
+	Description     string
 }
 
 type CreateOfferResponse struct {
-	Name     string
-	OfferURL string
+	Name        string
+	OfferURL    string
+	Description string
 }
 
 type ReadOfferInput struct {
@@ -54,6 +57,58 @@ type ReadOfferResponse struct {
 	ModelName       string
 	Name            string
 	OfferURL        string
+	Description     string
+	// Endpoints are all the endpoints of the offer, sorted by name.
+	Endpoints []OfferEndpoint
+	// Users are the users with access to the offer, sorted by name.
+	Users []OfferUser
+	// Connections are the relations consuming the offer, sorted by
+	// relation id.
+	Connections []OfferConnection
+}
+
+// OfferEndpoint is an endpoint of an offer.
+type OfferEndpoint struct {
+	Name      string
+	Interface string
+	Role      string
+}
+
+// OfferUser is a user with access to an offer.
+type OfferUser struct {
+	Name   string
+	Access string
+}
+
+// OfferConnection is a relation consuming an offer.
+type OfferConnection struct {
+	// SourceModelUUID is the UUID of the consuming model.
+	SourceModelUUID string
+	Username        string
+	RelationID      int
+	Endpoint        string
+	Status          string
+	Message         string
+	// IngressSubnets are the subnets the traffic of the consuming
+	// model comes from.
+	IngressSubnets []string
+}
+
+type UpdateOfferInput struct {
+	ApplicationName string
+	Endpoint        string
+	ModelName       string
+	Name            string
+	Description     string
+}
+
+// UpdateOfferAccessInput lists the users to grant the access to the
+// offer and the users to revoke it from.
+type UpdateOfferAccessInput struct {
+	OfferURL string
+	Access   string
+	Grant    []string
+	Revoke   []string
 }
 
 type DestroyOfferInput struct {
@@ -63,10 +118,20 @@ type DestroyOfferInput struct {
 type ConsumeRemoteOfferInput struct {
 	ModelName string
 	OfferURL  string
+	// SAASName is the name of the application standing for the offer
+	// in the model, the name of the offer if empty.
+	SAASName string
 }
 
 type ConsumeRemoteOfferResponse struct {
 	SAASName string
+	// LocalApplication is set instead of SAASName when the offer is
+	// hosted in the consuming model itself. Nothing is consumed then,
+	// the offered application is to be related to directly.
+	LocalApplication string
+	// LocalEndpoint is the endpoint of LocalApplication which is
+	// offered, empty if the offer has more than one endpoint.
+	LocalEndpoint string
 }
 
 type RemoveRemoteOfferInput struct {
@@ -80,10 +145,10 @@ func newOffersClient(sc SharedClient) *offersClient {
 	}
 }
 
-func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse, []error) {
+func (c offersClient) CreateOffer(ctx context.Context, input *CreateOfferInput) (*CreateOfferResponse, []error) {
 	var errs []error
 
-	conn, err := c.GetConnection(nil)
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, append(errs, err)
 	}
@@ -97,7 +162,7 @@ func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse
 	}
 
 	// connect to the corresponding model
-	modelConn, err := c.GetConnection(&input.ModelName)
+	modelConn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, append(errs, err)
 	}
@@ -105,19 +170,20 @@ func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse
 	applicationClient := apiapplication.NewClient(modelConn)
 
 	// wait for the app to be available
-	ctx, cancel := context.WithTimeout(context.Background(), OfferAppAvailableTimeout)
+	// The wait is bounded, and stops when terraform is interrupted.
+	waitCtx, cancel := context.WithTimeout(ctx, OfferAppAvailableTimeout)
 	defer cancel()
 
-	err = WaitForAppsAvailable(ctx, applicationClient, []string{input.ApplicationName}, OfferApiTickWait)
+	err = WaitForAppsAvailable(waitCtx, applicationClient, []string{input.ApplicationName}, OfferApiTickWait)
 	if err != nil {
 		return nil, append(errs, errors.New("the application was not available to be offered"))
 	}
 
-	modelUUID, err := c.ModelUUID(input.ModelName)
+	modelUUID, err := c.ModelUUID(ctx, input.ModelName)
 	if err != nil {
 		return nil, append(errs, err)
 	}
-	result, err := client.Offer(modelUUID, input.ApplicationName, []string{input.Endpoint}, "admin", offerName, "")
+	result, err := client.Offer(modelUUID, input.ApplicationName, []string{input.Endpoint}, "admin", offerName, input.Description)
 	if err != nil {
 		return nil, append(errs, err)
 	}
@@ -146,14 +212,15 @@ func (c offersClient) CreateOffer(input *CreateOfferInput) (*CreateOfferResponse
 	}
 
 	resp := CreateOfferResponse{
-		Name:     offer.OfferName,
-		OfferURL: offer.OfferURL,
+		Name:        offer.OfferName,
+		OfferURL:    offer.OfferURL,
+		Description: offer.ApplicationDescription,
 	}
 	return &resp, nil
 }
 
-func (c offersClient) ReadOffer(input *ReadOfferInput) (*ReadOfferResponse, error) {
-	conn, err := c.GetConnection(nil)
+func (c offersClient) ReadOffer(ctx context.Context, input *ReadOfferInput) (*ReadOfferResponse, error) {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +237,36 @@ func (c offersClient) ReadOffer(input *ReadOfferInput) (*ReadOfferResponse, erro
 	response.ApplicationName = result.ApplicationName
 	response.OfferURL = result.OfferURL
 	response.Endpoint = result.Endpoints[0].Name
+	response.Description = result.ApplicationDescription
+	for _, endpoint := range result.Endpoints {
+		response.Endpoints = append(response.Endpoints, OfferEndpoint{
+			Name:      endpoint.Name,
+			Interface: endpoint.Interface,
+			Role:      string(endpoint.Role),
+		})
+	}
+	sort.Slice(response.Endpoints, func(i, j int) bool { return response.Endpoints[i].Name < response.Endpoints[j].Name })
+	for _, user := range result.Users {
+		response.Users = append(response.Users, OfferUser{
+			Name:   user.UserName,
+			Access: string(user.Access),
+		})
+	}
+	sort.Slice(response.Users, func(i, j int) bool { return response.Users[i].Name < response.Users[j].Name })
+	for _, connection := range result.Connections {
+		response.Connections = append(response.Connections, OfferConnection{
+			SourceModelUUID: connection.SourceModelUUID,
+			Username:        connection.Username,
+			RelationID:      connection.RelationId,
+			Endpoint:        connection.Endpoint,
+			Status:          string(connection.Status),
+			Message:         connection.Message,
+			IngressSubnets:  connection.IngressSubnets,
+		})
+	}
+	sort.Slice(response.Connections, func(i, j int) bool {
+		return response.Connections[i].RelationID < response.Connections[j].RelationID
+	})
 
 	//no model name is returned but it can be parsed from the resulting offer URL to ensure parity
 	//TODO: verify if we can fetch information another way
@@ -182,8 +279,60 @@ func (c offersClient) ReadOffer(input *ReadOfferInput) (*ReadOfferResponse, erro
 	return &response, nil
 }
 
-func (c offersClient) DestroyOffer(input *DestroyOfferInput) error {
-	conn, err := c.GetConnection(nil)
+// UpdateOffer updates the description of an existing offer. The juju
+// API treats an offer of an already offered application and name as
+// an update of that offer.
+func (c offersClient) UpdateOffer(ctx context.Context, input *UpdateOfferInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := applicationoffers.NewClient(conn)
+
+	modelUUID, err := c.ModelUUID(ctx, input.ModelName)
+	if err != nil {
+		return err
+	}
+	result, err := client.Offer(modelUUID, input.ApplicationName, []string{input.Endpoint}, "admin", input.Name, input.Description)
+	if err != nil {
+		return err
+	}
+	for _, v := range result {
+		if v.Error != nil {
+			return v.Error
+		}
+	}
+	return nil
+}
+
+// UpdateOfferAccess grants the access to the offer to the users of
+// Grant, and revokes it from the users of Revoke. Revoking consume access
+// leaves the users with read access.
+func (c offersClient) UpdateOfferAccess(ctx context.Context, input *UpdateOfferAccessInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := applicationoffers.NewClient(conn)
+	for _, user := range input.Revoke {
+		if err := client.RevokeOffer(user, input.Access, input.OfferURL); err != nil {
+			return fmt.Errorf("revoking %s access to offer %q from %q: %w", input.Access, input.OfferURL, user, err)
+		}
+	}
+	for _, user := range input.Grant {
+		if err := client.GrantOffer(user, input.Access, input.OfferURL); err != nil {
+			return fmt.Errorf("granting %s access to offer %q to %q: %w", input.Access, input.OfferURL, user, err)
+		}
+	}
+	return nil
+}
+
+func (c offersClient) DestroyOffer(ctx context.Context, input *DestroyOfferInput) error {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -206,7 +355,11 @@ func (c offersClient) DestroyOffer(input *DestroyOfferInput) error {
 				forceDestroy = true
 				break
 			}
-			time.Sleep(10 * time.Second)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(10 * time.Second):
+			}
 			offer, err = client.ApplicationOffer(input.OfferURL)
 			if err != nil {
 				return err
@@ -250,13 +403,13 @@ func parseModelFromURL(url string) (result string, success bool) {
 }
 
 // This function allows the integration resource to consume the offers managed by the offer resource
-func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*ConsumeRemoteOfferResponse, error) {
-	modelConn, err := c.GetConnection(&input.ModelName)
+func (c offersClient) ConsumeRemoteOffer(ctx context.Context, input *ConsumeRemoteOfferInput) (*ConsumeRemoteOfferResponse, error) {
+	modelConn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = modelConn.Close() }()
-	conn, err := c.GetConnection(nil)
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -279,6 +432,27 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 		return nil, err
 	}
 
+	// Consuming an offer into the model hosting it would create a SAAS
+	// standing for an application of the same model, relate to the
+	// offered application instead.
+	modelUUID, err := c.ModelUUID(ctx, input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	if isLocalOffer(consumeDetails, modelUUID) {
+		offer, err := offersClient.ApplicationOffer(url.AsLocal().String())
+		if err != nil {
+			return nil, err
+		}
+		response := ConsumeRemoteOfferResponse{
+			LocalApplication: offer.ApplicationName,
+		}
+		if len(offer.Endpoints) == 1 {
+			response.LocalEndpoint = offer.Endpoints[0].Name
+		}
+		return &response, nil
+	}
+
 	offerURL, err := crossmodel.ParseOfferURL(consumeDetails.Offer.OfferURL)
 	if err != nil {
 		return nil, err
@@ -291,6 +465,9 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 		ApplicationAlias: consumeDetails.Offer.OfferName,
 		Macaroon:         consumeDetails.Macaroon,
 	}
+	if input.SAASName != "" {
+		consumeArgs.ApplicationAlias = input.SAASName
+	}
 	if consumeDetails.ControllerInfo != nil {
 		controllerTag, err := names.ParseControllerTag(consumeDetails.ControllerInfo.ControllerTag)
 		if err != nil {
@@ -331,9 +508,9 @@ func (c offersClient) ConsumeRemoteOffer(input *ConsumeRemoteOfferInput) (*Consu
 }
 
 // This function allows the integration resource to destroy the offers managed by the offer resource
-func (c offersClient) RemoveRemoteOffer(input *RemoveRemoteOfferInput) []error {
+func (c offersClient) RemoveRemoteOffer(ctx context.Context, input *RemoveRemoteOfferInput) []error {
 	var errors []error
-	conn, err := c.GetConnection(&input.ModelName)
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		errors = append(errors, err)
 		return errors
@@ -349,23 +526,26 @@ func (c offersClient) RemoveRemoteOffer(input *RemoveRemoteOfferInput) []error {
 		return errors
 	}
 
-	remoteApplications := status.RemoteApplications
-
-	if len(remoteApplications) == 0 {
-		errors = append(errors, fmt.Errorf("no offers found in model"))
-		return errors
-	}
-
+	// The remote applications are keyed by their SAAS name.
 	var offerName string
-	for _, v := range remoteApplications {
+	for name, v := range status.RemoteApplications {
 		if v.Err != nil {
 			errors = append(errors, v.Err)
 			return errors
 		}
-		if v.OfferURL != input.OfferURL {
+		if !OfferURLsMatch(v.OfferURL, input.OfferURL) {
 			continue
 		}
-		offerName = v.OfferName
+		offerName = name
+	}
+	if offerName == "" {
+		// Offers hosted in the model itself are never consumed, see
+		// ConsumeRemoteOffer, there is nothing to remove.
+		if offerInModel(input.OfferURL, input.ModelName) {
+			return nil
+		}
+		errors = append(errors, fmt.Errorf("offer %q is not consumed in model %q", input.OfferURL, input.ModelName))
+		return errors
 	}
 
 	returnErrors, err := client.DestroyConsumedApplication(apiapplication.DestroyConsumedApplicationParams{
@@ -390,3 +570,49 @@ func (c offersClient) RemoveRemoteOffer(input *RemoveRemoteOfferInput) []error {
 
 	return nil
 }
+
+// isLocalOffer returns whether the offer described by the consume
+// details is hosted in the model with the given UUID, on the same
+// controller.
+func isLocalOffer(details params.ConsumeOfferDetails, modelUUID string) bool {
+	if details.Offer == nil || details.ControllerInfo != nil {
+		return false
+	}
+	return details.Offer.SourceModelTag == names.NewModelTag(modelUUID).String()
+}
+
+// offerInModel returns whether the offer URL refers to an offer of the
+// named model.
+func offerInModel(offerURL, modelName string) bool {
+	url, err := crossmodel.ParseOfferURL(offerURL)
+	if err != nil {
+		return false
+	}
+	return url.ModelName == modelName
+}
+
+// OfferURLsMatch returns whether two offer URLs refer to the same offer.
+// The controller hosting the offer and the owner of its model are only
+// compared when both URLs include them, as the URL of a consumed offer
+// reported by the controller may differ from the URL used to consume it,
+// e.g. when the offer is hosted on another controller.
+func OfferURLsMatch(a, b string) bool {
+	if a == b {
+		return true
+	}
+	urlA, err := crossmodel.ParseOfferURL(a)
+	if err != nil {
+		return false
+	}
+	urlB, err := crossmodel.ParseOfferURL(b)
+	if err != nil {
+		return false
+	}
+	if urlA.Source != "" && urlB.Source != "" && urlA.Source != urlB.Source {
+		return false
+	}
+	if urlA.User != "" && urlB.User != "" && urlA.User != urlB.User {
+		return false
+	}
+	return urlA.ModelName == urlB.ModelName && urlA.ApplicationName == urlB.ApplicationName
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOfferURLsMatch(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		match bool
+	}{
+		{"admin/db.postgresql", "admin/db.postgresql", true},
+		{"prod:admin/db.postgresql", "admin/db.postgresql", true},
+		{"db.postgresql", "prod:admin/db.postgresql", true},
+		{"prod:admin/db.postgresql", "staging:admin/db.postgresql", false},
+		{"alice/db.postgresql", "admin/db.postgresql", false},
+		{"admin/db.postgresql", "admin/db.mysql", false},
+		{"admin/db.postgresql", "admin/other.postgresql", false},
+		{"not an offer", "admin/db.postgresql", false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.match, OfferURLsMatch(test.a, test.b), "%q and %q", test.a, test.b)
+	}
+}
+
+func TestIsLocalOffer(t *testing.T) {
+	modelUUID := "f3a2b6a4-3d41-4c2e-8d8b-0f5c7a4b2e11"
+	offer := &params.ApplicationOfferDetailsV5{
+		SourceModelTag: names.NewModelTag(modelUUID).String(),
+	}
+	assert.True(t, isLocalOffer(params.ConsumeOfferDetails{Offer: offer}, modelUUID))
+	assert.False(t, isLocalOffer(params.ConsumeOfferDetails{Offer: offer}, "9d1c3c5e-7b0a-4d8e-a1f4-6c2b9e8d7a30"))
+	assert.False(t, isLocalOffer(params.ConsumeOfferDetails{
+		Offer:          offer,
+		ControllerInfo: &params.ExternalControllerInfo{ControllerTag: "controller-prod"},
+	}, modelUUID))
+	assert.False(t, isLocalOffer(params.ConsumeOfferDetails{}, modelUUID))
+}
+
+func TestOfferInModel(t *testing.T) {
+	assert.True(t, offerInModel("admin/db.postgresql", "db"))
+	assert.True(t, offerInModel("prod:admin/db.postgresql", "db"))
+	assert.False(t, offerInModel("admin/db.postgresql", "app"))
+	assert.False(t, offerInModel("not an offer", "db"))
+}
+
+func TestDestroyOfferCancelled(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	conn := NewMockConnection(ctlr)
+	conn.EXPECT().BestFacadeVersion("ApplicationOffers").Return(5).AnyTimes()
+	// The offer is still connected, destroying it waits for the
+	// connections to be removed.
+	conn.EXPECT().APICall("ApplicationOffers", 5, "", "ApplicationOffers", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ string, _ int, _, _ string, _, response interface{}) error {
+			*response.(*params.ApplicationOffersResults) = params.ApplicationOffersResults{Results: []params.ApplicationOfferResult{{
+				Result: &params.ApplicationOfferAdminDetailsV5{
+					ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{OfferURL: "admin/development.postgresql"},
+					Connections: []params.OfferConnection{{
+						SourceModelTag: names.NewModelTag("e7cbd4a8-7b3a-4a0e-8c55-8c6a8e0a1c2d").String(),
+					}},
+				},
+			}}}
+			return nil
+		})
+	conn.EXPECT().Close().Return(nil)
+	sharedClient := NewMockSharedClient(ctlr)
+	sharedClient.EXPECT().GetConnection(gomock.Any(), nil).Return(conn, nil)
+
+	// The wait stops with the operation, the offer is not destroyed.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := newOffersClient(sharedClient).DestroyOffer(ctx, &DestroyOfferInput{OfferURL: "admin/development.postgresql"})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedFieldKeys are the keys of the log fields whose value is never
+// written to the logs, as they hold credential attributes, kubeconfig
+// contents or secret values.
+var redactedFieldKeys = []string{
+	"attributes",
+	"ca_certificate",
+	"client_secret",
+	"credential",
+	"kubernetes_config",
+	"password",
+	"secret",
+	"token",
+	"value",
+	"values",
+}
+
+// redactedRegexes match sensitive values wherever they appear in log
+// messages and field values: PEM private keys, and the values of
+// credential attributes as written in kubeconfig files and juju
+// credentials.
+var redactedRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`),
+	regexp.MustCompile(`(?i)"?(client-key-data|client-certificate-data|password|client-secret|client_secret|token)"?\s*[:=]\s*"?[^\s",}]+"?`),
+}
+
+// newRedactedSubsystem returns a context with a new tflog subsystem
+// whose output is redacted: the values of sensitive fields and the
+// given sensitive strings, such as the password used to connect to the
+// controller, are masked.
+func newRedactedSubsystem(ctx context.Context, subsystem string, sensitive ...string) context.Context {
+	ctx = tflog.NewSubsystem(ctx, subsystem)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, redactedFieldKeys...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, subsystem, redactedRegexes...)
+	ctx = tflog.SubsystemMaskMessageRegexes(ctx, subsystem, redactedRegexes...)
+
+	// An empty string would mask every message.
+	var values []string
+	for _, s := range sensitive {
+		if s != "" {
+			values = append(values, s)
+		}
+	}
+	if len(values) > 0 {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, subsystem, values...)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, subsystem, values...)
+	}
+	return ctx
+}
+
+// NewLogSubsystem returns a context with a new tflog subsystem for the
+// resources and data sources of the provider. Its output is redacted so
+// that debug logging can be enabled safely, e.g. in CI.
+func (c Client) NewLogSubsystem(ctx context.Context, subsystem string) context.Context {
+	return newRedactedSubsystem(ctx, subsystem, c.redactedValues...)
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactedSubsystem(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = newRedactedSubsystem(ctx, "test", "hunter2", "")
+
+	tflog.SubsystemDebug(ctx, "test", "connecting with password hunter2", map[string]interface{}{
+		"values":     map[string]string{"key": "s3cret"},
+		"kubeconfig": "users:\n- user:\n    client-key-data: TUlJRXZR\n",
+		"model":      "default",
+	})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	assert.Equal(t, "connecting with password ***", entry["@message"])
+	assert.Equal(t, "***", entry["values"])
+	assert.NotContains(t, entry["kubeconfig"], "TUlJRXZR")
+	assert.Equal(t, "default", entry["model"])
+}
@@ -0,0 +1,228 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/juju/api"
+	apiannotations "github.com/juju/juju/api/client/annotations"
+	apiapplication "github.com/juju/juju/api/client/application"
+	apistorage "github.com/juju/juju/api/client/storage"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/names/v5"
+	"github.com/juju/retry"
+)
+
+// RetainedStorageAnnotationPrefix prefixes the name of the application
+// in the key of the model annotation recording the storage retained when
+// the application was destroyed, e.g. terraform-retained-storage-mysql.
+const RetainedStorageAnnotationPrefix = "terraform-retained-storage-"
+
+// retainedStorageAnnotation returns the key of the model annotation
+// recording the storage retained for the application.
+func retainedStorageAnnotation(appName string) string {
+	return RetainedStorageAnnotationPrefix + appName
+}
+
+// unitStorage returns the IDs of the persistent storage owned by or
+// attached to the units of the application, grouped by unit in the
+// order of the unit numbers. Storage which is not persistent does not
+// outlive the machine of its unit and cannot be retained.
+func unitStorage(details []params.StorageDetails, appName string) [][]string {
+	byUnit := make(map[string][]string)
+	for _, detail := range details {
+		if !detail.Persistent {
+			continue
+		}
+		storageTag, err := names.ParseStorageTag(detail.StorageTag)
+		if err != nil {
+			continue
+		}
+		unitTags := []string{detail.OwnerTag}
+		for unitTag := range detail.Attachments {
+			unitTags = append(unitTags, unitTag)
+		}
+		for _, tag := range unitTags {
+			unitTag, err := names.ParseUnitTag(tag)
+			if err != nil || unitTag.Id() == "" {
+				continue
+			}
+			if appOfUnit, _ := names.UnitApplication(unitTag.Id()); appOfUnit != appName {
+				continue
+			}
+			byUnit[unitTag.Id()] = append(byUnit[unitTag.Id()], storageTag.Id())
+			break
+		}
+	}
+
+	units := make([]string, 0, len(byUnit))
+	for unit := range byUnit {
+		units = append(units, unit)
+	}
+	sort.Slice(units, func(i, j int) bool {
+		a, _ := names.UnitNumber(units[i])
+		b, _ := names.UnitNumber(units[j])
+		return a < b
+	})
+	groups := make([][]string, len(units))
+	for i, unit := range units {
+		groups[i] = byUnit[unit]
+		sort.Strings(groups[i])
+	}
+	return groups
+}
+
+// encodeRetainedStorage returns the storage IDs grouped by unit as the
+// value of the retained storage annotation, e.g. "data/0,logs/1;data/2".
+func encodeRetainedStorage(groups [][]string) string {
+	encoded := make([]string, len(groups))
+	for i, group := range groups {
+		encoded[i] = strings.Join(group, ",")
+	}
+	return strings.Join(encoded, ";")
+}
+
+// decodeRetainedStorage parses the value of the retained storage
+// annotation.
+func decodeRetainedStorage(value string) ([][]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var groups [][]string
+	for _, encoded := range strings.Split(value, ";") {
+		group := strings.Split(encoded, ",")
+		for _, id := range group {
+			if !names.IsValidStorage(id) {
+				return nil, fmt.Errorf("invalid retained storage ID %q", id)
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// retainStorage records the persistent storage of the units of the
+// application in a model annotation, so that it can be attached to the
+// units of an application with the same name created later on.
+func (c applicationsClient) retainStorage(conn api.Connection, appName string) error {
+	details, err := apistorage.NewClient(conn).ListStorageDetails()
+	if err != nil {
+		return err
+	}
+	groups := unitStorage(details, appName)
+	c.Tracef("retaining storage of application", map[string]interface{}{"application": appName, "storage": groups})
+	if len(groups) == 0 {
+		return nil
+	}
+	return setModelAnnotation(conn, retainedStorageAnnotation(appName), encodeRetainedStorage(groups))
+}
+
+// readRetainedStorage returns the storage retained when an application
+// with the given name was destroyed, grouped by unit.
+func readRetainedStorage(conn api.Connection, appName string) ([][]string, error) {
+	modelTag, ok := conn.ModelTag()
+	if !ok {
+		return nil, fmt.Errorf("retained storage requires a model connection")
+	}
+	results, err := apiannotations.NewClient(conn).Get([]string{modelTag.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("expected one result for entity %q, got %d", modelTag.String(), len(results))
+	}
+	if results[0].Error.Error != nil {
+		return nil, results[0].Error.Error
+	}
+	return decodeRetainedStorage(results[0].Annotations[retainedStorageAnnotation(appName)])
+}
+
+// setModelAnnotation sets an annotation of the model the connection is
+// to, an empty value removes it.
+func setModelAnnotation(conn api.Connection, key, value string) error {
+	modelTag, ok := conn.ModelTag()
+	if !ok {
+		return fmt.Errorf("annotating the model requires a model connection")
+	}
+	results, err := apiannotations.NewClient(conn).Set(map[string]map[string]string{
+		modelTag.String(): {key: value},
+	})
+	if err != nil {
+		return err
+	}
+	// Only the failures are returned by the controller.
+	for _, result := range results {
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+// reattachStorage adds a unit to the application for each group of
+// retained storage, attaching the storage to it, once the storage has
+// been detached from the units of the destroyed application. The
+// placement directives are used in order for the units added.
+func (c applicationsClient) reattachStorage(ctx context.Context, conn api.Connection, applicationAPIClient *apiapplication.Client, appName string, groups [][]string, placement []*instance.Placement) error {
+	var tags []names.StorageTag
+	for _, group := range groups {
+		for _, id := range group {
+			tags = append(tags, names.NewStorageTag(id))
+		}
+	}
+	storageAPIClient := apistorage.NewClient(conn)
+	err := retry.Call(retry.CallArgs{
+		Func: func() error {
+			results, err := storageAPIClient.StorageDetails(tags)
+			if err != nil {
+				return err
+			}
+			for _, result := range results {
+				if result.Error != nil {
+					return result.Error
+				}
+				if len(result.Result.Attachments) > 0 {
+					return &retryReadError{msg: fmt.Sprintf("storage %q still attached", result.Result.StorageTag)}
+				}
+			}
+			return nil
+		},
+		IsFatalError: func(err error) bool {
+			return !errors.As(err, &RetryReadError)
+		},
+		NotifyFunc: func(err error, attempt int) {
+			c.Tracef("waiting for retained storage to be detached", map[string]interface{}{"attempt": attempt, "err": err})
+		},
+		Attempts: 60,
+		Delay:    5 * time.Second,
+		Clock:    clock.WallClock,
+		Stop:     ctx.Done(),
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for retained storage to be detached: %w", retry.LastError(err))
+	}
+
+	for i, group := range groups {
+		args := apiapplication.AddUnitsParams{
+			ApplicationName: appName,
+			NumUnits:        1,
+			AttachStorage:   group,
+		}
+		if i < len(placement) {
+			args.Placement = []*instance.Placement{placement[i]}
+		}
+		if _, err := applicationAPIClient.AddUnits(args); err != nil {
+			return fmt.Errorf("attaching retained storage %s: %w", strings.Join(group, ", "), err)
+		}
+	}
+	return nil
+}
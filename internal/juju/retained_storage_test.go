@@ -0,0 +1,83 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/suite"
+)
+
+type RetainedStorageSuite struct {
+	suite.Suite
+}
+
+func (s *RetainedStorageSuite) TestUnitStorage() {
+	details := []params.StorageDetails{
+		{
+			StorageTag:  "storage-data-10",
+			OwnerTag:    "unit-mysql-10",
+			Persistent:  true,
+			Attachments: map[string]params.StorageAttachmentDetails{"unit-mysql-10": {}},
+		},
+		{
+			StorageTag:  "storage-logs-3",
+			OwnerTag:    "unit-mysql-2",
+			Persistent:  true,
+			Attachments: map[string]params.StorageAttachmentDetails{"unit-mysql-2": {}},
+		},
+		{
+			StorageTag:  "storage-data-2",
+			OwnerTag:    "unit-mysql-2",
+			Persistent:  true,
+			Attachments: map[string]params.StorageAttachmentDetails{"unit-mysql-2": {}},
+		},
+		{
+			// Shared storage owned by the application.
+			StorageTag:  "storage-shared-4",
+			OwnerTag:    "application-mysql",
+			Persistent:  true,
+			Attachments: map[string]params.StorageAttachmentDetails{"unit-mysql-10": {}},
+		},
+		{
+			// Storage not outliving the machine is not retained.
+			StorageTag: "storage-tmp-5",
+			OwnerTag:   "unit-mysql-2",
+		},
+		{
+			StorageTag: "storage-data-6",
+			OwnerTag:   "unit-mysql-router-0",
+			Persistent: true,
+		},
+	}
+	s.Assert().Equal([][]string{
+		{"data/2", "logs/3"},
+		{"data/10", "shared/4"},
+	}, unitStorage(details, "mysql"))
+	s.Assert().Empty(unitStorage(details, "postgresql"))
+}
+
+func (s *RetainedStorageSuite) TestEncodeDecodeRetainedStorage() {
+	groups := [][]string{{"data/2", "logs/3"}, {"data/10"}}
+	encoded := encodeRetainedStorage(groups)
+	s.Assert().Equal("data/2,logs/3;data/10", encoded)
+
+	decoded, err := decodeRetainedStorage(encoded)
+	s.Require().NoError(err)
+	s.Assert().Equal(groups, decoded)
+
+	decoded, err = decodeRetainedStorage("")
+	s.Require().NoError(err)
+	s.Assert().Empty(decoded)
+
+	_, err = decodeRetainedStorage("data/2;data")
+	s.Assert().Error(err)
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run
+func TestRetainedStorageSuite(t *testing.T) {
+	suite.Run(t, new(RetainedStorageSuite))
+}
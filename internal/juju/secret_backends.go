@@ -0,0 +1,186 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	apisecretbackends "github.com/juju/juju/api/client/secretbackends"
+	"gopkg.in/yaml.v2"
+)
+
+var SecretBackendNotFoundError = &secretBackendNotFoundError{}
+
+type secretBackendNotFoundError struct {
+	name string
+}
+
+func (se *secretBackendNotFoundError) Error() string {
+	return fmt.Sprintf("secret backend %q was not found", se.name)
+}
+
+type secretBackendsClient struct {
+	SharedClient
+}
+
+type CreateSecretBackendInput struct {
+	Name        string
+	BackendType string
+	// TokenRotateInterval is how often the token used by juju to access
+	// the backend is rotated, zero to never rotate it.
+	TokenRotateInterval time.Duration
+	Config              map[string]string
+}
+
+type ReadSecretBackendResponse struct {
+	Name                string
+	BackendType         string
+	TokenRotateInterval time.Duration
+	// Config holds the revealed config of the backend, including its
+	// token.
+	Config     map[string]string
+	NumSecrets int
+	Status     string
+	Message    string
+}
+
+type UpdateSecretBackendInput struct {
+	Name string
+	// TokenRotateInterval is only updated if not nil, zero to never
+	// rotate the token.
+	TokenRotateInterval *time.Duration
+	Config              map[string]string
+	Reset               []string
+}
+
+func newSecretBackendsClient(sc SharedClient) *secretBackendsClient {
+	return &secretBackendsClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateSecretBackend registers an external secret backend, e.g. vault,
+// on the controller.
+func (c *secretBackendsClient) CreateSecretBackend(ctx context.Context, input *CreateSecretBackendInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	backend := apisecretbackends.CreateSecretBackend{
+		Name:        input.Name,
+		BackendType: input.BackendType,
+		Config:      secretBackendConfig(input.Config),
+	}
+	if input.TokenRotateInterval != 0 {
+		backend.TokenRotateInterval = &input.TokenRotateInterval
+	}
+	return apisecretbackends.NewClient(conn).AddSecretBackend(backend)
+}
+
+// ReadSecretBackend returns the type, token rotate interval and revealed
+// config of a secret backend.
+func (c *secretBackendsClient) ReadSecretBackend(ctx context.Context, name string) (*ReadSecretBackendResponse, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	backends, err := apisecretbackends.NewClient(conn).ListSecretBackends([]string{name}, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, backend := range backends {
+		if backend.Name != name {
+			continue
+		}
+		if backend.Error != nil {
+			return nil, backend.Error
+		}
+		response := &ReadSecretBackendResponse{
+			Name:        backend.Name,
+			BackendType: backend.BackendType,
+			Config:      make(map[string]string, len(backend.Config)),
+			NumSecrets:  backend.NumSecrets,
+			Status:      string(backend.Status),
+			Message:     backend.Message,
+		}
+		if backend.TokenRotateInterval != nil {
+			response.TokenRotateInterval = *backend.TokenRotateInterval
+		}
+		for k, v := range backend.Config {
+			if response.Config[k], err = configValueString(v); err != nil {
+				return nil, errors.Annotatef(err, "secret backend config %q", k)
+			}
+		}
+		return response, nil
+	}
+	return nil, &secretBackendNotFoundError{name: name}
+}
+
+// UpdateSecretBackend sets the given config keys of a secret backend,
+// resets the keys to reset and updates its token rotate interval.
+func (c *secretBackendsClient) UpdateSecretBackend(ctx context.Context, input *UpdateSecretBackendInput) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return apisecretbackends.NewClient(conn).UpdateSecretBackend(apisecretbackends.UpdateSecretBackend{
+		Name:                input.Name,
+		TokenRotateInterval: input.TokenRotateInterval,
+		Config:              secretBackendConfig(input.Config),
+		Reset:               input.Reset,
+	}, false)
+}
+
+// DestroySecretBackend removes a secret backend. The controller refuses
+// to remove a backend which still holds secrets.
+func (c *secretBackendsClient) DestroySecretBackend(ctx context.Context, name string) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return apisecretbackends.NewClient(conn).RemoveSecretBackend(name, false)
+}
+
+// secretBackendConfig converts the config of a secret backend to the type
+// expected by the API. Lists, such as the `ca-certs` of a kubernetes
+// backend, are given in YAML or JSON, e.g. `[a, b]`.
+func secretBackendConfig(config map[string]string) map[string]interface{} {
+	values := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		values[k] = v
+		if !strings.HasPrefix(strings.TrimSpace(v), "[") {
+			continue
+		}
+		var list []interface{}
+		if err := yaml.Unmarshal([]byte(v), &list); err == nil {
+			values[k] = list
+		}
+	}
+	return values
+}
+
+// SecretBackendConfigValueEqual returns true if both config values of a
+// secret backend have the same meaning for juju, e.g. the lists `[a, b]`
+// and `["a","b"]`.
+func SecretBackendConfigValueEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	values := secretBackendConfig(map[string]string{"a": a, "b": b})
+	valueA, errA := configValueString(values["a"])
+	valueB, errB := configValueString(values["b"])
+	return errA == nil && errB == nil && valueA == valueB
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretBackendConfig(t *testing.T) {
+	config := secretBackendConfig(map[string]string{
+		"endpoint":        "https://vault.example.com:8200",
+		"tls-skip-verify": "true",
+		"ca-certs":        "[cert-a, cert-b]",
+		"token":           "[not a list",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"endpoint":        "https://vault.example.com:8200",
+		"tls-skip-verify": "true",
+		"ca-certs":        []interface{}{"cert-a", "cert-b"},
+		"token":           "[not a list",
+	}, config)
+}
+
+func TestSecretBackendConfigValueEqual(t *testing.T) {
+	assert.True(t, SecretBackendConfigValueEqual("[a, b]", `["a","b"]`))
+	assert.True(t, SecretBackendConfigValueEqual("vault", "vault"))
+	assert.False(t, SecretBackendConfigValueEqual("[a, b]", `["b","a"]`))
+	assert.False(t, SecretBackendConfigValueEqual("a", "b"))
+}
@@ -4,6 +4,7 @@
 package juju
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -110,9 +111,11 @@ func newSecretsClient(sc SharedClient) *secretsClient {
 	}
 }
 
-// CreateSecret creates a new secret.
-func (c *secretsClient) CreateSecret(input *CreateSecretInput) (CreateSecretOutput, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+// CreateSecret creates a new secret. User secrets have no rotation
+// policy nor expiry time: the Secrets facade ignores them when creating
+// or updating a user secret.
+func (c *secretsClient) CreateSecret(ctx context.Context, input *CreateSecretInput) (CreateSecretOutput, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return CreateSecretOutput{}, err
 	}
@@ -140,8 +143,8 @@ func (c *secretsClient) CreateSecret(input *CreateSecretInput) (CreateSecretOutp
 }
 
 // ReadSecret reads a secret.
-func (c *secretsClient) ReadSecret(input *ReadSecretInput) (ReadSecretOutput, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c *secretsClient) ReadSecret(ctx context.Context, input *ReadSecretInput) (ReadSecretOutput, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return ReadSecretOutput{}, err
 	}
@@ -194,8 +197,8 @@ func (c *secretsClient) ReadSecret(input *ReadSecretInput) (ReadSecretOutput, er
 }
 
 // UpdateSecret updates a secret.
-func (c *secretsClient) UpdateSecret(input *UpdateSecretInput) error {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c *secretsClient) UpdateSecret(ctx context.Context, input *UpdateSecretInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -255,8 +258,8 @@ func (c *secretsClient) UpdateSecret(input *UpdateSecretInput) error {
 }
 
 // DeleteSecret deletes a secret.
-func (c *secretsClient) DeleteSecret(input *DeleteSecretInput) error {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c *secretsClient) DeleteSecret(ctx context.Context, input *DeleteSecretInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -276,8 +279,8 @@ func (c *secretsClient) DeleteSecret(input *DeleteSecretInput) error {
 }
 
 // UpdateAccessSecret updates access to a secret.
-func (c *secretsClient) UpdateAccessSecret(input *GrantRevokeAccessSecretInput, op AccessSecretAction) error {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c *secretsClient) UpdateAccessSecret(ctx context.Context, input *GrantRevokeAccessSecretInput, op AccessSecretAction) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
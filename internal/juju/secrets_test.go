@@ -4,6 +4,7 @@
 package juju
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"testing"
@@ -57,7 +58,7 @@ func (s *SecretSuite) TestCreateSecret() {
 	).Return(secretURI.ID, nil).AnyTimes()
 
 	client := s.getSecretsClient()
-	output, err := client.CreateSecret(&CreateSecretInput{
+	output, err := client.CreateSecret(context.Background(), &CreateSecretInput{
 		ModelName: *s.testModelName,
 		Name:      "test-secret",
 		Value:     decodedValue,
@@ -83,7 +84,7 @@ func (s *SecretSuite) TestCreateSecretError() {
 	).Return("", errBoom).AnyTimes()
 
 	client := s.getSecretsClient()
-	output, err := client.CreateSecret(&CreateSecretInput{
+	output, err := client.CreateSecret(context.Background(), &CreateSecretInput{
 		ModelName: *s.testModelName,
 		Name:      "test-secret",
 		Value:     decodedValue,
@@ -129,7 +130,7 @@ func (s *SecretSuite) TestReadSecret() {
 	}, nil).AnyTimes()
 
 	client := s.getSecretsClient()
-	output, err := client.ReadSecret(&ReadSecretInput{
+	output, err := client.ReadSecret(context.Background(), &ReadSecretInput{
 		SecretId:  secretId,
 		ModelName: *s.testModelName,
 		Name:      &secretName,
@@ -161,7 +162,7 @@ func (s *SecretSuite) TestReadSecretError() {
 	}, nil).AnyTimes()
 
 	client := s.getSecretsClient()
-	output, err := client.ReadSecret(&ReadSecretInput{
+	output, err := client.ReadSecret(context.Background(), &ReadSecretInput{
 		SecretId:  secretId,
 		ModelName: *s.testModelName,
 	})
@@ -191,7 +192,7 @@ func (s *SecretSuite) TestUpdateSecretWithRenaming() {
 	).Return(nil).AnyTimes()
 
 	client := s.getSecretsClient()
-	err = client.UpdateSecret(&UpdateSecretInput{
+	err = client.UpdateSecret(context.Background(), &UpdateSecretInput{
 		SecretId:  secretId,
 		ModelName: *s.testModelName,
 		Name:      &newSecretName,
@@ -220,7 +221,7 @@ func (s *SecretSuite) TestUpdateSecretWithRenaming() {
 	}, nil).Times(1)
 
 	// read secret and check if value is updated
-	output, err := client.ReadSecret(&ReadSecretInput{
+	output, err := client.ReadSecret(context.Background(), &ReadSecretInput{
 		SecretId:  secretId,
 		ModelName: *s.testModelName,
 	})
@@ -248,7 +249,7 @@ func (s *SecretSuite) TestUpdateSecret() {
 	).Return(nil).AnyTimes()
 
 	client := s.getSecretsClient()
-	err = client.UpdateSecret(&UpdateSecretInput{
+	err = client.UpdateSecret(context.Background(), &UpdateSecretInput{
 		SecretId:  secretId,
 		ModelName: *s.testModelName,
 		Value:     &decodedValue,
@@ -277,7 +278,7 @@ func (s *SecretSuite) TestUpdateSecret() {
 	}, nil).Times(1)
 
 	// read secret and check if secret info is updated
-	output, err := client.ReadSecret(&ReadSecretInput{
+	output, err := client.ReadSecret(context.Background(), &ReadSecretInput{
 		SecretId:  secretId,
 		ModelName: *s.testModelName,
 	})
@@ -298,7 +299,7 @@ func (s *SecretSuite) TestDeleteSecret() {
 	s.mockSecretClient.EXPECT().RemoveSecret(secretURI, "", nil).Return(nil).AnyTimes()
 
 	client := s.getSecretsClient()
-	err = client.DeleteSecret(&DeleteSecretInput{
+	err = client.DeleteSecret(context.Background(), &DeleteSecretInput{
 		SecretId:  secretId,
 		ModelName: *s.testModelName,
 	})
@@ -319,14 +320,14 @@ func (s *SecretSuite) TestUpdateAccessSecret() {
 	s.mockSecretClient.EXPECT().RevokeSecret(secretURI, "", applications).Return([]error{nil}, nil).AnyTimes()
 
 	client := s.getSecretsClient()
-	err = client.UpdateAccessSecret(&GrantRevokeAccessSecretInput{
+	err = client.UpdateAccessSecret(context.Background(), &GrantRevokeAccessSecretInput{
 		SecretId:     secretId,
 		ModelName:    *s.testModelName,
 		Applications: applications,
 	}, GrantAccess)
 	s.Require().NoError(err)
 
-	err = client.UpdateAccessSecret(&GrantRevokeAccessSecretInput{
+	err = client.UpdateAccessSecret(context.Background(), &GrantRevokeAccessSecretInput{
 		SecretId:     secretId,
 		ModelName:    *s.testModelName,
 		Applications: applications,
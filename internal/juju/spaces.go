@@ -0,0 +1,188 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apispaces "github.com/juju/juju/api/client/spaces"
+	apisubnets "github.com/juju/juju/api/client/subnets"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/names/v5"
+)
+
+var SpaceNotFoundError = &spaceNotFoundError{}
+
+type spaceNotFoundError struct {
+	name string
+}
+
+func (se *spaceNotFoundError) Error() string {
+	return fmt.Sprintf("space %q was not found", se.name)
+}
+
+type spacesClient struct {
+	SharedClient
+}
+
+type CreateSpaceInput struct {
+	ModelName string
+	Name      string
+	CIDRs     []string
+}
+
+type ReadSpaceInput struct {
+	ModelName string
+	Name      string
+}
+
+type ReadSpaceResponse struct {
+	Name string
+	// CIDRs are the sorted CIDRs of the subnets in the space.
+	CIDRs []string
+}
+
+type UpdateSpaceInput struct {
+	ModelName string
+	Name      string
+	NewName   string
+	// AddCIDRs are the CIDRs of the subnets to move into the space.
+	AddCIDRs []string
+	// RemoveCIDRs are the CIDRs of the subnets to move back to the
+	// alpha space.
+	RemoveCIDRs []string
+}
+
+type DestroySpaceInput struct {
+	ModelName string
+	Name      string
+}
+
+func newSpacesClient(sc SharedClient) *spacesClient {
+	return &spacesClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateSpace creates a space made of the subnets with the given CIDRs.
+func (c *spacesClient) CreateSpace(ctx context.Context, input *CreateSpaceInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apispaces.NewAPI(conn)
+	return client.CreateSpace(input.Name, input.CIDRs, false)
+}
+
+// ReadSpace returns the name and subnets of a space.
+func (c *spacesClient) ReadSpace(ctx context.Context, input *ReadSpaceInput) (*ReadSpaceResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apispaces.NewAPI(conn)
+	result, err := client.ShowSpace(input.Name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, &spaceNotFoundError{name: input.Name}
+		}
+		return nil, err
+	}
+
+	cidrs := make([]string, 0, len(result.Space.Subnets))
+	for _, subnet := range result.Space.Subnets {
+		cidrs = append(cidrs, subnet.CIDR)
+	}
+	sort.Strings(cidrs)
+	return &ReadSpaceResponse{
+		Name:  result.Space.Name,
+		CIDRs: cidrs,
+	}, nil
+}
+
+// UpdateSpace moves subnets in and out of a space, then renames it.
+func (c *spacesClient) UpdateSpace(ctx context.Context, input *UpdateSpaceInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apispaces.NewAPI(conn)
+	subnetsClient := apisubnets.NewAPI(conn)
+
+	moveSubnets := func(space string, cidrs []string) error {
+		if len(cidrs) == 0 {
+			return nil
+		}
+		results, err := subnetsClient.SubnetsByCIDR(cidrs)
+		if err != nil {
+			return err
+		}
+		var tags []names.SubnetTag
+		for i, result := range results {
+			if len(result.Subnets) == 0 {
+				return fmt.Errorf("subnet %q not found", cidrs[i])
+			}
+			for _, subnet := range result.Subnets {
+				tags = append(tags, names.NewSubnetTag(subnet.ID))
+			}
+		}
+		_, err = client.MoveSubnets(names.NewSpaceTag(space), tags, false)
+		return err
+	}
+
+	if err := moveSubnets(input.Name, input.AddCIDRs); err != nil {
+		return fmt.Errorf("moving subnets to space %q: %w", input.Name, err)
+	}
+	if err := moveSubnets(network.AlphaSpaceName, input.RemoveCIDRs); err != nil {
+		return fmt.Errorf("moving subnets to space %q: %w", network.AlphaSpaceName, err)
+	}
+
+	if input.NewName != "" && input.NewName != input.Name {
+		if err := client.RenameSpace(input.Name, input.NewName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DestroySpace removes a space, its subnets are moved back to the
+// alpha space.
+func (c *spacesClient) DestroySpace(ctx context.Context, input *DestroySpaceInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apispaces.NewAPI(conn)
+	result, err := client.RemoveSpace(input.Name, false, false)
+	if err != nil {
+		return err
+	}
+	// The space is not removed while it is in use, the result
+	// lists what still uses it.
+	var inUse []string
+	if len(result.Bindings) > 0 {
+		inUse = append(inUse, fmt.Sprintf("bound to %d application endpoint(s)", len(result.Bindings)))
+	}
+	if len(result.Constraints) > 0 {
+		inUse = append(inUse, fmt.Sprintf("used in %d constraint(s)", len(result.Constraints)))
+	}
+	if len(result.ControllerSettings) > 0 {
+		inUse = append(inUse, "used in controller config")
+	}
+	if len(inUse) > 0 {
+		return fmt.Errorf("space %q cannot be removed, it is %s", input.Name, strings.Join(inUse, ", "))
+	}
+	return nil
+}
@@ -4,9 +4,13 @@
 package juju
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/juju/juju/api/client/keymanager"
+	"github.com/juju/juju/api/client/modelmanager"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/utils/v3/ssh"
 
 	"github.com/juju/terraform-provider-juju/internal/utils"
@@ -42,8 +46,8 @@ func newSSHKeysClient(sc SharedClient) *sshKeysClient {
 	}
 }
 
-func (c *sshKeysClient) CreateSSHKey(input *CreateSSHKeyInput) error {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c *sshKeysClient) CreateSSHKey(ctx context.Context, input *CreateSSHKeyInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -74,8 +78,8 @@ func (c *sshKeysClient) CreateSSHKey(input *CreateSSHKeyInput) error {
 	return nil
 }
 
-func (c *sshKeysClient) ReadSSHKey(input *ReadSSHKeyInput) (*ReadSSHKeyOutput, error) {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c *sshKeysClient) ReadSSHKey(ctx context.Context, input *ReadSSHKeyInput) (*ReadSSHKeyOutput, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return nil, err
 	}
@@ -104,8 +108,8 @@ func (c *sshKeysClient) ReadSSHKey(input *ReadSSHKeyInput) (*ReadSSHKeyOutput, e
 	return nil, fmt.Errorf("no ssh key found for %s", input.KeyIdentifier)
 }
 
-func (c *sshKeysClient) DeleteSSHKey(input *DeleteSSHKeyInput) error {
-	conn, err := c.GetConnection(&input.ModelName)
+func (c *sshKeysClient) DeleteSSHKey(ctx context.Context, input *DeleteSSHKeyInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
 	if err != nil {
 		return err
 	}
@@ -150,3 +154,47 @@ func (c *sshKeysClient) DeleteSSHKey(input *DeleteSSHKeyInput) error {
 
 	return err
 }
+
+// ReadControllerAuthorizedKeys returns the authorized keys set as the
+// controller default for new models on the given cloud.
+func (c *sshKeysClient) ReadControllerAuthorizedKeys(ctx context.Context, cloud string) ([]string, error) {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+	defaults, err := client.ModelDefaults(cloud)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := defaults[config.AuthorizedKeysKey].Controller.(string)
+	if !ok {
+		return nil, nil
+	}
+	var keys []string
+	for _, key := range ssh.SplitAuthorisedKeys(value) {
+		keys = append(keys, strings.TrimSpace(key))
+	}
+	return keys, nil
+}
+
+// SetControllerAuthorizedKeys replaces the authorized keys set as the
+// controller default for new models on the given cloud. The default is
+// unset when no keys are provided.
+func (c *sshKeysClient) SetControllerAuthorizedKeys(ctx context.Context, cloud string, keys []string) error {
+	conn, err := c.GetConnection(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := modelmanager.NewClient(conn)
+	if len(keys) == 0 {
+		return client.UnsetModelDefaults(cloud, "", config.AuthorizedKeysKey)
+	}
+	return client.SetModelDefaults(cloud, "", map[string]interface{}{
+		config.AuthorizedKeysKey: strings.Join(keys, "\n"),
+	})
+}
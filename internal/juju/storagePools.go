@@ -0,0 +1,141 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"fmt"
+
+	apistorage "github.com/juju/juju/api/client/storage"
+)
+
+var StoragePoolNotFoundError = &storagePoolNotFoundError{}
+
+type storagePoolNotFoundError struct {
+	name string
+}
+
+func (se *storagePoolNotFoundError) Error() string {
+	return fmt.Sprintf("storage pool %q was not found", se.name)
+}
+
+type storagePoolsClient struct {
+	SharedClient
+}
+
+type CreateStoragePoolInput struct {
+	ModelName  string
+	Name       string
+	Provider   string
+	Attributes map[string]string
+}
+
+type ReadStoragePoolInput struct {
+	ModelName string
+	Name      string
+}
+
+type ReadStoragePoolResponse struct {
+	Name       string
+	Provider   string
+	Attributes map[string]string
+}
+
+type UpdateStoragePoolInput struct {
+	ModelName string
+	Name      string
+	Provider  string
+	// Attributes replace all the attributes of the storage pool.
+	Attributes map[string]string
+}
+
+type DestroyStoragePoolInput struct {
+	ModelName string
+	Name      string
+}
+
+func newStoragePoolsClient(sc SharedClient) *storagePoolsClient {
+	return &storagePoolsClient{
+		SharedClient: sc,
+	}
+}
+
+// CreateStoragePool creates a storage pool for the given storage provider.
+func (c *storagePoolsClient) CreateStoragePool(ctx context.Context, input *CreateStoragePoolInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+	return client.CreatePool(input.Name, input.Provider, storagePoolAttrs(input.Attributes))
+}
+
+// ReadStoragePool returns the storage provider and attributes of a storage pool.
+func (c *storagePoolsClient) ReadStoragePool(ctx context.Context, input *ReadStoragePoolInput) (*ReadStoragePoolResponse, error) {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+	pools, err := client.ListPools(nil, []string{input.Name})
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		if pool.Name != input.Name {
+			continue
+		}
+		attributes := make(map[string]string, len(pool.Attrs))
+		for key, value := range pool.Attrs {
+			attributes[key] = fmt.Sprint(value)
+		}
+		return &ReadStoragePoolResponse{
+			Name:       pool.Name,
+			Provider:   pool.Provider,
+			Attributes: attributes,
+		}, nil
+	}
+	return nil, &storagePoolNotFoundError{name: input.Name}
+}
+
+// UpdateStoragePool replaces the storage provider and attributes of a
+// storage pool.
+func (c *storagePoolsClient) UpdateStoragePool(ctx context.Context, input *UpdateStoragePoolInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+	return client.UpdatePool(input.Name, input.Provider, storagePoolAttrs(input.Attributes))
+}
+
+// DestroyStoragePool removes a storage pool. The controller refuses to
+// remove a pool which is in use.
+func (c *storagePoolsClient) DestroyStoragePool(ctx context.Context, input *DestroyStoragePoolInput) error {
+	conn, err := c.GetConnection(ctx, &input.ModelName)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := apistorage.NewClient(conn)
+	return client.RemovePool(input.Name)
+}
+
+// storagePoolAttrs converts the attributes of a storage pool to the type
+// expected by the API. The map is never nil, as the controller adds the
+// name and provider of the pool to it.
+func storagePoolAttrs(attributes map[string]string) map[string]interface{} {
+	attrs := make(map[string]interface{}, len(attributes))
+	for key, value := range attributes {
+		attrs[key] = value
+	}
+	return attrs
+}
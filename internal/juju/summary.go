@@ -0,0 +1,90 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/errors"
+)
+
+// Operations recorded in the apply summary.
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// OperationSummary describes an operation performed on a resource during
+// an apply, in a machine-readable form.
+type OperationSummary struct {
+	Time      time.Time `json:"time"`
+	Resource  string    `json:"resource"`
+	ID        string    `json:"id"`
+	Operation string    `json:"operation"`
+	// Changes are keyed by attribute name, e.g. units or revision.
+	Changes map[string]OperationChange `json:"changes,omitempty"`
+}
+
+// OperationChange holds the values of an attribute before and after an
+// operation. Before is nil on create, after is nil on delete.
+type OperationChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// applySummary appends operation summaries, one JSON document per line,
+// to a file. Resources are applied concurrently, hence the mutex.
+type applySummary struct {
+	path string
+	mu   sync.Mutex
+}
+
+func (s *applySummary) write(summary OperationSummary) error {
+	line, err := json.Marshal(summary)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return errors.Trace(err)
+	}
+	return errors.Trace(f.Close())
+}
+
+// RecordOperation logs the summary of an operation performed on a
+// resource at info level, with the summary as structured fields, and
+// appends it to the apply summary file if one is configured. Failing to
+// write the summary is logged, it does not fail the operation.
+func (c Client) RecordOperation(ctx context.Context, summary OperationSummary) {
+	if summary.Time.IsZero() {
+		summary.Time = time.Now().UTC()
+	}
+	tflog.Info(ctx, "juju operation summary", map[string]interface{}{
+		"resource":  summary.Resource,
+		"id":        summary.ID,
+		"operation": summary.Operation,
+		"changes":   summary.Changes,
+	})
+	if c.summary == nil {
+		return
+	}
+	if err := c.summary.write(summary); err != nil {
+		tflog.Warn(ctx, "unable to write the apply summary", map[string]interface{}{
+			"path":  c.summary.path,
+			"error": err.Error(),
+		})
+	}
+}
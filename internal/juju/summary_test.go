@@ -0,0 +1,70 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordOperation(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	path := filepath.Join(t.TempDir(), "summary.jsonl")
+	client := Client{summary: &applySummary{path: path}}
+
+	client.RecordOperation(ctx, OperationSummary{
+		Resource:  "juju_application",
+		ID:        "default:app",
+		Operation: OperationUpdate,
+		Changes: map[string]OperationChange{
+			"units": {Before: 1, After: 3},
+		},
+	})
+	client.RecordOperation(ctx, OperationSummary{
+		Resource:  "juju_application",
+		ID:        "default:app",
+		Operation: OperationDelete,
+	})
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	var summary OperationSummary
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &summary))
+	assert.Equal(t, "juju_application", summary.Resource)
+	assert.Equal(t, OperationUpdate, summary.Operation)
+	assert.False(t, summary.Time.IsZero())
+	assert.Equal(t, OperationChange{Before: float64(1), After: float64(3)}, summary.Changes["units"])
+	assert.NotContains(t, lines[1], "changes")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "juju operation summary", entries[0]["@message"])
+	assert.Equal(t, "info", entries[0]["@level"])
+	assert.Equal(t, "update", entries[0]["operation"])
+}
+
+func TestRecordOperationWithoutFile(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	Client{}.RecordOperation(ctx, OperationSummary{Resource: "juju_model", Operation: OperationCreate})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "juju_model", entries[0]["resource"])
+}
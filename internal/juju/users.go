@@ -4,8 +4,10 @@
 package juju
 
 import (
+	"context"
 	"fmt"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/juju/api/client/usermanager"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v5"
@@ -44,6 +46,8 @@ type UpdateUserInput struct {
 	DisplayName string
 	User        string
 	Password    string
+	// Disabled, when set, disables or enables the user.
+	Disabled *bool
 }
 
 type DestroyUserInput struct {
@@ -56,8 +60,8 @@ func newUsersClient(sc SharedClient) *usersClient {
 	}
 }
 
-func (c *usersClient) CreateUser(input CreateUserInput) (*CreateUserResponse, error) {
-	conn, err := c.GetConnection(nil)
+func (c *usersClient) CreateUser(ctx context.Context, input CreateUserInput) (*CreateUserResponse, error) {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +77,8 @@ func (c *usersClient) CreateUser(input CreateUserInput) (*CreateUserResponse, er
 	return &CreateUserResponse{UserTag: userTag, Secret: userSecret}, nil
 }
 
-func (c *usersClient) ReadUser(name string) (*ReadUserResponse, error) {
-	usermanagerConn, err := c.GetConnection(nil)
+func (c *usersClient) ReadUser(ctx context.Context, name string) (*ReadUserResponse, error) {
+	usermanagerConn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,16 +86,18 @@ func (c *usersClient) ReadUser(name string) (*ReadUserResponse, error) {
 
 	usermanagerClient := usermanager.NewClient(usermanagerConn)
 
-	users, err := usermanagerClient.UserInfo([]string{name}, false) //don't list disabled users
+	users, err := usermanagerClient.UserInfo([]string{name}, usermanager.AllUsers)
 	if err != nil {
-		return nil, err
+		return nil, typedError(err)
 	}
 
 	if len(users) > 1 {
 		return nil, fmt.Errorf("more than one user returned for user name: %s", name)
 	}
 	if len(users) < 1 {
-		return nil, fmt.Errorf("no user returned for user name: %s", name)
+		// The user does not exist or is not visible to the
+		// identity of the provider.
+		return nil, jujuerrors.NotFoundf("user %q", name)
 	}
 
 	userInfo := users[0]
@@ -101,15 +107,15 @@ func (c *usersClient) ReadUser(name string) (*ReadUserResponse, error) {
 	}, nil
 }
 
-func (c *usersClient) ModelUserInfo(modelName string) (*ReadModelUserResponse, error) {
-	usermanagerConn, err := c.GetConnection(nil)
+func (c *usersClient) ModelUserInfo(ctx context.Context, modelName string) (*ReadModelUserResponse, error) {
+	usermanagerConn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = usermanagerConn.Close() }()
 	usermanagerClient := usermanager.NewClient(usermanagerConn)
 
-	uuid, err := c.ModelUUID(modelName)
+	uuid, err := c.ModelUUID(ctx, modelName)
 	if err != nil {
 		return nil, err
 	}
@@ -128,8 +134,8 @@ func (c *usersClient) ModelUserInfo(modelName string) (*ReadModelUserResponse, e
 	}, nil
 }
 
-func (c *usersClient) UpdateUser(input UpdateUserInput) error {
-	conn, err := c.GetConnection(nil)
+func (c *usersClient) UpdateUser(ctx context.Context, input UpdateUserInput) error {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -144,11 +150,22 @@ func (c *usersClient) UpdateUser(input UpdateUserInput) error {
 		}
 	}
 
+	if input.Disabled != nil {
+		if *input.Disabled {
+			err = client.DisableUser(input.Name)
+		} else {
+			err = client.EnableUser(input.Name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (c *usersClient) DestroyUser(input DestroyUserInput) error {
-	conn, err := c.GetConnection(nil)
+func (c *usersClient) DestroyUser(ctx context.Context, input DestroyUserInput) error {
+	conn, err := c.GetConnection(ctx, nil)
 	if err != nil {
 		return err
 	}
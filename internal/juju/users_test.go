@@ -0,0 +1,47 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"context"
+	"testing"
+
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReadUserNotReturned(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	conn := NewMockConnection(ctlr)
+	conn.EXPECT().BestFacadeVersion("UserManager").Return(3).AnyTimes()
+	// The user is not visible to the identity of the provider.
+	conn.EXPECT().APICall("UserManager", 3, "", "UserInfo", gomock.Any(), gomock.Any()).Return(nil)
+	conn.EXPECT().Close().Return(nil)
+	sharedClient := NewMockSharedClient(ctlr)
+	sharedClient.EXPECT().GetConnection(gomock.Any(), nil).Return(conn, nil)
+
+	_, err := newUsersClient(sharedClient).ReadUser(context.Background(), "alice@canonical.com")
+	assert.True(t, jujuerrors.Is(err, jujuerrors.NotFound), err)
+}
+
+func TestReadUserNotFound(t *testing.T) {
+	ctlr := gomock.NewController(t)
+	conn := NewMockConnection(ctlr)
+	conn.EXPECT().BestFacadeVersion("UserManager").Return(3).AnyTimes()
+	conn.EXPECT().APICall("UserManager", 3, "", "UserInfo", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ string, _ int, _, _ string, _, response interface{}) error {
+			*response.(*params.UserInfoResults) = params.UserInfoResults{Results: []params.UserInfoResult{{
+				Error: &params.Error{Code: params.CodeNotFound, Message: `user "bob" not found`},
+			}}}
+			return nil
+		})
+	conn.EXPECT().Close().Return(nil)
+	sharedClient := NewMockSharedClient(ctlr)
+	sharedClient.EXPECT().GetConnection(gomock.Any(), nil).Return(conn, nil)
+
+	_, err := newUsersClient(sharedClient).ReadUser(context.Background(), "bob")
+	assert.True(t, jujuerrors.Is(err, jujuerrors.NotFound), err)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	apiapplication "github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/jujuclient"
 	"github.com/juju/names/v5"
 )
 
@@ -46,70 +47,142 @@ type controllerConfig struct {
 	} `json:"account"`
 }
 
-// localProviderConfig is populated once and queried later
-// to avoid multiple juju CLI executions
-var localProviderConfig map[string]string
+// localControllerConfigs holds the configuration of each controller
+// queried via the juju CLI, keyed by controller name, to avoid multiple
+// juju CLI executions. The empty name is the current controller.
+var localControllerConfigs = map[string]map[string]string{}
 
-// singleQuery will be used to limit the number of CLI queries to ONE
-var singleQuery sync.Once
+// localControllerConfigsMu guards localControllerConfigs, as the
+// configurations of several aliased providers can be discovered at once.
+var localControllerConfigsMu sync.Mutex
 
 // GetLocalControllerConfig runs the locally installed juju command,
-// if available, to get the current controller configuration.
-func GetLocalControllerConfig() (map[string]string, bool) {
-	// populate the controller controllerConfig information only once
-	singleQuery.Do(populateControllerConfig)
-
-	return localProviderConfig, localProviderConfig == nil
+// if available, to get the configuration of the named controller, or of
+// the current controller if the name is empty.
+func GetLocalControllerConfig(controllerName string) (map[string]string, bool) {
+	localControllerConfigsMu.Lock()
+	defer localControllerConfigsMu.Unlock()
+
+	// populate the controller configuration only once per controller
+	config, ok := localControllerConfigs[controllerName]
+	if !ok {
+		config = populateControllerConfig(controllerName)
+		localControllerConfigs[controllerName] = config
+	}
+	return config, config == nil
 }
 
-// populateControllerConfig executes the local juju CLI command
-// to obtain the current controller configuration
-func populateControllerConfig() {
+// populateControllerConfig reads the configuration of a controller from
+// the files of the juju CLI client, falling back to executing the local
+// juju CLI command, e.g. when the files are elsewhere than JUJU_DATA.
+func populateControllerConfig(controllerName string) map[string]string {
+	config, err := controllerConfigFromClientStore(jujuclient.NewFileClientStore(), controllerName)
+	if err == nil {
+		logControllerConfig(controllerName, config)
+		return config
+	}
+	tflog.Debug(context.TODO(), "unable to read the juju CLI client files, invoking the juju CLI", map[string]interface{}{"error": err, "controller": controllerName})
+
 	// get the value from the juju provider
-	cmd := exec.Command("juju", "show-controller", "--show-password", "--format=json")
+	args := []string{"show-controller", "--show-password", "--format=json"}
+	if controllerName != "" {
+		args = append(args, controllerName)
+	}
+	cmd := exec.Command("juju", args...)
 
 	cmdData, err := cmd.Output()
 	if err != nil {
-		tflog.Error(context.TODO(), "error invoking juju CLI", map[string]interface{}{"error": err})
-		return
+		tflog.Error(context.TODO(), "error invoking juju CLI", map[string]interface{}{"error": err, "controller": controllerName})
+		return nil
 	}
 
-	// given that the CLI output is a map containing arbitrary keys
-	// (controllers) and fixed json structures, we have to do some
-	// workaround to populate the struct
-	var cliOutput interface{}
-	err = json.Unmarshal(cmdData, &cliOutput)
+	config, err = controllerConfigFromCLIOutput(cmdData)
 	if err != nil {
-		tflog.Error(context.TODO(), "error unmarshalling Juju CLI output", map[string]interface{}{"error": err})
-		return
+		tflog.Error(context.TODO(), "error reading provider configuration from Juju CLI", map[string]interface{}{"error": err})
+		return nil
 	}
 
-	// convert to the map and extract the only entry
-	controllerConfig := controllerConfig{}
-	for _, v := range cliOutput.(map[string]interface{}) {
-		// now v is a map[string]interface{} type
-		marshalled, err := json.Marshal(v)
-		if err != nil {
-			tflog.Error(context.TODO(), "error marshalling provider controllerConfig", map[string]interface{}{"error": err})
-			return
+	logControllerConfig(controllerName, config)
+	return config
+}
+
+// logControllerConfig logs the configuration found for the controller,
+// without the password.
+func logControllerConfig(controllerName string, config map[string]string) {
+	logged := make(map[string]string, len(config))
+	for k, v := range config {
+		if k == "JUJU_PASSWORD" {
+			v = "***"
 		}
-		// now we have a controllerConfig type
-		err = json.Unmarshal(marshalled, &controllerConfig)
+		logged[k] = v
+	}
+	tflog.Debug(context.TODO(), "local provider controllerConfig was set", map[string]interface{}{"controller": controllerName, "localProviderConfig": fmt.Sprintf("%#v", logged)})
+}
+
+// controllerConfigFromClientStore returns the provider configuration of
+// the named controller, or of the current one if the name is empty, from
+// the controllers.yaml and accounts.yaml files of the juju CLI client, in
+// JUJU_DATA or ~/.local/share/juju by default.
+func controllerConfigFromClientStore(store jujuclient.ClientStore, controllerName string) (map[string]string, error) {
+	if controllerName == "" {
+		current, err := store.CurrentController()
 		if err != nil {
-			tflog.Error(context.TODO(), "error unmarshalling provider configuration from Juju CLI", map[string]interface{}{"error": err})
-			return
+			return nil, fmt.Errorf("reading the current controller: %w", err)
 		}
-		break
+		controllerName = current
+	}
+	controller, err := store.ControllerByName(controllerName)
+	if err != nil {
+		return nil, fmt.Errorf("reading controller %q: %w", controllerName, err)
+	}
+	account, err := store.AccountDetails(controllerName)
+	if err != nil {
+		return nil, fmt.Errorf("reading the account of controller %q: %w", controllerName, err)
+	}
+	// Accounts logged in without a password, e.g. with macaroons, cannot
+	// be used by the provider.
+	if account.Password == "" {
+		return nil, fmt.Errorf("the account of controller %q has no password", controllerName)
+	}
+
+	return map[string]string{
+		"JUJU_AGENT_VERSION":        controller.AgentVersion,
+		"JUJU_CONTROLLER_ADDRESSES": strings.Join(controller.APIEndpoints, ","),
+		"JUJU_CA_CERT":              controller.CACert,
+		"JUJU_USERNAME":             account.User,
+		"JUJU_PASSWORD":             account.Password,
+	}, nil
+}
+
+// controllerConfigFromCLIOutput returns the provider configuration from
+// the output of `juju show-controller --show-password --format=json`.
+func controllerConfigFromCLIOutput(cmdData []byte) (map[string]string, error) {
+	// given that the CLI output is a map containing arbitrary keys
+	// (controllers) and fixed json structures, we have to do some
+	// workaround to populate the struct
+	var cliOutput map[string]json.RawMessage
+	if err := json.Unmarshal(cmdData, &cliOutput); err != nil {
+		return nil, fmt.Errorf("unmarshalling Juju CLI output: %w", err)
+	}
+	if len(cliOutput) != 1 {
+		return nil, fmt.Errorf("expected the details of one controller, got %d", len(cliOutput))
 	}
 
-	localProviderConfig = map[string]string{}
-	localProviderConfig["JUJU_AGENT_VERSION"] = controllerConfig.ProviderDetails.AgentVersion
-	localProviderConfig["JUJU_CONTROLLER_ADDRESSES"] = strings.Join(controllerConfig.ProviderDetails.ApiEndpoints, ",")
-	localProviderConfig["JUJU_CA_CERT"] = controllerConfig.ProviderDetails.CACert
-	localProviderConfig["JUJU_USERNAME"] = controllerConfig.Account.User
-	localProviderConfig["JUJU_PASSWORD"] = controllerConfig.Account.Password
+	// extract the only entry
+	controllerConfig := controllerConfig{}
+	for _, v := range cliOutput {
+		if err := json.Unmarshal(v, &controllerConfig); err != nil {
+			return nil, fmt.Errorf("unmarshalling controller details: %w", err)
+		}
+	}
 
-	tflog.Debug(context.TODO(), "local provider controllerConfig was set", map[string]interface{}{"localProviderConfig": fmt.Sprintf("%#v", localProviderConfig)})
+	return map[string]string{
+		"JUJU_AGENT_VERSION":        controllerConfig.ProviderDetails.AgentVersion,
+		"JUJU_CONTROLLER_ADDRESSES": strings.Join(controllerConfig.ProviderDetails.ApiEndpoints, ","),
+		"JUJU_CA_CERT":              controllerConfig.ProviderDetails.CACert,
+		"JUJU_USERNAME":             controllerConfig.Account.User,
+		"JUJU_PASSWORD":             controllerConfig.Account.Password,
+	}, nil
 }
 
 // WaitForAppAvailable blocks the execution flow and waits until all the
@@ -0,0 +1,74 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package juju
+
+import (
+	"testing"
+
+	"github.com/juju/juju/jujuclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerConfigFromCLIOutput(t *testing.T) {
+	output := `{
+  "staging": {
+    "details": {
+      "api-endpoints": ["10.0.0.1:17070", "10.0.0.2:17070"],
+      "ca-cert": "cert",
+      "agent-version": "3.5.3"
+    },
+    "account": {"user": "admin", "password": "secret", "access": "superuser"}
+  }
+}`
+	config, err := controllerConfigFromCLIOutput([]byte(output))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"JUJU_AGENT_VERSION":        "3.5.3",
+		"JUJU_CONTROLLER_ADDRESSES": "10.0.0.1:17070,10.0.0.2:17070",
+		"JUJU_CA_CERT":              "cert",
+		"JUJU_USERNAME":             "admin",
+		"JUJU_PASSWORD":             "secret",
+	}, config)
+
+	_, err = controllerConfigFromCLIOutput([]byte(`{"staging": {}, "production": {}}`))
+	assert.ErrorContains(t, err, "expected the details of one controller, got 2")
+
+	_, err = controllerConfigFromCLIOutput([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestControllerConfigFromClientStore(t *testing.T) {
+	store := jujuclient.NewMemStore()
+	store.Controllers["staging"] = jujuclient.ControllerDetails{
+		APIEndpoints: []string{"10.0.0.1:17070", "10.0.0.2:17070"},
+		CACert:       "cert",
+		AgentVersion: "3.5.3",
+	}
+	store.Accounts["staging"] = jujuclient.AccountDetails{User: "admin", Password: "secret"}
+	store.Controllers["production"] = jujuclient.ControllerDetails{APIEndpoints: []string{"10.0.1.1:17070"}}
+	store.Accounts["production"] = jujuclient.AccountDetails{User: "admin"}
+	store.CurrentControllerName = "staging"
+
+	expected := map[string]string{
+		"JUJU_AGENT_VERSION":        "3.5.3",
+		"JUJU_CONTROLLER_ADDRESSES": "10.0.0.1:17070,10.0.0.2:17070",
+		"JUJU_CA_CERT":              "cert",
+		"JUJU_USERNAME":             "admin",
+		"JUJU_PASSWORD":             "secret",
+	}
+	config, err := controllerConfigFromClientStore(store, "staging")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, config)
+
+	// The current controller is used when no name is given.
+	config, err = controllerConfigFromClientStore(store, "")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, config)
+
+	_, err = controllerConfigFromClientStore(store, "production")
+	assert.ErrorContains(t, err, `the account of controller "production" has no password`)
+
+	_, err = controllerConfigFromClientStore(store, "unknown")
+	assert.ErrorContains(t, err, `reading controller "unknown"`)
+}
@@ -0,0 +1,451 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/juju/juju/core/constraints"
+)
+
+// applicationConstraintsModel describes the structured constraints of
+// an application, the keys of the constraints string of juju.
+type applicationConstraintsModel struct {
+	Arch         types.String `tfsdk:"arch"`
+	Cores        types.Int64  `tfsdk:"cores"`
+	InstanceType types.String `tfsdk:"instance_type"`
+	Mem          types.String `tfsdk:"mem"`
+	RootDisk     types.String `tfsdk:"root_disk"`
+	Spaces       types.Set    `tfsdk:"spaces"`
+	VirtType     types.String `tfsdk:"virt_type"`
+	Zones        types.Set    `tfsdk:"zones"`
+}
+
+var applicationConstraintsAttrTypes = map[string]attr.Type{
+	"arch":          types.StringType,
+	"cores":         types.Int64Type,
+	"instance_type": types.StringType,
+	"mem":           types.StringType,
+	"root_disk":     types.StringType,
+	"spaces":        types.SetType{ElemType: types.StringType},
+	"virt_type":     types.StringType,
+	"zones":         types.SetType{ElemType: types.StringType},
+}
+
+// applicationConstraintsAttribute returns the schema of the structured
+// constraints of an application. The constraints not configured are
+// read from juju, e.g. the architecture juju picked for the charm.
+func applicationConstraintsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Constraints imposed on this application, as attributes rather than a string. Sizes are " +
+			"compared by value, e.g. `4G` and `4096M` are the same memory. Constraints which are not set are " +
+			"read from juju. Conflicts with `constraints`. Changing the value of a constraint will cause the " +
+			"application to be destroyed and recreated by terraform.",
+		Optional: true,
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"arch": schema.StringAttribute{
+				Description: "The architecture of the machines, e.g. `amd64` or `arm64`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					constraintValueValidator{key: constraints.Arch},
+				},
+			},
+			"cores": schema.Int64Attribute{
+				Description: "The minimum number of effective CPU cores.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"instance_type": schema.StringAttribute{
+				Description: "The cloud specific instance type, e.g. `m5.large`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					constraintValueValidator{key: constraints.InstanceType},
+				},
+			},
+			"mem": schema.StringAttribute{
+				Description: "The minimum memory, in megabytes unless a suffix among `M`, `G`, `T` or `P` is given, e.g. `4G`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					constraintValueValidator{key: constraints.Mem},
+				},
+			},
+			"root_disk": schema.StringAttribute{
+				Description: "The minimum size of the root disk, in megabytes unless a suffix among `M`, `G`, `T` " +
+					"or `P` is given, e.g. `16G`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					constraintValueValidator{key: constraints.RootDisk},
+				},
+			},
+			"spaces": schema.SetAttribute{
+				Description: "The spaces the machines must be in, or not be in if prefixed with `^`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"virt_type": schema.StringAttribute{
+				Description: "The virtualization type of the machines, e.g. `virtual-machine` on LXD.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					constraintValueValidator{key: constraints.VirtType},
+				},
+			},
+			"zones": schema.SetAttribute{
+				Description: "The availability zones the machines can be in.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRoot("constraints")),
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(applicationConstraintsRequireReplace,
+				"Changing the value of a constraint requires the application to be replaced.",
+				"Changing the value of a constraint requires the application to be replaced."),
+			objectplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// terms returns the known constraints of the model, keyed by their juju
+// name. The values of spaces and zones are sorted.
+func (m applicationConstraintsModel) terms(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	terms := make(map[string]string)
+	addString := func(key string, value types.String) {
+		if !value.IsNull() && !value.IsUnknown() {
+			terms[key] = value.ValueString()
+		}
+	}
+	addSet := func(key string, value types.Set) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+		var values []string
+		diags.Append(value.ElementsAs(ctx, &values, false)...)
+		sort.Strings(values)
+		terms[key] = strings.Join(values, ",")
+	}
+	addString(constraints.Arch, m.Arch)
+	if !m.Cores.IsNull() && !m.Cores.IsUnknown() {
+		terms[constraints.Cores] = strconv.FormatInt(m.Cores.ValueInt64(), 10)
+	}
+	addString(constraints.InstanceType, m.InstanceType)
+	addString(constraints.Mem, m.Mem)
+	addString(constraints.RootDisk, m.RootDisk)
+	addSet(constraints.Spaces, m.Spaces)
+	addString(constraints.VirtType, m.VirtType)
+	addSet(constraints.Zones, m.Zones)
+	return terms, diags
+}
+
+// constraintsFromObject returns the constraints of the known attributes
+// of the structured constraints.
+func constraintsFromObject(ctx context.Context, object types.Object) (constraints.Value, diag.Diagnostics) {
+	var model applicationConstraintsModel
+	diags := object.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return constraints.Value{}, diags
+	}
+	terms, dErr := model.terms(ctx)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return constraints.Value{}, diags
+	}
+	keys := make([]string, 0, len(terms))
+	for key := range terms {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key + "=" + terms[key]
+	}
+	cons, err := constraints.Parse(strings.Join(parts, " "))
+	if err != nil {
+		diags.AddError("Conversion", fmt.Sprintf("Unable to parse constraints, got error: %s", err))
+	}
+	return cons, diags
+}
+
+// constraintValueTerms returns the constraints of the value handled by
+// the structured constraints, keyed by their juju name. Sizes are in
+// megabytes, the values of spaces and zones are sorted.
+func constraintValueTerms(cons constraints.Value) map[string]string {
+	terms := make(map[string]string)
+	addString := func(key string, value *string) {
+		if value != nil && *value != "" {
+			terms[key] = *value
+		}
+	}
+	addSize := func(key string, value *uint64) {
+		if value != nil {
+			terms[key] = fmt.Sprintf("%dM", *value)
+		}
+	}
+	addList := func(key string, value *[]string) {
+		if value != nil && len(*value) > 0 {
+			values := append([]string(nil), *value...)
+			sort.Strings(values)
+			terms[key] = strings.Join(values, ",")
+		}
+	}
+	addString(constraints.Arch, cons.Arch)
+	if cons.CpuCores != nil {
+		terms[constraints.Cores] = strconv.FormatUint(*cons.CpuCores, 10)
+	}
+	addString(constraints.InstanceType, cons.InstanceType)
+	addSize(constraints.Mem, cons.Mem)
+	addSize(constraints.RootDisk, cons.RootDisk)
+	addList(constraints.Spaces, cons.Spaces)
+	addString(constraints.VirtType, cons.VirtType)
+	addList(constraints.Zones, cons.Zones)
+	return terms
+}
+
+// normalizeConstraint returns the value of a single constraint as juju
+// writes it, e.g. "4096M" for a memory of "4G".
+func normalizeConstraint(key, value string) string {
+	cons, err := constraints.Parse(key + "=" + value)
+	if err != nil {
+		return value
+	}
+	return constraintValueTerms(cons)[key]
+}
+
+// applicationConstraintsFromValue returns the structured constraints of
+// the constraints read from juju. The values of current are kept where
+// they are the same constraint, so that e.g. "4G" is not replaced by
+// "4096M".
+func applicationConstraintsFromValue(ctx context.Context, cons constraints.Value, current types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model applicationConstraintsModel
+	if !current.IsNull() && !current.IsUnknown() {
+		diags.Append(current.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return types.ObjectNull(applicationConstraintsAttrTypes), diags
+		}
+	}
+	terms := constraintValueTerms(cons)
+
+	model.Arch = constraintString(model.Arch, constraints.Arch, terms)
+	if cons.CpuCores == nil {
+		model.Cores = types.Int64Null()
+	} else {
+		model.Cores = types.Int64Value(int64(*cons.CpuCores))
+	}
+	model.InstanceType = constraintString(model.InstanceType, constraints.InstanceType, terms)
+	model.Mem = constraintString(model.Mem, constraints.Mem, terms)
+	model.RootDisk = constraintString(model.RootDisk, constraints.RootDisk, terms)
+	model.VirtType = constraintString(model.VirtType, constraints.VirtType, terms)
+	var dErr diag.Diagnostics
+	model.Spaces, dErr = constraintSet(ctx, model.Spaces, constraints.Spaces, terms)
+	diags.Append(dErr...)
+	model.Zones, dErr = constraintSet(ctx, model.Zones, constraints.Zones, terms)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return types.ObjectNull(applicationConstraintsAttrTypes), diags
+	}
+
+	object, dErr := types.ObjectValueFrom(ctx, applicationConstraintsAttrTypes, model)
+	diags.Append(dErr...)
+	return object, diags
+}
+
+// constraintString returns the value of the constraint, or current if
+// it is the same constraint.
+func constraintString(current types.String, key string, terms map[string]string) types.String {
+	value := terms[key]
+	if !current.IsNull() && !current.IsUnknown() && normalizeConstraint(key, current.ValueString()) == value {
+		return current
+	}
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// constraintSet returns the values of the constraint, or current if it
+// holds the same values.
+func constraintSet(ctx context.Context, current types.Set, key string, terms map[string]string) (types.Set, diag.Diagnostics) {
+	value := terms[key]
+	if !current.IsNull() && !current.IsUnknown() {
+		var values []string
+		diags := current.ElementsAs(ctx, &values, false)
+		if diags.HasError() {
+			return current, diags
+		}
+		sort.Strings(values)
+		if strings.Join(values, ",") == value {
+			return current, nil
+		}
+	}
+	if value == "" {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, strings.Split(value, ","))
+}
+
+// constraintsStringValue returns the constraints read from juju as a
+// string, or current if it describes the same constraints.
+func constraintsStringValue(current types.String, cons constraints.Value) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		if parsed, err := constraints.Parse(current.ValueString()); err == nil && parsed.String() == cons.String() {
+			return current
+		}
+	}
+	return types.StringValue(cons.String())
+}
+
+// constraintsRequireReplace requires the application to be replaced
+// when the configured constraints differ from the constraints in state,
+// regardless of how they are written, e.g. "mem=4G" and "mem=4096M".
+func constraintsRequireReplace(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.ConfigValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	resp.RequiresReplace = constraintsChanged(req.PlanValue.ValueString(), req.StateValue.ValueString())
+}
+
+// applicationConstraintsRequireReplace requires the application to be
+// replaced when a configured constraint differs from the constraints in
+// state.
+func applicationConstraintsRequireReplace(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.ConfigValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	var stateConstraints types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("constraints"), &stateConstraints)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	changed, diags := applicationConstraintsChanged(ctx, req.PlanValue, stateConstraints.ValueString())
+	resp.Diagnostics.Append(diags...)
+	resp.RequiresReplace = changed
+}
+
+// constraintsChanged returns whether the planned constraints string
+// describes other constraints than the one in state. Invalid planned
+// constraints are reported by the validator, not as a change.
+func constraintsChanged(planConstraints, stateConstraints string) bool {
+	plan, err := constraints.Parse(planConstraints)
+	if err != nil {
+		return false
+	}
+	state, err := constraints.Parse(stateConstraints)
+	return err != nil || plan.String() != state.String()
+}
+
+// applicationConstraintsChanged returns whether a known attribute of the
+// planned structured constraints differs from the constraints string in
+// state. The constraints string is compared to, as the structured
+// constraints are not in the state of applications created before them.
+func applicationConstraintsChanged(ctx context.Context, object types.Object, stateConstraints string) (bool, diag.Diagnostics) {
+	var model applicationConstraintsModel
+	diags := object.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return false, diags
+	}
+	planTerms, dErr := model.terms(ctx)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return false, diags
+	}
+	state, err := constraints.Parse(stateConstraints)
+	if err != nil {
+		return true, diags
+	}
+	stateTerms := constraintValueTerms(state)
+	for key, value := range planTerms {
+		if normalizeConstraint(key, value) != stateTerms[key] {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// modifyConstraintsPlan marks the constraints computed from the ones
+// configured as unknown when the configured ones change, as the state
+// they are planned from no longer holds.
+func modifyConstraintsPlan(ctx context.Context, plan, state applicationResourceModel, resp *resource.ModifyPlanResponse) {
+	if !plan.Constraints.IsUnknown() && constraintsChanged(plan.Constraints.ValueString(), state.Constraints.ValueString()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("structured_constraints"),
+			types.ObjectUnknown(applicationConstraintsAttrTypes))...)
+		return
+	}
+	if plan.StructuredConstraints.IsNull() || plan.StructuredConstraints.IsUnknown() {
+		return
+	}
+	changed, diags := applicationConstraintsChanged(ctx, plan.StructuredConstraints, state.Constraints.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if changed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("constraints"), types.StringUnknown())...)
+	}
+}
+
+// constraintValueValidator checks that a string is a valid value of a
+// juju constraint.
+type constraintValueValidator struct {
+	key string
+}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v constraintValueValidator) Description(context.Context) string {
+	return fmt.Sprintf("string must be a valid value of the %q constraint", v.key)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v constraintValueValidator) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("string must be a valid value of the `%s` constraint", v.key)
+}
+
+// ValidateString runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v constraintValueValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	if _, err := constraints.Parse(v.key + "=" + req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Constraint",
+			err.Error(),
+		)
+	}
+}
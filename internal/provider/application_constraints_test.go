@@ -0,0 +1,95 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/juju/juju/core/constraints"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeConstraint(t *testing.T) {
+	assert.Equal(t, "4096M", normalizeConstraint(constraints.Mem, "4G"))
+	assert.Equal(t, "4096M", normalizeConstraint(constraints.Mem, "4096M"))
+	assert.Equal(t, "4096M", normalizeConstraint(constraints.Mem, "4096"))
+	assert.Equal(t, "16384M", normalizeConstraint(constraints.RootDisk, "16G"))
+	assert.Equal(t, "a,b", normalizeConstraint(constraints.Spaces, "b,a"))
+	assert.Equal(t, "", normalizeConstraint(constraints.Arch, ""))
+}
+
+func TestConstraintsStringValue(t *testing.T) {
+	cons := constraints.MustParse("arch=amd64 mem=4096M")
+	assert.Equal(t, "mem=4G arch=amd64", constraintsStringValue(types.StringValue("mem=4G arch=amd64"), cons).ValueString())
+	assert.Equal(t, "arch=amd64 mem=4096M", constraintsStringValue(types.StringValue("mem=4G"), cons).ValueString())
+	assert.Equal(t, "arch=amd64 mem=4096M", constraintsStringValue(types.StringUnknown(), cons).ValueString())
+}
+
+func TestApplicationConstraintsFromValue(t *testing.T) {
+	ctx := context.Background()
+	cons := constraints.MustParse("arch=amd64 cores=2 mem=4096M spaces=public,internal")
+
+	// Not configured, the constraints are read.
+	object, diags := applicationConstraintsFromValue(ctx, cons, types.ObjectUnknown(applicationConstraintsAttrTypes))
+	require.False(t, diags.HasError(), diags)
+	var model applicationConstraintsModel
+	require.False(t, object.As(ctx, &model, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "amd64", model.Arch.ValueString())
+	assert.Equal(t, int64(2), model.Cores.ValueInt64())
+	assert.Equal(t, "4096M", model.Mem.ValueString())
+	assert.True(t, model.RootDisk.IsNull())
+	assert.True(t, model.Zones.IsNull())
+	var spaces []string
+	require.False(t, model.Spaces.ElementsAs(ctx, &spaces, false).HasError())
+	assert.ElementsMatch(t, []string{"internal", "public"}, spaces)
+
+	// Configured, the values written differently are kept.
+	model.Mem = types.StringValue("4G")
+	model.Arch = types.StringUnknown()
+	model.Spaces, diags = types.SetValueFrom(ctx, types.StringType, []string{"public", "internal"})
+	require.False(t, diags.HasError(), diags)
+	current, diags := types.ObjectValueFrom(ctx, applicationConstraintsAttrTypes, model)
+	require.False(t, diags.HasError(), diags)
+	object, diags = applicationConstraintsFromValue(ctx, cons, current)
+	require.False(t, diags.HasError(), diags)
+	require.False(t, object.As(ctx, &model, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "4G", model.Mem.ValueString())
+	assert.Equal(t, "amd64", model.Arch.ValueString())
+	assert.True(t, model.Spaces.Equal(current.Attributes()["spaces"]))
+
+	// Round trip to constraints.
+	roundTrip, diags := constraintsFromObject(ctx, object)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "arch=amd64 cores=2 mem=4096M spaces=internal,public", roundTrip.String())
+}
+
+func TestConstraintsChanged(t *testing.T) {
+	assert.False(t, constraintsChanged("mem=4G arch=amd64", "arch=amd64 mem=4096M"))
+	assert.True(t, constraintsChanged("mem=8G arch=amd64", "arch=amd64 mem=4096M"))
+	assert.False(t, constraintsChanged("", ""))
+	assert.False(t, constraintsChanged("memory=4G", "arch=amd64"))
+
+	ctx := context.Background()
+	object, diags := types.ObjectValueFrom(ctx, applicationConstraintsAttrTypes, applicationConstraintsModel{
+		Arch:         types.StringUnknown(),
+		Cores:        types.Int64Null(),
+		InstanceType: types.StringNull(),
+		Mem:          types.StringValue("4G"),
+		RootDisk:     types.StringNull(),
+		Spaces:       types.SetNull(types.StringType),
+		VirtType:     types.StringNull(),
+		Zones:        types.SetNull(types.StringType),
+	})
+	require.False(t, diags.HasError(), diags)
+	changed, diags := applicationConstraintsChanged(ctx, object, "arch=amd64 mem=4096M")
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, changed)
+	changed, diags = applicationConstraintsChanged(ctx, object, "arch=amd64 mem=8192M")
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, changed)
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CLIEquivalentKey is the name of the computed attribute holding the
+// juju CLI command equivalent to a resource.
+const CLIEquivalentKey = "cli_equivalent"
+
+// cliEquivalentAttribute returns the schema of the cli_equivalent
+// attribute, the command being described by what.
+func cliEquivalentAttribute(what string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The juju CLI command %s, to reproduce or troubleshoot what the provider "+
+			"does by hand. Informational only, the provider does not run it.", what),
+		Computed: true,
+	}
+}
+
+// cliCommand builds a juju CLI command, its arguments quoted for a POSIX
+// shell.
+type cliCommand struct {
+	args []string
+}
+
+// newCLICommand returns a command starting with the given arguments,
+// e.g. "juju", "deploy".
+func newCLICommand(args ...string) *cliCommand {
+	return &cliCommand{args: args}
+}
+
+// arg appends the non empty values as positional arguments.
+func (c *cliCommand) arg(values ...string) {
+	for _, value := range values {
+		if value != "" {
+			c.args = append(c.args, shellQuote(value))
+		}
+	}
+}
+
+// flag appends the flag with the value, unless the value is empty.
+func (c *cliCommand) flag(name, value string) {
+	if value != "" {
+		c.args = append(c.args, "--"+name, shellQuote(value))
+	}
+}
+
+// boolFlag appends the flag without a value when set is true.
+func (c *cliCommand) boolFlag(name string, set bool) {
+	if set {
+		c.args = append(c.args, "--"+name)
+	}
+}
+
+// mapFlag appends the flag once per key of the values, as key=value,
+// sorted by key.
+func (c *cliCommand) mapFlag(name string, values map[string]string) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		c.flag(name, k+"="+values[k])
+	}
+}
+
+func (c *cliCommand) String() string {
+	return strings.Join(c.args, " ")
+}
+
+// isKnown returns whether the value is neither null nor unknown.
+func isKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// stringMap returns the elements of the map of strings, nil if the map is
+// null or unknown.
+func stringMap(ctx context.Context, value types.Map) map[string]string {
+	if !isKnown(value) {
+		return nil
+	}
+	var values map[string]string
+	_ = value.ElementsAs(ctx, &values, false)
+	return values
+}
+
+// shellQuote quotes the value for a POSIX shell, unless it only has
+// characters which are never interpreted by the shell.
+func shellQuote(value string) string {
+	safe := value != "" && strings.IndexFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:=@,+%", r))
+	}) == -1
+	if safe {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// applicationCLIEquivalent returns the juju deploy command equivalent to
+// the main arguments of the application.
+func applicationCLIEquivalent(ctx context.Context, app applicationResourceModel) types.String {
+	cmd := newCLICommand("juju", "deploy")
+	cmd.flag("model", app.ModelName.ValueString())
+
+	var charms []nestedCharm
+	if isKnown(app.Charm) {
+		_ = app.Charm.ElementsAs(ctx, &charms, false)
+	}
+	if len(charms) > 0 {
+		charm := charms[0]
+		if charm.Path.ValueString() != "" {
+			cmd.arg(charm.Path.ValueString())
+		} else {
+			cmd.arg(charm.Name.ValueString())
+		}
+		cmd.arg(app.ApplicationName.ValueString())
+		cmd.flag("channel", charm.Channel.ValueString())
+		if charm.Revision.ValueInt64() > 0 {
+			cmd.flag("revision", fmt.Sprint(charm.Revision.ValueInt64()))
+		}
+		cmd.flag("base", charm.Base.ValueString())
+	} else {
+		cmd.arg(app.ApplicationName.ValueString())
+	}
+
+	if !app.UnitCount.IsNull() && !app.UnitCount.IsUnknown() {
+		cmd.flag("num-units", fmt.Sprint(app.UnitCount.ValueInt64()))
+	}
+	cmd.flag("to", app.Placement.ValueString())
+	cmd.flag("constraints", app.Constraints.ValueString())
+	cmd.mapFlag("config", stringMap(ctx, app.Config))
+	cmd.mapFlag("storage", stringMap(ctx, app.StorageDirectives))
+	cmd.mapFlag("resource", stringMap(ctx, app.Resources))
+	cmd.boolFlag("trust", app.Trust.ValueBool())
+	return types.StringValue(cmd.String())
+}
+
+// integrationCLIEquivalent returns the juju integrate command equivalent
+// to the integration.
+func integrationCLIEquivalent(ctx context.Context, integration integrationResourceModel) types.String {
+	cmd := newCLICommand("juju", "integrate")
+	cmd.flag("model", integration.ModelName.ValueString())
+
+	var apps []nestedApplication
+	if isKnown(integration.Application) {
+		_ = integration.Application.ElementsAs(ctx, &apps, false)
+	}
+	endpoints := make([]string, 0, len(apps))
+	for _, app := range apps {
+		switch {
+		case app.OfferURL.ValueString() != "":
+			endpoints = append(endpoints, app.OfferURL.ValueString())
+		case app.Endpoint.ValueString() != "":
+			endpoints = append(endpoints, app.Name.ValueString()+":"+app.Endpoint.ValueString())
+		default:
+			endpoints = append(endpoints, app.Name.ValueString())
+		}
+	}
+	// The applications are a set, sort them for a stable command.
+	sort.Strings(endpoints)
+	cmd.arg(endpoints...)
+	cmd.flag("via", integration.Via.ValueString())
+	return types.StringValue(cmd.String())
+}
+
+// modelCLIEquivalent returns the juju add-model command equivalent to the
+// model, followed by the command setting its constraints if any.
+func modelCLIEquivalent(ctx context.Context, model modelResourceModel) types.String {
+	cmd := newCLICommand("juju", "add-model")
+	cmd.arg(model.Name.ValueString())
+
+	var clouds []nestedCloud
+	if isKnown(model.Cloud) {
+		_ = model.Cloud.ElementsAs(ctx, &clouds, false)
+	}
+	if len(clouds) > 0 {
+		cloud := clouds[0].Name.ValueString()
+		if region := clouds[0].Region.ValueString(); region != "" {
+			cloud += "/" + region
+		}
+		cmd.arg(cloud)
+	}
+	cmd.flag("credential", model.Credential.ValueString())
+	cmd.mapFlag("config", stringMap(ctx, model.Config))
+
+	command := cmd.String()
+	if constraints := model.Constraints.ValueString(); constraints != "" {
+		setConstraints := newCLICommand("juju", "set-model-constraints")
+		setConstraints.flag("model", model.Name.ValueString())
+		setConstraints.arg(strings.Fields(constraints)...)
+		command += " && " + setConstraints.String()
+	}
+	return types.StringValue(command)
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// schemaElementType returns the type of the elements of the nested
+// attribute or block of the resource with the given name.
+func schemaElementType(t *testing.T, r resource.Resource, name string) attr.Type {
+	resp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	var typ attr.Type
+	if attribute, ok := resp.Schema.Attributes[name]; ok {
+		typ = attribute.GetType()
+	} else {
+		block, ok := resp.Schema.Blocks[name]
+		require.True(t, ok, "no attribute or block %q", name)
+		typ = block.Type()
+	}
+	withElement, ok := typ.(attr.TypeWithElementType)
+	require.True(t, ok, "%q is not a list or set", name)
+	return withElement.ElementType()
+}
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, "postgresql", shellQuote("postgresql"))
+	assert.Equal(t, "14/stable", shellQuote("14/stable"))
+	assert.Equal(t, "arch=amd64", shellQuote("arch=amd64"))
+	assert.Equal(t, "'mem=4G cores=2'", shellQuote("mem=4G cores=2"))
+	assert.Equal(t, `'logging-config=<root>=INFO'`, shellQuote("logging-config=<root>=INFO"))
+	assert.Equal(t, `'it'\''s'`, shellQuote("it's"))
+	assert.Equal(t, "''", shellQuote(""))
+}
+
+func TestApplicationCLIEquivalent(t *testing.T) {
+	ctx := context.Background()
+	charmType := schemaElementType(t, NewApplicationResource(), CharmKey)
+	charm, diags := types.ListValueFrom(ctx, charmType, []nestedCharm{{
+		Name:     types.StringValue("postgresql"),
+		Channel:  types.StringValue("14/stable"),
+		Revision: types.Int64Value(363),
+		Base:     types.StringValue("ubuntu@22.04"),
+	}})
+	require.False(t, diags.HasError(), diags)
+	config, diags := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"profile":      "testing",
+		"experimental": "a b",
+	})
+	require.False(t, diags.HasError(), diags)
+
+	app := applicationResourceModel{
+		ModelName:       types.StringValue("development"),
+		ApplicationName: types.StringValue("database"),
+		Charm:           charm,
+		UnitCount:       types.Int64Value(3),
+		Constraints:     types.StringValue("mem=4G cores=2"),
+		Config:          config,
+		Trust:           types.BoolValue(true),
+	}
+	assert.Equal(t, "juju deploy --model development postgresql database --channel 14/stable --revision 363 "+
+		"--base ubuntu@22.04 --num-units 3 --constraints 'mem=4G cores=2' --config 'experimental=a b' "+
+		"--config profile=testing --trust", applicationCLIEquivalent(ctx, app).ValueString())
+}
+
+func TestIntegrationCLIEquivalent(t *testing.T) {
+	ctx := context.Background()
+	appType := schemaElementType(t, NewIntegrationResource(), "application")
+	apps, diags := types.SetValueFrom(ctx, appType, []nestedApplication{{
+		Name:     types.StringValue("wordpress"),
+		Endpoint: types.StringValue("db"),
+	}, {
+		OfferURL: types.StringValue("admin/database.postgresql"),
+	}})
+	require.False(t, diags.HasError(), diags)
+
+	integration := integrationResourceModel{
+		ModelName:   types.StringValue("development"),
+		Application: apps,
+		Via:         types.StringValue("10.0.0.0/24"),
+	}
+	assert.Equal(t, "juju integrate --model development admin/database.postgresql wordpress:db --via 10.0.0.0/24",
+		integrationCLIEquivalent(ctx, integration).ValueString())
+}
+
+func TestModelCLIEquivalent(t *testing.T) {
+	ctx := context.Background()
+	cloudType := schemaElementType(t, NewModelResource(), "cloud")
+	cloud, diags := types.ListValueFrom(ctx, cloudType, []nestedCloud{{
+		Name:   types.StringValue("localhost"),
+		Region: types.StringValue("localhost"),
+	}})
+	require.False(t, diags.HasError(), diags)
+	config, diags := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"logging-config": "<root>=INFO",
+	})
+	require.False(t, diags.HasError(), diags)
+
+	model := modelResourceModel{
+		Name:   types.StringValue("development"),
+		Cloud:  cloud,
+		Config: config,
+	}
+	assert.Equal(t, "juju add-model development localhost/localhost --config 'logging-config=<root>=INFO'",
+		modelCLIEquivalent(ctx, model).ValueString())
+
+	// The constraints are set by a second command.
+	model.Constraints = types.StringValue("arch=amd64 mem=4G")
+	assert.Equal(t, "juju add-model development localhost/localhost --config 'logging-config=<root>=INFO' "+
+		"&& juju set-model-constraints --model development arch=amd64 mem=4G",
+		modelCLIEquivalent(ctx, model).ValueString())
+}
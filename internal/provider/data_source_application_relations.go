@@ -0,0 +1,208 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &applicationRelationsDataSource{}
+
+func NewApplicationRelationsDataSource() datasource.DataSourceWithConfigure {
+	return &applicationRelationsDataSource{}
+}
+
+type applicationRelationsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type applicationRelationsDataSourceModel struct {
+	Model           types.String `tfsdk:"model"`
+	ApplicationName types.String `tfsdk:"application_name"`
+	Relations       types.List   `tfsdk:"relations"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+type applicationRelationModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	Interface          types.String `tfsdk:"interface"`
+	Role               types.String `tfsdk:"role"`
+	Scope              types.String `tfsdk:"scope"`
+	RelatedApplication types.String `tfsdk:"related_application"`
+	RelatedEndpoint    types.String `tfsdk:"related_endpoint"`
+	RelatedOfferURL    types.String `tfsdk:"related_offer_url"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *applicationRelationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_relations"
+}
+
+func (d *applicationRelationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the integrations, known as relations in Juju, of an application. " +
+			"It can be used to check that an application no longer backs other services before scaling it down or removing it.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model where the application is deployed.",
+				Required:    true,
+			},
+			"application_name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Required:    true,
+			},
+			"relations": schema.ListNestedAttribute{
+				Description: "The relations of the application, sorted by id.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "The id of the relation in the model.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The endpoint of the application in the relation.",
+							Computed:    true,
+						},
+						"interface": schema.StringAttribute{
+							Description: "The interface of the relation.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the application in the relation: `provider`, `requirer` or `peer`.",
+							Computed:    true,
+						},
+						"scope": schema.StringAttribute{
+							Description: "The scope of the relation: `global` or `container`.",
+							Computed:    true,
+						},
+						"related_application": schema.StringAttribute{
+							Description: "The application at the other end of the relation, or the application itself for a peer relation.",
+							Computed:    true,
+						},
+						"related_endpoint": schema.StringAttribute{
+							Description: "The endpoint of the related application.",
+							Computed:    true,
+						},
+						"related_offer_url": schema.StringAttribute{
+							Description: "The URL of the offer when the related application is consumed from an offer, null otherwise.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *applicationRelationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceApplicationRelations)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *applicationRelationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "application relations")
+		return
+	}
+
+	var data applicationRelationsDataSourceModel
+
+	// Read Terraform configuration data into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := data.Model.ValueString()
+	appName := data.ApplicationName.ValueString()
+	d.trace("reading application relations", map[string]interface{}{
+		"model":       modelName,
+		"application": appName,
+	})
+
+	response, err := d.client.Integrations.ReadApplicationIntegrations(ctx, &juju.ReadApplicationIntegrationsInput{
+		ModelName: modelName,
+		AppName:   appName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read relations of application %q, got error: %s", appName, err))
+		return
+	}
+
+	relations := make([]applicationRelationModel, len(response.Integrations))
+	for i, integration := range response.Integrations {
+		relations[i] = applicationRelationModel{
+			ID:                 types.Int64Value(int64(integration.ID)),
+			Endpoint:           types.StringValue(integration.Endpoint),
+			Interface:          types.StringValue(integration.Interface),
+			Role:               types.StringValue(integration.Role),
+			Scope:              types.StringValue(integration.Scope),
+			RelatedApplication: types.StringValue(integration.RelatedApplication),
+			RelatedEndpoint:    types.StringValue(integration.RelatedEndpoint),
+			RelatedOfferURL:    types.StringNull(),
+		}
+		if integration.RelatedOfferURL != "" {
+			relations[i].RelatedOfferURL = types.StringValue(integration.RelatedOfferURL)
+		}
+	}
+	relationType := req.Config.Schema.GetAttributes()["relations"].(schema.ListNestedAttribute).NestedObject.Type()
+	relationsValue, dErr := types.ListValueFrom(ctx, relationType, relations)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Relations = relationsValue
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", modelName, appName))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *applicationRelationsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceApplicationRelations, msg, additionalFields...)
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceApplicationRelations(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-application-relations-test-model")
+	dataSourceName := "data.juju_application_relations.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApplicationRelations(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", modelName+":one"),
+					resource.TestCheckResourceAttr(dataSourceName, "relations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "relations.0.endpoint", "source"),
+					resource.TestCheckResourceAttr(dataSourceName, "relations.0.related_application", "two"),
+					resource.TestCheckResourceAttr(dataSourceName, "relations.0.related_endpoint", "sink"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "relations.0.interface"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "relations.0.related_offer_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApplicationRelations(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "one" {
+  model = juju_model.this.name
+  name  = "one"
+
+  charm {
+    name = "juju-qa-dummy-sink"
+  }
+}
+
+resource "juju_application" "two" {
+  model = juju_model.this.name
+  name  = "two"
+
+  charm {
+    name = "juju-qa-dummy-source"
+  }
+}
+
+resource "juju_integration" "this" {
+  model = juju_model.this.name
+
+  application {
+    name     = juju_application.one.name
+    endpoint = "source"
+  }
+
+  application {
+    name     = juju_application.two.name
+    endpoint = "sink"
+  }
+}
+
+data "juju_application_relations" "this" {
+  model            = juju_model.this.name
+  application_name = juju_application.one.name
+
+  depends_on = [juju_integration.this]
+}
+`, modelName)
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &applicationStatusDataSource{}
+
+func NewApplicationStatusDataSource() datasource.DataSourceWithConfigure {
+	return &applicationStatusDataSource{}
+}
+
+type applicationStatusDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type applicationStatusDataSourceModel struct {
+	Model           types.String `tfsdk:"model"`
+	ApplicationName types.String `tfsdk:"application_name"`
+	Status          types.String `tfsdk:"status"`
+	Message         types.String `tfsdk:"message"`
+	Description     types.String `tfsdk:"description"`
+	Units           types.List   `tfsdk:"units"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+type unitStatusModel struct {
+	Name            types.String `tfsdk:"name"`
+	Machine         types.String `tfsdk:"machine"`
+	Leader          types.Bool   `tfsdk:"leader"`
+	WorkloadStatus  types.String `tfsdk:"workload_status"`
+	WorkloadMessage types.String `tfsdk:"workload_message"`
+	AgentStatus     types.String `tfsdk:"agent_status"`
+	PublicAddress   types.String `tfsdk:"public_address"`
+	PrivateAddress  types.String `tfsdk:"private_address"`
+	OpenPorts       types.List   `tfsdk:"open_ports"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *applicationStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_status"
+}
+
+func (d *applicationStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the current status of a Juju application and the addresses of its " +
+			"units. It can be used to wire the endpoints of an application into other providers, e.g. DNS records " +
+			"or load balancer targets.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model where the application is deployed.",
+				Required:    true,
+			},
+			"application_name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the application, e.g. `active` or `blocked`.",
+				Computed:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "The message set along with the status of the application.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the application, see the `description` of `juju_application`. " +
+					"Null if it has none.",
+				Computed: true,
+			},
+			"units": schema.ListNestedAttribute{
+				Description: "The units of the application, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the unit, e.g. `myapp/0`.",
+							Computed:    true,
+						},
+						"machine": schema.StringAttribute{
+							Description: "The machine hosting the unit, empty in kubernetes models.",
+							Computed:    true,
+						},
+						"leader": schema.BoolAttribute{
+							Description: "Whether the unit is the leader of the application.",
+							Computed:    true,
+						},
+						"workload_status": schema.StringAttribute{
+							Description: "The status of the workload of the unit, e.g. `active`.",
+							Computed:    true,
+						},
+						"workload_message": schema.StringAttribute{
+							Description: "The message set along with the workload status.",
+							Computed:    true,
+						},
+						"agent_status": schema.StringAttribute{
+							Description: "The status of the agent of the unit, e.g. `idle`.",
+							Computed:    true,
+						},
+						"public_address": schema.StringAttribute{
+							Description: "The public address of the unit, empty if it has none yet.",
+							Computed:    true,
+						},
+						"private_address": schema.StringAttribute{
+							Description: "The address of the pod of the unit in kubernetes models, the first address " +
+								"of its machine otherwise. Empty if it has none yet.",
+							Computed: true,
+						},
+						"open_ports": schema.ListAttribute{
+							Description: "The ports opened by the unit, e.g. `443/tcp` or `8000-8010/udp`.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *applicationStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceApplicationStatus)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *applicationStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "application status")
+		return
+	}
+
+	var data applicationStatusDataSourceModel
+
+	// Read Terraform configuration data into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &juju.ReadApplicationInput{
+		ModelName: data.Model.ValueString(),
+		AppName:   data.ApplicationName.ValueString(),
+	}
+	d.trace("reading application status", map[string]interface{}{
+		"model":       input.ModelName,
+		"application": input.AppName,
+	})
+
+	response, err := d.client.Applications.ReadApplicationStatus(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status of application %q, got error: %s", input.AppName, err))
+		return
+	}
+
+	units := make([]unitStatusModel, len(response.Units))
+	for i, unit := range response.Units {
+		openPorts, dErr := types.ListValueFrom(ctx, types.StringType, unit.OpenPorts)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		units[i] = unitStatusModel{
+			Name:            types.StringValue(unit.Name),
+			Machine:         types.StringValue(unit.Machine),
+			Leader:          types.BoolValue(unit.Leader),
+			WorkloadStatus:  types.StringValue(unit.WorkloadStatus),
+			WorkloadMessage: types.StringValue(unit.WorkloadMessage),
+			AgentStatus:     types.StringValue(unit.AgentStatus),
+			PublicAddress:   types.StringValue(unit.PublicAddress),
+			PrivateAddress:  types.StringValue(unit.PrivateAddress),
+			OpenPorts:       openPorts,
+		}
+	}
+	unitType := req.Config.Schema.GetAttributes()["units"].(schema.ListNestedAttribute).NestedObject.Type()
+	unitsValue, dErr := types.ListValueFrom(ctx, unitType, units)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Units = unitsValue
+	data.Status = types.StringValue(response.Status)
+	data.Message = types.StringValue(response.Message)
+	data.Description, err = readDescription(ctx, d.client, input.ModelName, names.NewApplicationTag(input.AppName).String())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the description of application %q, got error: %s", input.AppName, err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", input.ModelName, input.AppName))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *applicationStatusDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceApplicationStatus, msg, additionalFields...)
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/juju/core/status"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+const (
+	// defaultStatusHistorySize is the number of entries read when no
+	// size is configured.
+	defaultStatusHistorySize = 20
+	// maxStatusHistorySize bounds the number of entries kept in state.
+	maxStatusHistorySize = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &applicationStatusHistoryDataSource{}
+
+func NewApplicationStatusHistoryDataSource() datasource.DataSourceWithConfigure {
+	return &applicationStatusHistoryDataSource{}
+}
+
+type applicationStatusHistoryDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type applicationStatusHistoryDataSourceModel struct {
+	Model           types.String `tfsdk:"model"`
+	ApplicationName types.String `tfsdk:"application_name"`
+	Unit            types.String `tfsdk:"unit"`
+	Kind            types.String `tfsdk:"kind"`
+	Size            types.Int64  `tfsdk:"size"`
+	Entries         types.List   `tfsdk:"entries"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+type statusHistoryEntryModel struct {
+	Kind    types.String `tfsdk:"kind"`
+	Status  types.String `tfsdk:"status"`
+	Message types.String `tfsdk:"message"`
+	Since   types.String `tfsdk:"since"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *applicationStatusHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_status_history"
+}
+
+func (d *applicationStatusHistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the recent status history of a Juju application or one of its units. " +
+			"It can be used to check that an application has been stable for some time before proceeding.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model where the application is deployed.",
+				Required:    true,
+			},
+			"application_name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Required:    true,
+			},
+			"unit": schema.StringAttribute{
+				Description: "The name of a unit of the application, e.g. `myapp/0`. When set, the history of the " +
+					"unit is read instead of the history of the application.",
+				Optional: true,
+			},
+			"kind": schema.StringAttribute{
+				Description: fmt.Sprintf("The kind of status to read. An application only supports %q, which is "+
+					"also its default. A unit supports %q (default) for both its agent and workload statuses, %q "+
+					"for its agent status and %q for its workload status.",
+					status.KindApplication, status.KindUnit, status.KindUnitAgent, status.KindWorkload),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(status.KindApplication),
+						string(status.KindUnit),
+						string(status.KindUnitAgent),
+						string(status.KindWorkload),
+					),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of entries to read, between 1 and %d. Defaults to %d.",
+					maxStatusHistorySize, defaultStatusHistorySize),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxStatusHistorySize),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "The status history entries, from the most recent to the oldest.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							Description: "The kind of the status.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status, e.g. `active` or `idle`.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The message set along with the status.",
+							Computed:    true,
+						},
+						"since": schema.StringAttribute{
+							Description: "The time the status was set, in RFC 3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *applicationStatusHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceApplicationStatusHistory)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *applicationStatusHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "application status history")
+		return
+	}
+
+	var data applicationStatusHistoryDataSourceModel
+
+	// Read Terraform configuration data into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	size := int64(defaultStatusHistorySize)
+	if !data.Size.IsNull() {
+		size = data.Size.ValueInt64()
+	}
+	input := &juju.ReadStatusHistoryInput{
+		ModelName: data.Model.ValueString(),
+		AppName:   data.ApplicationName.ValueString(),
+		UnitName:  data.Unit.ValueString(),
+		Kind:      data.Kind.ValueString(),
+		Size:      int(size),
+	}
+	d.trace("reading status history", map[string]interface{}{
+		"model":       input.ModelName,
+		"application": input.AppName,
+		"unit":        input.UnitName,
+	})
+
+	response, err := d.client.Applications.ReadStatusHistory(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status history of %q, got error: %s", statusHistoryEntity(input), err))
+		return
+	}
+
+	entries := make([]statusHistoryEntryModel, len(response.Entries))
+	for i, entry := range response.Entries {
+		entries[i] = statusHistoryEntryModel{
+			Kind:    types.StringValue(entry.Kind),
+			Status:  types.StringValue(entry.Status),
+			Message: types.StringValue(entry.Message),
+			Since:   types.StringValue(entry.Since.Format(time.RFC3339)),
+		}
+	}
+	entryType := req.Config.Schema.GetAttributes()["entries"].(schema.ListNestedAttribute).NestedObject.Type()
+	entriesValue, dErr := types.ListValueFrom(ctx, entryType, entries)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Entries = entriesValue
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", input.ModelName, statusHistoryEntity(input)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// statusHistoryEntity returns the name of the application or unit
+// whose history is read.
+func statusHistoryEntity(input *juju.ReadStatusHistoryInput) string {
+	if input.UnitName != "" {
+		return input.UnitName
+	}
+	return input.AppName
+}
+
+func (d *applicationStatusHistoryDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceApplicationStatusHistory, msg, additionalFields...)
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceApplicationStatusHistory(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-status-history-test-model")
+	appName := "test-app"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceApplicationStatusHistory(modelName, appName, `kind = "workload"`),
+				ExpectError: regexp.MustCompile(`kind "workload" is not valid for an application`),
+			},
+			{
+				Config: testAccDataSourceApplicationStatusHistory(modelName, appName, `size = 5`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_application_status_history.this", "id", modelName+":"+appName),
+					resource.TestCheckResourceAttrSet("data.juju_application_status_history.this", "entries.0.status"),
+					resource.TestCheckResourceAttr("data.juju_application_status_history.this", "entries.0.kind", "application"),
+				),
+			},
+			{
+				Config: testAccDataSourceApplicationStatusHistory(modelName, appName, fmt.Sprintf("unit = \"%s/0\"\n  kind = \"juju-unit\"", appName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_application_status_history.this", "id", modelName+":"+appName+"/0"),
+					resource.TestCheckResourceAttr("data.juju_application_status_history.this", "entries.0.kind", "juju-unit"),
+					resource.TestCheckResourceAttrSet("data.juju_application_status_history.this", "entries.0.since"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApplicationStatusHistory(modelName, appName, extra string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = %q
+
+  charm {
+    name = "juju-qa-test"
+  }
+}
+
+data "juju_application_status_history" "this" {
+  model            = juju_model.this.name
+  application_name = juju_application.this.name
+  %s
+}
+`, modelName, appName, extra)
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceApplicationStatus(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-application-status-test-model")
+	appName := "test-app"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApplicationStatus(modelName, appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_application_status.this", "id", modelName+":"+appName),
+					resource.TestCheckResourceAttrSet("data.juju_application_status.this", "status"),
+					resource.TestCheckResourceAttr("data.juju_application_status.this", "units.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_application_status.this", "units.0.name", appName+"/0"),
+					resource.TestCheckResourceAttrSet("data.juju_application_status.this", "units.0.workload_status"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+data "juju_application_status" "this" {
+  model            = juju_model.this.name
+  application_name = "missing"
+}
+`, modelName),
+				ExpectError: regexp.MustCompile(`application "missing" not found`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApplicationStatus(modelName, appName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model          = juju_model.this.name
+  name           = %q
+  wait_for_ready = true
+
+  charm {
+    name = "juju-qa-test"
+  }
+}
+
+data "juju_application_status" "this" {
+  model            = juju_model.this.name
+  application_name = juju_application.this.name
+}
+`, modelName, appName)
+}
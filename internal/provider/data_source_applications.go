@@ -0,0 +1,223 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &applicationsDataSource{}
+
+func NewApplicationsDataSource() datasource.DataSourceWithConfigure {
+	return &applicationsDataSource{}
+}
+
+type applicationsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type applicationsDataSourceModel struct {
+	Model        types.String `tfsdk:"model"`
+	NamePrefix   types.String `tfsdk:"name_prefix"`
+	CharmName    types.String `tfsdk:"charm_name"`
+	Names        types.List   `tfsdk:"names"`
+	Applications types.List   `tfsdk:"applications"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+type applicationSummaryModel struct {
+	Name        types.String `tfsdk:"name"`
+	CharmName   types.String `tfsdk:"charm_name"`
+	Channel     types.String `tfsdk:"channel"`
+	Revision    types.Int64  `tfsdk:"revision"`
+	Status      types.String `tfsdk:"status"`
+	Subordinate types.Bool   `tfsdk:"subordinate"`
+	Exposed     types.Bool   `tfsdk:"exposed"`
+	Units       types.Int64  `tfsdk:"units"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *applicationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+func (d *applicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the applications of a model, optionally filtered by name prefix " +
+			"and charm. It can be used to act on every application of a kind, e.g. to relate every " +
+			"application of a charm to a logging application.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the applications.",
+				Required:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list the applications whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"charm_name": schema.StringAttribute{
+				Description: "Only list the applications of this charm, e.g. `postgresql`.",
+				Optional:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "The names of the matching applications, sorted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"applications": schema.ListNestedAttribute{
+				Description: "The matching applications, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the application.",
+							Computed:    true,
+						},
+						"charm_name": schema.StringAttribute{
+							Description: "The name of the charm of the application.",
+							Computed:    true,
+						},
+						"channel": schema.StringAttribute{
+							Description: "The channel of the charm, empty for a local charm.",
+							Computed:    true,
+						},
+						"revision": schema.Int64Attribute{
+							Description: "The revision of the charm.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the application, e.g. `active` or `blocked`.",
+							Computed:    true,
+						},
+						"subordinate": schema.BoolAttribute{
+							Description: "Whether the application is a subordinate.",
+							Computed:    true,
+						},
+						"exposed": schema.BoolAttribute{
+							Description: "Whether the application is exposed.",
+							Computed:    true,
+						},
+						"units": schema.Int64Attribute{
+							Description: "The number of units of the application.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *applicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceApplications)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "applications")
+		return
+	}
+
+	var data applicationsDataSourceModel
+
+	// Read Terraform configuration data into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &juju.ListApplicationsInput{
+		ModelName:  data.Model.ValueString(),
+		NamePrefix: data.NamePrefix.ValueString(),
+		CharmName:  data.CharmName.ValueString(),
+	}
+	d.trace("listing applications", map[string]interface{}{
+		"model":       input.ModelName,
+		"name-prefix": input.NamePrefix,
+		"charm-name":  input.CharmName,
+	})
+
+	response, err := d.client.Applications.ListApplications(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list applications of model %q, got error: %s", input.ModelName, err))
+		return
+	}
+
+	names := make([]string, len(response.Applications))
+	applications := make([]applicationSummaryModel, len(response.Applications))
+	for i, application := range response.Applications {
+		names[i] = application.Name
+		applications[i] = applicationSummaryModel{
+			Name:        types.StringValue(application.Name),
+			CharmName:   types.StringValue(application.CharmName),
+			Channel:     types.StringValue(application.Channel),
+			Revision:    types.Int64Value(int64(application.Revision)),
+			Status:      types.StringValue(application.Status),
+			Subordinate: types.BoolValue(application.Subordinate),
+			Exposed:     types.BoolValue(application.Exposed),
+			Units:       types.Int64Value(int64(application.Units)),
+		}
+	}
+	namesValue, dErr := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(dErr...)
+	applicationType := req.Config.Schema.GetAttributes()["applications"].(schema.ListNestedAttribute).NestedObject.Type()
+	applicationsValue, dErr := types.ListValueFrom(ctx, applicationType, applications)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Names = namesValue
+	data.Applications = applicationsValue
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", input.ModelName, input.NamePrefix, input.CharmName))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *applicationsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceApplications, msg, additionalFields...)
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceApplications(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-applications-test-model")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApplications(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_applications.all", "names.#", "3"),
+					resource.TestCheckResourceAttr("data.juju_applications.prefix", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.juju_applications.prefix", "names.0", "web-one"),
+					resource.TestCheckResourceAttr("data.juju_applications.prefix", "names.1", "web-two"),
+					resource.TestCheckResourceAttr("data.juju_applications.charm", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_applications.charm", "applications.0.name", "web-two"),
+					resource.TestCheckResourceAttr("data.juju_applications.charm", "applications.0.charm_name", "juju-qa-dummy-source"),
+					resource.TestCheckResourceAttr("data.juju_applications.charm", "applications.0.subordinate", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApplications(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "one" {
+  model = juju_model.this.name
+  name  = "web-one"
+
+  charm {
+    name = "juju-qa-dummy-sink"
+  }
+}
+
+resource "juju_application" "two" {
+  model = juju_model.this.name
+  name  = "web-two"
+
+  charm {
+    name = "juju-qa-dummy-source"
+  }
+}
+
+resource "juju_application" "three" {
+  model = juju_model.this.name
+  name  = "db"
+
+  charm {
+    name = "juju-qa-dummy-sink"
+  }
+}
+
+data "juju_applications" "all" {
+  model = juju_model.this.name
+
+  depends_on = [juju_application.one, juju_application.two, juju_application.three]
+}
+
+data "juju_applications" "prefix" {
+  model       = juju_model.this.name
+  name_prefix = "web-"
+
+  depends_on = [juju_application.one, juju_application.two, juju_application.three]
+}
+
+data "juju_applications" "charm" {
+  model       = juju_model.this.name
+  name_prefix = "web-"
+  charm_name  = "juju-qa-dummy-source"
+
+  depends_on = [juju_application.one, juju_application.two, juju_application.three]
+}
+`, modelName)
+}
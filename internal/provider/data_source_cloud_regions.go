@@ -0,0 +1,182 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &cloudRegionsDataSource{}
+
+func NewCloudRegionsDataSource() datasource.DataSourceWithConfigure {
+	return &cloudRegionsDataSource{}
+}
+
+type cloudRegionsDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type cloudRegionsDataSourceModel struct {
+	Cloud   types.String `tfsdk:"cloud"`
+	Names   types.List   `tfsdk:"names"`
+	Regions types.List   `tfsdk:"regions"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+type cloudRegionModel struct {
+	Name             types.String `tfsdk:"name"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	IdentityEndpoint types.String `tfsdk:"identity_endpoint"`
+	StorageEndpoint  types.String `tfsdk:"storage_endpoint"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *cloudRegionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_regions"
+}
+
+func (d *cloudRegionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the regions of a cloud of the controller, like `juju regions`.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud.",
+				Required:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "The names of the regions of the cloud, in the order of the cloud definition.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"regions": schema.ListNestedAttribute{
+				Description: "The regions of the cloud, in the order of the cloud definition.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the region.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The API endpoint of the region, empty when it is the endpoint of the cloud.",
+							Computed:    true,
+						},
+						"identity_endpoint": schema.StringAttribute{
+							Description: "The identity endpoint of the region, empty when it is the one of the cloud.",
+							Computed:    true,
+						},
+						"storage_endpoint": schema.StringAttribute{
+							Description: "The storage endpoint of the region, empty when it is the one of the cloud.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *cloudRegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceCloudRegions)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *cloudRegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "cloud regions")
+		return
+	}
+
+	var data cloudRegionsDataSourceModel
+
+	// Read Terraform configuration data into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudName := data.Cloud.ValueString()
+	d.trace("reading cloud regions", map[string]interface{}{"cloud": cloudName})
+
+	response, err := d.client.Clouds.ReadCloud(ctx, cloudName)
+	if errors.As(err, &juju.CloudNotFoundError) {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Cloud %q was not found on the controller.", cloudName))
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud %q, got error: %s", cloudName, err))
+		return
+	}
+
+	names := make([]string, len(response.Regions))
+	regions := make([]cloudRegionModel, len(response.Regions))
+	for i, region := range response.Regions {
+		names[i] = region.Name
+		regions[i] = cloudRegionModel{
+			Name:             types.StringValue(region.Name),
+			Endpoint:         types.StringValue(region.Endpoint),
+			IdentityEndpoint: types.StringValue(region.IdentityEndpoint),
+			StorageEndpoint:  types.StringValue(region.StorageEndpoint),
+		}
+	}
+	namesValue, dErr := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(dErr...)
+	regionType := req.Config.Schema.GetAttributes()["regions"].(schema.ListNestedAttribute).NestedObject.Type()
+	regionsValue, dErr := types.ListValueFrom(ctx, regionType, regions)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Names = namesValue
+	data.Regions = regionsValue
+	data.ID = types.StringValue(cloudName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *cloudRegionsDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceCloudRegions, msg, additionalFields...)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceCloudRegions(t *testing.T) {
+	SkipJAAS(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCloudRegions(testingCloud.CloudName()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_cloud_regions.this", "cloud", testingCloud.CloudName()),
+					resource.TestCheckResourceAttrSet("data.juju_cloud_regions.this", "names.0"),
+					resource.TestCheckResourceAttrPair("data.juju_cloud_regions.this", "names.0",
+						"data.juju_cloud_regions.this", "regions.0.name"),
+				),
+			},
+			{
+				Config:      testAccDataSourceCloudRegions("not-a-cloud"),
+				ExpectError: regexp.MustCompile(`Cloud "not-a-cloud" was not found`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCloudRegions(cloudName string) string {
+	return fmt.Sprintf(`
+data "juju_cloud_regions" "this" {
+  cloud = %q
+}`, cloudName)
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &dataSourceControllerSelector{}
+var _ datasource.DataSourceWithConfigure = &dataSourceControllerSelector{}
+var _ datasource.DataSourceWithConfigValidators = &dataSourceControllerSelector{}
+var _ datasource.DataSourceWithValidateConfig = &dataSourceControllerSelector{}
+
+// dataSourceControllerSelector wraps a data source to add the
+// controller_name attribute, see controllerSelector.
+type dataSourceControllerSelector struct {
+	datasource.DataSource
+
+	newDataSource func() datasource.DataSource
+	client        *juju.Client
+}
+
+func newDataSourceControllerSelector(newDataSource func() datasource.DataSource) datasource.DataSource {
+	return &dataSourceControllerSelector{DataSource: newDataSource(), newDataSource: newDataSource}
+}
+
+// Configure keeps the client of the provider, to get the clients of the
+// controllers selected, and configures the data source with it.
+func (s *dataSourceControllerSelector) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(*juju.Client); ok {
+		s.client = client
+	}
+	if d, ok := s.DataSource.(datasource.DataSourceWithConfigure); ok {
+		d.Configure(ctx, req, resp)
+	}
+}
+
+// Schema adds the controller_name attribute to the schema of the data
+// source.
+func (s *dataSourceControllerSelector) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	s.DataSource.Schema(ctx, req, resp)
+	resp.Schema.Attributes = maps.Clone(resp.Schema.Attributes)
+	resp.Schema.Attributes[JujuControllerName] = schema.StringAttribute{
+		Description: controllerNameDescription,
+		Optional:    true,
+	}
+}
+
+func (s *dataSourceControllerSelector) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	v := s.values(ctx)
+	config, controller := v.split(req.Config.Raw)
+	state, _ := v.split(resp.State.Raw)
+	d := s.dataSourceFor(ctx, controller, &resp.Diagnostics)
+	if d == nil {
+		return
+	}
+
+	innerResp := datasource.ReadResponse{
+		State:       tfsdk.State{Schema: v.schema, Raw: state},
+		Diagnostics: resp.Diagnostics,
+	}
+	d.Read(ctx, datasource.ReadRequest{
+		Config:       tfsdk.Config{Schema: v.schema, Raw: config},
+		ProviderMeta: req.ProviderMeta,
+	}, &innerResp)
+	resp.State.Raw = v.join(innerResp.State.Raw, controller)
+	resp.Diagnostics = innerResp.Diagnostics
+}
+
+func (s *dataSourceControllerSelector) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	if _, ok := s.DataSource.(datasource.DataSourceWithConfigValidators); !ok {
+		return nil
+	}
+	return []datasource.ConfigValidator{dataSourceControllerConfigValidators{selector: s}}
+}
+
+func (s *dataSourceControllerSelector) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	if _, ok := s.DataSource.(datasource.DataSourceWithValidateConfig); !ok {
+		return
+	}
+	v := s.values(ctx)
+	config, controller := v.split(req.Config.Raw)
+	if validator, ok := s.dataSourceFor(ctx, controller, &resp.Diagnostics).(datasource.DataSourceWithValidateConfig); ok {
+		validator.ValidateConfig(ctx, datasource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: v.schema, Raw: config},
+		}, resp)
+	}
+}
+
+// dataSourceFor returns the data source configured with the client of
+// the controller selected, see controllerSelector.resourceFor.
+func (s *dataSourceControllerSelector) dataSourceFor(ctx context.Context, controller tftypes.Value, diags *diag.Diagnostics) datasource.DataSource {
+	if !controller.IsKnown() {
+		return s.newDataSource()
+	}
+	var name string
+	if !controller.IsNull() {
+		_ = controller.As(&name)
+	}
+	if name == "" || s.client == nil {
+		return s.DataSource
+	}
+	client, err := s.client.ForController(ctx, name)
+	if err != nil {
+		diags.AddAttributeError(path.Root(JujuControllerName), "Controller Not Found",
+			fmt.Sprintf("Unable to select controller %q, got error: %s", name, err))
+		return nil
+	}
+	d := s.newDataSource()
+	if configurable, ok := d.(datasource.DataSourceWithConfigure); ok {
+		var resp datasource.ConfigureResponse
+		configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return d
+}
+
+// dataSourceValues converts the values of the data source, whose own
+// schema is schema.
+type dataSourceValues struct {
+	controllerValues
+	schema schema.Schema
+}
+
+// values returns the converter of the values of the data source.
+func (s *dataSourceControllerSelector) values(ctx context.Context) dataSourceValues {
+	var resp datasource.SchemaResponse
+	s.DataSource.Schema(ctx, datasource.SchemaRequest{}, &resp)
+	var outer datasource.SchemaResponse
+	s.Schema(ctx, datasource.SchemaRequest{}, &outer)
+	return dataSourceValues{
+		controllerValues: controllerValues{
+			innerType: resp.Schema.Type().TerraformType(ctx),
+			outerType: outer.Schema.Type().TerraformType(ctx),
+		},
+		schema: resp.Schema,
+	}
+}
+
+// dataSourceControllerConfigValidators runs the config validators of
+// the data source configured for the controller selected.
+type dataSourceControllerConfigValidators struct {
+	selector *dataSourceControllerSelector
+}
+
+func (v dataSourceControllerConfigValidators) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v dataSourceControllerConfigValidators) MarkdownDescription(_ context.Context) string {
+	return "Validates the configuration with the controller selected by controller_name"
+}
+
+func (v dataSourceControllerConfigValidators) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	values := v.selector.values(ctx)
+	config, controller := values.split(req.Config.Raw)
+	withValidators, ok := v.selector.dataSourceFor(ctx, controller, &resp.Diagnostics).(datasource.DataSourceWithConfigValidators)
+	if !ok {
+		return
+	}
+	innerReq := datasource.ValidateConfigRequest{Config: tfsdk.Config{Schema: values.schema, Raw: config}}
+	for _, validator := range withValidators.ConfigValidators(ctx) {
+		validator.ValidateDataSource(ctx, innerReq, resp)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &jaasControllersDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &jaasControllersDataSource{}
+
+// NewJAASControllersDataSource returns a new instance of the JAAS
+// controllers data source.
+func NewJAASControllersDataSource() datasource.DataSource {
+	return &jaasControllersDataSource{}
+}
+
+type jaasControllersDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// jaasControllersDataSourceModel is the juju data stored by terraform.
+// tfsdk must match JAAS controllers data source schema attribute names.
+type jaasControllersDataSourceModel struct {
+	Controllers types.List `tfsdk:"controllers"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+type jaasControllerModel struct {
+	Name          types.String `tfsdk:"name"`
+	UUID          types.String `tfsdk:"uuid"`
+	Status        types.String `tfsdk:"status"`
+	PublicAddress types.String `tfsdk:"public_address"`
+	Cloud         types.String `tfsdk:"cloud"`
+	CloudRegion   types.String `tfsdk:"cloud_region"`
+	AgentVersion  types.String `tfsdk:"agent_version"`
+	APIAddresses  types.List   `tfsdk:"api_addresses"`
+	CACertificate types.String `tfsdk:"ca_certificate"`
+}
+
+func (d *jaasControllersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_controllers"
+}
+
+func (d *jaasControllersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the Juju controllers registered in JAAS. It can be used to " +
+			"choose the controller of a model, e.g. the available controller running in a given cloud region.",
+		Attributes: map[string]schema.Attribute{
+			"controllers": schema.ListNestedAttribute{
+				Description: "The controllers registered in JAAS, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the controller in JAAS.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the controller.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: fmt.Sprintf("The status of the controller: `available`, `unavailable` or "+
+								"`%s`. JAAS does not place new models on deprecated controllers.",
+								juju.ControllerStatusDeprecated),
+							Computed: true,
+						},
+						"public_address": schema.StringAttribute{
+							Description: "The DNS name and port of the controller, empty if it has none.",
+							Computed:    true,
+						},
+						"cloud": schema.StringAttribute{
+							Description: "The cloud the controller is running in.",
+							Computed:    true,
+						},
+						"cloud_region": schema.StringAttribute{
+							Description: "The cloud region the controller is running in.",
+							Computed:    true,
+						},
+						"agent_version": schema.StringAttribute{
+							Description: "The version of the juju agent running on the controller.",
+							Computed:    true,
+						},
+						"api_addresses": schema.ListAttribute{
+							Description: "The addresses of the API servers of the controller.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ca_certificate": schema.StringAttribute{
+							Description: "The CA certificate of the controller, it changes when the controller is " +
+								"rebuilt. It can be set as the ca_certificate of a provider connecting to the " +
+								"controller directly.",
+							Computed: true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ConfigValidators sets validators for the data source.
+func (d *jaasControllersDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		NewRequiresJAASValidator(d.client),
+	}
+}
+
+func (d *jaasControllersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceJAASControllers)
+}
+
+func (d *jaasControllersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "jaas controllers")
+		return
+	}
+
+	var data jaasControllersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	controllers, err := d.client.Jaas.ListControllers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list JAAS controllers, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read %d JAAS controllers", len(controllers)))
+
+	models := make([]jaasControllerModel, len(controllers))
+	for i, controller := range controllers {
+		apiAddresses, diags := types.ListValueFrom(ctx, types.StringType, controller.APIAddresses)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		models[i] = jaasControllerModel{
+			Name:          types.StringValue(controller.Name),
+			UUID:          types.StringValue(controller.UUID),
+			Status:        types.StringValue(controller.Status),
+			PublicAddress: types.StringValue(controller.PublicAddress),
+			Cloud:         types.StringValue(controller.Cloud),
+			CloudRegion:   types.StringValue(controller.CloudRegion),
+			AgentVersion:  types.StringValue(controller.AgentVersion),
+			APIAddresses:  apiAddresses,
+			CACertificate: types.StringValue(controller.CACertificate),
+		}
+	}
+	controllerType := req.Config.Schema.GetAttributes()["controllers"].(schema.ListNestedAttribute).NestedObject.Type()
+	controllersValue, diags := types.ListValueFrom(ctx, controllerType, models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	data.Controllers = controllersValue
+	data.ID = types.StringValue("jaas-controllers")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *jaasControllersDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceJAASControllers, msg, additionalFields...)
+}
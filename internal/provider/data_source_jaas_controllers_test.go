@@ -0,0 +1,38 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceJAASControllers(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	dataSourceName := "data.juju_jaas_controllers.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceJAASControllers(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "controllers.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "controllers.0.uuid"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "controllers.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "controllers.0.agent_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "controllers.0.ca_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceJAASControllers() string {
+	return `
+data "juju_jaas_controllers" "this" {}
+`
+}
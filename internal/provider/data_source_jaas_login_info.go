@@ -0,0 +1,168 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jujuerrors "github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &jaasLoginInfoDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &jaasLoginInfoDataSource{}
+
+// NewJAASLoginInfoDataSource returns a new instance of the JAAS login
+// info data source.
+func NewJAASLoginInfoDataSource() datasource.DataSource {
+	return &jaasLoginInfoDataSource{}
+}
+
+type jaasLoginInfoDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// jaasLoginInfoDataSourceModel is the juju data stored by terraform.
+// tfsdk must match JAAS login info data source schema attribute names.
+type jaasLoginInfoDataSourceModel struct {
+	Identity         types.String `tfsdk:"identity"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	ControllerAccess types.String `tfsdk:"controller_access"`
+	Groups           types.Set    `tfsdk:"groups"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *jaasLoginInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_login_info"
+}
+
+func (d *jaasLoginInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the identity the provider is authenticated as with JAAS.",
+		Attributes: map[string]schema.Attribute{
+			"identity": schema.StringAttribute{
+				Description: "The email of the user or the client ID of the service account, " +
+					"including the @serviceaccount domain.",
+				Computed: true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the identity, null if the controller does not return it as a user.",
+				Computed:    true,
+			},
+			"controller_access": schema.StringAttribute{
+				Description: "The access level of the identity to the JAAS controller.",
+				Computed:    true,
+			},
+			"groups": schema.SetAttribute{
+				Description: "The UUIDs of the groups the identity is a direct member of.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ConfigValidators sets validators for the data source.
+func (d *jaasLoginInfoDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		NewRequiresJAASValidator(d.client),
+	}
+}
+
+func (d *jaasLoginInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceJAASLoginInfo)
+}
+
+func (d *jaasLoginInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "jaas login info")
+		return
+	}
+
+	var data jaasLoginInfoDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.Jaas.ReadLoginInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read JAAS login info, got error: %s", err))
+		return
+	}
+	// Service accounts, and users the identity may not see, are not
+	// returned as users, only their display name is then unknown.
+	data.DisplayName = types.StringNull()
+	user, err := d.client.Users.ReadUser(ctx, info.Identity)
+	switch {
+	case jujuerrors.Is(err, jujuerrors.NotFound):
+		resp.Diagnostics.AddAttributeWarning(path.Root("display_name"), "User Not Found",
+			fmt.Sprintf("%q is not a user the controller returns, e.g. it is a service account, "+
+				"so its display name is not known.", info.Identity))
+	case err != nil:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %q, got error: %s", info.Identity, err))
+		return
+	default:
+		data.DisplayName = types.StringValue(user.UserInfo.DisplayName)
+	}
+	d.trace(fmt.Sprintf("read JAAS login info for %q", info.Identity))
+
+	groups, diags := types.SetValueFrom(ctx, types.StringType, info.Groups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	data.Identity = types.StringValue(info.Identity)
+	data.ControllerAccess = types.StringValue(info.ControllerAccess)
+	data.Groups = groups
+	data.ID = types.StringValue(info.Identity)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *jaasLoginInfoDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-jaas-login-info", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-jaas-login-info","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceJAASLoginInfo, msg, additionalFields...)
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceJAASLoginInfo(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	dataSourceName := "data.juju_jaas_login_info.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceJAASLoginInfo(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "identity"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "controller_access"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", dataSourceName, "identity"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceJAASLoginInfo() string {
+	return `
+data "juju_jaas_login_info" "this" {}
+`
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestDataSourceJAASRoleReadWithMockClient(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	jaasClient := mocks.NewMockJaasClient(ctlr)
+	jaasClient.EXPECT().ReadRoleByName(gomock.Any(), "admins").Return(&juju.JaasRole{Name: "admins", UUID: "role-uuid"}, nil)
+
+	d := &jaasRoleDataSource{client: &juju.Client{Jaas: jaasClient}}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "admins"),
+		"uuid": tftypes.NewValue(tftypes.String, nil),
+		"id":   tftypes.NewValue(tftypes.String, nil),
+	})}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got jaasRoleDataSourceModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, types.StringValue("role-uuid"), got.UUID)
+	assert.Equal(t, types.StringValue("role-uuid"), got.ID)
+}
+
+func TestAcc_DataSourceJAASRole(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	roleName := acctest.RandomWithPrefix("tf-jaas-role")
+	dataSourceName := "data.juju_jaas_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceJAASRole(roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", roleName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "uuid", "juju_jaas_role.test", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceJAASRole(name string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccDataSourceJAASRole",
+		`
+resource "juju_jaas_role" "test" {
+  name = "{{ .Name }}"
+}
+
+data "juju_jaas_role" "test" {
+  name = juju_jaas_role.test.name
+}
+`, internaltesting.TemplateData{
+			"Name": name,
+		})
+}
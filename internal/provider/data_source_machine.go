@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -30,12 +31,25 @@ type machineDataSource struct {
 }
 
 type machineDataSourceModel struct {
-	Model     types.String `tfsdk:"model"`
-	MachineID types.String `tfsdk:"machine_id"`
+	Model             types.String `tfsdk:"model"`
+	MachineID         types.String `tfsdk:"machine_id"`
+	NetworkInterfaces types.List   `tfsdk:"network_interfaces"`
+	Spaces            types.Set    `tfsdk:"spaces"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
 
+type machineNetworkInterfaceModel struct {
+	Name           types.String `tfsdk:"name"`
+	MACAddress     types.String `tfsdk:"mac_address"`
+	IPAddresses    types.List   `tfsdk:"ip_addresses"`
+	Subnets        types.List   `tfsdk:"subnets"`
+	Gateway        types.String `tfsdk:"gateway"`
+	DNSNameservers types.List   `tfsdk:"dns_nameservers"`
+	Space          types.String `tfsdk:"space"`
+	IsUp           types.Bool   `tfsdk:"is_up"`
+}
+
 // Metadata returns the full data source name as used in terraform plans.
 func (d *machineDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_machine"
@@ -53,6 +67,57 @@ func (d *machineDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "The Juju id of the machine.",
 				Required:    true,
 			},
+			"network_interfaces": schema.ListNestedAttribute{
+				Description: "The network interfaces of the machine, sorted by name. They can be used to " +
+					"validate network sensitive placements once the machine is provisioned.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the interface, e.g. `eth0`.",
+							Computed:    true,
+						},
+						"mac_address": schema.StringAttribute{
+							Description: "The MAC address of the interface.",
+							Computed:    true,
+						},
+						"ip_addresses": schema.ListAttribute{
+							Description: "The IP addresses bound to the interface.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"subnets": schema.ListAttribute{
+							Description: "The CIDRs of the subnets, known to the model, which the IP addresses " +
+								"of the interface belong to.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"gateway": schema.StringAttribute{
+							Description: "The gateway of the interface, empty if it has none.",
+							Computed:    true,
+						},
+						"dns_nameservers": schema.ListAttribute{
+							Description: "The DNS nameservers of the interface.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"space": schema.StringAttribute{
+							Description: "The space the interface belongs to, empty if its subnets are not " +
+								"part of any space.",
+							Computed: true,
+						},
+						"is_up": schema.BoolAttribute{
+							Description: "Whether the interface is up.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"spaces": schema.SetAttribute{
+				Description: "The spaces the machine is a member of through its network interfaces.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			// ID required by the testing framework
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -80,7 +145,7 @@ func (d *machineDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 
 	d.client = client
-	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceMachine)
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceMachine)
 }
 
 // Read is called when the provider must read data source values in
@@ -108,17 +173,56 @@ func (d *machineDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	machine_id := data.MachineID.ValueString()
 	d.trace(fmt.Sprintf("reading juju machine %q data source", machine_id))
 
-	// Verify the machine exists in the model provided
-	if _, err := d.client.Machines.ReadMachine(
+	// Verify the machine exists in the model provided and read
+	// its network interfaces.
+	interfaces, err := d.client.Machines.ReadMachineNetworkInterfaces(ctx,
 		juju.ReadMachineInput{
 			ModelName: data.Model.ValueString(),
 			ID:        machine_id,
 		},
-	); err != nil {
+	)
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read machine %q, got error: %s", machine_id, err))
 		return
 	}
 
+	networkInterfaces := make([]machineNetworkInterfaceModel, len(interfaces))
+	spaces := []string{}
+	for i, nic := range interfaces {
+		ipAddresses, dErr := types.ListValueFrom(ctx, types.StringType, nic.IPAddresses)
+		resp.Diagnostics.Append(dErr...)
+		subnets, dErr := types.ListValueFrom(ctx, types.StringType, nic.Subnets)
+		resp.Diagnostics.Append(dErr...)
+		dnsNameservers, dErr := types.ListValueFrom(ctx, types.StringType, nic.DNSNameservers)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		networkInterfaces[i] = machineNetworkInterfaceModel{
+			Name:           types.StringValue(nic.Name),
+			MACAddress:     types.StringValue(nic.MACAddress),
+			IPAddresses:    ipAddresses,
+			Subnets:        subnets,
+			Gateway:        types.StringValue(nic.Gateway),
+			DNSNameservers: dnsNameservers,
+			Space:          types.StringValue(nic.Space),
+			IsUp:           types.BoolValue(nic.IsUp),
+		}
+		if nic.Space != "" && !slices.Contains(spaces, nic.Space) {
+			spaces = append(spaces, nic.Space)
+		}
+	}
+	interfaceType := req.Config.Schema.GetAttributes()["network_interfaces"].(schema.ListNestedAttribute).NestedObject.Type()
+	networkInterfacesValue, dErr := types.ListValueFrom(ctx, interfaceType, networkInterfaces)
+	resp.Diagnostics.Append(dErr...)
+	spacesValue, dErr := types.SetValueFrom(ctx, types.StringType, spaces)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.NetworkInterfaces = networkInterfacesValue
+	data.Spaces = spacesValue
+
 	// machine_id is not unique, however it matches the
 	// SDK value used. "id" is required for tests.
 	data.ID = types.StringValue(machine_id)
@@ -25,6 +25,9 @@ func TestAcc_DataSourceMachine_Edge(t *testing.T) {
 				Config: testAccDataSourceMachine(modelName, "base = \"ubuntu@22.04\""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.juju_machine.machine", "model", modelName),
+					resource.TestCheckResourceAttrSet("data.juju_machine.machine", "network_interfaces.0.name"),
+					resource.TestCheckResourceAttrSet("data.juju_machine.machine", "network_interfaces.0.mac_address"),
+					resource.TestCheckTypeSetElemAttr("data.juju_machine.machine", "spaces.*", "alpha"),
 				),
 			},
 		},
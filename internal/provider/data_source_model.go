@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -32,6 +33,8 @@ type modelDataSource struct {
 type modelDataSourceModel struct {
 	Name types.String `tfsdk:"name"`
 	UUID types.String `tfsdk:"uuid"`
+	// Description is read from the annotations of the model.
+	Description types.String `tfsdk:"description"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -54,6 +57,10 @@ func (d *modelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description: "The UUID of the model.",
 				Computed:    true,
 			},
+			"description": schema.StringAttribute{
+				Description: "The description of the model, see the `description` of `juju_model`. Null if it has none.",
+				Computed:    true,
+			},
 			// ID required by the testing framework
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -81,7 +88,7 @@ func (d *modelDataSource) Configure(ctx context.Context, req datasource.Configur
 	}
 
 	d.client = client
-	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceModel)
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceModel)
 }
 
 // Read is called when the provider must read data source values in
@@ -103,7 +110,7 @@ func (d *modelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get current juju model data source values.
-	model, err := d.client.Models.GetModelByName(data.Name.ValueString())
+	model, err := d.client.Models.GetModelByName(ctx, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model, got error: %s", err))
 		return
@@ -113,6 +120,11 @@ func (d *modelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Save data into Terraform state
 	data.Name = types.StringValue(model.Name)
 	data.UUID = types.StringValue(model.UUID)
+	data.Description, err = readDescription(ctx, d.client, model.Name, names.NewModelTag(model.UUID).String())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the description of model %q, got error: %s", model.Name, err))
+		return
+	}
 	data.ID = types.StringValue(model.UUID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
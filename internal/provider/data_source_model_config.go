@@ -0,0 +1,160 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &modelConfigDataSource{}
+
+func NewModelConfigDataSource() datasource.DataSourceWithConfigure {
+	return &modelConfigDataSource{}
+}
+
+type modelConfigDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type modelConfigDataSourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	UUID    types.String `tfsdk:"uuid"`
+	Config  types.Map    `tfsdk:"config"`
+	Sources types.Map    `tfsdk:"sources"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *modelConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_config"
+}
+
+// Schema returns the schema for the model config data source.
+func (d *modelConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source representing the effective configuration of a Juju model, " +
+			"including the values inherited from the controller and cloud defaults.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the model. Exactly one of name or uuid must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("uuid")),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				Description: "The UUID of the model. Exactly one of name or uuid must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"config": schema.MapAttribute{
+				Description: "The effective configuration of the model. Values which are not strings, " +
+					"such as booleans and numbers, are encoded as JSON. Unset values are empty strings.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"sources": schema.MapAttribute{
+				Description: "The source of each configuration value: `default`, `controller`, `region` or `model`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *modelConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceModelConfig)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *modelConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "model config")
+		return
+	}
+
+	var data modelConfigDataSourceModel
+
+	// Read Terraform configuration data into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Models.ReadModelConfig(ctx, juju.ReadModelConfigInput{
+		Name: data.Name.ValueString(),
+		UUID: data.UUID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model config, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju model %q config data source", response.Name))
+
+	config, dErr := types.MapValueFrom(ctx, types.StringType, response.Config)
+	resp.Diagnostics.Append(dErr...)
+	sources, dErr := types.MapValueFrom(ctx, types.StringType, response.Sources)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	data.Name = types.StringValue(response.Name)
+	data.UUID = types.StringValue(response.UUID)
+	data.Config = config
+	data.Sources = sources
+	data.ID = types.StringValue(response.UUID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *modelConfigDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceModelConfig, msg, additionalFields...)
+}
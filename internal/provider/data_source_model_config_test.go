@@ -0,0 +1,54 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceModelConfig(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-model-config-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceModelConfig(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.juju_model_config.by_name", "name", modelName),
+					resource.TestCheckResourceAttr("data.juju_model_config.by_name", "config.development", "true"),
+					resource.TestCheckResourceAttr("data.juju_model_config.by_name", "sources.development", "model"),
+					resource.TestCheckResourceAttrSet("data.juju_model_config.by_name", "config.default-base"),
+					resource.TestCheckResourceAttrPair("data.juju_model_config.by_uuid", "name", "data.juju_model_config.by_name", "name"),
+					resource.TestCheckResourceAttrPair("data.juju_model_config.by_uuid", "config.development", "data.juju_model_config.by_name", "config.development"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceModelConfig(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+
+  config = {
+    development = true
+  }
+}
+
+data "juju_model_config" "by_name" {
+  name = juju_model.this.name
+}
+
+data "juju_model_config" "by_uuid" {
+  uuid = data.juju_model_config.by_name.uuid
+}
+`, modelName)
+}
@@ -0,0 +1,163 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &modelCredentialValidityDataSource{}
+
+func NewModelCredentialValidityDataSource() datasource.DataSourceWithConfigure {
+	return &modelCredentialValidityDataSource{}
+}
+
+type modelCredentialValidityDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type modelCredentialValidityDataSourceModel struct {
+	Model      types.String `tfsdk:"model"`
+	Cloud      types.String `tfsdk:"cloud"`
+	Credential types.String `tfsdk:"credential"`
+	Owner      types.String `tfsdk:"owner"`
+	Valid      types.Bool   `tfsdk:"valid"`
+	Errors     types.List   `tfsdk:"errors"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the full data source name as used in terraform plans.
+func (d *modelCredentialValidityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_credential_validity"
+}
+
+func (d *modelCredentialValidityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source which checks whether the controller can still use the cloud credential of a " +
+			"model. Reading it triggers the controller's validity check of the credential, as done by " +
+			"`juju update-credential`, without changing its content. It can be used to stop a deployment " +
+			"when the credential of a model has expired. The credential must be owned by the user of the provider.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model.",
+				Required:    true,
+			},
+			"cloud": schema.StringAttribute{
+				Description: "The cloud of the credential.",
+				Computed:    true,
+			},
+			"credential": schema.StringAttribute{
+				Description: "The name of the credential used by the model.",
+				Computed:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "The owner of the credential.",
+				Computed:    true,
+			},
+			"valid": schema.BoolAttribute{
+				Description: "Whether the controller can use the credential for the model.",
+				Computed:    true,
+			},
+			"errors": schema.ListAttribute{
+				Description: "The errors found by the controller while checking the credential for the model, " +
+					"empty if the credential is valid.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *modelCredentialValidityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceModelCredentialValidity)
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *modelCredentialValidityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "model credential validity")
+		return
+	}
+
+	var data modelCredentialValidityDataSourceModel
+
+	// Read Terraform configuration data into the model.
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.Credentials.CheckModelCredential(ctx, juju.CheckModelCredentialInput{
+		ModelName: data.Model.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check the credential of model %q, got error: %s", data.Model.ValueString(), err))
+		return
+	}
+	d.trace("checked model credential", map[string]interface{}{
+		"model":      data.Model.ValueString(),
+		"credential": response.Credential,
+		"valid":      response.Valid,
+	})
+
+	checkErrors, dErr := types.ListValueFrom(ctx, types.StringType, response.Errors)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Cloud = types.StringValue(response.Cloud)
+	data.Credential = types.StringValue(response.Credential)
+	data.Owner = types.StringValue(response.Owner)
+	data.Valid = types.BoolValue(response.Valid)
+	data.Errors = checkErrors
+	data.ID = types.StringValue(response.ModelUUID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *modelCredentialValidityDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceModelCredentialValidity, msg, additionalFields...)
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceModelCredentialValidity(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-datasource-model-credential-test")
+	dataSourceName := "data.juju_model_credential_validity.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceModelCredentialValidity(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "model", modelName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cloud"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "credential"),
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "juju_model.this", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceModelCredentialValidity(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+data "juju_model_credential_validity" "this" {
+  model = juju_model.this.name
+}
+`, modelName)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -37,20 +38,38 @@ type offerDataSourceModel struct {
 	ModelName       types.String `tfsdk:"model"`
 	OfferName       types.String `tfsdk:"name"`
 	OfferURL        types.String `tfsdk:"url"`
+	Description     types.String `tfsdk:"description"`
+	Endpoints       types.List   `tfsdk:"endpoints"`
+	Users           types.List   `tfsdk:"users"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
 
+// offerEndpointModel is an endpoint of the offer data source.
+type offerEndpointModel struct {
+	Name      types.String `tfsdk:"name"`
+	Interface types.String `tfsdk:"interface"`
+	Role      types.String `tfsdk:"role"`
+}
+
+// offerUserModel is a user with access to the offer data source.
+type offerUserModel struct {
+	Name   types.String `tfsdk:"name"`
+	Access types.String `tfsdk:"access"`
+}
+
 func (d *offerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_offer"
 }
 
 func (d *offerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A data source representing a Juju Offer.",
+		Description: "A data source representing a Juju Offer. It resolves an offer URL into the endpoints of " +
+			"the offer and the users with access to it, e.g. to check the interface of an endpoint before " +
+			"integrating with the offer.",
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
-				Description: "The offer URL.",
+				Description: "The offer URL, e.g. `admin/model.application`.",
 				Required:    true,
 			},
 			"model": schema.StringAttribute{
@@ -68,7 +87,48 @@ func (d *offerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:    true,
 			},
 			"endpoint": schema.StringAttribute{
-				Description: "The endpoint name.",
+				Description: "The endpoint name. For an offer of several endpoints, the first one, see `endpoints`.",
+				Computed:    true,
+			},
+			"endpoints": schema.ListNestedAttribute{
+				Description: "The endpoints of the offer, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the endpoint.",
+							Computed:    true,
+						},
+						"interface": schema.StringAttribute{
+							Description: "The interface of the endpoint.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the endpoint: `provider` or `requirer`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users with access to the offer, sorted by name. Only the users the user " +
+					"of the provider can see are listed, e.g. all of them for an admin of the offer.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the user.",
+							Computed:    true,
+						},
+						"access": schema.StringAttribute{
+							Description: "The access of the user to the offer: `read`, `consume` or `admin`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the offer.",
 				Computed:    true,
 			},
 			// ID required by the testing framework
@@ -95,7 +155,7 @@ func (d *offerDataSource) Configure(ctx context.Context, req datasource.Configur
 	}
 
 	d.client = client
-	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceOffer)
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceOffer)
 }
 
 func (d *offerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -114,14 +174,14 @@ func (d *offerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get current juju machine data source values .
-	offer, err := d.client.Offers.ReadOffer(&juju.ReadOfferInput{
+	offer, err := d.client.Offers.ReadOffer(ctx, &juju.ReadOfferInput{
 		OfferURL: data.OfferURL.ValueString(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read offer, got error: %s", err))
 		return
 	}
-	d.trace(fmt.Sprintf("read juju offer %q data source", data.OfferName))
+	d.trace(fmt.Sprintf("read juju offer %q data source", offer.OfferURL))
 
 	// Save data into Terraform state
 	data.ApplicationName = types.StringValue(offer.ApplicationName)
@@ -129,6 +189,34 @@ func (d *offerDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.ModelName = types.StringValue(offer.ModelName)
 	data.OfferName = types.StringValue(offer.Name)
 	data.OfferURL = types.StringValue(offer.OfferURL)
+	data.Description = types.StringValue(offer.Description)
+
+	endpoints := make([]offerEndpointModel, len(offer.Endpoints))
+	for i, endpoint := range offer.Endpoints {
+		endpoints[i] = offerEndpointModel{
+			Name:      types.StringValue(endpoint.Name),
+			Interface: types.StringValue(endpoint.Interface),
+			Role:      types.StringValue(endpoint.Role),
+		}
+	}
+	endpointType := req.Config.Schema.GetAttributes()["endpoints"].(schema.ListNestedAttribute).NestedObject.Type()
+	var dErr diag.Diagnostics
+	data.Endpoints, dErr = types.ListValueFrom(ctx, endpointType, endpoints)
+	resp.Diagnostics.Append(dErr...)
+
+	users := make([]offerUserModel, len(offer.Users))
+	for i, user := range offer.Users {
+		users[i] = offerUserModel{
+			Name:   types.StringValue(user.Name),
+			Access: types.StringValue(user.Access),
+		}
+	}
+	userType := req.Config.Schema.GetAttributes()["users"].(schema.ListNestedAttribute).NestedObject.Type()
+	data.Users, dErr = types.ListValueFrom(ctx, userType, users)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	data.ID = types.StringValue(offer.OfferURL)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
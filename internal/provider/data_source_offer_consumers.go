@@ -0,0 +1,189 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigure = &offerConsumersDataSource{}
+
+func NewOfferConsumersDataSource() datasource.DataSource {
+	return &offerConsumersDataSource{}
+}
+
+type offerConsumersDataSource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+type offerConsumersDataSourceModel struct {
+	OfferURL  types.String `tfsdk:"offer_url"`
+	Consumers types.List   `tfsdk:"consumers"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// offerConsumerModel is a relation consuming the offer.
+type offerConsumerModel struct {
+	SourceModelUUID types.String `tfsdk:"source_model_uuid"`
+	Username        types.String `tfsdk:"username"`
+	RelationID      types.Int64  `tfsdk:"relation_id"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Status          types.String `tfsdk:"status"`
+	Message         types.String `tfsdk:"message"`
+	IngressSubnets  types.List   `tfsdk:"ingress_subnets"`
+}
+
+func (d *offerConsumersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_offer_consumers"
+}
+
+func (d *offerConsumersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source listing the relations consuming a Juju Offer, so that the owner of the offer " +
+			"can audit who consumes it. Only an admin of the offer sees its consumers.",
+		Attributes: map[string]schema.Attribute{
+			"offer_url": schema.StringAttribute{
+				Description: "The offer URL, e.g. `admin/model.application`.",
+				Required:    true,
+			},
+			"consumers": schema.ListNestedAttribute{
+				Description: "The relations consuming the offer, sorted by relation id.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_model_uuid": schema.StringAttribute{
+							Description: "The UUID of the consuming model.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The user who consumed the offer.",
+							Computed:    true,
+						},
+						"relation_id": schema.Int64Attribute{
+							Description: "The id of the relation in the model of the offer.",
+							Computed:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The endpoint of the offer the relation is to.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the relation, e.g. `joined` or `suspended`.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The message set along with the status, e.g. why the relation is suspended.",
+							Computed:    true,
+						},
+						"ingress_subnets": schema.ListAttribute{
+							Description: "The subnets the traffic of the consuming model comes from.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *offerConsumersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceOfferConsumers)
+}
+
+func (d *offerConsumersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if d.client == nil {
+		addDSClientNotConfiguredError(&resp.Diagnostics, "offer consumers")
+		return
+	}
+
+	var data offerConsumersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	offer, err := d.client.Offers.ReadOffer(ctx, &juju.ReadOfferInput{
+		OfferURL: data.OfferURL.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read offer, got error: %s", err))
+		return
+	}
+	d.trace(fmt.Sprintf("read juju offer %q consumers data source", offer.OfferURL),
+		map[string]interface{}{"consumers": len(offer.Connections)})
+
+	consumers := make([]offerConsumerModel, len(offer.Connections))
+	for i, connection := range offer.Connections {
+		ingressSubnets, dErr := types.ListValueFrom(ctx, types.StringType, connection.IngressSubnets)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		consumers[i] = offerConsumerModel{
+			SourceModelUUID: types.StringValue(connection.SourceModelUUID),
+			Username:        types.StringValue(connection.Username),
+			RelationID:      types.Int64Value(int64(connection.RelationID)),
+			Endpoint:        types.StringValue(connection.Endpoint),
+			Status:          types.StringValue(connection.Status),
+			Message:         types.StringValue(connection.Message),
+			IngressSubnets:  ingressSubnets,
+		}
+	}
+	consumerType := req.Config.Schema.GetAttributes()["consumers"].(schema.ListNestedAttribute).NestedObject.Type()
+	consumersValue, dErr := types.ListValueFrom(ctx, consumerType, consumers)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Consumers = consumersValue
+	data.ID = types.StringValue(offer.OfferURL)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *offerConsumersDataSource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if d.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "datasource-offer-consumers", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"juju.datasource-offer-consumers","foo":123}
+	tflog.SubsystemTrace(d.subCtx, LogDataSourceOfferConsumers, msg, additionalFields...)
+}
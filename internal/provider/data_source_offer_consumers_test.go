@@ -0,0 +1,94 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DataSourceOfferConsumers(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	offerModelName := acctest.RandomWithPrefix("tf-datasource-offer-consumers")
+	consumerModelName := acctest.RandomWithPrefix("tf-datasource-offer-consumers-dst")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOfferConsumers(offerModelName, consumerModelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.juju_offer_consumers.this", "offer_url", "juju_offer.this", "url"),
+					resource.TestCheckResourceAttr("data.juju_offer_consumers.this", "consumers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.juju_offer_consumers.this", "consumers.0.source_model_uuid", "juju_model.consumer", "id"),
+					resource.TestCheckResourceAttr("data.juju_offer_consumers.this", "consumers.0.username", "admin"),
+					resource.TestCheckResourceAttr("data.juju_offer_consumers.this", "consumers.0.endpoint", "sink"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOfferConsumers(offerModelName, consumerModelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "offer" {
+	name = %q
+}
+
+resource "juju_application" "source" {
+	model = juju_model.offer.name
+	name  = "source"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "this" {
+	model            = juju_model.offer.name
+	application_name = juju_application.source.name
+	endpoint         = "sink"
+}
+
+resource "juju_model" "consumer" {
+	name = %q
+}
+
+resource "juju_application" "sink" {
+	model = juju_model.consumer.name
+	name  = "sink"
+
+	charm {
+		name = "juju-qa-dummy-sink"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_integration" "this" {
+	model = juju_model.consumer.name
+
+	application {
+		name     = juju_application.sink.name
+		endpoint = "source"
+	}
+
+	application {
+		offer_url = juju_offer.this.url
+	}
+}
+
+data "juju_offer_consumers" "this" {
+	offer_url = juju_offer.this.url
+
+	depends_on = [juju_integration.this]
+}
+`, offerModelName, consumerModelName)
+}
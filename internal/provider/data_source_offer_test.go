@@ -25,6 +25,15 @@ func TestAcc_DataSourceOffer(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.juju_offer.this", "model", modelName),
 					resource.TestCheckResourceAttr("data.juju_offer.this", "name", offerName),
+					resource.TestCheckResourceAttrPair("data.juju_offer.this", "description", "juju_offer.this", "description"),
+					resource.TestCheckResourceAttr("data.juju_offer.this", "endpoints.#", "1"),
+					resource.TestCheckResourceAttr("data.juju_offer.this", "endpoints.0.name", "sink"),
+					resource.TestCheckResourceAttr("data.juju_offer.this", "endpoints.0.interface", "dummy-token"),
+					resource.TestCheckResourceAttr("data.juju_offer.this", "endpoints.0.role", "requirer"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.juju_offer.this", "users.*", map[string]string{
+						"name":   "admin",
+						"access": "admin",
+					}),
 				),
 			},
 		},
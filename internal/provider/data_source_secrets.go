@@ -85,7 +85,7 @@ func (d *secretDataSource) Configure(ctx context.Context, req datasource.Configu
 	}
 
 	d.client = client
-	d.subCtx = tflog.NewSubsystem(ctx, LogDataSourceSecret)
+	d.subCtx = client.NewLogSubsystem(ctx, LogDataSourceSecret)
 }
 
 // Read is called when the provider must read data source values in
@@ -115,7 +115,7 @@ func (d *secretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		readSecretInput.SecretId = data.SecretId.ValueString()
 	}
 
-	readSecretOutput, err := d.client.Secrets.ReadSecret(&readSecretInput)
+	readSecretOutput, err := d.client.Secrets.ReadSecret(ctx, &readSecretInput)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
 		return
@@ -0,0 +1,70 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// knownFeatures maps the name of each experimental behaviour which can be
+// enabled with the features attribute of the provider to its description.
+// An experiment is added here along with the code it gates, which checks
+// it with juju.Client.FeatureEnabled, and is removed once the behaviour
+// becomes the default.
+var knownFeatures = map[string]string{
+	featureCharmhubPlanValidation: "Resolve the Charmhub charm of new applications, and the charm applications " +
+		"are refreshed to, when planning, so that a charm, channel, revision or base which cannot be deployed " +
+		"fails the plan rather than the apply.",
+}
+
+// featureCharmhubPlanValidation resolves Charmhub charms when planning,
+// see applicationResource.validateCharmhubPlan.
+const featureCharmhubPlanValidation = "charmhub_plan_validation"
+
+// featuresFromConfig returns the experimental behaviours enabled in the
+// provider configuration. Unknown names are ignored with a warning, so
+// that configurations keep working once an experiment is removed.
+func featuresFromConfig(ctx context.Context, config types.Map) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if config.IsNull() || config.IsUnknown() {
+		return nil, diags
+	}
+	configured := make(map[string]bool)
+	diags.Append(config.ElementsAs(ctx, &configured, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	features := make(map[string]bool)
+	for name, enabled := range configured {
+		if _, ok := knownFeatures[name]; !ok {
+			diags.AddAttributeWarning(path.Root(JujuFeatures).AtMapKey(name), "Unknown Feature",
+				fmt.Sprintf("The feature %q is not known to this version of the provider and is ignored. %s",
+					name, knownFeaturesDetail()))
+			continue
+		}
+		features[name] = enabled
+	}
+	return features, diags
+}
+
+// knownFeaturesDetail lists the features known to the provider.
+func knownFeaturesDetail() string {
+	if len(knownFeatures) == 0 {
+		return "This version of the provider has no experimental features."
+	}
+	names := make([]string, 0, len(knownFeatures))
+	for name := range knownFeatures {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("Known features are: %s.", strings.Join(names, ", "))
+}
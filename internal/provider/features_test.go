@@ -0,0 +1,34 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeaturesFromConfig(t *testing.T) {
+	defer func(features map[string]string) { knownFeatures = features }(knownFeatures)
+	knownFeatures = map[string]string{"experiment": "An experiment."}
+
+	config := types.MapValueMust(types.BoolType, map[string]attr.Value{
+		"experiment": types.BoolValue(true),
+		"unknown":    types.BoolValue(true),
+	})
+	features, diags := featuresFromConfig(context.Background(), config)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]bool{"experiment": true}, features)
+	if assert.Len(t, diags.Warnings(), 1) {
+		assert.Equal(t, "Unknown Feature", diags.Warnings()[0].Summary())
+		assert.Contains(t, diags.Warnings()[0].Detail(), `Known features are: "experiment".`)
+	}
+
+	features, diags = featuresFromConfig(context.Background(), types.MapNull(types.BoolType))
+	assert.False(t, diags.HasError())
+	assert.Empty(t, features)
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/juju/names/v5"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &isValidApplicationNameFunction{}
+var _ function.Function = &isValidModelNameFunction{}
+var _ function.Function = &canonicalUserNameFunction{}
+
+func NewIsValidApplicationNameFunction() function.Function {
+	return &isValidApplicationNameFunction{}
+}
+
+// isValidApplicationNameFunction checks a name against the rules Juju
+// applies to application names.
+type isValidApplicationNameFunction struct{}
+
+func (f *isValidApplicationNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_application_name"
+}
+
+func (f *isValidApplicationNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a string is a valid Juju application name",
+		MarkdownDescription: "Returns true if the name can be used as the name of a `juju_application`: lowercase letters, " +
+			"digits and single hyphens, starting with a letter, where no part between hyphens is only digits.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The application name to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *isValidApplicationNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, names.IsValidApplication(name)))
+}
+
+func NewIsValidModelNameFunction() function.Function {
+	return &isValidModelNameFunction{}
+}
+
+// isValidModelNameFunction checks a name against the rules Juju applies
+// to model names.
+type isValidModelNameFunction struct{}
+
+func (f *isValidModelNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_model_name"
+}
+
+func (f *isValidModelNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a string is a valid Juju model name",
+		MarkdownDescription: "Returns true if the name can be used as the name of a `juju_model`: lowercase letters, " +
+			"digits and hyphens, not starting with a hyphen.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The model name to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *isValidModelNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, names.IsValidModelName(name)))
+}
+
+func NewCanonicalUserNameFunction() function.Function {
+	return &canonicalUserNameFunction{}
+}
+
+// canonicalUserNameFunction returns the name Juju reports for a user, so
+// that names given in different forms compare equal.
+type canonicalUserNameFunction struct{}
+
+func (f *canonicalUserNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "canonical_user_name"
+}
+
+func (f *canonicalUserNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return the canonical form of a Juju user name",
+		MarkdownDescription: "Returns the user name as reported by Juju, e.g. `bob` for `bob@local`. Users of an external " +
+			"identity provider keep their domain, e.g. `bob@external`. Fails if the user name is not valid.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The user name, optionally followed by `@` and a domain.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *canonicalUserNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+	if !names.IsValidUser(name) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid Juju user name", name))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, names.NewUserTag(name).Id()))
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+)
+
+func runFunction(f function.Function, name string, result attr.Value) (attr.Value, *function.FuncError) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(name)})}
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestIsValidApplicationNameFunction(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"postgresql":     true,
+		"postgresql-k8s": true,
+		"app-2fa":        true,
+		"app-2":          false,
+		"App":            false,
+		"2app":           false,
+		"app--db":        false,
+	} {
+		result, err := runFunction(NewIsValidApplicationNameFunction(), name, types.BoolUnknown())
+		assert.Nil(t, err)
+		assert.Equal(t, types.BoolValue(valid), result, name)
+	}
+}
+
+func TestIsValidModelNameFunction(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"development":  true,
+		"2024-staging": true,
+		"-staging":     false,
+		"Staging":      false,
+		"staging_eu":   false,
+	} {
+		result, err := runFunction(NewIsValidModelNameFunction(), name, types.BoolUnknown())
+		assert.Nil(t, err)
+		assert.Equal(t, types.BoolValue(valid), result, name)
+	}
+}
+
+func TestCanonicalUserNameFunction(t *testing.T) {
+	for name, expected := range map[string]string{
+		"bob":          "bob",
+		"bob@local":    "bob",
+		"bob@external": "bob@external",
+	} {
+		result, err := runFunction(NewCanonicalUserNameFunction(), name, types.StringUnknown())
+		assert.Nil(t, err)
+		assert.Equal(t, types.StringValue(expected), result, name)
+	}
+
+	_, err := runFunction(NewCanonicalUserNameFunction(), "bob@", types.StringUnknown())
+	if assert.NotNil(t, err) {
+		assert.Equal(t, `"bob@" is not a valid Juju user name`, err.Text)
+	}
+}
+
+func TestAcc_ProviderFunctions(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "valid_application" {
+  value = provider::juju::is_valid_application_name("postgresql-k8s")
+}
+
+output "valid_model" {
+  value = provider::juju::is_valid_model_name("Staging")
+}
+
+output "user" {
+  value = provider::juju::canonical_user_name("bob@local")
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("valid_application", "true"),
+					resource.TestCheckOutput("valid_model", "false"),
+					resource.TestCheckOutput("user", "bob"),
+				),
+			},
+			{
+				Config: `
+output "user" {
+  value = provider::juju::canonical_user_name("bob@")
+}
+`,
+				ExpectError: regexp.MustCompile(`is not a valid Juju user name`),
+			},
+		},
+	})
+}
@@ -4,10 +4,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 // model names for logging
@@ -16,10 +21,21 @@ import (
 //
 //	@module=juju.resource-application
 const (
-	LogDataSourceMachine = "datasource-machine"
-	LogDataSourceModel   = "datasource-model"
-	LogDataSourceOffer   = "datasource-offer"
-	LogDataSourceSecret  = "datasource-secret"
+	LogDataSourceMachine                  = "datasource-machine"
+	LogDataSourceModel                    = "datasource-model"
+	LogDataSourceOffer                    = "datasource-offer"
+	LogDataSourceOfferConsumers           = "datasource-offer-consumers"
+	LogDataSourceSecret                   = "datasource-secret"
+	LogDataSourceJAASLoginInfo            = "datasource-jaas-login-info"
+	LogDataSourceJAASControllers          = "datasource-jaas-controllers"
+	LogDataSourceJAASRole                 = "datasource-jaas-role"
+	LogDataSourceApplicationStatusHistory = "datasource-application-status-history"
+	LogDataSourceApplicationStatus        = "datasource-application-status"
+	LogDataSourceApplicationRelations     = "datasource-application-relations"
+	LogDataSourceApplications             = "datasource-applications"
+	LogDataSourceCloudRegions             = "datasource-cloud-regions"
+	LogDataSourceModelConfig              = "datasource-model-config"
+	LogDataSourceModelCredentialValidity  = "datasource-model-credential-validity"
 
 	LogResourceApplication  = "resource-application"
 	LogResourceAccessModel  = "resource-access-model"
@@ -31,6 +47,7 @@ const (
 	LogResourceUser         = "resource-user"
 	LogResourceSecret       = "resource-secret"
 	LogResourceAccessSecret = "resource-access-secret"
+	LogResourceRunAction    = "resource-run-action"
 
 	LogResourceJAASAccessModel      = "resource-jaas-access-model"
 	LogResourceJAASAccessCloud      = "resource-jaas-access-cloud"
@@ -39,6 +56,20 @@ const (
 	LogResourceJAASAccessController = "resource-jaas-access-controller"
 	LogResourceJAASAccessSvcAcc     = "resource-jaas-access-service-account"
 	LogResourceJAASGroup            = "resource-jaas-group"
+	LogResourceJAASGroupMembership  = "resource-jaas-group-membership"
+	LogResourceJAASRole             = "resource-jaas-role"
+	LogResourceModelMigrationTarget = "resource-model-migration-target"
+
+	LogResourceControllerAuthorizedKeys = "resource-controller-authorized-keys"
+	LogResourceSpace                    = "resource-space"
+	LogResourceStoragePool              = "resource-storage-pool"
+	LogResourceAnnotation               = "resource-annotation"
+	LogResourceControllerConfig         = "resource-controller-config"
+	LogResourceSecretBackend            = "resource-secret-backend"
+	LogResourceKubernetesCloud          = "resource-kubernetes-cloud"
+	LogResourceCloud                    = "resource-cloud"
+	LogResourceAccessCloud              = "resource-access-cloud"
+	LogResourceAccessController         = "resource-access-controller"
 )
 
 const LogResourceIntegration = "resource-integration"
@@ -63,3 +94,32 @@ func intPtr(value types.Int64) *int {
 	count := int(value.ValueInt64())
 	return &count
 }
+
+// keepStateDuringControllerUpgrade returns true if err was returned because
+// the controller is being upgraded and the provider is configured to
+// tolerate controller upgrades. A warning is added in place of an error,
+// so the resource keeps its prior state until the next refresh.
+func keepStateDuringControllerUpgrade(client *juju.Client, err error, diags *diag.Diagnostics, resource string) bool {
+	if client == nil || !client.TolerateControllerUpgrades() || !juju.IsControllerUpgradeError(err) {
+		return false
+	}
+	diags.AddWarning("Controller Upgrade In Progress",
+		fmt.Sprintf("Unable to refresh %s while the controller is being upgraded, keeping its prior state: %s", resource, err))
+	return true
+}
+
+// addMalformedIDError adds the error returned when decoding the ID of
+// a resource with the ids package.
+func addMalformedIDError(diag *diag.Diagnostics, err error) {
+	diag.AddError("Malformed ID", err.Error())
+}
+
+// importStatePassthroughValidID passes the import ID through to the id
+// attribute, if it can be decoded with the given ids parse function.
+func importStatePassthroughValidID[T any](ctx context.Context, parse func(string) (T, error), req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := parse(req.ID); err != nil {
+		resp.Diagnostics.AddError("ImportState Failure", fmt.Sprintf("Malformed import ID: %s", err))
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -10,11 +10,15 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -22,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/juju/pki"
+	"github.com/juju/names/v5"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -34,6 +40,9 @@ const (
 	JujuClientIDEnvKey     = "JUJU_CLIENT_ID"
 	JujuClientSecretEnvKey = "JUJU_CLIENT_SECRET"
 
+	JujuApplySummaryFileEnvKey = "JUJU_APPLY_SUMMARY_FILE"
+	JujuReadOnlyEnvKey         = "JUJU_READ_ONLY"
+
 	JujuController   = "controller_addresses"
 	JujuUsername     = "username"
 	JujuPassword     = "password"
@@ -41,6 +50,18 @@ const (
 	JujuClientSecret = "client_secret"
 	JujuCACert       = "ca_certificate"
 
+	JujuControllerName = "controller_name"
+	JujuControllerUUID = "controller_uuid"
+
+	JujuTolerateControllerUpgrades = "tolerate_controller_upgrades"
+	JujuFeatures                   = "features"
+	JujuApplySummaryFile           = "apply_summary_file"
+	JujuConnectionPoolSize         = "connection_pool_size"
+	JujuConnectionIdleTimeout      = "connection_idle_timeout"
+	JujuReadOnly                   = "read_only"
+	JujuDefaultCharmChannel        = "default_charm_channel"
+	JujuDefaultBase                = "default_base"
+
 	TwoSourcesAuthWarning = "Two sources of identity for controller login"
 )
 
@@ -57,9 +78,12 @@ func jujuProviderModelEnvVar() jujuProviderModel {
 	}
 }
 
-func jujuProviderModelLiveDiscovery() (jujuProviderModel, bool) {
+// jujuProviderModelLiveDiscovery gets the controller config of the named
+// controller, or of the current one if the name is empty, from the juju CLI
+// client files or the juju CLI itself.
+func jujuProviderModelLiveDiscovery(controllerName string) (jujuProviderModel, bool) {
 	data := jujuProviderModel{}
-	controllerConfig, cliNotExist := juju.GetLocalControllerConfig()
+	controllerConfig, cliNotExist := juju.GetLocalControllerConfig(controllerName)
 	if cliNotExist {
 		return data, false
 	}
@@ -91,6 +115,7 @@ func getEnvVar(field string) types.String {
 
 // Ensure jujuProvider satisfies various provider interfaces.
 var _ provider.Provider = &jujuProvider{}
+var _ provider.ProviderWithFunctions = &jujuProvider{}
 
 // NewJujuProvider returns a framework style terraform provider.
 func NewJujuProvider(version string) provider.Provider {
@@ -108,6 +133,21 @@ type jujuProviderModel struct {
 	CACert          types.String `tfsdk:"ca_certificate"`
 	ClientID        types.String `tfsdk:"client_id"`
 	ClientSecret    types.String `tfsdk:"client_secret"`
+	ControllerName  types.String `tfsdk:"controller_name"`
+	ControllerUUID  types.String `tfsdk:"controller_uuid"`
+
+	TolerateControllerUpgrades types.Bool `tfsdk:"tolerate_controller_upgrades"`
+	Features                   types.Map  `tfsdk:"features"`
+
+	ApplySummaryFile types.String `tfsdk:"apply_summary_file"`
+
+	ConnectionPoolSize    types.Int64  `tfsdk:"connection_pool_size"`
+	ConnectionIdleTimeout types.String `tfsdk:"connection_idle_timeout"`
+
+	ReadOnly types.Bool `tfsdk:"read_only"`
+
+	DefaultCharmChannel types.String `tfsdk:"default_charm_channel"`
+	DefaultBase         types.String `tfsdk:"default_base"`
 }
 
 func (j jujuProviderModel) loginViaUsername() bool {
@@ -118,6 +158,18 @@ func (j jujuProviderModel) loginViaClientCredentials() bool {
 	return j.ClientID.ValueString() != "" && j.ClientSecret.ValueString() != ""
 }
 
+// redacted returns a copy of the model whose password and client secret
+// are masked, so that it can be logged.
+func (j jujuProviderModel) redacted() jujuProviderModel {
+	if j.Password.ValueString() != "" {
+		j.Password = types.StringValue("***")
+	}
+	if j.ClientSecret.ValueString() != "" {
+		j.ClientSecret = types.StringValue("***")
+	}
+	return j
+}
+
 func (j jujuProviderModel) valid() bool {
 	validUserPass := j.loginViaUsername()
 	validClientCredentials := j.loginViaClientCredentials()
@@ -206,8 +258,10 @@ func (p *jujuProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				},
 			},
 			JujuClientID: schema.StringAttribute{
-				Description: fmt.Sprintf("This is the client ID to be used. This can also be set by the `%s` environment variable", JujuClientIDEnvKey),
-				Optional:    true,
+				Description: fmt.Sprintf("This is the client ID to be used. This can also be set by the `%s` environment variable. "+
+					"The client ID and secret of a JAAS service account log in with the OAuth client credentials flow, "+
+					"the provider logs in again when the session expires during an apply.", JujuClientIDEnvKey),
+				Optional: true,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot(JujuUsername),
@@ -230,6 +284,91 @@ func (p *jujuProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Description: fmt.Sprintf("This is the certificate to use for identification. This can also be set by the `%s` environment variable", JujuCACertEnvKey),
 				Optional:    true,
 			},
+			JujuControllerName: schema.StringAttribute{
+				Description: "The name of a controller known to the local juju CLI client, whose addresses, " +
+					"certificate and credentials are used for any value not set in the provider block. They are " +
+					"read from the controllers.yaml and accounts.yaml files in JUJU_DATA, `~/.local/share/juju` " +
+					"by default, or with the juju CLI when the files cannot be read. " +
+					"Environment variables are ignored when it is set, so that aliased providers can target " +
+					"different controllers, e.g. staging and production.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			JujuControllerUUID: schema.StringAttribute{
+				Description: "The UUID of the controller the provider must connect to. The provider fails to " +
+					"configure if the controller at controller_addresses has a different UUID, which happens " +
+					"once a controller has been rebuilt and its certificate authority has changed.",
+				Optional: true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidController, "must be a valid controller UUID"),
+				},
+			},
+			JujuTolerateControllerUpgrades: schema.BoolAttribute{
+				Description: "If true, API calls rejected because the controller is being upgraded are retried for " +
+					"about a minute. If the controller is still upgrading when resources are refreshed, their " +
+					"previous state is kept and a warning is emitted instead of an error. Defaults to false.",
+				Optional: true,
+			},
+			JujuFeatures: schema.MapAttribute{
+				Description: "Experimental behaviours to enable or disable, keyed by name. Experiments may change " +
+					"or be removed in any release of the provider. Unknown names are ignored with a warning.",
+				ElementType: types.BoolType,
+				Optional:    true,
+			},
+			JujuApplySummaryFile: schema.StringAttribute{
+				Description: fmt.Sprintf("The path of a file the summary of each application and model operation performed by the provider, "+
+					"e.g. the units and charm revision of an application before and after an update, is appended to "+
+					"as a line of JSON. The file is created if needed and never truncated. The summaries are also "+
+					"logged at info level. This can also be set by the `%s` environment variable.", JujuApplySummaryFileEnvKey),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			JujuConnectionPoolSize: schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of idle connections to the controller and its models kept open, "+
+					"to be shared by the operations of the resources rather than dialing the controller for each "+
+					"of them. Connections are not shared if 0. Defaults to %d.", juju.DefaultConnectionPoolSize),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			JujuConnectionIdleTimeout: schema.StringAttribute{
+				Description: fmt.Sprintf("How long an idle shared connection is kept open, e.g. `1m`. Defaults to `%s`.",
+					juju.DefaultConnectionIdleTimeout),
+				Optional: true,
+				Validators: []validator.String{
+					ValidatorMatchString(isDuration, "must be a duration, e.g. \"1m\""),
+				},
+			},
+			JujuReadOnly: schema.BoolAttribute{
+				Description: fmt.Sprintf("If true, creating, updating or deleting any resource fails, while "+
+					"resources can still be refreshed and planned and data sources read. This lets a shared "+
+					"state be planned without the risk of changing anything. This can also be set by the `%s` "+
+					"environment variable. Defaults to false.", JujuReadOnlyEnvKey),
+				Optional: true,
+			},
+			JujuDefaultCharmChannel: schema.StringAttribute{
+				Description: "The channel applications deploy their charm from when they do not set one, e.g. " +
+					"`latest/stable`, so that it can be enforced without repeating it in every module. Only " +
+					"applies when an application is deployed. Applications use `stable` if not set.",
+				Optional: true,
+				Validators: []validator.String{
+					StringIsChannelValidator{},
+				},
+			},
+			JujuDefaultBase: schema.StringAttribute{
+				Description: "The base applications are deployed on when they set neither base, series nor " +
+					"base_selection, e.g. `ubuntu@22.04`. Only applies when an application is deployed. The " +
+					"base is selected by Juju if not set.",
+				Optional: true,
+				Validators: []validator.String{
+					stringIsBaseValidator{},
+				},
+			},
 		},
 	}
 }
@@ -249,6 +388,12 @@ func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	features, diags := featuresFromConfig(ctx, data.Features)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
 	config := juju.ControllerConfiguration{
 		ControllerAddresses: strings.Split(data.ControllerAddrs.ValueString(), ","),
 		Username:            data.UserName.ValueString(),
@@ -256,6 +401,41 @@ func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		CACert:              data.CACert.ValueString(),
 		ClientID:            data.ClientID.ValueString(),
 		ClientSecret:        data.ClientSecret.ValueString(),
+
+		TolerateControllerUpgrades: data.TolerateControllerUpgrades.ValueBool(),
+		Features:                   features,
+		ApplySummaryFile:           data.ApplySummaryFile.ValueString(),
+
+		ConnectionPoolSize:    juju.DefaultConnectionPoolSize,
+		ConnectionIdleTimeout: juju.DefaultConnectionIdleTimeout,
+
+		ReadOnly: data.ReadOnly.ValueBool(),
+
+		DefaultCharmChannel: data.DefaultCharmChannel.ValueString(),
+		DefaultBase:         data.DefaultBase.ValueString(),
+
+		ControllerLookup: lookupController,
+	}
+	if config.ApplySummaryFile == "" {
+		config.ApplySummaryFile = os.Getenv(JujuApplySummaryFileEnvKey)
+	}
+	if data.ReadOnly.IsNull() {
+		if value := os.Getenv(JujuReadOnlyEnvKey); value != "" {
+			readOnly, err := strconv.ParseBool(value)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid Read Only Mode",
+					fmt.Sprintf("The %s environment variable must be a boolean, got %q.", JujuReadOnlyEnvKey, value))
+				return
+			}
+			config.ReadOnly = readOnly
+		}
+	}
+	if !data.ConnectionPoolSize.IsNull() {
+		config.ConnectionPoolSize = int(data.ConnectionPoolSize.ValueInt64())
+	}
+	if data.ConnectionIdleTimeout.ValueString() != "" {
+		// The duration has been validated with the configuration.
+		config.ConnectionIdleTimeout, _ = time.ParseDuration(data.ConnectionIdleTimeout.ValueString())
 	}
 	client, err := juju.NewClient(ctx, config)
 	if err != nil {
@@ -265,12 +445,17 @@ func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	// Here we are testing that we can connect successfully to the Juju server
 	// this prevents having logic to check the connection is OK in every function
-	testConn, err := client.Models.GetConnection(nil)
+	testConn, err := client.Models.GetConnection(ctx, nil)
 	if err != nil {
 		resp.Diagnostics.Append(checkClientErr(err, config)...)
 		return
 	}
+	controllerUUID := testConn.ControllerTag().Id()
 	_ = testConn.Close()
+	resp.Diagnostics.Append(checkControllerUUID(data.ControllerUUID.ValueString(), controllerUUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.ResourceData = client
 	resp.DataSourceData = client
@@ -303,6 +488,14 @@ func getJujuProviderModel(ctx context.Context, req provider.ConfigureRequest) (j
 		return planData, diags
 	}
 
+	// A named controller is discovered via the juju CLI only, the
+	// environment variables describe a single controller which is
+	// likely not the one named.
+	controllerName := planData.ControllerName.ValueString()
+	if controllerName != "" {
+		return getNamedControllerProviderModel(ctx, planData, controllerName, diags)
+	}
+
 	// Not all controller config contained in the plan, attempt
 	// to find it via the optional environment variables.
 	envVarData := jujuProviderModelEnvVar()
@@ -322,7 +515,7 @@ func getJujuProviderModel(ctx context.Context, req provider.ConfigureRequest) (j
 
 	// Not all controller config contained in the plan, attempt
 	// to find it via live discovery.
-	liveData, cliAlive := jujuProviderModelLiveDiscovery()
+	liveData, cliAlive := jujuProviderModelLiveDiscovery("")
 	errMsgDataModel := planEnvVarDataModel
 	if cliAlive {
 		livePlanEnvVarDataModel, livePlanEnvVarDataDiags := planEnvVarDataModel.merge(liveData, "live discovery")
@@ -347,20 +540,64 @@ func getJujuProviderModel(ctx context.Context, req provider.ConfigureRequest) (j
 	}
 	if diags.HasError() {
 		tflog.Debug(ctx, "Current login values.",
-			map[string]interface{}{"jujuProviderModel": planData})
+			map[string]interface{}{"jujuProviderModel": planData.redacted()})
 	}
 
 	return errMsgDataModel, diags
 }
 
+// getNamedControllerProviderModel fills in the values missing from the
+// plan with the configuration of the named controller, as known to the
+// juju CLI.
+func getNamedControllerProviderModel(ctx context.Context, planData jujuProviderModel, controllerName string, diags diag.Diagnostics) (jujuProviderModel, diag.Diagnostics) {
+	liveData, cliAlive := jujuProviderModelLiveDiscovery(controllerName)
+	if !cliAlive {
+		diags.AddAttributeError(path.Root(JujuControllerName), "Controller not found",
+			fmt.Sprintf("The configuration of controller %q could not be read from the juju CLI client files "+
+				"nor with the juju CLI. Check that `juju show-controller %s --show-password` shows its password.",
+				controllerName, controllerName))
+		return planData, diags
+	}
+	data, mergeDiags := planData.merge(liveData, fmt.Sprintf("controller %q", controllerName))
+	diags.Append(mergeDiags...)
+	if !data.valid() {
+		diags.AddAttributeError(path.Root(JujuControllerName), "Incomplete controller configuration",
+			fmt.Sprintf("The juju CLI did not provide the addresses and credentials of controller %q, "+
+				"set the missing values in the provider block.", controllerName))
+		tflog.Debug(ctx, "Current login values.",
+			map[string]interface{}{"jujuProviderModel": planData.redacted()})
+	}
+	return data, diags
+}
+
+// lookupController returns the addresses and credentials of a controller
+// known to the juju CLI client, for the resources and data sources
+// selecting it with controller_name.
+func lookupController(name string) (juju.ControllerConfiguration, error) {
+	data, cliAlive := jujuProviderModelLiveDiscovery(name)
+	if !cliAlive || !data.valid() {
+		return juju.ControllerConfiguration{}, fmt.Errorf("the addresses and credentials of the controller "+
+			"could not be read from the juju CLI client files nor with the juju CLI, check that "+
+			"`juju show-controller %s --show-password` shows its password", name)
+	}
+	return juju.ControllerConfiguration{
+		ControllerAddresses: strings.Split(data.ControllerAddrs.ValueString(), ","),
+		Username:            data.UserName.ValueString(),
+		Password:            data.Password.ValueString(),
+		CACert:              data.CACert.ValueString(),
+	}, nil
+}
+
 // Resources returns a slice of functions to instantiate each Resource
 // implementation.
 //
 // The resource type name is determined by the Resource implementing
 // the Metadata method. All resources must have unique names.
 func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	resources := []func() resource.Resource{
 		func() resource.Resource { return NewAccessModelResource() },
+		func() resource.Resource { return NewAccessCloudResource() },
+		func() resource.Resource { return NewAccessControllerResource() },
 		func() resource.Resource { return NewApplicationResource() },
 		func() resource.Resource { return NewCredentialResource() },
 		func() resource.Resource { return NewIntegrationResource() },
@@ -370,6 +607,9 @@ func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return NewSSHKeyResource() },
 		func() resource.Resource { return NewUserResource() },
 		func() resource.Resource { return NewSecretResource() },
+		func() resource.Resource { return NewSecretBackendResource() },
+		func() resource.Resource { return NewCloudResource() },
+		func() resource.Resource { return NewKubernetesCloudResource() },
 		func() resource.Resource { return NewAccessSecretResource() },
 		func() resource.Resource { return NewJAASAccessModelResource() },
 		func() resource.Resource { return NewJAASAccessCloudResource() },
@@ -378,7 +618,23 @@ func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return NewJAASAccessControllerResource() },
 		func() resource.Resource { return NewJAASAccessServiceAccountResource() },
 		func() resource.Resource { return NewJAASGroupResource() },
-	}
+		func() resource.Resource { return NewJAASGroupMembershipResource() },
+		func() resource.Resource { return NewJAASRoleResource() },
+		func() resource.Resource { return NewModelMigrationTargetResource() },
+		func() resource.Resource { return NewControllerAuthorizedKeysResource() },
+		func() resource.Resource { return NewSpaceResource() },
+		func() resource.Resource { return NewStoragePoolResource() },
+		func() resource.Resource { return NewAnnotationResource() },
+		func() resource.Resource { return NewControllerConfigResource() },
+		func() resource.Resource { return NewRunActionResource() },
+	}
+	// Every resource can select its controller, and refuses to change
+	// anything in read only mode.
+	for i, newResource := range resources {
+		newResource := newResource
+		resources[i] = func() resource.Resource { return newReadOnlyGuard(newControllerSelector(newResource)) }
+	}
+	return resources
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -387,11 +643,41 @@ func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
 // The data source type name is determined by the DataSource implementing
 // the Metadata method. All data sources must have unique names.
 func (p *jujuProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	dataSources := []func() datasource.DataSource{
 		func() datasource.DataSource { return NewMachineDataSource() },
 		func() datasource.DataSource { return NewModelDataSource() },
 		func() datasource.DataSource { return NewOfferDataSource() },
+		func() datasource.DataSource { return NewOfferConsumersDataSource() },
 		func() datasource.DataSource { return NewSecretDataSource() },
+		func() datasource.DataSource { return NewJAASLoginInfoDataSource() },
+		func() datasource.DataSource { return NewJAASControllersDataSource() },
+		func() datasource.DataSource { return NewJAASRoleDataSource() },
+		func() datasource.DataSource { return NewApplicationStatusDataSource() },
+		func() datasource.DataSource { return NewApplicationStatusHistoryDataSource() },
+		func() datasource.DataSource { return NewApplicationRelationsDataSource() },
+		func() datasource.DataSource { return NewApplicationsDataSource() },
+		func() datasource.DataSource { return NewCloudRegionsDataSource() },
+		func() datasource.DataSource { return NewModelConfigDataSource() },
+		func() datasource.DataSource { return NewModelCredentialValidityDataSource() },
+	}
+	// Every data source can select its controller.
+	for i, newDataSource := range dataSources {
+		newDataSource := newDataSource
+		dataSources[i] = func() datasource.DataSource { return newDataSourceControllerSelector(newDataSource) }
+	}
+	return dataSources
+}
+
+// Functions returns a slice of functions to instantiate each Function
+// implementation.
+//
+// The function name is determined by the Function implementing the
+// Metadata method. All functions must have unique names.
+func (p *jujuProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIsValidApplicationNameFunction,
+		NewIsValidModelNameFunction,
+		NewCanonicalUserNameFunction,
 	}
 }
 
@@ -402,14 +688,12 @@ func checkClientErr(err error, config juju.ControllerConfiguration) diag.Diagnos
 	x509error := &x509.UnknownAuthorityError{}
 	x509HostError := &x509.HostnameError{}
 	netOpError := &net.OpError{}
-	if errors.As(err, x509error) || errors.As(err, x509HostError) {
-		errDetail = "Verify the ca_certificate property set on the provider"
-
-		if config.CACert == "" {
-			errDetail = "The ca_certificate provider property is not set and the Juju certificate authority is not trusted by your system"
-		}
-
-		diags.AddError(x509error.Error(), errDetail)
+	if errors.As(err, x509error) {
+		diags.AddError(x509error.Error(), caCertificateDetail(config.CACert))
+		return diags
+	}
+	if errors.As(err, x509HostError) {
+		diags.AddError(x509HostError.Error(), caCertificateDetail(config.CACert))
 		return diags
 	}
 	if errors.As(err, &netOpError) {
@@ -420,3 +704,36 @@ func checkClientErr(err error, config juju.ControllerConfiguration) diag.Diagnos
 	diags.AddError("Client Error", err.Error())
 	return diags
 }
+
+// caCertificateDetail explains how to fix a connection whose certificate
+// was not verified by the configured certificate authority. The most
+// likely cause is a controller which has been rebuilt, so the fingerprint
+// of the configured certificate is given to compare with the one shown
+// by the juju CLI client.
+func caCertificateDetail(caCert string) string {
+	if caCert == "" {
+		return "The ca_certificate provider property is not set and the Juju certificate authority is not trusted by your system"
+	}
+	detail := "Verify the ca_certificate property set on the provider"
+	if fingerprint, _, err := pki.Fingerprint([]byte(caCert)); err == nil {
+		detail += fmt.Sprintf(", its fingerprint is %s", fingerprint)
+	}
+	return detail + ". If the controller has been rebuilt its certificate authority has changed: " +
+		"compare with the ca-fingerprint shown by `juju show-controller`, then set ca_certificate " +
+		"to the ca-cert it shows, or set controller_name to read it from the juju CLI client. A controller " +
+		"registered in JAAS also has its current certificate in the juju_jaas_controllers data source."
+}
+
+// checkControllerUUID returns an error if the provider is pinned to a
+// controller UUID which is not the one of the connected controller.
+func checkControllerUUID(pinned, actual string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if pinned == "" || pinned == actual {
+		return diags
+	}
+	diags.AddAttributeError(path.Root(JujuControllerUUID), "Controller Mismatch",
+		fmt.Sprintf("The controller at controller_addresses has UUID %q, not %q. If the controller has "+
+			"been rebuilt, verify that ca_certificate belongs to the new controller, then set "+
+			"controller_uuid to the controller-uuid shown by `juju show-controller`.", actual, pinned))
+	return diags
+}
@@ -136,6 +136,22 @@ func TestProviderConfigureAddresses(t *testing.T) {
 	assert.Equal(t, "Connection error, please check the controller_addresses property set on the provider", err.Detail())
 }
 
+func TestProviderConfigureUnknownControllerName(t *testing.T) {
+	jujuProvider := NewJujuProvider("dev")
+	// The environment variables must not be used for a named controller.
+	t.Setenv(JujuControllerEnvKey, "192.0.2.100:17070")
+	t.Setenv(JujuUsernameEnvKey, "the-username")
+	t.Setenv(JujuPasswordEnvKey, "the-password")
+
+	confResp := configureProviderWith(t, jujuProvider, jujuProviderModel{
+		ControllerName: types.StringValue("no-such-controller"),
+	})
+	assert.Equal(t, confResp.Diagnostics.HasError(), true)
+	err := confResp.Diagnostics.Errors()[0]
+	assert.Equal(t, "Controller not found", err.Summary())
+	assert.Contains(t, err.Detail(), `controller "no-such-controller"`)
+}
+
 // This is a valid certificate allowing the client to attempt a connection but failing certificate validation
 const (
 	invalidCA = "-----BEGIN CERTIFICATE-----\nMIIDazCCAlOgAwIBAgIULHtYyq/mjGAaZTTFcfd4Dmi6LtkwDQYJKoZIhvcNAQEL\nBQAwRTELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUxITAfBgNVBAoM\nGEludGVybmV0IFdpZGdpdHMgUHR5IEx0ZDAeFw0yMjA2MjQxNTQzMTFaFw0yMjA3\nMjQxNTQzMTFaMEUxCzAJBgNVBAYTAkFVMRMwEQYDVQQIDApTb21lLVN0YXRlMSEw\nHwYDVQQKDBhJbnRlcm5ldCBXaWRnaXRzIFB0eSBMdGQwggEiMA0GCSqGSIb3DQEB\nAQUAA4IBDwAwggEKAoIBAQCgSrxunimy/Nig3y5mAUtc3quvJI7MVdlWrhhWcNP4\nacF6bsAYDMa02Praf3pUBkyU9Fe83nalcimVO1NO18/FvKK4ZYuwQi4B+Rx1ltF/\nZx5czxrH+kb9FsZJNAtxbAo0hT9rusuCd1m0zhzSOZCTWkmguDew41IQHUtW7Wgy\nM0TlmrCzJkf2w+GwmhxFbJLR37b7N2ylyrFyuLTEKSMAxSw7k4+Djqgat5NdVGmo\niTZST86Br9Xg+goVjFTHxj/f84OaazM6DhyIdizyntkIV6nZVxZmhisO9iWk41Q/\noPeN4ZYUCe+VpZoZShMZ7H281tOYfgCOP2IHyQxxwLQBAgMBAAGjUzBRMB0GA1Ud\nDgQWBBS1ziAYMPkbTHaOfgpKlX70/wkusDAfBgNVHSMEGDAWgBS1ziAYMPkbTHaO\nfgpKlX70/wkusDAPBgNVHRMBAf8EBTADAQH/MA0GCSqGSIb3DQEBCwUAA4IBAQAN\n76z4TTrH5Wj7nPBROyx9Ab3TCF+gSqi2lhxCo5obtdAUdnfsbTtIGH82Ayduz13R\nvWcqn0EXgi2jJ8fMQxujalBwqhw2BPLgXPhIlR8/IcvUp9CIQA3FasvqNrSrfUzJ\ntO9oA3LG5EGnlxeDS5ehkx/bAOQl4yz70Vh+xssU/E5T74Zb8Kgf8uSZbj2jbRh7\nBC4qYzO7jVFOLkIWUjIeKlE2iG3OJnb17NMuODApPLyRslKvRyxwITtWr/jhaTNQ\n4L64mCtPPU2bMLScqsEYDOx237na8m9Xej6MOGb1D4noe59ML/4IwCmG2iK982mQ\n2zpE+UCo97FGq4kDK6bc\n-----END CERTIFICATE-----\n"
@@ -156,7 +172,7 @@ func TestProviderConfigurex509FromEnv(t *testing.T) {
 	assert.Equal(t, confResp.Diagnostics.HasError(), true)
 	err := confResp.Diagnostics.Errors()[0]
 	assert.Equal(t, diag.SeverityError, err.Severity())
-	assert.Equal(t, "Verify the ca_certificate property set on the provider", err.Detail())
+	assert.Contains(t, err.Detail(), "Verify the ca_certificate property set on the provider")
 	assert.Equal(t, "x509: certificate signed by unknown authority", err.Summary())
 }
 
@@ -172,10 +188,30 @@ func TestProviderConfigurex509InvalidFromEnv(t *testing.T) {
 	assert.Equal(t, confResp.Diagnostics.HasError(), true)
 	err := confResp.Diagnostics.Errors()[0]
 	assert.Equal(t, diag.SeverityError, err.Severity())
-	assert.Equal(t, "Verify the ca_certificate property set on the provider", err.Detail())
+	assert.Contains(t, err.Detail(), "Verify the ca_certificate property set on the provider")
 	assert.Equal(t, "x509: certificate signed by unknown authority", err.Summary())
 }
 
+func TestCACertificateDetail(t *testing.T) {
+	detail := caCertificateDetail(invalidCA)
+	assert.Contains(t, detail, "Verify the ca_certificate property set on the provider")
+	assert.Contains(t, detail, "its fingerprint is 71:05:D2:44:")
+	assert.Contains(t, detail, "juju show-controller")
+
+	assert.Equal(t, "The ca_certificate provider property is not set and the Juju certificate authority is not trusted by your system", caCertificateDetail(""))
+}
+
+func TestCheckControllerUUID(t *testing.T) {
+	uuid := "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+	assert.False(t, checkControllerUUID("", uuid).HasError())
+	assert.False(t, checkControllerUUID(uuid, uuid).HasError())
+
+	diags := checkControllerUUID("deadbeef-0bad-400d-8000-5b1d0d06f00d", uuid)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Controller Mismatch", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), uuid)
+}
+
 func TestProviderAllowsEmptyCACert(t *testing.T) {
 	SkipJAAS(t)
 	jujuProvider := NewJujuProvider("dev")
@@ -238,11 +274,18 @@ func validateJujuTestConfig(t *testing.T) {
 }
 
 func configureProvider(t *testing.T, p provider.Provider) provider.ConfigureResponse {
+	return configureProviderWith(t, p, jujuProviderModel{})
+}
+
+func configureProviderWith(t *testing.T, p provider.Provider, conf jujuProviderModel) provider.ConfigureResponse {
 	schemaResp := provider.SchemaResponse{}
 	Provider.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
 	assert.Equal(t, schemaResp.Diagnostics.HasError(), false)
 
-	conf := jujuProviderModel{}
+	// The zero value of a map has no element type.
+	if conf.Features.ElementType(context.Background()) == nil {
+		conf.Features = types.MapNull(types.BoolType)
+	}
 
 	mapTypes := map[string]attr.Type{
 		JujuController:   types.StringType,
@@ -251,6 +294,18 @@ func configureProvider(t *testing.T, p provider.Provider) provider.ConfigureResp
 		JujuCACert:       types.StringType,
 		JujuClientID:     types.StringType,
 		JujuClientSecret: types.StringType,
+
+		JujuControllerName: types.StringType,
+		JujuControllerUUID: types.StringType,
+
+		JujuTolerateControllerUpgrades: types.BoolType,
+		JujuFeatures:                   types.MapType{ElemType: types.BoolType},
+		JujuApplySummaryFile:           types.StringType,
+		JujuConnectionPoolSize:         types.Int64Type,
+		JujuConnectionIdleTimeout:      types.StringType,
+		JujuReadOnly:                   types.BoolType,
+		JujuDefaultCharmChannel:        types.StringType,
+		JujuDefaultBase:                types.StringType,
 	}
 
 	val, confObjErr := types.ObjectValueFrom(context.Background(), mapTypes, conf)
@@ -275,7 +330,7 @@ func TestFrameworkProviderSchema(t *testing.T) {
 	resp := provider.SchemaResponse{}
 	jujuProvider.Schema(context.Background(), req, &resp)
 	assert.Equal(t, resp.Diagnostics.HasError(), false)
-	assert.Len(t, resp.Schema.Attributes, 6)
+	assert.Len(t, resp.Schema.Attributes, 16)
 }
 
 func expectedResourceOwner() string {
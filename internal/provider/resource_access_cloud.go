@@ -0,0 +1,307 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/ids"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accessCloudResource{}
+var _ resource.ResourceWithConfigure = &accessCloudResource{}
+var _ resource.ResourceWithImportState = &accessCloudResource{}
+var _ resource.ResourceWithConfigValidators = &accessCloudResource{}
+
+func NewAccessCloudResource() resource.Resource {
+	return &accessCloudResource{}
+}
+
+type accessCloudResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type accessCloudResourceModel struct {
+	Cloud  types.String `tfsdk:"cloud"`
+	Users  types.Set    `tfsdk:"users"`
+	Access types.String `tfsdk:"access"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (a *accessCloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_cloud"
+}
+
+// ConfigValidators sets validators for the resource.
+func (a *accessCloudResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(a.client, "juju_jaas_access_cloud"),
+	}
+}
+
+func (a *accessCloudResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the access of users to a cloud of a Juju controller, " +
+			"like `juju grant-cloud`.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud for access management.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				Description: "List of users to grant access to.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"access": schema.StringAttribute{
+				Description: "Type of access to the cloud, `add-model` lets the users add models to the " +
+					"cloud, `admin` also lets them manage the access of other users.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("add-model", "admin"),
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (a *accessCloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	a.subCtx = client.NewLogSubsystem(ctx, LogResourceAccessCloud)
+}
+
+func (a *accessCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access cloud", "create")
+		return
+	}
+	var plan accessCloudResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloud := plan.Cloud.ValueString()
+	access := plan.Access.ValueString()
+	err := a.client.Clouds.GrantCloudAccess(ctx, juju.CloudAccessInput{
+		Cloud:  cloud,
+		Access: access,
+		Users:  users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access cloud resource, got error: %s", err))
+		return
+	}
+	a.trace(fmt.Sprintf("granted %q access to cloud %q", access, cloud))
+
+	plan.ID = types.StringValue(ids.AccessCloudID{Cloud: cloud, Access: access, Users: users}.String())
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (a *accessCloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access cloud", "read")
+		return
+	}
+	var state accessCloudResourceModel
+
+	// Get the Terraform state from the request into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := ids.ParseAccessCloudID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+
+	cloudAccess, err := a.client.Clouds.ReadCloudAccess(ctx, id.Cloud)
+	if err != nil {
+		if keepStateDuringControllerUpgrade(a.client, err, &resp.Diagnostics, "access cloud") {
+			return
+		}
+		if errors.As(err, &juju.CloudNotFoundError) {
+			// The cloud was removed, and the access with it.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access cloud resource, got error: %s", err))
+		return
+	}
+
+	// Only the users of the resource which still have its access are
+	// kept, the others show up as changes.
+	var users []string
+	for _, user := range id.Users {
+		if cloudAccess[user] == id.Access {
+			users = append(users, user)
+		}
+	}
+	usersValue, errDiag := types.SetValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Cloud = types.StringValue(id.Cloud)
+	state.Access = types.StringValue(id.Access)
+	state.Users = usersValue
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update grants the access to the users added to the resource and
+// revokes it from the users removed from it. Changing the cloud or the
+// access replaces the resource.
+func (a *accessCloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access cloud", "update")
+		return
+	}
+
+	var plan, state accessCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planUsers, stateUsers []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &planUsers, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloud := plan.Cloud.ValueString()
+	access := plan.Access.ValueString()
+	if err := a.client.Clouds.RevokeCloudAccess(ctx, juju.CloudAccessInput{
+		Cloud: cloud,
+		Users: getMissingUsers(stateUsers, planUsers),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access cloud resource, got error: %s", err))
+		return
+	}
+	if err := a.client.Clouds.GrantCloudAccess(ctx, juju.CloudAccessInput{
+		Cloud:  cloud,
+		Access: access,
+		Users:  getAddedUsers(stateUsers, planUsers),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access cloud resource, got error: %s", err))
+		return
+	}
+	a.trace(fmt.Sprintf("updated access cloud resource for cloud %q", cloud))
+
+	plan.ID = types.StringValue(ids.AccessCloudID{Cloud: cloud, Access: access, Users: planUsers}.String())
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (a *accessCloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access cloud", "delete")
+		return
+	}
+
+	var state accessCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Clouds.RevokeCloudAccess(ctx, juju.CloudAccessInput{
+		Cloud: state.Cloud.ValueString(),
+		Users: users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access cloud resource, got error: %s", err))
+	}
+}
+
+func (a *accessCloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughValidID(ctx, ids.ParseAccessCloudID, req, resp)
+}
+
+func (a *accessCloudResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if a.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(a.subCtx, LogResourceAccessCloud, msg, additionalFields...)
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAcc_ResourceAccessCloud(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+	userName2 := acctest.RandomWithPrefix("tfuser")
+	cloudName := testingCloud.CloudName()
+
+	resourceName := "juju_access_cloud.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceAccessCloud(userName, userName2, cloudName, "bogus", false),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match.*"),
+			},
+			{
+				Config: testAccResourceAccessCloud(userName, userName2, cloudName, "add-model", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cloud", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "access", "add-model"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceAccessCloud(userName, userName2, cloudName, "add-model", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName2),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources[resourceName].Primary.ID, nil
+				},
+				ResourceName: resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessCloud(userName, userName2, cloudName, access string, both bool) string {
+	users := "[juju_user.one.name]"
+	if both {
+		users = "[juju_user.one.name, juju_user.two.name]"
+	}
+	return fmt.Sprintf(`
+resource "juju_user" "one" {
+  name     = %q
+  password = "password"
+}
+
+resource "juju_user" "two" {
+  name     = %q
+  password = "password"
+}
+
+resource "juju_access_cloud" "test" {
+  cloud  = %q
+  access = %q
+  users  = %s
+}`, userName, userName2, cloudName, access, users)
+}
@@ -0,0 +1,286 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/ids"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &accessControllerResource{}
+var _ resource.ResourceWithConfigure = &accessControllerResource{}
+var _ resource.ResourceWithImportState = &accessControllerResource{}
+var _ resource.ResourceWithConfigValidators = &accessControllerResource{}
+
+func NewAccessControllerResource() resource.Resource {
+	return &accessControllerResource{}
+}
+
+type accessControllerResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type accessControllerResourceModel struct {
+	Users  types.Set    `tfsdk:"users"`
+	Access types.String `tfsdk:"access"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (a *accessControllerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_controller"
+}
+
+// ConfigValidators sets validators for the resource.
+func (a *accessControllerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(a.client, "juju_jaas_access_controller"),
+	}
+}
+
+func (a *accessControllerResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the access of users to a Juju controller, like `juju grant` " +
+			"with controller access.",
+		Attributes: map[string]schema.Attribute{
+			"users": schema.SetAttribute{
+				Description: "List of users to grant access to.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"access": schema.StringAttribute{
+				Description: "Type of access to the controller, `login` lets the users log in, `superuser` " +
+					"also gives them full control of the controller.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("login", "superuser"),
+				},
+			},
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (a *accessControllerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	a.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	a.subCtx = client.NewLogSubsystem(ctx, LogResourceAccessController)
+}
+
+func (a *accessControllerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "create")
+		return
+	}
+	var plan accessControllerResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access := plan.Access.ValueString()
+	err := a.client.Controllers.GrantControllerAccess(ctx, juju.ControllerAccessInput{
+		Access: access,
+		Users:  users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access controller resource, got error: %s", err))
+		return
+	}
+	a.trace(fmt.Sprintf("granted %q access to the controller", access))
+
+	plan.ID = types.StringValue(ids.AccessControllerID{Access: access, Users: users}.String())
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (a *accessControllerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "read")
+		return
+	}
+	var state accessControllerResourceModel
+
+	// Get the Terraform state from the request into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := ids.ParseAccessControllerID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+
+	controllerAccess, err := a.client.Controllers.ReadControllerAccess(ctx)
+	if err != nil {
+		if keepStateDuringControllerUpgrade(a.client, err, &resp.Diagnostics, "access controller") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access controller resource, got error: %s", err))
+		return
+	}
+
+	// Only the users of the resource which still have its access are
+	// kept, the others show up as changes.
+	var users []string
+	for _, user := range id.Users {
+		if controllerAccess[user] == id.Access {
+			users = append(users, user)
+		}
+	}
+	usersValue, errDiag := types.SetValueFrom(ctx, types.StringType, users)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Access = types.StringValue(id.Access)
+	state.Users = usersValue
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update grants the access to the users added to the resource and
+// revokes it from the users removed from it. Changing the access
+// replaces the resource.
+func (a *accessControllerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "update")
+		return
+	}
+
+	var plan, state accessControllerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planUsers, stateUsers []string
+	resp.Diagnostics.Append(plan.Users.ElementsAs(ctx, &planUsers, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &stateUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access := plan.Access.ValueString()
+	if err := a.client.Controllers.RevokeControllerAccess(ctx, juju.ControllerAccessInput{
+		Users: getMissingUsers(stateUsers, planUsers),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access controller resource, got error: %s", err))
+		return
+	}
+	if err := a.client.Controllers.GrantControllerAccess(ctx, juju.ControllerAccessInput{
+		Access: access,
+		Users:  getAddedUsers(stateUsers, planUsers),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access controller resource, got error: %s", err))
+		return
+	}
+	a.trace("updated access controller resource")
+
+	plan.ID = types.StringValue(ids.AccessControllerID{Access: access, Users: planUsers}.String())
+
+	// Set the plan onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (a *accessControllerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if a.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "access controller", "delete")
+		return
+	}
+
+	var state accessControllerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var users []string
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.Controllers.RevokeControllerAccess(ctx, juju.ControllerAccessInput{
+		Users: users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access controller resource, got error: %s", err))
+	}
+}
+
+func (a *accessControllerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughValidID(ctx, ids.ParseAccessControllerID, req, resp)
+}
+
+func (a *accessControllerResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if a.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(a.subCtx, LogResourceAccessController, msg, additionalFields...)
+}
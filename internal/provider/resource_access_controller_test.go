@@ -0,0 +1,56 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceAccessController(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+
+	resourceName := "juju_access_controller.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceAccessController(userName, "bogus"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match.*"),
+			},
+			{
+				Config: testAccResourceAccessController(userName, "superuser"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "access", "superuser"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", userName),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("superuser:%s", userName),
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceAccessController(userName, access string) string {
+	return fmt.Sprintf(`
+resource "juju_user" "this" {
+  name     = %q
+  password = "password"
+}
+
+resource "juju_access_controller" "test" {
+  access = %q
+  users  = [juju_user.this.name]
+}`, userName, access)
+}
@@ -18,20 +18,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/collections/set"
 	"github.com/juju/names/v5"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
+// everyoneUser is the special JAAS identity standing for every user.
+const everyoneUser = "everyone@external"
+
+// Groups are granted access through their members, i.e. the object of the
+// tuple is `group-<uuid>#member`, which includes the members of nested
+// groups.
+const groupTagRelation = "#member"
+
+// Roles are granted access through their assignees, i.e. the object of
+// the tuple is `role-<uuid>#assignee`. The names package of the
+// jimm-go-sdk does not know about role tags yet.
+const (
+	roleTagPrefix   = "role-"
+	roleTagRelation = "#assignee"
+)
+
 var (
 	basicEmailValidationRe = regexp.MustCompile(".+@.+")
 	avoidAtSymbolRe        = regexp.MustCompile("^[^@]*$")
+	validRoleIDRe          = regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
 )
 
 // Getter is used to get details from a plan or state object.
@@ -55,6 +75,20 @@ type resourcer interface {
 	TagFromID(id string) (names.Tag, error)
 }
 
+// referenceResolver is implemented by the targets which can also be
+// imported with a human-friendly reference instead of their ID, using an
+// import ID of the form <kind>:<reference>:<access-level>, e.g.
+// model:<owner>/<model-name>:admin.
+type referenceResolver interface {
+	// ReferenceKind returns the kind prefixing the import IDs using a
+	// reference.
+	ReferenceKind() string
+	// ResolveReference returns the ID of the target the reference
+	// refers to. The reference is empty when the import ID only holds
+	// the kind and the access level, e.g. controller:admin.
+	ResolveReference(ctx context.Context, client *juju.Client, reference string) (string, error)
+}
+
 // genericJAASAccessResource is a generic resource that can be used for creating access rules with JAAS.
 // Other types should embed this struct and implement their own metadata and schema methods. The schema
 // should build on top of [PartialAccessSchema].
@@ -73,9 +107,12 @@ type genericJAASAccessResource struct {
 // Note that service accounts are treated as users but kept as a separate field for improved validation.
 type genericJAASAccessData struct {
 	Users           types.Set    `tfsdk:"users"`
+	GrantToEveryone types.Bool   `tfsdk:"grant_to_everyone"`
 	ServiceAccounts types.Set    `tfsdk:"service_accounts"`
 	Groups          types.Set    `tfsdk:"groups"`
+	Roles           types.Set    `tfsdk:"roles"`
 	Access          types.String `tfsdk:"access"`
+	Reconcile       types.Bool   `tfsdk:"reconcile"`
 
 	// ID required for imports
 	ID types.String `tfsdk:"id"`
@@ -87,9 +124,12 @@ func (r *genericJAASAccessResource) ConfigValidators(ctx context.Context) []reso
 		NewRequiresJAASValidator(r.client),
 		resourcevalidator.AtLeastOneOf(
 			path.MatchRoot("users"),
+			path.MatchRoot("grant_to_everyone"),
 			path.MatchRoot("groups"),
+			path.MatchRoot("roles"),
 			path.MatchRoot("service_accounts"),
 		),
+		grantToEveryoneValidator{},
 	}
 }
 
@@ -113,12 +153,29 @@ func (r *genericJAASAccessResource) partialAccessSchema() map[string]schema.Attr
 				setvalidator.ValueStringsAre(stringvalidator.RegexMatches(basicEmailValidationRe, "email must contain an @ symbol")),
 			},
 		},
+		"grant_to_everyone": schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether to grant access to every user through the special %q identity. "+
+				"It cannot be used together with %q in users. Defaults to false.", everyoneUser, everyoneUser),
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
 		"groups": schema.SetAttribute{
-			Description: "List of groups to grant access.",
+			Description: "List of group UUIDs to grant access. Access is granted to the members of the groups, " +
+				"including the members of nested groups. The membership set can also be written explicitly " +
+				"with the `#member` suffix, e.g. `<uuid>#member`.",
 			Optional:    true,
 			ElementType: types.StringType,
 			Validators: []validator.Set{
-				setvalidator.ValueStringsAre(ValidatorMatchString(jimmnames.IsValidGroupId, "group ID must be valid")),
+				setvalidator.ValueStringsAre(ValidatorMatchString(isValidGroupMembers, "group ID must be a valid UUID, optionally followed by #member")),
+			},
+		},
+		"roles": schema.SetAttribute{
+			Description: "List of role UUIDs to grant access. Every identity assigned to a role gets the access.",
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.RegexMatches(validRoleIDRe, "role ID must be a valid UUID")),
 			},
 		},
 		"service_accounts": schema.SetAttribute{
@@ -140,6 +197,15 @@ func (r *genericJAASAccessResource) partialAccessSchema() map[string]schema.Attr
 				setvalidator.ValueStringsAre(stringvalidator.RegexMatches(avoidAtSymbolRe, "service account should not contain an @ symbol")),
 			},
 		},
+		"reconcile": schema.BoolAttribute{
+			Description: "Whether to apply the access rules to JAAS. When false, the resource only reports " +
+				"relations that are missing from or extra to the plan as warnings, without creating or removing any, " +
+				"and keeps the relations configured in the state. " +
+				"Useful for audit-only workspaces. Defaults to true.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
 		// ID required for imports
 		"id": schema.StringAttribute{
 			Computed: true,
@@ -169,7 +235,7 @@ func (resource *genericJAASAccessResource) Configure(ctx context.Context, req re
 	}
 	resource.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	resource.subCtx = tflog.NewSubsystem(ctx, resource.resourceLogName)
+	resource.subCtx = client.NewLogSubsystem(ctx, resource.resourceLogName)
 }
 
 // Create defines how tuples for access control will be created.
@@ -186,18 +252,25 @@ func (resource *genericJAASAccessResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	// Create tuples to create from the plan
-	tuples := modelToTuples(ctx, targetTag, plan, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	// Make a call to create relations
-	err := resource.client.Jaas.AddRelations(tuples)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access relationships for %s, got error: %s", targetTag.String(), err))
-		return
+	if plan.Reconcile.ValueBool() {
+		// Create tuples to create from the plan
+		tuples := modelToTuples(ctx, targetTag, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Make a call to create relations
+		err := resource.client.Jaas.AddRelations(ctx, tuples)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access relationships for %s, got error: %s", targetTag.String(), err))
+			return
+		}
+	} else {
+		resource.reportDrift(ctx, targetTag, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	plan.ID = types.StringValue(newJaasAccessID(targetTag, plan.Access.ValueString()))
+	plan.ID = types.StringValue(ids.JaasAccessID{Target: targetTag.Id(), Access: plan.Access.ValueString()}.String())
 	// Set the plan onto the Terraform state
 	resp.Diagnostics.Append(resource.targetResource.Save(ctx, &resp.State, plan, targetTag)...)
 }
@@ -230,20 +303,43 @@ func (resource *genericJAASAccessResource) Read(ctx context.Context, req resourc
 	}
 	tuples, err := resource.client.Jaas.ReadRelations(ctx, &readTuple)
 	if err != nil {
+		if keepStateDuringControllerUpgrade(resource.client, err, &resp.Diagnostics, "access") {
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access rules for %s, got error: %s", targetTag.String(), err))
 		return
 	}
 
+	// A resource which does not reconcile keeps the relations configured,
+	// the differences with JAAS are only reported.
+	if !state.Reconcile.IsNull() && !state.Reconcile.ValueBool() {
+		resource.reportTuplesDrift(ctx, targetTag, state, tuples, &resp.Diagnostics)
+		return
+	}
+
 	// Transform the tuples into an access model
 	newModel := tuplesToModel(ctx, tuples, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// everyone@external stays in users unless grant_to_everyone manages it,
+	// e.g. after an import.
+	splitEveryone(&newModel, state.GrantToEveryone.ValueBool(), &resp.Diagnostics)
+	keepGroupMembersSyntax(&newModel, state.Groups, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	state.Users = newModel.Users
+	state.GrantToEveryone = newModel.GrantToEveryone
 	state.Groups = newModel.Groups
+	state.Roles = newModel.Roles
 	state.ServiceAccounts = newModel.ServiceAccounts
 	state.Access = basetypes.NewStringValue(access)
+	// Reconcile is not known when importing, use the default.
+	if state.Reconcile.IsNull() {
+		state.Reconcile = types.BoolValue(true)
+	}
 	resp.Diagnostics.Append(resource.targetResource.Save(ctx, &resp.State, state, targetTag)...)
 }
 
@@ -272,6 +368,16 @@ func (resource *genericJAASAccessResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	// Only report the differences if the resource does not reconcile.
+	if !plan.Reconcile.ValueBool() {
+		resource.reportDrift(ctx, targetTag, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resource.save(ctx, &resp.State, plan, targetTag)...)
+		return
+	}
+
 	// Get a diff of the plan vs. state to know what relations to add/remove
 	modelAdd, modelRemove := diffModels(plan, state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -287,10 +393,13 @@ func (resource *genericJAASAccessResource) Update(ctx context.Context, req resou
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Moving everyone@external between users and grant_to_everyone
+	// keeps the relation as it is.
+	addTuples, removeTuples = cancelTuples(addTuples, removeTuples)
 
 	// Add new relations
 	if len(addTuples) > 0 {
-		err := resource.client.Jaas.AddRelations(addTuples)
+		err := resource.client.Jaas.AddRelations(ctx, addTuples)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add access rules for %s, got error: %s", targetTag.String(), err))
 			return
@@ -302,7 +411,7 @@ func (resource *genericJAASAccessResource) Update(ctx context.Context, req resou
 
 	// Delete removed relations
 	if len(removeTuples) > 0 {
-		err := resource.client.Jaas.DeleteRelations(removeTuples)
+		err := resource.client.Jaas.DeleteRelations(ctx, removeTuples)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove access rules for %s, got error: %s", targetTag.String(), err))
 			return
@@ -316,35 +425,88 @@ func (resource *genericJAASAccessResource) Update(ctx context.Context, req resou
 func diffModels(plan, state genericJAASAccessData, diag *diag.Diagnostics) (toAdd, toRemove genericJAASAccessData) {
 	newUsers := diffSet(plan.Users, state.Users, diag)
 	newGroups := diffSet(plan.Groups, state.Groups, diag)
+	newRoles := diffSet(plan.Roles, state.Roles, diag)
 	newServiceAccounts := diffSet(plan.ServiceAccounts, state.ServiceAccounts, diag)
 	toAdd.Users = newUsers
 	toAdd.Groups = newGroups
+	toAdd.Roles = newRoles
 	toAdd.ServiceAccounts = newServiceAccounts
+	toAdd.GrantToEveryone = types.BoolValue(plan.GrantToEveryone.ValueBool() && !state.GrantToEveryone.ValueBool())
 	toAdd.Access = plan.Access
 
 	removedUsers := diffSet(state.Users, plan.Users, diag)
 	removedGroups := diffSet(state.Groups, plan.Groups, diag)
+	removedRoles := diffSet(state.Roles, plan.Roles, diag)
 	removedServiceAccounts := diffSet(state.ServiceAccounts, plan.ServiceAccounts, diag)
 	toRemove.Users = removedUsers
 	toRemove.Groups = removedGroups
+	toRemove.Roles = removedRoles
 	toRemove.ServiceAccounts = removedServiceAccounts
+	toRemove.GrantToEveryone = types.BoolValue(state.GrantToEveryone.ValueBool() && !plan.GrantToEveryone.ValueBool())
 	toRemove.Access = plan.Access
 
 	return
 }
 
-// diffSet returns the elements in the target set that are not present in the current set.
+// reportDrift reads the relations currently defined in JAAS for the target and
+// adds a warning for each relation which is missing from, or extra to, the plan.
+// No relations are added or removed.
+func (resource *genericJAASAccessResource) reportDrift(ctx context.Context, targetTag names.Tag, plan genericJAASAccessData, diag *diag.Diagnostics) {
+	readTuple := juju.JaasTuple{
+		Target:   targetTag.String(),
+		Relation: plan.Access.ValueString(),
+	}
+	tuples, err := resource.client.Jaas.ReadRelations(ctx, &readTuple)
+	if err != nil {
+		diag.AddError("Client Error", fmt.Sprintf("Unable to read access rules for %s, got error: %s", targetTag.String(), err))
+		return
+	}
+	resource.reportTuplesDrift(ctx, targetTag, plan, tuples, diag)
+}
+
+// reportTuplesDrift warns about the differences between the relations
+// of the plan and the tuples defined in JAAS for the target.
+func (resource *genericJAASAccessResource) reportTuplesDrift(ctx context.Context, targetTag names.Tag, plan genericJAASAccessData, tuples []juju.JaasTuple, diag *diag.Diagnostics) {
+	current := tuplesToModel(ctx, tuples, diag)
+	splitEveryone(&current, plan.GrantToEveryone.ValueBool(), diag)
+	keepGroupMembersSyntax(&current, plan.Groups, diag)
+	if diag.HasError() {
+		return
+	}
+	current.Access = plan.Access
+
+	missing, extra := diffModels(plan, current, diag)
+	missingTuples := modelToTuples(ctx, targetTag, missing, diag)
+	extraTuples := modelToTuples(ctx, targetTag, extra, diag)
+	if diag.HasError() {
+		return
+	}
+	for _, t := range missingTuples {
+		diag.AddWarning("Access Drift",
+			fmt.Sprintf("Relation %q %s %q is missing in JAAS and was not created because reconcile is false.", t.Object, t.Relation, t.Target))
+	}
+	for _, t := range extraTuples {
+		diag.AddWarning("Access Drift",
+			fmt.Sprintf("Relation %q %s %q exists in JAAS but not in the plan and was not removed because reconcile is false.", t.Object, t.Relation, t.Target))
+	}
+	resource.trace("reported access drift", map[string]interface{}{
+		"target":  targetTag.String(),
+		"missing": len(missingTuples),
+		"extra":   len(extraTuples),
+	})
+}
+
+// diffSet returns the elements in the current set that are not present in the target set.
 func diffSet(current, target basetypes.SetValue, diag *diag.Diagnostics) basetypes.SetValue {
+	// Index the target once so large sets are not compared element by element.
+	targetValues := set.NewStrings()
+	for _, value := range target.Elements() {
+		targetValues.Add(value.String())
+	}
 	var diff []attr.Value
-	for _, source := range current.Elements() {
-		found := false
-		for _, target := range target.Elements() {
-			if source.Equal(target) {
-				found = true
-			}
-		}
-		if !found {
-			diff = append(diff, source)
+	for _, value := range current.Elements() {
+		if !targetValues.Contains(value.String()) {
+			diff = append(diff, value)
 		}
 	}
 	newSet, diags := basetypes.NewSetValue(current.ElementType(context.Background()), diff)
@@ -366,13 +528,19 @@ func (resource *genericJAASAccessResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	// Relations are never removed when the resource does not reconcile,
+	// only the resource is removed from the Terraform state.
+	if !state.Reconcile.IsNull() && !state.Reconcile.ValueBool() {
+		return
+	}
+
 	// Create the tuples to delete
 	tuples := modelToTuples(ctx, targetTag, state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	// Delete the tuples
-	err := resource.client.Jaas.DeleteRelations(tuples)
+	err := resource.client.Jaas.DeleteRelations(ctx, tuples)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access rules for %s, got error: %s", targetTag.String(), err))
 		return
@@ -383,9 +551,11 @@ func (resource *genericJAASAccessResource) Delete(ctx context.Context, req resou
 func modelToTuples(ctx context.Context, targetTag names.Tag, model genericJAASAccessData, diag *diag.Diagnostics) []juju.JaasTuple {
 	var users []string
 	var groups []string
+	var roles []string
 	var serviceAccounts []string
 	diag.Append(model.Users.ElementsAs(ctx, &users, false)...)
 	diag.Append(model.Groups.ElementsAs(ctx, &groups, false)...)
+	diag.Append(model.Roles.ElementsAs(ctx, &roles, false)...)
 	diag.Append(model.ServiceAccounts.ElementsAs(ctx, &serviceAccounts, false)...)
 	if diag.HasError() {
 		return []juju.JaasTuple{}
@@ -396,7 +566,10 @@ func modelToTuples(ctx context.Context, targetTag names.Tag, model genericJAASAc
 	}
 	var tuples []juju.JaasTuple
 	userNameToTagf := func(s string) string { return names.NewUserTag(s).String() }
-	groupIDToTagf := func(s string) string { return jimmnames.NewGroupTag(s).String() + "#member" }
+	groupIDToTagf := func(s string) string {
+		return jimmnames.NewGroupTag(strings.TrimSuffix(s, groupTagRelation)).String() + groupTagRelation
+	}
+	roleIDToTagf := func(s string) string { return roleTagPrefix + s + roleTagRelation }
 	// Note that service accounts are treated as users but with an @serviceaccount domain.
 	// We add the @serviceaccount domain by calling `EnsureValidServiceAccountId` so that the user writing the plan doesn't have to.
 	// We can ignore the error below because the inputs have already gone through validation.
@@ -406,16 +579,110 @@ func modelToTuples(ctx context.Context, targetTag names.Tag, model genericJAASAc
 	}
 	tuples = append(tuples, assignTupleObject(baseTuple, users, userNameToTagf)...)
 	tuples = append(tuples, assignTupleObject(baseTuple, groups, groupIDToTagf)...)
+	tuples = append(tuples, assignTupleObject(baseTuple, roles, roleIDToTagf)...)
 	tuples = append(tuples, assignTupleObject(baseTuple, serviceAccounts, serviceAccIDToTagf)...)
+	if model.GrantToEveryone.ValueBool() {
+		tuples = append(tuples, assignTupleObject(baseTuple, []string{everyoneUser}, userNameToTagf)...)
+	}
 	return tuples
 }
 
+// splitEveryone moves everyone@external out of the users of the model and
+// into GrantToEveryone when grantToEveryone is set. Otherwise the identity
+// is left in the users and GrantToEveryone is false.
+func splitEveryone(model *genericJAASAccessData, grantToEveryone bool, diag *diag.Diagnostics) {
+	model.GrantToEveryone = types.BoolValue(false)
+	if !grantToEveryone {
+		return
+	}
+	everyone := types.StringValue(everyoneUser)
+	var users []attr.Value
+	for _, user := range model.Users.Elements() {
+		if user.Equal(everyone) {
+			model.GrantToEveryone = types.BoolValue(true)
+			continue
+		}
+		users = append(users, user)
+	}
+	// Match tuplesToModel, which returns a null set without users.
+	if len(users) == 0 {
+		model.Users = types.SetNull(types.StringType)
+		return
+	}
+	userSet, errDiag := basetypes.NewSetValue(types.StringType, users)
+	diag.Append(errDiag...)
+	model.Users = userSet
+}
+
+// isValidGroupMembers returns true if the group is a group UUID,
+// optionally followed by the #member relation.
+func isValidGroupMembers(group string) bool {
+	uuid, relation, found := strings.Cut(group, "#")
+	if found && "#"+relation != groupTagRelation {
+		return false
+	}
+	return jimmnames.IsValidGroupId(uuid)
+}
+
+// keepGroupMembersSyntax writes the groups of the model, which are read
+// as plain UUIDs, with the #member suffix when they are written that way
+// in the previous groups, so both syntaxes do not show as a change.
+func keepGroupMembersSyntax(model *genericJAASAccessData, previous types.Set, diag *diag.Diagnostics) {
+	explicit := set.NewStrings()
+	for _, group := range previous.Elements() {
+		if value, ok := group.(types.String); ok && strings.HasSuffix(value.ValueString(), groupTagRelation) {
+			explicit.Add(strings.TrimSuffix(value.ValueString(), groupTagRelation))
+		}
+	}
+	if explicit.IsEmpty() || model.Groups.IsNull() {
+		return
+	}
+	groups := set.NewStrings()
+	for _, group := range model.Groups.Elements() {
+		id := group.(types.String).ValueString()
+		if explicit.Contains(id) {
+			id += groupTagRelation
+		}
+		groups.Add(id)
+	}
+	model.Groups = stringsToSet(groups, diag)
+}
+
+// cancelTuples removes the tuples found in both slices from each of them.
+func cancelTuples(a, b []juju.JaasTuple) ([]juju.JaasTuple, []juju.JaasTuple) {
+	common := make(map[juju.JaasTuple]bool)
+	for _, t := range a {
+		common[t] = false
+	}
+	for _, t := range b {
+		if _, ok := common[t]; ok {
+			common[t] = true
+		}
+	}
+	keep := func(tuples []juju.JaasTuple) []juju.JaasTuple {
+		var kept []juju.JaasTuple
+		for _, t := range tuples {
+			if !common[t] {
+				kept = append(kept, t)
+			}
+		}
+		return kept
+	}
+	return keep(a), keep(b)
+}
+
 // tuplesToModel does the reverse of planToTuples converting a slice of tuples to an access model.
+// Duplicate objects are collapsed and empty sets are returned as null.
 func tuplesToModel(ctx context.Context, tuples []juju.JaasTuple, diag *diag.Diagnostics) genericJAASAccessData {
-	var users []string
-	var groups []string
-	var serviceAccounts []string
+	users := set.NewStrings()
+	groups := set.NewStrings()
+	roles := set.NewStrings()
+	serviceAccounts := set.NewStrings()
 	for _, tuple := range tuples {
+		if strings.HasPrefix(tuple.Object, roleTagPrefix) {
+			roles.Add(strings.TrimSuffix(strings.TrimPrefix(tuple.Object, roleTagPrefix), roleTagRelation))
+			continue
+		}
 		tag, err := jimmnames.ParseTag(tuple.Object)
 		if err != nil {
 			diag.AddError("failed to parse relation tag", fmt.Sprintf("error parsing %s:%s", tuple.Object, err.Error()))
@@ -431,27 +698,39 @@ func tuplesToModel(ctx context.Context, tuples []juju.JaasTuple, diag *diag.Diag
 				if domainStart != -1 {
 					svcAccount = svcAccount[:domainStart]
 				}
-				serviceAccounts = append(serviceAccounts, svcAccount)
+				serviceAccounts.Add(svcAccount)
 			} else {
-				users = append(users, userTag.Id())
+				users.Add(userTag.Id())
 			}
 		case jimmnames.GroupTagKind:
-			groups = append(groups, strings.ReplaceAll(tag.Id(), "#member", ""))
+			groups.Add(strings.TrimSuffix(tag.Id(), groupTagRelation))
 		}
 	}
-	userSet, errDiag := basetypes.NewSetValueFrom(ctx, types.StringType, users)
-	diag.Append(errDiag...)
-	groupSet, errDiag := basetypes.NewSetValueFrom(ctx, types.StringType, groups)
-	diag.Append(errDiag...)
-	serviceAccountSet, errDiag := basetypes.NewSetValueFrom(ctx, types.StringType, serviceAccounts)
-	diag.Append(errDiag...)
 	var model genericJAASAccessData
-	model.Users = userSet
-	model.Groups = groupSet
-	model.ServiceAccounts = serviceAccountSet
+	model.Users = stringsToSet(users, diag)
+	model.Groups = stringsToSet(groups, diag)
+	model.Roles = stringsToSet(roles, diag)
+	model.ServiceAccounts = stringsToSet(serviceAccounts, diag)
 	return model
 }
 
+// stringsToSet converts a set of strings to a Terraform set, which is
+// null when there are no strings. The strings are already unique, so the
+// set is built directly rather than with NewSetValueFrom, whose duplicate
+// check is quadratic in the number of elements.
+func stringsToSet(values set.Strings, diag *diag.Diagnostics) basetypes.SetValue {
+	if values.IsEmpty() {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, values.Size())
+	for _, value := range values.SortedValues() {
+		elements = append(elements, types.StringValue(value))
+	}
+	setValue, errDiag := basetypes.NewSetValue(types.StringType, elements)
+	diag.Append(errDiag...)
+	return setValue
+}
+
 func assignTupleObject(baseTuple juju.JaasTuple, items []string, idToTag func(string) string) []juju.JaasTuple {
 	tuples := make([]juju.JaasTuple, 0, len(items))
 	for _, item := range items {
@@ -462,6 +741,13 @@ func assignTupleObject(baseTuple juju.JaasTuple, items []string, idToTag func(st
 	return tuples
 }
 
+func (a *genericJAASAccessResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if a.subCtx == nil {
+		return
+	}
+	tflog.SubsystemTrace(a.subCtx, a.resourceLogName, msg, additionalFields...)
+}
+
 func (a *genericJAASAccessResource) info(ctx context.Context, getter Getter, diags *diag.Diagnostics) (genericJAASAccessData, names.Tag) {
 	return a.targetResource.Info(ctx, getter, diags)
 }
@@ -470,30 +756,32 @@ func (a *genericJAASAccessResource) save(ctx context.Context, setter Setter, inf
 	return a.targetResource.Save(ctx, setter, info, tag)
 }
 
-func newJaasAccessID(targetTag names.Tag, accessStr string) string {
-	return fmt.Sprintf("%s:%s", targetTag.Id(), accessStr)
-}
-
 func (a *genericJAASAccessResource) retrieveJaasAccessFromID(ID types.String, diag *diag.Diagnostics) (resourceTag names.Tag, access string) {
-	resID := strings.Split(ID.ValueString(), ":")
-	if len(resID) != 2 {
-		diag.AddError("Malformed ID", fmt.Sprintf("Access ID %q is malformed", resID))
+	id, err := ids.ParseJaasAccessID(ID.ValueString())
+	if err != nil {
+		addMalformedIDError(diag, err)
 		return nil, ""
 	}
-	tag, err := a.targetResource.TagFromID(resID[0])
+	tag, err := a.targetResource.TagFromID(id.Target)
 	if err != nil {
-		diag.AddError("ID Error", fmt.Sprintf("Tag %s from ID is not valid: %s", resID[0], err))
+		diag.AddError("ID Error", fmt.Sprintf("Tag %s from ID is not valid: %s", id.Target, err))
 		return nil, ""
 	}
-	return tag, resID[1]
+	return tag, id.Access
 }
 
 // Importstate validates the user provided ID and attempts to create a resource by
 // reading and importing the object referred to by the provided ID.
 func (a *genericJAASAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	IDstr := req.ID
-	resID := strings.Split(IDstr, ":")
-	if len(resID) != 2 {
+	if resolver, ok := a.targetResource.(referenceResolver); ok && strings.HasPrefix(IDstr, resolver.ReferenceKind()+":") {
+		IDstr = a.resolveImportID(ctx, resolver, IDstr, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	id, err := ids.ParseJaasAccessID(IDstr)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"ImportState Failure",
 			fmt.Sprintf("Malformed Import ID %q, "+
@@ -501,14 +789,41 @@ func (a *genericJAASAccessResource) ImportState(ctx context.Context, req resourc
 		)
 		return
 	}
-	_, err := a.targetResource.TagFromID(resID[0])
+	_, err = a.targetResource.TagFromID(id.Target)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"ImportState Failure",
 			fmt.Sprintf("Malformed Import ID %q, "+
-				"%s is not a valid tag, expected %q", IDstr, resID[0], a.targetResource.ImportHint()),
+				"%s is not a valid tag, expected %q", IDstr, id.Target, a.targetResource.ImportHint()),
 		)
 		return
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), IDstr)...)
+}
+
+// resolveImportID returns the import ID with the reference to the target
+// replaced by the ID of the target.
+func (a *genericJAASAccessResource) resolveImportID(ctx context.Context, resolver referenceResolver, importID string, diag *diag.Diagnostics) string {
+	if a.client == nil {
+		addClientNotConfiguredError(diag, a.resourceLogName, "import")
+		return ""
+	}
+	reference := strings.TrimPrefix(importID, resolver.ReferenceKind()+":")
+	access := reference
+	if i := strings.LastIndex(reference, ":"); i != -1 {
+		reference, access = reference[:i], reference[i+1:]
+	} else {
+		reference = ""
+	}
+	target, err := resolver.ResolveReference(ctx, a.client, reference)
+	if err != nil {
+		diag.AddError(
+			"ImportState Failure",
+			fmt.Sprintf("Unable to resolve the %s of import ID %q, got error: %s", resolver.ReferenceKind(), importID, err),
+		)
+		return ""
+	}
+	id := ids.JaasAccessID{Target: target, Access: access}.String()
+	a.trace("resolved import ID", map[string]interface{}{"import-id": importID, "id": id})
+	return id
 }
@@ -4,15 +4,31 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/canonical/jimm-go-sdk/v3/api"
 	"github.com/canonical/jimm-go-sdk/v3/api/params"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/juju/names/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
 )
 
 func TestBasicEmailValidation(t *testing.T) {
@@ -73,6 +89,210 @@ func TestAvoidAtSymbolValidation(t *testing.T) {
 	}
 }
 
+func TestSplitEveryone(t *testing.T) {
+	ctx := context.Background()
+	users, diags := types.SetValueFrom(ctx, types.StringType, []string{"foo@domain.com", everyoneUser})
+	assert.False(t, diags.HasError())
+
+	model := genericJAASAccessData{Users: users}
+	var d diag.Diagnostics
+	splitEveryone(&model, false, &d)
+	assert.False(t, d.HasError())
+	assert.Equal(t, types.BoolValue(false), model.GrantToEveryone)
+	assert.Len(t, model.Users.Elements(), 2)
+
+	splitEveryone(&model, true, &d)
+	assert.False(t, d.HasError())
+	assert.Equal(t, types.BoolValue(true), model.GrantToEveryone)
+	expected, _ := types.SetValueFrom(ctx, types.StringType, []string{"foo@domain.com"})
+	assert.Equal(t, expected, model.Users)
+
+	// Without other users, the set is null like the one from tuplesToModel.
+	only, _ := types.SetValueFrom(ctx, types.StringType, []string{everyoneUser})
+	model = genericJAASAccessData{Users: only}
+	splitEveryone(&model, true, &d)
+	assert.Equal(t, types.BoolValue(true), model.GrantToEveryone)
+	assert.True(t, model.Users.IsNull())
+}
+
+func TestCancelTuples(t *testing.T) {
+	everyone := juju.JaasTuple{Object: "user-" + everyoneUser, Relation: "reader", Target: "model-uuid"}
+	foo := juju.JaasTuple{Object: "user-foo@domain.com", Relation: "reader", Target: "model-uuid"}
+	bar := juju.JaasTuple{Object: "user-bar@domain.com", Relation: "reader", Target: "model-uuid"}
+
+	add, remove := cancelTuples([]juju.JaasTuple{everyone, foo}, []juju.JaasTuple{bar, everyone})
+	assert.Equal(t, []juju.JaasTuple{foo}, add)
+	assert.Equal(t, []juju.JaasTuple{bar}, remove)
+}
+
+func TestTuplesToModel(t *testing.T) {
+	tuples := []juju.JaasTuple{
+		{Object: "user-foo@domain.com"},
+		{Object: "user-foo@domain.com"},
+		{Object: "group-8ff8b8d4-1d5b-4f8c-9b1a-3c4d5e6f7a8b#member"},
+		{Object: "user-my-svc@serviceaccount"},
+		{Object: "role-0b6a6f4c-2f0e-4d5a-8c3b-7e9f1a2b3c4d#assignee"},
+	}
+	var d diag.Diagnostics
+	model := tuplesToModel(context.Background(), tuples, &d)
+	assert.False(t, d.HasError())
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("foo@domain.com")}), model.Users)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("8ff8b8d4-1d5b-4f8c-9b1a-3c4d5e6f7a8b")}), model.Groups)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("my-svc")}), model.ServiceAccounts)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("0b6a6f4c-2f0e-4d5a-8c3b-7e9f1a2b3c4d")}), model.Roles)
+
+	model = tuplesToModel(context.Background(), nil, &d)
+	assert.True(t, model.Users.IsNull())
+	assert.True(t, model.Groups.IsNull())
+	assert.True(t, model.Roles.IsNull())
+	assert.True(t, model.ServiceAccounts.IsNull())
+}
+
+func TestModelToTuplesRoles(t *testing.T) {
+	roleID := "0b6a6f4c-2f0e-4d5a-8c3b-7e9f1a2b3c4d"
+	model := genericJAASAccessData{
+		Users:           types.SetNull(types.StringType),
+		Groups:          types.SetNull(types.StringType),
+		ServiceAccounts: types.SetNull(types.StringType),
+		Roles:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue(roleID)}),
+		Access:          types.StringValue("reader"),
+	}
+	var d diag.Diagnostics
+	tuples := modelToTuples(context.Background(), names.NewModelTag("model-uuid"), model, &d)
+	assert.False(t, d.HasError())
+	assert.Equal(t, []juju.JaasTuple{
+		{Object: "role-" + roleID + "#assignee", Relation: "reader", Target: "model-model-uuid"},
+	}, tuples)
+
+	// The tuples read back from JAAS give the same roles.
+	assert.Equal(t, model.Roles, tuplesToModel(context.Background(), tuples, &d).Roles)
+}
+
+func TestIsValidGroupMembers(t *testing.T) {
+	groupID := "8ff8b8d4-1d5b-4f8c-9b1a-3c4d5e6f7a8b"
+	assert.True(t, isValidGroupMembers(groupID))
+	assert.True(t, isValidGroupMembers(groupID+"#member"))
+	assert.False(t, isValidGroupMembers(groupID+"#administrator"))
+	assert.False(t, isValidGroupMembers(groupID+"#member#member"))
+	assert.False(t, isValidGroupMembers("not-a-uuid#member"))
+}
+
+// TestAccessSetsUnknownElements checks the sets of the access schema
+// accept elements unknown at plan time, e.g. the UUID of a group created
+// in the same apply, and still validate the known elements.
+func TestAccessSetsUnknownElements(t *testing.T) {
+	uuid := "8ff8b8d4-1d5b-4f8c-9b1a-3c4d5e6f7a8b"
+	tests := map[string]struct {
+		valid   string
+		invalid string
+	}{
+		"users":            {valid: "foo@domain.com", invalid: "foo"},
+		"groups":           {valid: uuid + "#member", invalid: "foo"},
+		"roles":            {valid: uuid, invalid: "foo"},
+		"service_accounts": {valid: "foo", invalid: "foo@domain.com"},
+	}
+	attributes := (&genericJAASAccessResource{}).partialAccessSchema()
+	for name, test := range tests {
+		validate := func(elements ...attr.Value) diag.Diagnostics {
+			req := validator.SetRequest{
+				Path:        path.Root(name),
+				ConfigValue: types.SetValueMust(types.StringType, elements),
+			}
+			var resp validator.SetResponse
+			for _, v := range attributes[name].(schema.SetAttribute).SetValidators() {
+				v.ValidateSet(context.Background(), req, &resp)
+			}
+			return resp.Diagnostics
+		}
+		d := validate(types.StringUnknown(), types.StringValue(test.valid))
+		assert.False(t, d.HasError(), "%s: %v", name, d)
+		d = validate(types.StringUnknown(), types.StringValue(test.invalid))
+		assert.True(t, d.HasError(), "%s: expected an error for %q", name, test.invalid)
+	}
+}
+
+func TestModelToTuplesGroupMembers(t *testing.T) {
+	groupID := "8ff8b8d4-1d5b-4f8c-9b1a-3c4d5e6f7a8b"
+	otherID := "0b6a6f4c-2f0e-4d5a-8c3b-7e9f1a2b3c4d"
+	model := genericJAASAccessData{
+		Users:           types.SetNull(types.StringType),
+		ServiceAccounts: types.SetNull(types.StringType),
+		Roles:           types.SetNull(types.StringType),
+		Groups: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue(groupID + "#member"),
+			types.StringValue(otherID),
+		}),
+		Access: types.StringValue("reader"),
+	}
+	var d diag.Diagnostics
+	tuples := modelToTuples(context.Background(), names.NewModelTag("model-uuid"), model, &d)
+	assert.False(t, d.HasError())
+	assert.ElementsMatch(t, []juju.JaasTuple{
+		{Object: "group-" + groupID + "#member", Relation: "reader", Target: "model-model-uuid"},
+		{Object: "group-" + otherID + "#member", Relation: "reader", Target: "model-model-uuid"},
+	}, tuples)
+
+	// Reading the tuples back keeps the syntax of each group.
+	read := tuplesToModel(context.Background(), tuples, &d)
+	keepGroupMembersSyntax(&read, model.Groups, &d)
+	assert.False(t, d.HasError())
+	assert.True(t, model.Groups.Equal(read.Groups), read.Groups)
+
+	// Without previous groups, e.g. on import, the plain UUIDs are used.
+	read = tuplesToModel(context.Background(), tuples, &d)
+	keepGroupMembersSyntax(&read, types.SetNull(types.StringType), &d)
+	assert.True(t, types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue(groupID),
+		types.StringValue(otherID),
+	}).Equal(read.Groups), read.Groups)
+}
+
+func TestDiffSet(t *testing.T) {
+	current := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("foo"), types.StringValue("bar")})
+	target := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("bar"), types.StringValue("baz")})
+	var d diag.Diagnostics
+	diff := diffSet(current, target, &d)
+	assert.False(t, d.HasError())
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("foo")}), diff)
+
+	diff = diffSet(current, types.SetNull(types.StringType), &d)
+	assert.Equal(t, current, diff)
+}
+
+// benchmarkUsers is the number of users in the access benchmarks, in the
+// order of a large JAAS deployment.
+const benchmarkUsers = 5000
+
+func BenchmarkTuplesToModel(b *testing.B) {
+	tuples := make([]juju.JaasTuple, benchmarkUsers)
+	for i := range tuples {
+		tuples[i] = juju.JaasTuple{Object: fmt.Sprintf("user-user%d@domain.com", i), Relation: "reader", Target: "model-uuid"}
+	}
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var d diag.Diagnostics
+		tuplesToModel(ctx, tuples, &d)
+	}
+}
+
+func BenchmarkDiffSet(b *testing.B) {
+	current := make([]attr.Value, benchmarkUsers)
+	target := make([]attr.Value, benchmarkUsers)
+	for i := range current {
+		current[i] = types.StringValue(fmt.Sprintf("user%d@domain.com", i))
+		// Half of the users are shared between both sets.
+		target[i] = types.StringValue(fmt.Sprintf("user%d@domain.com", i+benchmarkUsers/2))
+	}
+	currentSet := types.SetValueMust(types.StringType, current)
+	targetSet := types.SetValueMust(types.StringType, target)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var d diag.Diagnostics
+		diffSet(currentSet, targetSet, &d)
+	}
+}
+
 // ===============================
 // Helpers for jaas resource tests
 
@@ -143,7 +363,7 @@ func testAccCheckJaasResourceAccess(relation string, object, target *string, exp
 		if target == nil || *target == "" {
 			return fmt.Errorf("no target set")
 		}
-		conn, err := TestClient.Models.GetConnection(nil)
+		conn, err := TestClient.Models.GetConnection(context.Background(), nil)
 		if err != nil {
 			return err
 		}
@@ -176,3 +396,85 @@ func testAccCheckJaasResourceAccess(relation string, object, target *string, exp
 		return nil
 	}
 }
+
+func TestImportStateResolvesReference(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	modelsClient := mocks.NewMockModelsClient(ctlr)
+	modelsClient.EXPECT().ReadModelUUID(gomock.Any(), "alice@canonical.com", "development").Return("model-uuid", nil)
+	jaasClient := mocks.NewMockJaasClient(ctlr)
+	jaasClient.EXPECT().ReadGroupByName(gomock.Any(), "devops-team").Return(&juju.JaasGroup{Name: "devops-team", UUID: "9e5a3b0c-1d2f-4a6b-8c7d-0e1f2a3b4c5d"}, nil)
+	client := &juju.Client{Models: modelsClient, Jaas: jaasClient}
+
+	tests := []struct {
+		resource   fwresource.ResourceWithImportState
+		importID   string
+		expectedID string
+	}{
+		{NewJAASAccessModelResource().(fwresource.ResourceWithImportState), "model:alice@canonical.com/development:admin", "model-uuid:admin"},
+		{NewJAASAccessControllerResource().(fwresource.ResourceWithImportState), "controller:administrator", "jimm:administrator"},
+		{NewJAASAccessGroupResource().(fwresource.ResourceWithImportState), "group:devops-team:member", "9e5a3b0c-1d2f-4a6b-8c7d-0e1f2a3b4c5d:member"},
+		// IDs without a reference are kept as they are.
+		{NewJAASAccessControllerResource().(fwresource.ResourceWithImportState), "jimm:login", "jimm:login"},
+	}
+	for _, test := range tests {
+		test.resource.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, &fwresource.ConfigureResponse{})
+		schemaResp := fwresource.SchemaResponse{}
+		test.resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+		require.False(t, schemaResp.Diagnostics.HasError())
+
+		resp := fwresource.ImportStateResponse{State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}}
+		test.resource.ImportState(ctx, fwresource.ImportStateRequest{ID: test.importID}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var id types.String
+		require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+		assert.Equal(t, test.expectedID, id.ValueString(), test.importID)
+	}
+}
+
+func TestReadWithoutReconcileKeepsState(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	jaasClient := mocks.NewMockJaasClient(ctlr)
+	// alice is missing in JAAS, bob is not configured.
+	jaasClient.EXPECT().ReadRelations(gomock.Any(), &juju.JaasTuple{
+		Target:   names.NewModelTag("model-uuid").String(),
+		Relation: "writer",
+	}).Return([]juju.JaasTuple{{Object: "user-bob@canonical.com"}}, nil)
+	r := NewJAASAccessModelResource()
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+		ProviderData: &juju.Client{Jaas: jaasClient},
+	}, &fwresource.ConfigureResponse{})
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	configured := jaasAccessModelResourceModel{
+		ModelUUID:       types.StringValue("model-uuid"),
+		Users:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("alice@canonical.com")}),
+		GrantToEveryone: types.BoolNull(),
+		ServiceAccounts: types.SetNull(types.StringType),
+		Groups:          types.SetNull(types.StringType),
+		Roles:           types.SetNull(types.StringType),
+		Access:          types.StringValue("writer"),
+		Reconcile:       types.BoolValue(false),
+		ID:              types.StringValue("model-uuid:writer"),
+	}
+	require.False(t, state.Set(ctx, configured).HasError())
+
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// The drift is reported, and the state is not changed, so that the
+	// plan stays empty.
+	assert.Len(t, resp.Diagnostics.Warnings(), 2)
+	var got jaasAccessModelResourceModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, configured, got)
+}
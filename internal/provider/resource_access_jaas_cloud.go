@@ -41,9 +41,12 @@ func (j cloudInfo) Info(ctx context.Context, getter Getter, diag *diag.Diagnosti
 	accessCloud := genericJAASAccessData{
 		ID:              cloudAccess.ID,
 		Users:           cloudAccess.Users,
+		GrantToEveryone: cloudAccess.GrantToEveryone,
 		Groups:          cloudAccess.Groups,
+		Roles:           cloudAccess.Roles,
 		ServiceAccounts: cloudAccess.ServiceAccounts,
 		Access:          cloudAccess.Access,
+		Reconcile:       cloudAccess.Reconcile,
 	}
 	// When importing, the cloud name will be empty
 	var tag names.Tag
@@ -59,9 +62,12 @@ func (j cloudInfo) Save(ctx context.Context, setter Setter, info genericJAASAcce
 		CloudName:       basetypes.NewStringValue(tag.Id()),
 		ID:              info.ID,
 		Users:           info.Users,
+		GrantToEveryone: info.GrantToEveryone,
 		Groups:          info.Groups,
+		Roles:           info.Roles,
 		ServiceAccounts: info.ServiceAccounts,
 		Access:          info.Access,
+		Reconcile:       info.Reconcile,
 	}
 	return setter.Set(ctx, cloudAccess)
 }
@@ -87,9 +93,12 @@ type jaasAccessCloudResource struct {
 type jaasAccessCloudResourceCloud struct {
 	CloudName       types.String `tfsdk:"cloud_name"`
 	Users           types.Set    `tfsdk:"users"`
+	GrantToEveryone types.Bool   `tfsdk:"grant_to_everyone"`
 	ServiceAccounts types.Set    `tfsdk:"service_accounts"`
 	Groups          types.Set    `tfsdk:"groups"`
+	Roles           types.Set    `tfsdk:"roles"`
 	Access          types.String `tfsdk:"access"`
+	Reconcile       types.Bool   `tfsdk:"reconcile"`
 
 	// ID required for imports
 	ID types.String `tfsdk:"id"`
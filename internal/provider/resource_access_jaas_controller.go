@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 // Note: the "JAAS controller access resource" is slightly different from the other
@@ -48,7 +50,7 @@ func (j controllerInfo) Save(ctx context.Context, setter Setter, info genericJAA
 
 // ImportHint implements [resourceInfo] and provides a hint to users on the import string format.
 func (j controllerInfo) ImportHint() string {
-	return "jimm:<access-level>"
+	return "jimm:<access-level> or controller:<access-level>"
 }
 
 // TagFromID verifies the id can only be "jimm" and returns a controller tag.
@@ -59,15 +61,33 @@ func (j controllerInfo) TagFromID(id string) (names.Tag, error) {
 	return names.NewControllerTag(id), nil
 }
 
+// ReferenceKind implements [referenceResolver], the controller can be
+// imported with controller:<access-level>.
+func (j controllerInfo) ReferenceKind() string {
+	return "controller"
+}
+
+// ResolveReference implements [referenceResolver], the only controller
+// is jimm.
+func (j controllerInfo) ResolveReference(_ context.Context, _ *juju.Client, reference string) (string, error) {
+	if reference != "" && reference != "jimm" {
+		return "", fmt.Errorf("invalid controller name, got %s, expected jimm", reference)
+	}
+	return "jimm", nil
+}
+
 type jaasAccessControllerResource struct {
 	genericJAASAccessResource
 }
 
 type jaasAccessControllerResourceController struct {
 	Users           types.Set    `tfsdk:"users"`
+	GrantToEveryone types.Bool   `tfsdk:"grant_to_everyone"`
 	ServiceAccounts types.Set    `tfsdk:"service_accounts"`
 	Groups          types.Set    `tfsdk:"groups"`
+	Roles           types.Set    `tfsdk:"roles"`
 	Access          types.String `tfsdk:"access"`
+	Reconcile       types.Bool   `tfsdk:"reconcile"`
 
 	// ID required for imports
 	ID types.String `tfsdk:"id"`
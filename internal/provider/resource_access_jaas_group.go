@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -42,9 +44,12 @@ func (j groupInfo) Info(ctx context.Context, getter Getter, diag *diag.Diagnosti
 	accessGroup := genericJAASAccessData{
 		ID:              groupAccess.ID,
 		Users:           groupAccess.Users,
+		GrantToEveryone: groupAccess.GrantToEveryone,
 		Groups:          groupAccess.Groups,
+		Roles:           groupAccess.Roles,
 		ServiceAccounts: groupAccess.ServiceAccounts,
 		Access:          groupAccess.Access,
+		Reconcile:       groupAccess.Reconcile,
 	}
 	// When importing, the group name will be empty
 	var tag names.Tag
@@ -60,16 +65,19 @@ func (j groupInfo) Save(ctx context.Context, setter Setter, info genericJAASAcce
 		GroupID:         basetypes.NewStringValue(tag.Id()),
 		ID:              info.ID,
 		Users:           info.Users,
+		GrantToEveryone: info.GrantToEveryone,
 		Groups:          info.Groups,
+		Roles:           info.Roles,
 		ServiceAccounts: info.ServiceAccounts,
 		Access:          info.Access,
+		Reconcile:       info.Reconcile,
 	}
 	return setter.Set(ctx, groupAccess)
 }
 
 // ImportHint implements [resourceInfo] and provides a hint to users on the import string format.
 func (j groupInfo) ImportHint() string {
-	return "<group-uuid>:<access-level>"
+	return "<group-uuid>:<access-level> or group:<group-name>:<access-level>"
 }
 
 // TagFromID validates the id to be a valid group ID
@@ -81,6 +89,22 @@ func (j groupInfo) TagFromID(id string) (names.Tag, error) {
 	return jimmnames.NewGroupTag(id), nil
 }
 
+// ReferenceKind implements [referenceResolver], groups can be imported
+// with group:<group-name>:<access-level>.
+func (j groupInfo) ReferenceKind() string {
+	return "group"
+}
+
+// ResolveReference implements [referenceResolver] and returns the UUID of
+// the group with the name of the reference.
+func (j groupInfo) ResolveReference(ctx context.Context, client *juju.Client, reference string) (string, error) {
+	group, err := client.Jaas.ReadGroupByName(ctx, reference)
+	if err != nil {
+		return "", err
+	}
+	return group.UUID, nil
+}
+
 type jaasAccessGroupResource struct {
 	genericJAASAccessResource
 }
@@ -88,9 +112,12 @@ type jaasAccessGroupResource struct {
 type jaasAccessModelResourceGroup struct {
 	GroupID         types.String `tfsdk:"group_id"`
 	Users           types.Set    `tfsdk:"users"`
+	GrantToEveryone types.Bool   `tfsdk:"grant_to_everyone"`
 	ServiceAccounts types.Set    `tfsdk:"service_accounts"`
 	Groups          types.Set    `tfsdk:"groups"`
+	Roles           types.Set    `tfsdk:"roles"`
 	Access          types.String `tfsdk:"access"`
+	Reconcile       types.Bool   `tfsdk:"reconcile"`
 
 	// ID required for imports
 	ID types.String `tfsdk:"id"`
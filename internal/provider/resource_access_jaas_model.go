@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -41,9 +45,12 @@ func (j modelInfo) Info(ctx context.Context, getter Getter, diag *diag.Diagnosti
 	accessModel := genericJAASAccessData{
 		ID:              modelAccess.ID,
 		Users:           modelAccess.Users,
+		GrantToEveryone: modelAccess.GrantToEveryone,
 		Groups:          modelAccess.Groups,
+		Roles:           modelAccess.Roles,
 		ServiceAccounts: modelAccess.ServiceAccounts,
 		Access:          modelAccess.Access,
+		Reconcile:       modelAccess.Reconcile,
 	}
 	return accessModel, names.NewModelTag(modelAccess.ModelUUID.ValueString())
 }
@@ -54,16 +61,19 @@ func (j modelInfo) Save(ctx context.Context, setter Setter, info genericJAASAcce
 		ModelUUID:       basetypes.NewStringValue(tag.Id()),
 		ID:              info.ID,
 		Users:           info.Users,
+		GrantToEveryone: info.GrantToEveryone,
 		Groups:          info.Groups,
+		Roles:           info.Roles,
 		ServiceAccounts: info.ServiceAccounts,
 		Access:          info.Access,
+		Reconcile:       info.Reconcile,
 	}
 	return setter.Set(ctx, modelAccess)
 }
 
 // ImportHint implements [resourceInfo] and provides a hint to users on the import string format.
 func (j modelInfo) ImportHint() string {
-	return "<model-UUID>:<access-level>"
+	return "<model-UUID>:<access-level> or model:<owner>/<model-name>:<access-level>"
 }
 
 // TagFromID validates the id to be a valid model ID
@@ -75,6 +85,22 @@ func (j modelInfo) TagFromID(id string) (names.Tag, error) {
 	return names.NewModelTag(id), nil
 }
 
+// ReferenceKind implements [referenceResolver], models can be imported
+// with model:<owner>/<model-name>:<access-level>.
+func (j modelInfo) ReferenceKind() string {
+	return "model"
+}
+
+// ResolveReference implements [referenceResolver] and returns the UUID of
+// the model with the owner and name of the reference.
+func (j modelInfo) ResolveReference(ctx context.Context, client *juju.Client, reference string) (string, error) {
+	owner, name, ok := strings.Cut(reference, "/")
+	if !ok || owner == "" || name == "" {
+		return "", fmt.Errorf("expected <owner>/<model-name>, got %q", reference)
+	}
+	return client.Models.ReadModelUUID(ctx, owner, name)
+}
+
 type jaasAccessModelResource struct {
 	genericJAASAccessResource
 }
@@ -82,9 +108,12 @@ type jaasAccessModelResource struct {
 type jaasAccessModelResourceModel struct {
 	ModelUUID       types.String `tfsdk:"model_uuid"`
 	Users           types.Set    `tfsdk:"users"`
+	GrantToEveryone types.Bool   `tfsdk:"grant_to_everyone"`
 	ServiceAccounts types.Set    `tfsdk:"service_accounts"`
 	Groups          types.Set    `tfsdk:"groups"`
+	Roles           types.Set    `tfsdk:"roles"`
 	Access          types.String `tfsdk:"access"`
+	Reconcile       types.Bool   `tfsdk:"reconcile"`
 
 	// ID required for imports
 	ID types.String `tfsdk:"id"`
@@ -87,7 +87,7 @@ func TestAcc_ResourceJaasAccessModel(t *testing.T) {
 }
 
 // TestAcc_ResourceJaasAccessModelAllTypes tests that all types
-// i.e. users, groups and services accounts can successfully
+// i.e. users, groups, roles and services accounts can successfully
 // receive access to a model.
 func TestAcc_ResourceJaasAccessModelAllTypes(t *testing.T) {
 	OnlyTestAgainstJAAS(t)
@@ -95,18 +95,22 @@ func TestAcc_ResourceJaasAccessModelAllTypes(t *testing.T) {
 	// Resource names
 	modelResourceName := "juju_jaas_access_model.test"
 	groupResourcename := "juju_jaas_group.test"
+	roleResourcename := "juju_jaas_role.test"
 	modelName := acctest.RandomWithPrefix("tf-jaas-access-model")
 	access := "writer"
 	user := "foo@domain.com"
 	svcAcc := "test"
 	svcAccWithDomain := svcAcc + "@serviceaccount"
 	group := acctest.RandomWithPrefix("myGroup")
+	role := acctest.RandomWithPrefix("myRole")
 
 	// Objects for checking access
 	newModelTagF := func(s string) string { return names.NewModelTag(s).String() }
 	modelCheck := newCheckAttribute(modelResourceName, "model_uuid", newModelTagF)
 	groupRelationF := func(s string) string { return jimmnames.NewGroupTag(s).String() + "#member" }
 	groupCheck := newCheckAttribute(groupResourcename, "uuid", groupRelationF)
+	roleRelationF := func(s string) string { return roleTagPrefix + s + roleTagRelation }
+	roleCheck := newCheckAttribute(roleResourcename, "uuid", roleRelationF)
 	userTag := names.NewUserTag(user).String()
 	svcAccTag := names.NewUserTag(svcAccWithDomain).String()
 
@@ -117,16 +121,19 @@ func TestAcc_ResourceJaasAccessModelAllTypes(t *testing.T) {
 			testAccCheckJaasResourceAccess(access, &userTag, modelCheck.tag, false),
 			testAccCheckJaasResourceAccess(access, &svcAccTag, modelCheck.tag, false),
 			testAccCheckJaasResourceAccess(access, groupCheck.tag, modelCheck.tag, false),
+			testAccCheckJaasResourceAccess(access, roleCheck.tag, modelCheck.tag, false),
 		),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccResourceJaasAccessModelAllTypes(modelName, access, user, group, svcAcc),
+				Config: testAccResourceJaasAccessModelAllTypes(modelName, access, user, group, role, svcAcc),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAttributeNotEmpty(modelCheck),
 					testAccCheckAttributeNotEmpty(groupCheck),
+					testAccCheckAttributeNotEmpty(roleCheck),
 					testAccCheckJaasResourceAccess(access, &userTag, modelCheck.tag, true),
 					testAccCheckJaasResourceAccess(access, &svcAccTag, modelCheck.tag, true),
 					testAccCheckJaasResourceAccess(access, groupCheck.tag, modelCheck.tag, true),
+					testAccCheckJaasResourceAccess(access, roleCheck.tag, modelCheck.tag, true),
 					resource.TestCheckResourceAttr(modelResourceName, "access", access),
 					resource.TestCheckTypeSetElemAttr(modelResourceName, "users.*", user),
 					resource.TestCheckResourceAttr(modelResourceName, "users.#", "1"),
@@ -135,6 +142,10 @@ func TestAcc_ResourceJaasAccessModelAllTypes(t *testing.T) {
 						return resource.TestCheckTypeSetElemAttr(modelResourceName, "groups.*", *groupCheck.resourceID)(s)
 					},
 					resource.TestCheckResourceAttr(modelResourceName, "groups.#", "1"),
+					func(s *terraform.State) error {
+						return resource.TestCheckTypeSetElemAttr(modelResourceName, "roles.*", *roleCheck.resourceID)(s)
+					},
+					resource.TestCheckResourceAttr(modelResourceName, "roles.#", "1"),
 					resource.TestCheckTypeSetElemAttr(modelResourceName, "service_accounts.*", svcAcc),
 					resource.TestCheckResourceAttr(modelResourceName, "service_accounts.#", "1"),
 				),
@@ -300,6 +311,93 @@ func TestAcc_ResourceJaasAccessModelServiceAccountAndUsers(t *testing.T) {
 	})
 }
 
+// TestAcc_ResourceJaasAccessModelNoReconcile tests that relations are
+// neither created nor removed when reconcile is false.
+func TestAcc_ResourceJaasAccessModelNoReconcile(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	// Resource names
+	resourceName := "juju_jaas_access_model.test"
+	modelName := acctest.RandomWithPrefix("tf-jaas-access-model")
+	access := "writer"
+	user := "foo@domain.com"
+
+	// Objects for checking access
+	newModelTagF := func(s string) string { return names.NewModelTag(s).String() }
+	modelCheck := newCheckAttribute(resourceName, "model_uuid", newModelTagF)
+	userTag := names.NewUserTag(user).String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckJaasResourceAccess(access, &userTag, modelCheck.tag, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJaasAccessModelNoReconcile(modelName, access, user),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeNotEmpty(modelCheck),
+					testAccCheckJaasResourceAccess(access, &userTag, modelCheck.tag, false),
+					resource.TestCheckResourceAttr(resourceName, "reconcile", "false"),
+					// The user missing in JAAS is kept in the state, so
+					// the plan stays empty.
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAcc_ResourceJaasAccessModelGrantToEveryone tests that grant_to_everyone
+// manages the everyone@external relation and that the relation is kept when
+// it moves to the users set.
+func TestAcc_ResourceJaasAccessModelGrantToEveryone(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	// Resource names
+	resourceName := "juju_jaas_access_model.test"
+	modelName := acctest.RandomWithPrefix("tf-jaas-access-model")
+	access := "reader"
+	user := "foo@domain.com"
+
+	// Objects for checking access
+	newModelTagF := func(s string) string { return names.NewModelTag(s).String() }
+	modelCheck := newCheckAttribute(resourceName, "model_uuid", newModelTagF)
+	everyoneTag := names.NewUserTag(everyoneUser).String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckJaasResourceAccess(access, &everyoneTag, modelCheck.tag, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceJaasAccessModelGrantToEveryone(modelName, access, everyoneUser),
+				ExpectError: regexp.MustCompile(`cannot be set in users when grant_to_everyone is true`),
+			},
+			{
+				Config: testAccResourceJaasAccessModelGrantToEveryone(modelName, access, user),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeNotEmpty(modelCheck),
+					testAccCheckJaasResourceAccess(access, &everyoneTag, modelCheck.tag, true),
+					resource.TestCheckResourceAttr(resourceName, "grant_to_everyone", "true"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceJaasAccessModelTwoUsers(modelName, access, user, everyoneUser),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJaasResourceAccess(access, &everyoneTag, modelCheck.tag, true),
+					resource.TestCheckResourceAttr(resourceName, "grant_to_everyone", "false"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", everyoneUser),
+				),
+			},
+		},
+	})
+}
+
 // TODO(Kian): Add the test below after a stable release of the provider that includes jaas resources.
 
 // func TestAcc_ResourceJaasAccessModel_UpgradeProvider(t *testing.T) {
@@ -340,6 +438,68 @@ func TestAcc_ResourceJaasAccessModelServiceAccountAndUsers(t *testing.T) {
 // 	})
 // }
 
+// TestAcc_ResourceJaasAccessModelAddGroupInSameApply verifies a group
+// created in the same apply as the access is updated can be added, its
+// UUID being unknown until the group is created.
+func TestAcc_ResourceJaasAccessModelAddGroupInSameApply(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+
+	// Resource names
+	modelResourceName := "juju_jaas_access_model.test"
+	groupResourceName := "juju_jaas_group.test"
+	modelName := acctest.RandomWithPrefix("tf-jaas-access-model")
+	access := "writer"
+	user := "foo@domain.com"
+	group := acctest.RandomWithPrefix("myGroup")
+
+	// Objects for checking access
+	newModelTagF := func(s string) string { return names.NewModelTag(s).String() }
+	modelCheck := newCheckAttribute(modelResourceName, "model_uuid", newModelTagF)
+	groupRelationF := func(s string) string { return jimmnames.NewGroupTag(s).String() + "#member" }
+	groupCheck := newCheckAttribute(groupResourceName, "uuid", groupRelationF)
+	userTag := names.NewUserTag(user).String()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckJaasResourceAccess(access, &userTag, modelCheck.tag, false),
+			testAccCheckJaasResourceAccess(access, groupCheck.tag, modelCheck.tag, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJaasAccessModelOneUser(modelName, access, user),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeNotEmpty(modelCheck),
+					testAccCheckJaasResourceAccess(access, &userTag, modelCheck.tag, true),
+				),
+			},
+			{
+				Config: testAccResourceJaasAccessModelUserAndGroup(modelName, access, user, group),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(groupResourceName, plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction(modelResourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeNotEmpty(groupCheck),
+					testAccCheckJaasResourceAccess(access, &userTag, modelCheck.tag, true),
+					testAccCheckJaasResourceAccess(access, groupCheck.tag, modelCheck.tag, true),
+					// Wrap this check so that the pointer has deferred evaluation.
+					func(s *terraform.State) error {
+						return resource.TestCheckTypeSetElemAttr(modelResourceName, "groups.*", *groupCheck.resourceID)(s)
+					},
+					resource.TestCheckResourceAttr(modelResourceName, "groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceJaasAccessModelTwoUsers(modelName, access, userOne, userTwo string) string {
 	return internaltesting.GetStringFromTemplateWithData(
 		"testAccResourceJaasAccessModelTwoUsers",
@@ -381,7 +541,33 @@ resource "juju_jaas_access_model" "test" {
 		})
 }
 
-func testAccResourceJaasAccessModelAllTypes(modelName, access, user, group, svcAcc string) string {
+func testAccResourceJaasAccessModelUserAndGroup(modelName, access, user, group string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJaasAccessModelUserAndGroup",
+		`
+resource "juju_model" "test-model" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_jaas_group" "test" {
+  name = "{{ .Group }}"
+}
+
+resource "juju_jaas_access_model" "test" {
+  model_uuid          = juju_model.test-model.id
+  access              = "{{.Access}}"
+  users               = ["{{.User}}"]
+  groups              = [juju_jaas_group.test.uuid]
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+			"Access":    access,
+			"User":      user,
+			"Group":     group,
+		})
+}
+
+func testAccResourceJaasAccessModelAllTypes(modelName, access, user, group, role, svcAcc string) string {
 	return internaltesting.GetStringFromTemplateWithData(
 		"testAccResourceJaasAccessModelTwoUsers",
 		`
@@ -393,17 +579,23 @@ resource "juju_jaas_group" "test" {
   name = "{{ .Group }}"
 }
 
+resource "juju_jaas_role" "test" {
+  name = "{{ .Role }}"
+}
+
 resource "juju_jaas_access_model" "test" {
   model_uuid          = juju_model.test-model.id
   access              = "{{.Access}}"
   users               = ["{{.User}}"]
   groups              = [juju_jaas_group.test.uuid]
+  roles               = [juju_jaas_role.test.uuid]
   service_accounts    = ["{{.SvcAcc}}"]
 }
 `, internaltesting.TemplateData{
 			"ModelName": modelName,
 			"Access":    access,
 			"Group":     group,
+			"Role":      role,
 			"User":      user,
 			"SvcAcc":    svcAcc,
 		})
@@ -451,3 +643,45 @@ resource "juju_jaas_access_model" "test" {
 			"SvcAccTwo": svcAccTwo,
 		})
 }
+
+func testAccResourceJaasAccessModelGrantToEveryone(modelName, access, user string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJaasAccessModelGrantToEveryone",
+		`
+resource "juju_model" "test-model" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_jaas_access_model" "test" {
+  model_uuid          = juju_model.test-model.id
+  access              = "{{.Access}}"
+  users               = ["{{.User}}"]
+  grant_to_everyone   = true
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+			"Access":    access,
+			"User":      user,
+		})
+}
+
+func testAccResourceJaasAccessModelNoReconcile(modelName, access, user string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJaasAccessModelNoReconcile",
+		`
+resource "juju_model" "test-model" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_jaas_access_model" "test" {
+  model_uuid          = juju_model.test-model.id
+  access              = "{{.Access}}"
+  users               = ["{{.User}}"]
+  reconcile           = false
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+			"Access":    access,
+			"User":      user,
+		})
+}
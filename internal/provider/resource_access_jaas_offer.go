@@ -41,9 +41,12 @@ func (j offerInfo) Info(ctx context.Context, getter Getter, diag *diag.Diagnosti
 	genericInfo := genericJAASAccessData{
 		ID:              offerResource.ID,
 		Users:           offerResource.Users,
+		GrantToEveryone: offerResource.GrantToEveryone,
 		Groups:          offerResource.Groups,
+		Roles:           offerResource.Roles,
 		ServiceAccounts: offerResource.ServiceAccounts,
 		Access:          offerResource.Access,
+		Reconcile:       offerResource.Reconcile,
 	}
 	// When importing, the offer url will be empty
 	var tag names.Tag
@@ -59,9 +62,12 @@ func (j offerInfo) Save(ctx context.Context, setter Setter, info genericJAASAcce
 		OfferUrl:        basetypes.NewStringValue(tag.Id()),
 		ID:              info.ID,
 		Users:           info.Users,
+		GrantToEveryone: info.GrantToEveryone,
 		Groups:          info.Groups,
+		Roles:           info.Roles,
 		ServiceAccounts: info.ServiceAccounts,
 		Access:          info.Access,
+		Reconcile:       info.Reconcile,
 	}
 	return setter.Set(ctx, offerAccess)
 }
@@ -84,9 +90,12 @@ type jaasAccessOfferResource struct {
 type jaasAccessOfferResourceOffer struct {
 	OfferUrl        types.String `tfsdk:"offer_url"`
 	Users           types.Set    `tfsdk:"users"`
+	GrantToEveryone types.Bool   `tfsdk:"grant_to_everyone"`
 	ServiceAccounts types.Set    `tfsdk:"service_accounts"`
 	Groups          types.Set    `tfsdk:"groups"`
+	Roles           types.Set    `tfsdk:"roles"`
 	Access          types.String `tfsdk:"access"`
+	Reconcile       types.Bool   `tfsdk:"reconcile"`
 
 	// ID required for imports
 	ID types.String `tfsdk:"id"`
@@ -43,9 +43,12 @@ func (j serviceAccountInfo) Info(ctx context.Context, getter Getter, diag *diag.
 	accessServiceAccount := genericJAASAccessData{
 		ID:              serviceAccountAccess.ID,
 		Users:           serviceAccountAccess.Users,
+		GrantToEveryone: serviceAccountAccess.GrantToEveryone,
 		Groups:          serviceAccountAccess.Groups,
+		Roles:           serviceAccountAccess.Roles,
 		ServiceAccounts: serviceAccountAccess.ServiceAccounts,
 		Access:          serviceAccountAccess.Access,
+		Reconcile:       serviceAccountAccess.Reconcile,
 	}
 	// When importing, the serviceAccount name will be empty
 	var tag names.Tag
@@ -68,9 +71,12 @@ func (j serviceAccountInfo) Save(ctx context.Context, setter Setter, info generi
 		ServiceAccountID: basetypes.NewStringValue(svcAccID),
 		ID:               info.ID,
 		Users:            info.Users,
+		GrantToEveryone:  info.GrantToEveryone,
 		Groups:           info.Groups,
+		Roles:            info.Roles,
 		ServiceAccounts:  info.ServiceAccounts,
 		Access:           info.Access,
+		Reconcile:        info.Reconcile,
 	}
 	return setter.Set(ctx, serviceAccountAccess)
 }
@@ -80,13 +86,14 @@ func (j serviceAccountInfo) ImportHint() string {
 	return "<service-account-id>:<access-level>"
 }
 
-// TagFromID validates the id to be a valid service account ID
-// and returns a service account tag.
+// TagFromID validates the id to be a valid service account ID, with or
+// without the @serviceaccount suffix, and returns a service account tag.
 func (j serviceAccountInfo) TagFromID(id string) (names.Tag, error) {
-	if !jimmnames.IsValidServiceAccountId(id) {
-		return nil, errors.New("invalid model ID")
+	svcAccID, err := jimmnames.EnsureValidServiceAccountId(id)
+	if err != nil {
+		return nil, errors.New("invalid service account ID")
 	}
-	return jimmnames.NewServiceAccountTag(id), nil
+	return jimmnames.NewServiceAccountTag(svcAccID), nil
 }
 
 type jaasAccessServiceAccountResource struct {
@@ -96,9 +103,12 @@ type jaasAccessServiceAccountResource struct {
 type jaasAccessServiceAccountResourceServiceAccount struct {
 	ServiceAccountID types.String `tfsdk:"service_account_id"`
 	Users            types.Set    `tfsdk:"users"`
+	GrantToEveryone  types.Bool   `tfsdk:"grant_to_everyone"`
 	ServiceAccounts  types.Set    `tfsdk:"service_accounts"`
 	Groups           types.Set    `tfsdk:"groups"`
+	Roles            types.Set    `tfsdk:"roles"`
 	Access           types.String `tfsdk:"access"`
+	Reconcile        types.Bool   `tfsdk:"reconcile"`
 
 	// ID required for imports
 	ID types.String `tfsdk:"id"`
@@ -86,6 +86,21 @@ func TestAcc_ResourceJaasAccessServiceAccount(t *testing.T) {
 	})
 }
 
+func TestServiceAccountInfoTagFromID(t *testing.T) {
+	for _, id := range []string{"foo", "foo@serviceaccount"} {
+		tag, err := serviceAccountInfo{}.TagFromID(id)
+		if err != nil {
+			t.Fatalf("TagFromID(%q): %v", id, err)
+		}
+		if expected := jimmnames.NewServiceAccountTag("foo@serviceaccount"); tag != expected {
+			t.Errorf("TagFromID(%q): expected %v, got %v", id, expected, tag)
+		}
+	}
+	if _, err := (serviceAccountInfo{}).TagFromID("foo@domain.com"); err == nil {
+		t.Error("expected an error for a user ID")
+	}
+}
+
 func testAccResourceJaasAccessServiceAccount(access, user, group, svcAcc string) string {
 	return internaltesting.GetStringFromTemplateWithData(
 		"testAccResourceJaasAccessServiceAccount",
@@ -6,11 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -113,7 +112,7 @@ func (a *accessModelResource) Configure(ctx context.Context, req resource.Config
 	}
 	a.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	a.subCtx = tflog.NewSubsystem(ctx, LogResourceAccessModel)
+	a.subCtx = client.NewLogSubsystem(ctx, LogResourceAccessModel)
 }
 
 func (a *accessModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -141,7 +140,7 @@ func (a *accessModelResource) Create(ctx context.Context, req resource.CreateReq
 	accessStr := plan.Access.ValueString()
 	// Call Models.GrantModel
 	for _, user := range users {
-		err := a.client.Models.GrantModel(juju.GrantModelInput{
+		err := a.client.Models.GrantModel(ctx, juju.GrantModelInput{
 			User:      user,
 			Access:    accessStr,
 			ModelName: modelNameStr,
@@ -151,7 +150,7 @@ func (a *accessModelResource) Create(ctx context.Context, req resource.CreateReq
 			return
 		}
 	}
-	plan.ID = types.StringValue(newAccessModelIDFrom(modelNameStr, accessStr, users))
+	plan.ID = types.StringValue(ids.AccessModelID{Model: modelNameStr, Access: accessStr, Users: users}.String())
 
 	// Set the plan onto the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -176,8 +175,11 @@ func (a *accessModelResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	response, err := a.client.Users.ModelUserInfo(modelName)
+	response, err := a.client.Users.ModelUserInfo(ctx, modelName)
 	if err != nil {
+		if keepStateDuringControllerUpgrade(a.client, err, &resp.Diagnostics, "access model") {
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access model resource, got error: %s", err))
 		return
 	}
@@ -280,7 +282,7 @@ func (a *accessModelResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	err := a.client.Models.UpdateAccessModel(juju.UpdateAccessModelInput{
+	err := a.client.Models.UpdateAccessModel(ctx, juju.UpdateAccessModelInput{
 		ModelName: modelName,
 		OldAccess: oldAccess,
 		Grant:     addedUserList,
@@ -292,7 +294,7 @@ func (a *accessModelResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	a.trace(fmt.Sprintf("updated access model resource for model %q", modelName))
 
-	plan.ID = types.StringValue(newAccessModelIDFrom(modelName, access, planUsers))
+	plan.ID = types.StringValue(ids.AccessModelID{Model: modelName, Access: access, Users: planUsers}.String())
 
 	// Set the plan onto the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -320,7 +322,7 @@ func (a *accessModelResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := a.client.Models.DestroyAccessModel(juju.DestroyAccessModelInput{
+	err := a.client.Models.DestroyAccessModel(ctx, juju.DestroyAccessModelInput{
 		ModelName: plan.Model.ValueString(),
 		Revoke:    stateUsers,
 		Access:    plan.Access.ValueString(),
@@ -365,16 +367,7 @@ func getAddedUsers(oldUsers, newUsers []string) []string {
 }
 
 func (a *accessModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	IDstr := req.ID
-	if len(strings.Split(IDstr, ":")) != 3 {
-		resp.Diagnostics.AddError(
-			"ImportState Failure",
-			fmt.Sprintf("Malformed AccessModel ID %q, "+
-				"please use format '<modelname>:<access>:<user1,user1>'", IDstr),
-		)
-		return
-	}
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughValidID(ctx, ids.ParseAccessModelImportID, req, resp)
 }
 
 func (a *accessModelResource) trace(msg string, additionalFields ...map[string]interface{}) {
@@ -388,32 +381,28 @@ func (a *accessModelResource) trace(msg string, additionalFields ...map[string]i
 	tflog.SubsystemTrace(a.subCtx, LogResourceAccessModel, msg, additionalFields...)
 }
 
-func newAccessModelIDFrom(modelNameStr string, accessStr string, users []string) string {
-	return fmt.Sprintf("%s:%s:%s", modelNameStr, accessStr, strings.Join(users, ","))
-}
-
+// retrieveAccessModelDataFromID returns the model name, access and users
+// of the resource, falling back to the users in state for IDs which do
+// not contain them.
 func retrieveAccessModelDataFromID(ctx context.Context, ID types.String, users types.List, diag *diag.Diagnostics) (string, string,
 	[]string) {
-	resID := strings.Split(ID.ValueString(), ":")
-	if len(resID) < 2 {
-		diag.AddError("Malformed ID", fmt.Sprintf("AccessModel ID %q is malformed, "+
-			"please use the format '<modelname>:<access>:<user1,user1>'", resID))
+	id, err := ids.ParseAccessModelID(ID.ValueString())
+	if err != nil {
+		addMalformedIDError(diag, err)
 		return "", "", nil
 	}
-	stateUsers := []string{}
-	if len(resID) == 3 {
-		stateUsers = strings.Split(resID[2], ",")
-	} else {
+	stateUsers := id.Users
+	if stateUsers == nil {
 		// In 0.8.0 sdk2 version of the provider, the implementation of the access model
 		// resource had a bug where it didn't contain the users. So we accommodate upgrades
 		// from that by attempting to get the users from the state if the ID doesn't contain
 		// any users (which happens only when coming from the previous version because the
 		// ID is a computed field).
+		stateUsers = []string{}
 		diag.Append(users.ElementsAs(ctx, &stateUsers, false)...)
 		if diag.HasError() {
 			return "", "", nil
 		}
 	}
-
-	return resID[0], resID[1], stateUsers
+	return id.Model, id.Access, stateUsers
 }
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/juju/collections/set"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -56,18 +56,18 @@ func (s *accessSecretResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 	// model:name
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	importID, err := ids.ParseSecretID(req.ID)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: <modelname>:<secretname>. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: <modelname>:<secretname>. %s", err),
 		)
 		return
 	}
-	modelName := parts[0]
-	secretName := parts[1]
+	modelName := importID.Model
+	secretName := importID.Secret
 
-	readSecretOutput, err := s.client.Secrets.ReadSecret(&juju.ReadSecretInput{
+	readSecretOutput, err := s.client.Secrets.ReadSecret(ctx, &juju.ReadSecretInput{
 		ModelName: modelName,
 		Name:      &secretName,
 	})
@@ -80,7 +80,7 @@ func (s *accessSecretResource) ImportState(ctx context.Context, req resource.Imp
 	state := accessSecretResourceModel{
 		Model:    types.StringValue(modelName),
 		SecretId: types.StringValue(readSecretOutput.SecretId),
-		ID:       types.StringValue(newSecretID(modelName, readSecretOutput.SecretId)),
+		ID:       types.StringValue(ids.SecretID{Model: modelName, Secret: readSecretOutput.SecretId}.String()),
 	}
 
 	// Save the secret details into the Terraform state
@@ -153,7 +153,7 @@ func (s *accessSecretResource) Configure(ctx context.Context, req resource.Confi
 	}
 	s.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	s.subCtx = tflog.NewSubsystem(ctx, LogResourceAccessSecret)
+	s.subCtx = client.NewLogSubsystem(ctx, LogResourceAccessSecret)
 }
 
 // Create is called when the resource is being created.
@@ -175,7 +175,7 @@ func (s *accessSecretResource) Create(ctx context.Context, req resource.CreateRe
 	applications := make([]string, len(plan.Applications.Elements()))
 	resp.Diagnostics.Append(plan.Applications.ElementsAs(ctx, &applications, false)...)
 
-	err := s.client.Secrets.UpdateAccessSecret(&juju.GrantRevokeAccessSecretInput{
+	err := s.client.Secrets.UpdateAccessSecret(ctx, &juju.GrantRevokeAccessSecretInput{
 		ModelName:    plan.Model.ValueString(),
 		SecretId:     plan.SecretId.ValueString(),
 		Applications: applications,
@@ -186,7 +186,7 @@ func (s *accessSecretResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Save plan into Terraform state
-	plan.ID = types.StringValue(newSecretID(plan.Model.ValueString(), plan.SecretId.ValueString()))
+	plan.ID = types.StringValue(ids.SecretID{Model: plan.Model.ValueString(), Secret: plan.SecretId.ValueString()}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
 	s.trace(fmt.Sprintf("grant secret access to %s", plan.SecretId))
@@ -208,11 +208,14 @@ func (s *accessSecretResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	readSecretOutput, err := s.client.Secrets.ReadSecret(&juju.ReadSecretInput{
+	readSecretOutput, err := s.client.Secrets.ReadSecret(ctx, &juju.ReadSecretInput{
 		SecretId:  state.SecretId.ValueString(),
 		ModelName: state.Model.ValueString(),
 	})
 	if err != nil {
+		if keepStateDuringControllerUpgrade(s.client, err, &resp.Diagnostics, "access secret") {
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
 		return
 	}
@@ -225,7 +228,7 @@ func (s *accessSecretResource) Read(ctx context.Context, req resource.ReadReques
 	}
 	state.Applications = secretApplications
 
-	state.ID = types.StringValue(newSecretID(state.Model.ValueString(), readSecretOutput.SecretId))
+	state.ID = types.StringValue(ids.SecretID{Model: state.Model.ValueString(), Secret: readSecretOutput.SecretId}.String())
 
 	// Save state into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -295,7 +298,7 @@ func (s *accessSecretResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// revoke access to applications that are in the state but not in the plan
 	if !applicationsToGrant.IsEmpty() {
-		err := s.client.Secrets.UpdateAccessSecret(&juju.GrantRevokeAccessSecretInput{
+		err := s.client.Secrets.UpdateAccessSecret(ctx, &juju.GrantRevokeAccessSecretInput{
 			ModelName:    state.Model.ValueString(),
 			SecretId:     state.SecretId.ValueString(),
 			Applications: applicationsToGrant.Values(),
@@ -308,7 +311,7 @@ func (s *accessSecretResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// grant access to applications that are in the plan but not in the state
 	if !applicationsToRevoke.IsEmpty() {
-		err := s.client.Secrets.UpdateAccessSecret(&juju.GrantRevokeAccessSecretInput{
+		err := s.client.Secrets.UpdateAccessSecret(ctx, &juju.GrantRevokeAccessSecretInput{
 			ModelName:    state.Model.ValueString(),
 			SecretId:     state.SecretId.ValueString(),
 			Applications: applicationsToRevoke.Values(),
@@ -347,7 +350,7 @@ func (s *accessSecretResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := s.client.Secrets.UpdateAccessSecret(&juju.GrantRevokeAccessSecretInput{
+	err := s.client.Secrets.UpdateAccessSecret(ctx, &juju.GrantRevokeAccessSecretInput{
 		ModelName:    state.Model.ValueString(),
 		SecretId:     state.SecretId.ValueString(),
 		Applications: applications,
@@ -0,0 +1,375 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/ids"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &annotationResource{}
+var _ resource.ResourceWithConfigure = &annotationResource{}
+var _ resource.ResourceWithImportState = &annotationResource{}
+
+func NewAnnotationResource() resource.Resource {
+	return &annotationResource{}
+}
+
+type annotationResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type annotationResourceModel struct {
+	ModelName   types.String `tfsdk:"model"`
+	Entity      types.String `tfsdk:"entity"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Annotations can be imported with the name of the model and the tag of
+// the annotated entity: <model>:<entity tag>. All the annotations of the
+// entity are then managed by the resource.
+func (r *annotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughValidID(ctx, ids.ParseAnnotationID, req, resp)
+}
+
+func (r *annotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceAnnotation)
+}
+
+func (r *annotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_annotation"
+}
+
+func (r *annotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents annotations of a Juju entity, such as a model, an application, a unit or a machine. " +
+			"Only the annotations set by the resource are managed, other annotations of the entity are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the annotated entity. Changing this value will cause the annotations to be removed and set again by terraform.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity": schema.StringAttribute{
+				Description: "The tag of the annotated entity, e.g. `application-postgresql`, `unit-postgresql-0`, `machine-0` or " +
+					"`model-<model uuid>` for the model itself. The controller rejects entities which do not support annotations. " +
+					"Changing this value will cause the annotations to be removed and set again by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					StringIsEntityTagValidator{},
+				},
+			},
+			"annotations": schema.MapAttribute{
+				Description: "The annotations of the entity. Annotations changed outside of terraform are reported as drift and reset on the next apply.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					// An empty value removes an annotation in Juju.
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *annotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "annotation", "create")
+		return
+	}
+
+	var plan annotationResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	annotations := make(map[string]string)
+	resp.Diagnostics.Append(plan.Annotations.ElementsAs(ctx, &annotations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := plan.ModelName.ValueString()
+	entity := plan.Entity.ValueString()
+	if err := r.client.Annotations.SetAnnotations(ctx, &juju.SetAnnotationsInput{
+		ModelName:   modelName,
+		Entity:      entity,
+		Annotations: annotations,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set annotations of %q, got error: %s", entity, err))
+		return
+	}
+	r.trace(fmt.Sprintf("set annotations of %q", entity))
+
+	plan.ID = types.StringValue(ids.AnnotationID{Model: modelName, Entity: entity}.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *annotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "annotation", "read")
+		return
+	}
+
+	var state annotationResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	annotationID, err := ids.ParseAnnotationID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+
+	response, err := r.client.Annotations.ReadAnnotations(ctx, &juju.ReadAnnotationsInput{
+		ModelName: annotationID.Model,
+		Entity:    annotationID.Entity,
+	})
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "annotation") {
+			return
+		}
+		resp.Diagnostics.Append(handleAnnotationEntityNotFoundError(ctx, err, &resp.State)...)
+		return
+	}
+	r.trace(fmt.Sprintf("read annotations of %q", state.ID.ValueString()))
+
+	var managed map[string]string
+	if !state.Annotations.IsNull() {
+		managed = make(map[string]string)
+		resp.Diagnostics.Append(state.Annotations.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	annotations, dErr := types.MapValueFrom(ctx, types.StringType, managedAnnotations(response.Annotations, managed))
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ModelName = types.StringValue(annotationID.Model)
+	state.Entity = types.StringValue(annotationID.Entity)
+	state.Annotations = annotations
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *annotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "annotation", "update")
+		return
+	}
+
+	var plan, state annotationResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read Terraform configuration from the request into the plan model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := make(map[string]string)
+	resp.Diagnostics.Append(state.Annotations.ElementsAs(ctx, &current, false)...)
+	annotations := make(map[string]string)
+	resp.Diagnostics.Append(plan.Annotations.ElementsAs(ctx, &annotations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Annotations removed from the plan are removed from the entity.
+	for key := range current {
+		if _, ok := annotations[key]; !ok {
+			annotations[key] = ""
+		}
+	}
+
+	entity := state.Entity.ValueString()
+	if err := r.client.Annotations.SetAnnotations(ctx, &juju.SetAnnotationsInput{
+		ModelName:   state.ModelName.ValueString(),
+		Entity:      entity,
+		Annotations: annotations,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update annotations of %q, got error: %s", entity, err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated annotations of %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *annotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "annotation", "delete")
+		return
+	}
+
+	var state annotationResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	annotationID, err := ids.ParseAnnotationID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Only the annotations of the resource are removed.
+	annotations := make(map[string]string)
+	resp.Diagnostics.Append(state.Annotations.ElementsAs(ctx, &annotations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key := range annotations {
+		annotations[key] = ""
+	}
+
+	if err := r.client.Annotations.SetAnnotations(ctx, &juju.SetAnnotationsInput{
+		ModelName:   annotationID.Model,
+		Entity:      annotationID.Entity,
+		Annotations: annotations,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove annotations of %q, got error: %s", annotationID.Entity, err))
+		return
+	}
+	r.trace(fmt.Sprintf("removed annotations of %q", state.ID.ValueString()))
+}
+
+// managedAnnotations returns the annotations of an entity which are
+// managed by the resource. All of them are managed when managed is nil,
+// after an import.
+func managedAnnotations(annotations, managed map[string]string) map[string]string {
+	if managed == nil {
+		return annotations
+	}
+	result := make(map[string]string, len(managed))
+	for key := range managed {
+		if value, ok := annotations[key]; ok {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func handleAnnotationEntityNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
+	if errors.As(err, &juju.AnnotationEntityNotFoundError) {
+		// Annotated entity manually removed
+		st.RemoveResource(ctx)
+		return diag.Diagnostics{}
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", err.Error())
+	return diags
+}
+
+func (r *annotationResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceAnnotation, msg, additionalFields...)
+}
+
+// readDescription returns the description annotation of an entity, as
+// set by the description attribute of models and applications, or null
+// if the entity has none.
+func readDescription(ctx context.Context, client *juju.Client, modelName, entity string) (types.String, error) {
+	response, err := client.Annotations.ReadAnnotations(ctx, &juju.ReadAnnotationsInput{
+		ModelName: modelName,
+		Entity:    entity,
+	})
+	if err != nil {
+		return types.StringNull(), err
+	}
+	if description := response.Annotations[juju.DescriptionAnnotation]; description != "" {
+		return types.StringValue(description), nil
+	}
+	return types.StringNull(), nil
+}
+
+// setDescription sets the description annotation of an entity, or
+// removes it if description is null.
+func setDescription(ctx context.Context, client *juju.Client, modelName, entity string, description types.String) error {
+	return client.Annotations.SetAnnotations(ctx, &juju.SetAnnotationsInput{
+		ModelName:   modelName,
+		Entity:      entity,
+		Annotations: map[string]string{juju.DescriptionAnnotation: description.ValueString()},
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_ResourceAnnotation(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-annotation")
+	modelResourceName := "juju_annotation.model"
+	applicationResourceName := "juju_annotation.application"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAnnotation(modelName, `{ owner = "team-a" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelResourceName, "annotations.%", "1"),
+					resource.TestCheckResourceAttr(modelResourceName, "annotations.owner", "team-a"),
+					resource.TestCheckResourceAttr(applicationResourceName, "id", modelName+":application-test-app"),
+					resource.TestCheckResourceAttr(applicationResourceName, "annotations.owner", "team-a"),
+				),
+			},
+			{
+				Config: testAccResourceAnnotation(modelName, `{ owner = "team-b", tier = "backend" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(applicationResourceName, "annotations.%", "2"),
+					resource.TestCheckResourceAttr(applicationResourceName, "annotations.owner", "team-b"),
+					resource.TestCheckResourceAttr(applicationResourceName, "annotations.tier", "backend"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     modelName + ":application-test-app",
+				ResourceName:      applicationResourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceAnnotation(modelName, annotations string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+data "juju_model" "this" {
+  name = juju_model.this.name
+}
+
+resource "juju_application" "this" {
+  name  = "test-app"
+  model = juju_model.this.name
+  charm {
+    name = "juju-qa-test"
+  }
+}
+
+resource "juju_annotation" "model" {
+  model       = juju_model.this.name
+  entity      = "model-${data.juju_model.this.uuid}"
+  annotations = %[2]s
+}
+
+resource "juju_annotation" "application" {
+  model       = juju_model.this.name
+  entity      = "application-${juju_application.this.name}"
+  annotations = %[2]s
+}
+`, modelName, annotations)
+}
+
+func TestManagedAnnotations(t *testing.T) {
+	annotations := map[string]string{"owner": "team-a", "tier": "backend", "other": "value"}
+
+	// After an import, all the annotations are managed.
+	assert.Equal(t, annotations, managedAnnotations(annotations, nil))
+
+	// Otherwise only the annotations of the resource are kept, and those
+	// removed outside of terraform are dropped.
+	assert.Equal(t, map[string]string{"owner": "team-a"},
+		managedAnnotations(annotations, map[string]string{"owner": "team-b", "removed": "value"}))
+}
@@ -5,11 +5,22 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -22,38 +33,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/charm/v12"
 	"github.com/juju/errors"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
 	jujustorage "github.com/juju/juju/storage"
+	"github.com/juju/names/v5"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 const (
+	BaseSelectionKey    = "base_selection"
 	CharmKey            = "charm"
+	CharmPathKey        = "path"
 	CidrsKey            = "cidrs"
 	ConfigKey           = "config"
 	EndpointsKey        = "endpoints"
 	ExposeKey           = "expose"
+	ExposeModeKey       = "mode"
 	SpacesKey           = "spaces"
 	EndpointBindingsKey = "endpoint_bindings"
 	ResourceKey         = "resources"
 	StorageKey          = "storage"
 
+	// uploadedResourceValue is the value in state of a resource pinned
+	// to a revision which has been replaced by an upload outside of
+	// terraform.
+	uploadedResourceValue = "upload"
+
 	resourceKeyMarkdownDescription = `
-Charm resources. Must evaluate to a string. A resource could be a resource revision number from CharmHub or a custom OCI image resource.
+Charm resources. Must evaluate to a string. A resource could be a resource revision number from CharmHub, a custom OCI image resource or the path of a local file.
 Specify a resource other than the default for a charm. Note that not all charms have resources.
 
 Notes:
-* A resource can be specified by a revision number or by URL to a OCI image repository. Resources of type 'file' can only be specified by revision number. Resources of type 'oci-image' can be specified by revision number or URL.
+* A resource can be specified by a revision number, by URL to a OCI image repository or by the path of a local file. Resources of type 'file' can be specified by revision number or path. Resources of type 'oci-image' can be specified by revision number or URL.
+* The resources of a charm deployed from a local path cannot be fetched from CharmHub, they must all be specified by path or URL.
 * A resource can be added or changed at any time. If the charm has resources and None is specified in the plan, Juju will use the resource defined in the charm's specified channel.
 * If a charm is refreshed, by changing the charm revision or channel and if the resource is specified by a revision in the plan, Juju will use the resource defined in the plan.
+* If a charm is refreshed, the resources which are not specified in the plan are updated to the revisions required by the new charm revision or channel, in the same operation as the refresh.
 * Resources specified by URL to an OCI image repository will never be refreshed (upgraded) by juju during a charm refresh unless explicitly changed in the plan.
+* The revisions of the resources in the plan are read back from Juju, so resources changed outside of terraform are reported as drift and reset on the next apply. A resource pinned to a revision which was replaced by an upload, e.g. with ` + "`juju attach-resource`" + `, is reported as ` + "`upload`" + `.
+* If only resources are changed, the charm is not refreshed. Resources specified by URL to an OCI image repository are attached directly, the equivalent of ` + "`juju attach-resource`" + `. The resources to be attached are listed as a warning in the plan.
 `
 )
 
@@ -61,6 +91,7 @@ Notes:
 var _ resource.Resource = &applicationResource{}
 var _ resource.ResourceWithConfigure = &applicationResource{}
 var _ resource.ResourceWithImportState = &applicationResource{}
+var _ resource.ResourceWithModifyPlan = &applicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &applicationResource{}
@@ -76,13 +107,25 @@ type applicationResource struct {
 // applicationResourceModel describes the application data model.
 // tfsdk must match user resource schema attribute names.
 type applicationResourceModel struct {
-	ApplicationName   types.String `tfsdk:"name"`
-	Charm             types.List   `tfsdk:"charm"`
-	Config            types.Map    `tfsdk:"config"`
-	Constraints       types.String `tfsdk:"constraints"`
-	Expose            types.List   `tfsdk:"expose"`
-	ModelName         types.String `tfsdk:"model"`
-	Placement         types.String `tfsdk:"placement"`
+	ApplicationName types.String `tfsdk:"name"`
+	Charm           types.List   `tfsdk:"charm"`
+	Config          types.Map    `tfsdk:"config"`
+	Constraints     types.String `tfsdk:"constraints"`
+	// StructuredConstraints are the constraints as attributes, they
+	// conflict with Constraints.
+	StructuredConstraints types.Object `tfsdk:"structured_constraints"`
+	Expose                types.List   `tfsdk:"expose"`
+	ModelName             types.String `tfsdk:"model"`
+	Placement             types.String `tfsdk:"placement"`
+	// PlacementDirectives are not read from juju, the machines
+	// hosting the units are read into Placement.
+	PlacementDirectives types.Set `tfsdk:"placement_directive"`
+	// UnitPlacement is not read from juju either, the directives
+	// are used for the units added after them.
+	UnitPlacement     types.List   `tfsdk:"unit_placement"`
+	ColocateWith      types.String `tfsdk:"colocate_with"`
+	AntiAffinity      types.Set    `tfsdk:"anti_affinity"`
+	MapMachines       types.Map    `tfsdk:"map_machines"`
 	EndpointBindings  types.Set    `tfsdk:"endpoint_bindings"`
 	Resources         types.Map    `tfsdk:"resources"`
 	StorageDirectives types.Map    `tfsdk:"storage_directives"`
@@ -93,6 +136,30 @@ type applicationResourceModel struct {
 	Principal types.Bool  `tfsdk:"principal"`
 	Trust     types.Bool  `tfsdk:"trust"`
 	UnitCount types.Int64 `tfsdk:"units"`
+	// Description is stored as an annotation of the application.
+	Description types.String `tfsdk:"description"`
+	// The agent versions of the units are read from juju only.
+	MinUnitAgentVersion types.String `tfsdk:"min_unit_agent_version"`
+	MaxUnitAgentVersion types.String `tfsdk:"max_unit_agent_version"`
+	AgentUpgradePending types.Bool   `tfsdk:"agent_upgrade_pending"`
+	// WaitForReady, WaitForActive and Timeouts are not read from
+	// juju, they only change how the provider behaves on create and
+	// update.
+	WaitForReady  types.Bool   `tfsdk:"wait_for_ready"`
+	WaitForActive types.Bool   `tfsdk:"wait_for_active"`
+	Timeouts      types.Object `tfsdk:"timeouts"`
+	// UpgradePolicy and AllowMajorUpgrade are not read from juju
+	// either, they change how the charm is refreshed on update.
+	UpgradePolicy     types.String `tfsdk:"upgrade_policy"`
+	AllowMajorUpgrade types.Bool   `tfsdk:"allow_major_upgrade"`
+	// PreDestroyAction is not read from juju either, it is run
+	// before the application is destroyed.
+	PreDestroyAction types.List `tfsdk:"pre_destroy_action"`
+	// RetainStorageOnReplace is not read from juju either, it changes
+	// how the application is destroyed and created.
+	RetainStorageOnReplace types.Bool `tfsdk:"retain_storage_on_replace"`
+	// CLIEquivalent is computed from the other attributes.
+	CLIEquivalent types.String `tfsdk:"cli_equivalent"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -121,7 +188,433 @@ func (r *applicationResource) Configure(ctx context.Context, req resource.Config
 
 	r.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	r.subCtx = tflog.NewSubsystem(ctx, LogResourceApplication)
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceApplication)
+}
+
+// ModifyPlan warns about config options which are deprecated or no
+// longer defined by the charm of an existing application. The charm
+// metadata is pulled from the model, so new applications are skipped.
+func (r *applicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or without a configured provider.
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(r.validateCharmhubPlan(ctx, req)...)
+	// The rest is only done on update.
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state applicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(modifySubordinateUnitsPlan(ctx, req, resp, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	modifyConstraintsPlan(ctx, plan, state, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(modifyCharmPlan(ctx, req, resp, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Charm.Equal(state.Charm) && !plan.Resources.Equal(state.Resources) {
+		resp.Diagnostics.Append(resourceAttachWarning(ctx, plan.Resources, state.Resources)...)
+	}
+	if plan.Config.IsNull() || plan.Config.IsUnknown() {
+		return
+	}
+	config, dErr := knownMapElements(ctx, plan.Config)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &juju.ReadCharmConfigOptionsInput{
+		ModelName: state.ModelName.ValueString(),
+		AppName:   state.ApplicationName.ValueString(),
+	}
+	var planCharms, stateCharms []nestedCharm
+	resp.Diagnostics.Append(plan.Charm.ElementsAs(ctx, &planCharms, false)...)
+	resp.Diagnostics.Append(state.Charm.ElementsAs(ctx, &stateCharms, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(planCharms) == 1 && len(stateCharms) == 1 {
+		planRevision := planCharms[0].Revision
+		if !planRevision.IsUnknown() && !planRevision.IsNull() && !planRevision.Equal(stateCharms[0].Revision) {
+			input.Revision = intPtr(planRevision)
+		}
+	}
+
+	options, err := r.client.Applications.ReadCharmConfigOptions(ctx, input)
+	if err != nil {
+		// Warnings are best effort, do not fail the plan.
+		r.trace("unable to read charm config options", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	resp.Diagnostics.Append(charmConfigWarnings(config, options)...)
+}
+
+// validateCharmhubPlan resolves the Charmhub charm of a new application,
+// or the charm an application is refreshed to, when the
+// charmhub_plan_validation feature is enabled. Values which are not
+// known yet, and models which do not exist yet, are left to juju, as
+// when deploying.
+func (r *applicationResource) validateCharmhubPlan(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !r.client.FeatureEnabled(featureCharmhubPlanValidation) {
+		return diags
+	}
+	var modelName types.String
+	var planCharm, stateCharm types.List
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("model"), &modelName)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root(CharmKey), &planCharm)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root(CharmKey), &stateCharm)...)
+	}
+	if diags.HasError() || modelName.IsUnknown() || planCharm.IsUnknown() || planCharm.Equal(stateCharm) {
+		return diags
+	}
+	var charms []nestedCharm
+	diags.Append(planCharm.ElementsAs(ctx, &charms, false)...)
+	if diags.HasError() || len(charms) != 1 {
+		return diags
+	}
+	planned := charms[0]
+	if planned.Name.IsUnknown() || planned.Path.IsUnknown() || planned.Path.ValueString() != "" {
+		return diags
+	}
+
+	input := &juju.ResolveCharmInput{
+		ModelName: modelName.ValueString(),
+		CharmName: planned.Name.ValueString(),
+		Channel:   "stable",
+		Revision:  juju.UnspecifiedRevision,
+		Base:      planned.Base.ValueString(),
+	}
+	if defaultChannel := r.client.DefaultCharmChannel(); defaultChannel != "" {
+		input.Channel = defaultChannel
+	}
+	if !planned.Channel.IsUnknown() && !planned.Channel.IsNull() {
+		input.Channel = planned.Channel.ValueString()
+	}
+	if !planned.Revision.IsUnknown() && !planned.Revision.IsNull() {
+		input.Revision = int(planned.Revision.ValueInt64())
+	}
+	if planned.Base.IsUnknown() {
+		input.Base = ""
+	}
+	err := r.client.Applications.ResolveCharm(ctx, input)
+	if errors.As(err, &juju.ModelNotFoundError) {
+		// The model is created by the same apply, the charm is
+		// resolved when deploying it.
+		r.trace("model not found, charm not resolved", map[string]interface{}{"model": input.ModelName})
+	} else if err != nil {
+		diags.AddAttributeError(path.Root(CharmKey), "Charm Not Found",
+			fmt.Sprintf("Unable to resolve charm %q in channel %q on Charmhub, got error: %s", input.CharmName, input.Channel, err))
+	}
+	return diags
+}
+
+// subordinateKey is the key of the private state recording that the
+// application is a subordinate.
+const subordinateKey = "subordinate"
+
+// subordinateUnitsDetail explains why units cannot be set for a
+// subordinate application.
+const subordinateUnitsDetail = "The application is a subordinate, its units are deployed alongside the units " +
+	"of the principal applications it is related to. Remove units from the configuration, or set it to 0."
+
+// privateState is the private state of a resource, in the requests and
+// responses of the framework.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setSubordinate records in the private state whether the application
+// is a subordinate, so that the plan does not need to read it from the
+// controller.
+func setSubordinate(ctx context.Context, private privateState, subordinate bool) diag.Diagnostics {
+	var value []byte
+	if subordinate {
+		value = []byte("true")
+	}
+	return private.SetKey(ctx, subordinateKey, value)
+}
+
+// isSubordinate returns whether the private state records that the
+// application is a subordinate.
+func isSubordinate(ctx context.Context, private privateState) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, subordinateKey)
+	return string(value) == "true", diags
+}
+
+// modifySubordinateUnitsPlan keeps the units of a subordinate application
+// out of the plan when they are not configured, and rejects a number of
+// units configured for it.
+func modifySubordinateUnitsPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, state applicationResourceModel) diag.Diagnostics {
+	subordinate, diags := isSubordinate(ctx, req.Private)
+	if !subordinate || diags.HasError() {
+		return diags
+	}
+	var configUnits types.Int64
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("units"), &configUnits)...)
+	if diags.HasError() || configUnits.IsUnknown() {
+		return diags
+	}
+	if configUnits.IsNull() {
+		diags.Append(resp.Plan.SetAttribute(ctx, path.Root("units"), state.UnitCount)...)
+	} else if configUnits.ValueInt64() != 0 {
+		diags.AddAttributeError(path.Root("units"), "Units Not Supported", subordinateUnitsDetail)
+	}
+	return diags
+}
+
+// modifyCharmPlan plans a refresh of an application deployed from a
+// local charm when the content of the charm archive or directory has
+// changed. When switching between a local charm and a charm from
+// Charmhub, the application is replaced and its charm name and hash are
+// those of the new charm. A revision which is not configured follows a change of
+// channel, unless the upgrade policy only sets the channel.
+func modifyCharmPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan, state applicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var configCharmList types.List
+	diags.Append(req.Config.GetAttribute(ctx, path.Root(CharmKey), &configCharmList)...)
+	var planCharms, stateCharms, configCharms []nestedCharm
+	diags.Append(plan.Charm.ElementsAs(ctx, &planCharms, false)...)
+	diags.Append(state.Charm.ElementsAs(ctx, &stateCharms, false)...)
+	diags.Append(configCharmList.ElementsAs(ctx, &configCharms, false)...)
+	if diags.HasError() || len(planCharms) != 1 || len(stateCharms) != 1 || len(configCharms) != 1 {
+		return diags
+	}
+	planCharm, stateCharm := planCharms[0], stateCharms[0]
+	charmPath := path.Root(CharmKey).AtListIndex(0)
+	if planCharm.Path.IsUnknown() {
+		diags.Append(resp.Plan.SetAttribute(ctx, charmPath.AtName("sha256"), types.StringUnknown())...)
+		return diags
+	}
+	if planCharm.Path.IsNull() != stateCharm.Path.IsNull() {
+		// The values not configured are kept from the state by
+		// UseStateForUnknown, they are those of the charm replaced.
+		if configCharms[0].Name.IsNull() {
+			diags.Append(resp.Plan.SetAttribute(ctx, charmPath.AtName("name"), types.StringUnknown())...)
+		}
+		if configCharms[0].Channel.IsNull() {
+			diags.Append(resp.Plan.SetAttribute(ctx, charmPath.AtName("channel"), types.StringUnknown())...)
+		}
+		if configCharms[0].Revision.IsNull() {
+			diags.Append(resp.Plan.SetAttribute(ctx, charmPath.AtName("revision"), types.Int64Unknown())...)
+		}
+	}
+	if planCharm.Path.IsNull() {
+		if !stateCharm.Path.IsNull() {
+			diags.Append(resp.Plan.SetAttribute(ctx, charmPath.AtName("sha256"), types.StringNull())...)
+		}
+		if !plan.AllowMajorUpgrade.ValueBool() && !plan.AllowMajorUpgrade.IsUnknown() && !planCharm.Channel.IsUnknown() {
+			fromTrack, toTrack, major := majorTrackChange(stateCharm.Channel.ValueString(), planCharm.Channel.ValueString())
+			if major {
+				diags.AddAttributeError(charmPath.AtName("channel"), "Major Upgrade Not Allowed",
+					fmt.Sprintf("Changing the channel of the charm from track %q to track %q moves the workload "+
+						"to another major version, which may require a migration, e.g. of a database, and cannot be "+
+						"undone by changing the channel back. Check the upgrade documentation of the charm, then set "+
+						"allow_major_upgrade = true to apply the change.", fromTrack, toTrack))
+				return diags
+			}
+		}
+		if configCharms[0].Revision.IsNull() && !planCharm.Channel.IsUnknown() && !planCharm.Channel.Equal(stateCharm.Channel) &&
+			plan.UpgradePolicy.ValueString() != juju.UpgradePolicyChannelOnly {
+			diags.Append(resp.Plan.SetAttribute(ctx, charmPath.AtName("revision"), types.Int64Unknown())...)
+		}
+		return diags
+	}
+	hash, err := localCharmSHA256(planCharm.Path.ValueString())
+	if err != nil {
+		diags.AddAttributeError(charmPath.AtName(CharmPathKey), "Invalid Local Charm", err.Error())
+		return diags
+	}
+	if hash == stateCharm.SHA256.ValueString() {
+		return diags
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, charmPath.AtName("sha256"), types.StringValue(hash))...)
+	diags.Append(resp.Plan.SetAttribute(ctx, charmPath.AtName("revision"), types.Int64Unknown())...)
+	return diags
+}
+
+// majorTrackChange returns the tracks of the given channels and whether
+// changing from one to the other changes the major version of the track,
+// e.g. from 14/stable to 16/stable. Tracks which do not start with a
+// version, e.g. latest, are not compared.
+//
+// TODO: Charmhub does not publish upgrade paths between the tracks of a
+// charm, so the supported upgrades are not queried. Query them instead of
+// comparing the tracks once Charmhub provides them.
+func majorTrackChange(from, to string) (string, string, bool) {
+	if from == "" || to == "" || from == to {
+		return "", "", false
+	}
+	fromChannel, err := charm.ParseChannel(from)
+	if err != nil {
+		return "", "", false
+	}
+	toChannel, err := charm.ParseChannel(to)
+	if err != nil {
+		return "", "", false
+	}
+	fromMajor, fromOK := trackMajorVersion(fromChannel.Track)
+	toMajor, toOK := trackMajorVersion(toChannel.Track)
+	return fromChannel.Track, toChannel.Track, fromOK && toOK && fromMajor != toMajor
+}
+
+// trackMajorVersion returns the major version a track starts with, e.g.
+// 8 for 8.0 or 3 for 3.4-strict, and false if the track does not start
+// with a number.
+func trackMajorVersion(track string) (int, bool) {
+	end := strings.IndexFunc(track, func(r rune) bool { return r < '0' || r > '9' })
+	if end == -1 {
+		end = len(track)
+	}
+	major, err := strconv.Atoi(track[:end])
+	return major, err == nil
+}
+
+// localCharmRequiresReplace requires the application to be replaced when
+// switching between a local charm and a charm from Charmhub.
+func localCharmRequiresReplace(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.PlanValue.IsNull() != req.StateValue.IsNull()
+}
+
+// localCharmSHA256 returns the hex encoded SHA256 hash of the local charm
+// archive or directory at the given path, used to detect changes to the
+// charm. The hash of a directory covers the paths and the content of all
+// its files.
+func localCharmSHA256(charmPath string) (string, error) {
+	info, err := os.Stat(charmPath)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	if !info.IsDir() {
+		if err := hashFile(hash, charmPath); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+	// WalkDir visits the files in lexical order, the hash does not depend
+	// on the order the file system lists them.
+	err = filepath.WalkDir(charmPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(charmPath, filePath)
+		if err != nil {
+			return err
+		}
+		switch {
+		case entry.Type().IsRegular():
+			fileHash := sha256.New()
+			if err := hashFile(fileHash, filePath); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(hash, "file %s %x\n", filepath.ToSlash(relPath), fileHash.Sum(nil))
+		case entry.Type()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(filePath)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(hash, "link %s %s\n", filepath.ToSlash(relPath), target)
+		case entry.IsDir():
+			_, err = fmt.Fprintf(hash, "dir %s\n", filepath.ToSlash(relPath))
+		}
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// hashFile writes the content of the file at the given path to the hash.
+func hashFile(hash io.Writer, filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(hash, f)
+	return err
+}
+
+// resourceAttachWarning returns a warning listing the resources which
+// will be attached to the application without refreshing the charm.
+func resourceAttachWarning(ctx context.Context, planResources, stateResources types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if planResources.IsUnknown() {
+		return diags
+	}
+	var planResourceMap map[string]types.String
+	diags.Append(planResources.ElementsAs(ctx, &planResourceMap, false)...)
+	stateResourceMap, dErr := knownMapElements(ctx, stateResources)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return diags
+	}
+	var changed []string
+	for name, value := range planResourceMap {
+		// A value unknown until apply is likely to change.
+		if value.IsUnknown() || stateResourceMap[name] != value.ValueString() {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) == 0 {
+		return diags
+	}
+	sort.Strings(changed)
+	diags.AddAttributeWarning(path.Root(ResourceKey), "Resources Will Be Updated",
+		fmt.Sprintf("The charm is not refreshed, only the following resources of the application will be "+
+			"updated, to a new revision or to a newly uploaded file: %s.", strings.Join(changed, ", ")))
+	return diags
+}
+
+// knownMapElements returns the elements of the map of strings which are
+// known, the values of others may only be known at apply time, e.g. when
+// they refer to another resource.
+func knownMapElements(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	var elements map[string]types.String
+	diags := value.ElementsAs(ctx, &elements, false)
+	known := make(map[string]string, len(elements))
+	for key, element := range elements {
+		if !element.IsUnknown() && !element.IsNull() {
+			known[key] = element.ValueString()
+		}
+	}
+	return known, diags
+}
+
+// charmConfigWarnings returns a warning for each config key which is not
+// defined by the charm, or which the charm describes as deprecated.
+func charmConfigWarnings(config map[string]string, options map[string]juju.CharmConfigOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for key := range config {
+		option, ok := options[key]
+		if !ok {
+			diags.AddAttributeWarning(path.Root(ConfigKey).AtMapKey(key), "Unknown Charm Config Option",
+				fmt.Sprintf("The charm does not define the config option %q. It may have been removed or "+
+					"renamed, check the charm documentation for a replacement.", key))
+			continue
+		}
+		if strings.Contains(strings.ToLower(option.Description), "deprecated") {
+			diags.AddAttributeWarning(path.Root(ConfigKey).AtMapKey(key), "Deprecated Charm Config Option",
+				fmt.Sprintf("The charm config option %q is deprecated: %s", key, option.Description))
+		}
+	}
+	return diags
 }
 
 func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -146,26 +639,45 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"units": schema.Int64Attribute{
-				Description: "The number of application units to deploy for the charm.",
-				Optional:    true,
-				Computed:    true,
-				Default:     int64default.StaticInt64(int64(1)),
+				Description: "The number of application units to deploy for the charm. Must not be set, or be 0, for a " +
+					"subordinate charm: its units are deployed alongside the units of the principal applications it " +
+					"is related to and are not managed by terraform.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(int64(1)),
 			},
 			ConfigKey: schema.MapAttribute{
-				Description: "Application specific configuration. Must evaluate to a string, integer or boolean.",
+				Description: "Application specific configuration. Must evaluate to a string, integer or boolean." +
+					" For existing applications, a warning is shown during plan for options the charm does not define" +
+					" or describes as deprecated. Large values, such as certificates or other file contents, can be" +
+					" read with `file()`, or `filebase64()` where the charm expects base64 encoded content; the" +
+					" total size of the config is checked at plan time against the limit of the controller.",
 				Optional:    true,
 				ElementType: types.StringType,
+				Validators: []validator.Map{
+					ApplicationConfigSizeValidator{},
+				},
 			},
 			"constraints": schema.StringAttribute{
-				Description: "Constraints imposed on this application.",
-				Optional:    true,
+				Description: "Constraints imposed on this application, e.g. `mem=8G cores=2`. Unknown constraints " +
+					"are rejected at plan time. Provider specific requirements, such as GPUs, are requested with " +
+					"the `instance-type` or `tags` constraints. Changing the constraints, other than how they are " +
+					"written, e.g. `mem=4G` and `mem=4096M`, will cause the application to be destroyed and " +
+					"recreated by terraform.",
+				Optional: true,
 				// Set as "computed" to pre-populate and preserve any implicit constraints
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.RequiresReplaceIf(constraintsRequireReplace,
+						"Changing the constraints requires the application to be replaced.",
+						"Changing the constraints requires the application to be replaced."),
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					StringIsConstraintsValidator{},
+				},
 			},
+			"structured_constraints": applicationConstraintsAttribute(),
 			"storage_directives": schema.MapAttribute{
 				Description: "Storage directives (constraints) for the juju application." +
 					" The map key is the label of the storage defined by the charm," +
@@ -182,9 +694,10 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"storage": schema.SetNestedAttribute{
-				Description: "Storage used by the application.",
-				Optional:    true,
-				Computed:    true,
+				Description: "Storage used by the application, as reported by Juju. It is read on refresh, so that " +
+					"storage changed outside of terraform shows as drift. Use `storage_directives` to request storage.",
+				Optional: true,
+				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"label": schema.StringAttribute{
@@ -206,20 +719,155 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					},
 				},
 			},
+			"description": schema.StringAttribute{
+				Description: "A description of the application, e.g. its purpose and owner. It is stored on the " +
+					"application as the `" + juju.DescriptionAnnotation + "` annotation, which should not also be " +
+					"managed by `juju_annotation`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"trust": schema.BoolAttribute{
-				Description: "Set the trust for the application.",
-				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(false),
+				Description: "Set the trust for the application. The trust of the application is read back, so that " +
+					"a change made with `juju trust` outside of terraform is corrected on the next apply.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "Wait on create and on a charm, resource or unit count change until every unit runs " +
+					"the new charm revision with an idle agent. In kubernetes models the pod of each unit must also " +
+					"be ready, so that a failure to pull an OCI image fails the apply instead of surfacing later. " +
+					"Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"upgrade_policy": schema.StringAttribute{
+				Description: fmt.Sprintf("How the charm is refreshed when its channel or revision changes. %q "+
+					"refreshes the charm, to the configured revision or to the latest revision of the channel. %q "+
+					"refreshes the charm as %q does, with `juju refresh --force`, e.g. to bypass the LXD profile "+
+					"allow list. %q only sets the channel, used by future refreshes, and keeps the current revision, "+
+					"unless the revision also changes. Defaults to %q.",
+					juju.UpgradePolicyRefresh, juju.UpgradePolicyForce, juju.UpgradePolicyRefresh,
+					juju.UpgradePolicyChannelOnly, juju.UpgradePolicyRefresh),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(juju.UpgradePolicyRefresh),
+				Validators: []validator.String{
+					stringvalidator.OneOf(juju.UpgradePolicyRefresh, juju.UpgradePolicyForce, juju.UpgradePolicyChannelOnly),
+				},
+			},
+			"allow_major_upgrade": schema.BoolAttribute{
+				Description: "Allow changing the charm channel to a track with another major version, e.g. from " +
+					"`14/stable` to `16/stable`. Such a change often upgrades the workload across major versions, " +
+					"e.g. of a database, which may require a migration and cannot be undone by changing the channel " +
+					"back, so the plan fails unless this is true. The major versions are those leading the tracks, as " +
+					"Charmhub does not publish the supported upgrade paths of charms. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"wait_for_active": schema.BoolAttribute{
+				Description: "Wait on create and on a charm, resource or unit count change until every unit is " +
+					"ready, as with wait_for_ready, and its workload is active, so that resources depending on " +
+					"the application find it running. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"retain_storage_on_replace": schema.BoolAttribute{
+				Description: "If true, the persistent storage of the units is detached rather than destroyed when the " +
+					"application is destroyed, and attached to the first units of the application when it is created " +
+					"again with the same name, e.g. when a change forces its replacement, one unit for each unit the " +
+					"storage was attached to. The retained storage is recorded in the `" +
+					juju.RetainedStorageAnnotationPrefix + "<application>` annotation of the model. Storage which is " +
+					"not persistent, e.g. on the root disk of the machines, is destroyed with the machines. Storage " +
+					"retained when the application is destroyed for good stays detached in the model, it must be " +
+					"removed with `juju remove-storage`. Only applies to machine models. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"placement": schema.StringAttribute{
-				Description: "Specify the target location for the application's units",
-				Optional:    true,
-				Computed:    true,
+				Description: "Specify the target location for the application's units. Cannot be used with " +
+					"placement_directive, which is read into this attribute as the machines hosting the units.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("placement_directive"),
+					}...),
+				},
+			},
+			"colocate_with": schema.StringAttribute{
+				Description: "The name of an application in the same model whose machines the units are placed on." +
+					" Resolved to placement directives when the application is created. Cannot be used with placement." +
+					" Changing this value will cause the application to be destroyed and recreated by terraform.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("placement"),
+						path.MatchRoot("placement_directive"),
+					}...),
+				},
+			},
+			"anti_affinity": schema.SetAttribute{
+				Description: "The names of applications in the same model whose machines the units must not be placed on." +
+					" Creating the application fails if the placement targets one of those machines. Without a placement," +
+					" units are deployed to new machines." +
+					" Changing this value will cause the application to be destroyed and recreated by terraform.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"map_machines": schema.MapAttribute{
+				Description: "Maps the IDs of the machines used in placement to the IDs of existing machines of the" +
+					" model, like `juju deploy --map-machines` does for the machines of a bundle, so that a placement" +
+					" written for one model deploys the units to the same machines of a re-created model. Machines" +
+					" missing from the map are used as they are. The placement is kept in state in terms of the" +
+					" machine IDs used in placement." +
+					" Changing this value will cause the application to be destroyed and recreated by terraform.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(StringIsMachineIDValidator{}),
+					mapvalidator.ValueStringsAre(StringIsMachineIDValidator{}),
+				},
+			},
+			"unit_placement": schema.ListAttribute{
+				Description: "Placement directives for the units of the application, in order: the first " +
+					"directive places the first unit and so on. A directive is a machine ID, e.g. `3`, a new " +
+					"container, e.g. `lxd:3` or `lxd`, or a directive for the cloud, e.g. `zone=us-east-1a`. " +
+					"The directives are used when the application is deployed and when units are added, units " +
+					"without a directive are placed on new machines. Changing the directives of existing units " +
+					"does not move them. Machines are mapped with map_machines. Cannot be used with placement, " +
+					"placement_directive, colocate_with or anti_affinity.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					listvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("placement"),
+						path.MatchRoot("placement_directive"),
+						path.MatchRoot("colocate_with"),
+						path.MatchRoot("anti_affinity"),
+					}...),
+				},
 			},
 			"principal": schema.BoolAttribute{
 				Description: "Whether this is a Principal application",
@@ -229,6 +877,31 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 				DeprecationMessage: "Principal is computed only and not needed. This attribute will be removed in the next major version of the provider.",
 			},
+			"min_unit_agent_version": schema.StringAttribute{
+				Description: "The lowest version of the juju agents of the units, empty until an agent reports its version.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_unit_agent_version": schema.StringAttribute{
+				Description: "The highest version of the juju agents of the units, empty until an agent reports its version.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_upgrade_pending": schema.BoolAttribute{
+				Description: "Whether the juju agent of a unit does not run the agent version of the model yet, " +
+					"i.e. an upgrade of the model is still in progress for the application. It can be used to wait " +
+					"for an upgrade to complete before upgrading the next model or the controller.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			CLIEquivalentKey: cliEquivalentAttribute("deploying an equivalent application, e.g. " +
+				"`juju deploy --model development postgresql database --channel 14/stable --num-units 3`"),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -236,8 +909,10 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			EndpointBindingsKey: schema.SetNestedAttribute{
-				Description: "Configure endpoint bindings",
-				Optional:    true,
+				Description: "Configure endpoint bindings, the equivalent of `juju deploy --bind`. The bindings are " +
+					"applied when the application is deployed, and endpoints are rebound when their space changes. " +
+					"Endpoints removed from the bindings are bound to the default space of the application.",
+				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"endpoint": schema.StringAttribute{
@@ -266,15 +941,68 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": schema.SingleNestedBlock{
+				Description: "How long to wait for the application on create and update when wait_for_ready or " +
+					"wait_for_active is set. Each timeout is a duration, e.g. \"30m\", and defaults to 20 minutes.",
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Description: "How long to wait for the application to be ready once deployed.",
+						Optional:    true,
+						Validators: []validator.String{
+							ValidatorMatchString(isDuration, "must be a duration, e.g. \"30m\""),
+						},
+					},
+					"update": schema.StringAttribute{
+						Description: "How long to wait for the application to be ready once updated.",
+						Optional:    true,
+						Validators: []validator.String{
+							ValidatorMatchString(isDuration, "must be a duration, e.g. \"30m\""),
+						},
+					},
+				},
+			},
 			CharmKey: schema.ListNestedBlock{
-				Description: "The name of the charm to be installed from Charmhub.",
+				Description: "The charm to be installed, either from Charmhub or from a local charm archive or directory.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
-							Required:    true,
-							Description: "The name of the charm",
+							Description: "The name of the charm to be installed from Charmhub. Read from the charm " +
+								"when it is deployed from path.",
+							Optional: true,
+							Computed: true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.RequiresReplaceIfConfigured(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(CharmPathKey)),
+							},
+						},
+						CharmPathKey: schema.StringAttribute{
+							Description: "The path of a local charm, an archive, e.g. `./my-charm.charm`, or a directory, " +
+								"to upload to the controller and deploy instead of a charm from Charmhub. Its resources must " +
+								"all be provided in resources. The charm is refreshed when the content of the archive or of " +
+								"the files of the directory changes, " +
+								"switching between a local charm and a charm from Charmhub will cause the application " +
+								"to be destroyed and recreated by terraform.",
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplaceIf(localCharmRequiresReplace,
+									"Switching between a local charm and a charm from Charmhub requires the application to be replaced.",
+									"Switching between a local charm and a charm from Charmhub requires the application to be replaced."),
+							},
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.Expressions{
+									path.MatchRelative().AtParent().AtName("channel"),
+									path.MatchRelative().AtParent().AtName("revision"),
+								}...),
+							},
+						},
+						"sha256": schema.StringAttribute{
+							Description: "The SHA256 hash of the local charm archive or directory deployed from path.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
 						"channel": schema.StringAttribute{
@@ -289,9 +1017,11 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 							},
 						},
 						"revision": schema.Int64Attribute{
-							Description: "The revision of the charm to deploy. During the update phase, the charm revision should be update before config update, to avoid issues with config parameters parsing.",
-							Optional:    true,
-							Computed:    true,
+							Description: "The revision of the charm to deploy. During the update phase, the charm revision should be update before config update, to avoid issues with config parameters parsing. " +
+								"A revision configured with a channel pins the charm, the channel is used by future refreshes. " +
+								"When not configured, the revision follows the channel: it is read back after the channel changes.",
+							Optional: true,
+							Computed: true,
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
 							},
@@ -324,6 +1054,17 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 								stringIsBaseValidator{},
 							},
 						},
+						BaseSelectionKey: schema.StringAttribute{
+							Description: "The policy used to select the base when deploying. \"latest-lts\" selects the newest " +
+								"Ubuntu LTS base supported by the charm, \"charm-default\" selects the base suggested by the charm, " +
+								"ignoring the model default base, and \"explicit\" requires base or series to be set. The selected " +
+								"base is recorded in base. Only applies when the application is deployed.",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(juju.BaseSelectionLatestLTS, juju.BaseSelectionCharmDefault, juju.BaseSelectionExplicit),
+								baseSelectionValidator{},
+							},
+						},
 					},
 				},
 				Validators: []validator.List{
@@ -331,6 +1072,73 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					listvalidator.IsRequired(),
 				},
 			},
+			"placement_directive": schema.SetNestedBlock{
+				Description: "A target location for the units of the application, a structured alternative to " +
+					"placement. The directives are kept as configured, the machines hosting the units are read " +
+					"into placement." +
+					" Changing this value will cause the application to be destroyed and recreated by terraform.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"scope": schema.StringAttribute{
+							Description: fmt.Sprintf("Either %q, to place a unit on an existing machine or container, "+
+								"or %q, to place a unit in a new container.", placementScopeMachine, placementScopeContainer),
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(placementScopeMachine, placementScopeContainer),
+							},
+						},
+						"directive": schema.StringAttribute{
+							Description: fmt.Sprintf("The ID of the machine or container, e.g. `3` or `3/lxd/0`. Required "+
+								"with the %q scope. With the %q scope, the ID of the machine to create the container on, "+
+								"a new machine if not set.", placementScopeMachine, placementScopeContainer),
+							Optional: true,
+						},
+						"container_type": schema.StringAttribute{
+							Description: fmt.Sprintf("The type of the container to create, either `lxd` or `kvm`. "+
+								"Required with the %q scope only.", placementScopeContainer),
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(instance.LXD), string(instance.KVM)),
+							},
+						},
+					},
+					Validators: []validator.Object{
+						placementDirectiveValidator{},
+					},
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"pre_destroy_action": schema.ListNestedBlock{
+				Description: "An action run on the leader unit of the application before the application is " +
+					"destroyed, e.g. to drain traffic or back up data. The application is not destroyed if the " +
+					"action fails or times out; remove the block, and apply, to destroy it anyway.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the action, as defined by the charm.",
+							Required:    true,
+						},
+						"params": schema.MapAttribute{
+							Description: "The parameters of the action. Values are parsed as YAML scalars, " +
+								"like with `juju run`, e.g. `3` is a number and `true` a boolean.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"timeout": schema.StringAttribute{
+							Description: "How long to wait for the action to complete, e.g. \"30m\". Defaults to 10 minutes.",
+							Optional:    true,
+							Validators: []validator.String{
+								ValidatorMatchString(isDuration, "must be a duration, e.g. \"30m\""),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
 			ExposeKey: schema.ListNestedBlock{
 				Description: "Makes an application publicly available over the network",
 				NestedObject: schema.NestedBlockObject{
@@ -347,6 +1155,17 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 							Description: "A comma-delimited list of CIDRs that should be able to access the application ports once exposed.",
 							Optional:    true,
 						},
+						ExposeModeKey: schema.StringAttribute{
+							Description: "The expose mode, either \"all\" or \"auto\". \"all\" exposes the whole application, or the " +
+								"endpoints listed. \"auto\" only exposes the endpoints the charm provides to other applications, " +
+								"leaving out container scoped and juju-info endpoints. Cannot be used with endpoints. The mode " +
+								"is not known to Juju, an imported application lists its exposed endpoints instead.",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(exposeModeAll, juju.ExposeModeAuto),
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(EndpointsKey)),
+							},
+						},
 					},
 				},
 				Validators: []validator.List{
@@ -361,10 +1180,108 @@ func (r *applicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 // of the in the application resource schema
 type nestedCharm struct {
 	Name     types.String `tfsdk:"name"`
+	Path     types.String `tfsdk:"path"`
+	SHA256   types.String `tfsdk:"sha256"`
 	Channel  types.String `tfsdk:"channel"`
 	Revision types.Int64  `tfsdk:"revision"`
 	Base     types.String `tfsdk:"base"`
 	Series   types.String `tfsdk:"series"`
+	// BaseSelection is only used at deploy time and cannot be read
+	// back from juju, it is kept from the prior state.
+	BaseSelection types.String `tfsdk:"base_selection"`
+}
+
+// nestedTimeouts represents the timeouts SingleNestedBlock of the
+// application resource schema.
+type nestedTimeouts struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+}
+
+// waitTimeout returns the timeout of the given operation, create or
+// update, zero if it is not set.
+func waitTimeout(ctx context.Context, timeouts types.Object, operation string) (time.Duration, diag.Diagnostics) {
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return 0, nil
+	}
+	var nested nestedTimeouts
+	diags := timeouts.As(ctx, &nested, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return 0, diags
+	}
+	value := nested.Create
+	if operation == "update" {
+		value = nested.Update
+	}
+	if value.ValueString() == "" {
+		return 0, diags
+	}
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("timeouts").AtName(operation), "Invalid Attribute Value", err.Error())
+	}
+	return timeout, diags
+}
+
+func isDuration(value string) bool {
+	_, err := time.ParseDuration(value)
+	return err == nil
+}
+
+// nestedPreDestroyAction represents the single element of the
+// pre_destroy_action ListNestedBlock of the application resource schema.
+const (
+	// placementScopeMachine places a unit on an existing machine or
+	// container.
+	placementScopeMachine = "machine"
+	// placementScopeContainer places a unit in a new container.
+	placementScopeContainer = "container"
+)
+
+// nestedPlacementDirective represents an element of the
+// placement_directive SetNestedBlock of the application resource schema.
+type nestedPlacementDirective struct {
+	Scope         types.String `tfsdk:"scope"`
+	Directive     types.String `tfsdk:"directive"`
+	ContainerType types.String `tfsdk:"container_type"`
+}
+
+// String returns the placement directive as used by juju deploy --to,
+// e.g. 3, lxd:3 or lxd.
+func (n nestedPlacementDirective) String() string {
+	if n.Scope.ValueString() == placementScopeMachine {
+		return n.Directive.ValueString()
+	}
+	if n.Directive.IsNull() {
+		return n.ContainerType.ValueString()
+	}
+	return n.ContainerType.ValueString() + ":" + n.Directive.ValueString()
+}
+
+// placementFromDirectives returns the placement of the application
+// described by the placement directive blocks.
+func placementFromDirectives(ctx context.Context, placementDirectives types.Set) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if placementDirectives.IsNull() || placementDirectives.IsUnknown() {
+		return "", diags
+	}
+	var nested []nestedPlacementDirective
+	diags.Append(placementDirectives.ElementsAs(ctx, &nested, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+	directives := make([]string, len(nested))
+	for i, n := range nested {
+		directives[i] = n.String()
+	}
+	sort.Strings(directives)
+	return strings.Join(directives, ","), diags
+}
+
+type nestedPreDestroyAction struct {
+	Name    types.String `tfsdk:"name"`
+	Params  types.Map    `tfsdk:"params"`
+	Timeout types.String `tfsdk:"timeout"`
 }
 
 // nestedExpose represents the single element of expose ListNestedBlock
@@ -373,6 +1290,17 @@ type nestedExpose struct {
 	Endpoints types.String `tfsdk:"endpoints"`
 	Spaces    types.String `tfsdk:"spaces"`
 	Cidrs     types.String `tfsdk:"cidrs"`
+	Mode      types.String `tfsdk:"mode"`
+}
+
+// exposeModeAll is the default expose mode, exposing the whole
+// application or the endpoints listed.
+const exposeModeAll = "all"
+
+// isAuto returns true if the endpoints to expose are
+// picked from the charm metadata.
+func (n nestedExpose) isAuto() bool {
+	return n.Mode.ValueString() == juju.ExposeModeAuto
 }
 
 func (n nestedExpose) transformToMapStringInterface() map[string]interface{} {
@@ -388,6 +1316,9 @@ func (n nestedExpose) transformToMapStringInterface() map[string]interface{} {
 	if val := n.Cidrs.ValueString(); val != "" {
 		expose[CidrsKey] = val
 	}
+	if n.isAuto() {
+		expose[ExposeModeKey] = juju.ExposeModeAuto
+	}
 	return expose
 }
 
@@ -407,6 +1338,28 @@ func parseNestedExpose(value map[string]interface{}) nestedExpose {
 	return resp
 }
 
+// sameCommaDelimitedList returns prior if it holds the same items as
+// current in a different order, otherwise current.
+func sameCommaDelimitedList(current, prior types.String) types.String {
+	if current.IsNull() || prior.IsNull() {
+		return current
+	}
+	items := func(list string) []string {
+		var result []string
+		for _, item := range strings.Split(list, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+		sort.Strings(result)
+		return result
+	}
+	if slices.Equal(items(current.ValueString()), items(prior.ValueString())) {
+		return prior
+	}
+	return current
+}
+
 // nestedEndpointBinding represents the single element of endpoint_bindings
 // ListNestedAttribute
 type nestedEndpointBinding struct {
@@ -454,9 +1407,23 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	planCharm := charms[0]
 	charmName := planCharm.Name.ValueString()
 	channel := "stable"
+	if defaultChannel := r.client.DefaultCharmChannel(); defaultChannel != "" {
+		channel = defaultChannel
+	}
 	if !planCharm.Channel.IsUnknown() {
 		channel = planCharm.Channel.ValueString()
 	}
+	charmPath := planCharm.Path.ValueString()
+	planCharm.SHA256 = types.StringNull()
+	if charmPath != "" {
+		hash, err := localCharmSHA256(charmPath)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(CharmKey).AtListIndex(0).AtName(CharmPathKey), "Invalid Local Charm", err.Error())
+			return
+		}
+		planCharm.SHA256 = types.StringValue(hash)
+		channel = ""
+	}
 	revision := -1
 	if !planCharm.Revision.IsUnknown() {
 		revision = int(planCharm.Revision.ValueInt64())
@@ -501,6 +1468,11 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 			resp.Diagnostics.AddError("Input Error", fmt.Sprintf("Unable to parse constraints, go error: %s", err))
 		}
 	}
+	if !plan.StructuredConstraints.IsNull() && !plan.StructuredConstraints.IsUnknown() {
+		var dErr diag.Diagnostics
+		parsedConstraints, dErr = constraintsFromObject(ctx, plan.StructuredConstraints)
+		resp.Diagnostics.Append(dErr...)
+	}
 
 	// Parse endpoint bindings
 	var endpointBindings map[string]string
@@ -539,25 +1511,67 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
+	var antiAffinity []string
+	resp.Diagnostics.Append(plan.AntiAffinity.ElementsAs(ctx, &antiAffinity, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapMachines := make(map[string]string)
+	resp.Diagnostics.Append(plan.MapMachines.ElementsAs(ctx, &mapMachines, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	placement := plan.Placement.ValueString()
+	if !plan.PlacementDirectives.IsNull() {
+		var dErr diag.Diagnostics
+		placement, dErr = placementFromDirectives(ctx, plan.PlacementDirectives)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var unitPlacement []string
+	resp.Diagnostics.Append(plan.UnitPlacement.ElementsAs(ctx, &unitPlacement, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default base of the provider only applies when the
+	// application does not select its base in any way.
+	charmBase := planCharm.Base.ValueString()
+	if charmBase == "" && planCharm.Series.ValueString() == "" && planCharm.BaseSelection.ValueString() == "" {
+		charmBase = r.client.DefaultBase()
+	}
+
 	modelName := plan.ModelName.ValueString()
 	createResp, err := r.client.Applications.CreateApplication(ctx,
 		&juju.CreateApplicationInput{
 			ApplicationName:    plan.ApplicationName.ValueString(),
 			ModelName:          modelName,
 			CharmName:          charmName,
+			CharmPath:          charmPath,
 			CharmChannel:       channel,
 			CharmRevision:      revision,
-			CharmBase:          planCharm.Base.ValueString(),
+			CharmBase:          charmBase,
 			CharmSeries:        planCharm.Series.ValueString(),
+			BaseSelection:      planCharm.BaseSelection.ValueString(),
 			Units:              int(plan.UnitCount.ValueInt64()),
 			Config:             configField,
 			Constraints:        parsedConstraints,
 			Trust:              plan.Trust.ValueBool(),
 			Expose:             expose,
-			Placement:          plan.Placement.ValueString(),
+			Placement:          placement,
 			EndpointBindings:   endpointBindings,
 			Resources:          resourceRevisions,
 			StorageConstraints: storageConstraints,
+			ColocateWith:       plan.ColocateWith.ValueString(),
+			AntiAffinity:       antiAffinity,
+			MapMachines:        mapMachines,
+			UnitPlacement:      unitPlacement,
+			ReattachStorage:    plan.RetainStorageOnReplace.ValueBool(),
 		},
 	)
 	if err != nil {
@@ -567,6 +1581,13 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	r.trace(fmt.Sprintf("create application resource %q", createResp.AppName))
 
+	if !plan.Description.IsNull() {
+		if err := setDescription(ctx, r.client, modelName, names.NewApplicationTag(createResp.AppName).String(), plan.Description); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the description of application %q, got error: %s", createResp.AppName, err))
+			return
+		}
+	}
+
 	readResp, err := r.client.Applications.ReadApplicationWithRetryOnNotFound(ctx, &juju.ReadApplicationInput{
 		ModelName: modelName,
 		AppName:   createResp.AppName,
@@ -577,20 +1598,68 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	r.trace(fmt.Sprintf("read application resource %q", createResp.AppName))
 
+	resp.Diagnostics.Append(setSubordinate(ctx, resp.Private, !readResp.Principal)...)
+	if !readResp.Principal {
+		var configUnits types.Int64
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("units"), &configUnits)...)
+		if configUnits.ValueInt64() > 0 {
+			resp.Diagnostics.AddAttributeWarning(path.Root("units"), "Units Ignored", subordinateUnitsDetail)
+		}
+	}
+
+	// The application exists from now on, a failed wait is reported once
+	// the state is saved, so that the resource is tainted instead of
+	// being lost by terraform.
+	var waitErr error
+	if plan.WaitForReady.ValueBool() || plan.WaitForActive.ValueBool() {
+		timeout, dErr := waitTimeout(ctx, plan.Timeouts, "create")
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		waitErr = r.client.Applications.WaitForApplicationReady(ctx, &juju.WaitForApplicationReadyInput{
+			ModelName:   modelName,
+			AppName:     createResp.AppName,
+			Units:       readResp.Units,
+			Subordinate: !readResp.Principal,
+			Active:      plan.WaitForActive.ValueBool(),
+			Timeout:     timeout,
+		})
+		if waitErr == nil {
+			// The agents of the units have started, read their versions.
+			readResp, err = r.client.Applications.ReadApplication(ctx, &juju.ReadApplicationInput{
+				ModelName: modelName,
+				AppName:   createResp.AppName,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application, got error: %s", err))
+				return
+			}
+		}
+	}
+
 	// Save plan into Terraform state
 
 	// Constraints do not apply to subordinate applications. If the application
 	// is subordinate, the constraints will be set to the empty string.
-	plan.Constraints = types.StringValue(readResp.Constraints.String())
-	plan.Placement = types.StringValue(readResp.Placement)
+	plan.Constraints = constraintsStringValue(plan.Constraints, readResp.Constraints)
+	var dErr diag.Diagnostics
+	plan.StructuredConstraints, dErr = applicationConstraintsFromValue(ctx, readResp.Constraints, plan.StructuredConstraints)
+	resp.Diagnostics.Append(dErr...)
+	plan.Placement, dErr = placementFromMachines(ctx, plan.MapMachines, readResp.Placement)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	plan.Principal = types.BoolNull()
 	plan.ApplicationName = types.StringValue(createResp.AppName)
+	setUnitAgentVersions(&plan, readResp)
+	planCharm.Name = types.StringValue(readResp.Name)
 	planCharm.Revision = types.Int64Value(int64(readResp.Revision))
 	planCharm.Base = types.StringValue(readResp.Base)
 	planCharm.Series = types.StringValue(readResp.Series)
 	planCharm.Channel = types.StringValue(readResp.Channel)
 	charmType := req.Config.Schema.GetBlocks()[CharmKey].(schema.ListNestedBlock).NestedObject.Type()
-	var dErr diag.Diagnostics
 	plan.Charm, dErr = types.ListValueFrom(ctx, charmType, []nestedCharm{planCharm})
 	if dErr.HasError() {
 		resp.Diagnostics.Append(dErr...)
@@ -618,10 +1687,15 @@ func (r *applicationResource) Create(ctx context.Context, req resource.CreateReq
 		plan.Storage = types.SetNull(storageType)
 	}
 
-	plan.ID = types.StringValue(newAppID(plan.ModelName.ValueString(), createResp.AppName))
+	plan.ID = types.StringValue(ids.ApplicationID{Model: plan.ModelName.ValueString(), Application: createResp.AppName}.String())
 	r.trace("Created", applicationResourceModelForLogging(ctx, &plan))
+	r.client.RecordOperation(ctx, applicationOperationSummary(ctx, juju.OperationCreate, nil, &plan))
 
+	plan.CLIEquivalent = applicationCLIEquivalent(ctx, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if waitErr != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Application %q is not ready, got error: %s", createResp.AppName, waitErr))
+	}
 }
 
 func transformSizeToHumanizedFormat(size uint64) string {
@@ -668,17 +1742,21 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 		"ID": state.ID.ValueString(),
 	})
 
-	modelName, appName, dErr := modelAppNameFromID(state.ID.ValueString())
-	if dErr.HasError() {
-		resp.Diagnostics.Append(dErr...)
+	appID, err := ids.ParseApplicationID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	modelName, appName := appID.Model, appID.Application
 
-	response, err := r.client.Applications.ReadApplication(&juju.ReadApplicationInput{
+	response, err := r.client.Applications.ReadApplication(ctx, &juju.ReadApplicationInput{
 		ModelName: modelName,
 		AppName:   appName,
 	})
 	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "application") {
+			return
+		}
 		resp.Diagnostics.Append(handleApplicationNotFoundError(ctx, err, &resp.State)...)
 		return
 	}
@@ -687,23 +1765,75 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 	r.trace("read application", map[string]interface{}{"resource": appName, "response": response})
 
+	// The name is only missing from the state of an imported application.
+	if !state.Description.IsNull() || state.ApplicationName.IsNull() {
+		state.Description, err = readDescription(ctx, r.client, modelName, names.NewApplicationTag(appName).String())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the description of application %q, got error: %s", appName, err))
+			return
+		}
+	}
 	state.ApplicationName = types.StringValue(appName)
 	state.ModelName = types.StringValue(modelName)
 
 	// Use the response to fill in state
 
-	state.Placement = types.StringValue(response.Placement)
+	var dErr diag.Diagnostics
+	state.Placement, dErr = placementFromMachines(ctx, state.MapMachines, response.Placement)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	state.Principal = types.BoolNull()
-	state.UnitCount = types.Int64Value(int64(response.Units))
+	resp.Diagnostics.Append(setSubordinate(ctx, resp.Private, !response.Principal)...)
+	if response.Principal {
+		state.UnitCount = types.Int64Value(int64(response.Units))
+	} else if state.UnitCount.IsNull() {
+		// The units of a subordinate application follow its relations,
+		// they are not managed by terraform.
+		state.UnitCount = types.Int64Value(0)
+	}
 	state.Trust = types.BoolValue(response.Trust)
+	if state.WaitForReady.IsNull() {
+		// An imported application does not have it set yet.
+		state.WaitForReady = types.BoolValue(false)
+	}
+	if state.WaitForActive.IsNull() {
+		state.WaitForActive = types.BoolValue(false)
+	}
+	if state.UpgradePolicy.IsNull() {
+		state.UpgradePolicy = types.StringValue(juju.UpgradePolicyRefresh)
+	}
+	if state.AllowMajorUpgrade.IsNull() {
+		state.AllowMajorUpgrade = types.BoolValue(false)
+	}
+	if state.RetainStorageOnReplace.IsNull() {
+		state.RetainStorageOnReplace = types.BoolValue(false)
+	}
 
 	// state requiring transformation
+	stateCharms := []nestedCharm{}
+	resp.Diagnostics.Append(state.Charm.ElementsAs(ctx, &stateCharms, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The base selection and the local charm cannot be read back from
+	// juju, they are kept from the prior state.
+	baseSelection := types.StringNull()
+	charmPath, charmSHA256 := types.StringNull(), types.StringNull()
+	if len(stateCharms) > 0 {
+		baseSelection = stateCharms[0].BaseSelection
+		charmPath, charmSHA256 = stateCharms[0].Path, stateCharms[0].SHA256
+	}
 	dataCharm := nestedCharm{
-		Name:     types.StringValue(response.Name),
-		Channel:  types.StringValue(response.Channel),
-		Revision: types.Int64Value(int64(response.Revision)),
-		Base:     types.StringValue(response.Base),
-		Series:   types.StringValue(response.Series),
+		Name:          types.StringValue(response.Name),
+		Path:          charmPath,
+		SHA256:        charmSHA256,
+		Channel:       types.StringValue(response.Channel),
+		Revision:      types.Int64Value(int64(response.Revision)),
+		Base:          types.StringValue(response.Base),
+		Series:        types.StringValue(response.Series),
+		BaseSelection: baseSelection,
 	}
 	charmType := req.State.Schema.GetBlocks()[CharmKey].(schema.ListNestedBlock).NestedObject.Type()
 	state.Charm, dErr = types.ListValueFrom(ctx, charmType, []nestedCharm{dataCharm})
@@ -714,11 +1844,37 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Constraints do not apply to subordinate applications. If the application
 	// is subordinate, the constraints will be set to the empty string.
-	state.Constraints = types.StringValue(response.Constraints.String())
+	state.Constraints = constraintsStringValue(state.Constraints, response.Constraints)
+	state.StructuredConstraints, dErr = applicationConstraintsFromValue(ctx, response.Constraints, state.StructuredConstraints)
+	if dErr.HasError() {
+		resp.Diagnostics.Append(dErr...)
+		return
+	}
 
 	exposeType := req.State.Schema.GetBlocks()[ExposeKey].(schema.ListNestedBlock).NestedObject.Type()
 	if response.Expose != nil {
 		exp := parseNestedExpose(response.Expose)
+		// In auto mode the exposed endpoints are picked by the
+		// provider, keep the mode from the state and leave the
+		// endpoints unset.
+		var stateExpose []nestedExpose
+		resp.Diagnostics.Append(state.Expose.ElementsAs(ctx, &stateExpose, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(stateExpose) == 1 {
+			// Juju does not keep the order of the lists, keep
+			// the one from the state when the lists match.
+			exp.Endpoints = sameCommaDelimitedList(exp.Endpoints, stateExpose[0].Endpoints)
+			exp.Spaces = sameCommaDelimitedList(exp.Spaces, stateExpose[0].Spaces)
+			exp.Cidrs = sameCommaDelimitedList(exp.Cidrs, stateExpose[0].Cidrs)
+			if !stateExpose[0].Mode.IsNull() {
+				exp.Mode = stateExpose[0].Mode
+				if exp.isAuto() {
+					exp.Endpoints = types.StringNull()
+				}
+			}
+		}
 		state.Expose, dErr = types.ListValueFrom(ctx, exposeType, []nestedExpose{exp})
 		if dErr.HasError() {
 			resp.Diagnostics.Append(dErr...)
@@ -769,16 +1925,26 @@ func (r *applicationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	resourceType := req.State.Schema.GetAttributes()[ResourceKey].(schema.MapAttribute).ElementType
-	state.Resources, dErr = r.configureResourceData(ctx, resourceType, state.Resources, response.Resources)
+	state.Resources, dErr = r.configureResourceData(ctx, resourceType, state.Resources, response.Resources, response.UploadedResources)
 	if dErr.HasError() {
 		resp.Diagnostics.Append(dErr...)
 		return
 	}
+	setUnitAgentVersions(&state, response)
 
 	r.trace("Found", applicationResourceModelForLogging(ctx, &state))
+	state.CLIEquivalent = applicationCLIEquivalent(ctx, state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// setUnitAgentVersions sets the agent versions of the units of the
+// application read from juju.
+func setUnitAgentVersions(model *applicationResourceModel, response *juju.ReadApplicationResponse) {
+	model.MinUnitAgentVersion = types.StringValue(response.MinUnitAgentVersion)
+	model.MaxUnitAgentVersion = types.StringValue(response.MaxUnitAgentVersion)
+	model.AgentUpgradePending = types.BoolValue(response.AgentUpgradePending)
+}
+
 func (r *applicationResource) configureConfigData(ctx context.Context, configType attr.Type, config types.Map, respCfg map[string]juju.ConfigEntry) (types.Map, diag.Diagnostics) {
 	// We focus on those config entries that are not the default value.
 	// If the value was the same we ignore it. If no changes were made,
@@ -831,7 +1997,10 @@ func (r *applicationResource) toEndpointBindingsSet(ctx context.Context, endpoin
 	return types.SetValueFrom(ctx, endpointBindingsType, endpointBindingsSlice)
 }
 
-func (r *applicationResource) configureResourceData(ctx context.Context, resourceType attr.Type, resources types.Map, respResources map[string]string) (types.Map, diag.Diagnostics) {
+// configureResourceData refreshes the resources in state with those of
+// the application, so that resources changed outside of terraform are
+// reported as drift. Resources not in state are left to the charm.
+func (r *applicationResource) configureResourceData(ctx context.Context, resourceType attr.Type, resources types.Map, respResources map[string]string, uploadedResources []string) (types.Map, diag.Diagnostics) {
 	var previousResources map[string]string
 	diagErr := resources.ElementsAs(ctx, &previousResources, false)
 	if diagErr.HasError() {
@@ -844,14 +2013,22 @@ func (r *applicationResource) configureResourceData(ctx context.Context, resourc
 	// known previously
 	// update the values from the previous config
 	changes := false
-	for k, v := range respResources {
-		// Add if the value has changed from the previous state
-		if previousValue, found := previousResources[k]; found {
-			if v != previousValue {
-				// remember that this Terraform schema type only accepts strings
-				previousResources[k] = v
+	for k, previousValue := range previousResources {
+		if slices.Contains(uploadedResources, k) {
+			// An uploaded file or OCI image has no meaningful revision,
+			// the value in state is kept unless it is a revision, in
+			// which case the resource was attached outside of terraform.
+			if _, err := strconv.Atoi(previousValue); err == nil {
+				previousResources[k] = uploadedResourceValue
 				changes = true
 			}
+			continue
+		}
+		// Add if the value has changed from the previous state
+		if v, found := respResources[k]; found && v != previousValue {
+			// remember that this Terraform schema type only accepts strings
+			previousResources[k] = v
+			changes = true
 		}
 	}
 	if changes {
@@ -882,16 +2059,29 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 	r.trace("Current state", applicationResourceModelForLogging(ctx, &state))
 
 	updateApplicationInput := juju.UpdateApplicationInput{
-		ModelName: state.ModelName.ValueString(),
-		AppName:   state.ApplicationName.ValueString(),
+		ModelName:     state.ModelName.ValueString(),
+		AppName:       state.ApplicationName.ValueString(),
+		UpgradePolicy: plan.UpgradePolicy.ValueString(),
 	}
 
 	if !plan.ApplicationName.IsUnknown() && !plan.ApplicationName.Equal(state.ApplicationName) {
 		resp.Diagnostics.AddWarning("Unsupported", "unable to update application name")
 	}
 
-	if !plan.UnitCount.Equal(state.UnitCount) {
+	subordinate, dErr := isSubordinate(ctx, req.Private)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The units of a subordinate application follow its relations.
+	if !plan.UnitCount.Equal(state.UnitCount) && !subordinate {
 		updateApplicationInput.Units = intPtr(plan.UnitCount)
+		resp.Diagnostics.Append(plan.UnitPlacement.ElementsAs(ctx, &updateApplicationInput.UnitPlacement, false)...)
+		resp.Diagnostics.Append(plan.MapMachines.ElementsAs(ctx, &updateApplicationInput.MapMachines, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	if !plan.Trust.Equal(state.Trust) {
@@ -907,12 +2097,20 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		planCharm := planCharms[0]
 		stateCharm := stateCharms[0]
-		if !planCharm.Channel.Equal(stateCharm.Channel) && !planCharm.Revision.Equal(stateCharm.Revision) {
-			resp.Diagnostics.AddWarning("Not Supported", "Changing an application's revision and channel at the same time.")
-		} else if !planCharm.Channel.Equal(stateCharm.Channel) {
-			updateApplicationInput.Channel = planCharm.Channel.ValueString()
-		} else if !planCharm.Revision.Equal(stateCharm.Revision) {
-			updateApplicationInput.Revision = intPtr(planCharm.Revision)
+		if !planCharm.Path.IsNull() {
+			// The revision of a local charm is set by the controller
+			// when it is uploaded.
+			if !planCharm.SHA256.Equal(stateCharm.SHA256) {
+				updateApplicationInput.CharmPath = planCharm.Path.ValueString()
+			}
+		} else {
+			if !planCharm.Channel.Equal(stateCharm.Channel) {
+				updateApplicationInput.Channel = planCharm.Channel.ValueString()
+			}
+			// An unknown revision follows the new channel.
+			if !planCharm.Revision.IsUnknown() && !planCharm.Revision.Equal(stateCharm.Revision) {
+				updateApplicationInput.Revision = intPtr(planCharm.Revision)
+			}
 		}
 
 		if !planCharm.Series.Equal(stateCharm.Series) || !planCharm.Base.Equal(stateCharm.Base) {
@@ -960,14 +2158,17 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
-	// if resources in the plan are equal to resources stored in the state,
-	// we pass on the resources specified in the plan, which tells the provider
-	// NOT to update resources, because we want resources fixed to those
-	// specified in the plan.
+	// if resources in the plan are equal to resources stored in the state
+	// and the charm is refreshed, we pass on the resources specified in the
+	// plan, which tells the provider NOT to update those resources, because
+	// we want them fixed to those specified in the plan. The other resources
+	// follow the new charm. Without a charm refresh there is nothing to do.
 	if plan.Resources.Equal(state.Resources) {
-		planResourceMap := make(map[string]string)
-		resp.Diagnostics.Append(plan.Resources.ElementsAs(ctx, &planResourceMap, false)...)
-		updateApplicationInput.Resources = planResourceMap
+		if updateApplicationInput.Channel != "" || updateApplicationInput.Revision != nil || updateApplicationInput.CharmPath != "" {
+			planResourceMap := make(map[string]string)
+			resp.Diagnostics.Append(plan.Resources.ElementsAs(ctx, &planResourceMap, false)...)
+			updateApplicationInput.Resources = planResourceMap
+		}
 	} else {
 		planResourceMap := make(map[string]string)
 		stateResourceMap := make(map[string]string)
@@ -1002,6 +2203,13 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
+	if !plan.Description.Equal(state.Description) {
+		if err := setDescription(ctx, r.client, updateApplicationInput.ModelName, names.NewApplicationTag(updateApplicationInput.AppName).String(), plan.Description); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the description of application %q, got error: %s", updateApplicationInput.AppName, err))
+			return
+		}
+	}
+
 	// Do not use .Equal() here as we should consider null constraints the same
 	// as empty-string constraints. Terraform considers them different, so will
 	// incorrectly attempt to update the constraints, which can cause trouble
@@ -1013,6 +2221,14 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		updateApplicationInput.Constraints = &appConstraints
 	}
+	// Changing the value of a constraint replaces the application, the
+	// structured constraints not configured are those of the plan.
+	planConstraints, err := constraints.Parse(plan.Constraints.ValueString())
+	if err == nil {
+		var dErr diag.Diagnostics
+		plan.StructuredConstraints, dErr = applicationConstraintsFromValue(ctx, planConstraints, plan.StructuredConstraints)
+		resp.Diagnostics.Append(dErr...)
+	}
 
 	if !plan.EndpointBindings.Equal(state.EndpointBindings) {
 		endpointBindings, dErr := r.computeEndpointBindingsDeltas(ctx, state.EndpointBindings, plan.EndpointBindings)
@@ -1034,17 +2250,41 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		updateApplicationInput.StorageConstraints = directives
 	}
 
-	if err := r.client.Applications.UpdateApplication(&updateApplicationInput); err != nil {
+	if err := r.client.Applications.UpdateApplication(ctx, &updateApplicationInput); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update application resource, got error: %s", err))
 		return
 	}
 
+	var waitErr error
+	if (plan.WaitForReady.ValueBool() || plan.WaitForActive.ValueBool()) && (updateApplicationInput.Channel != "" ||
+		updateApplicationInput.Revision != nil ||
+		updateApplicationInput.CharmPath != "" ||
+		updateApplicationInput.Units != nil ||
+		len(updateApplicationInput.Resources) > 0) {
+		timeout, dErr := waitTimeout(ctx, plan.Timeouts, "update")
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The application has been updated, a failed wait is reported
+		// once the state is saved.
+		waitErr = r.client.Applications.WaitForApplicationReady(ctx, &juju.WaitForApplicationReadyInput{
+			ModelName:   updateApplicationInput.ModelName,
+			AppName:     updateApplicationInput.AppName,
+			Units:       int(plan.UnitCount.ValueInt64()),
+			Subordinate: subordinate,
+			Active:      plan.WaitForActive.ValueBool(),
+			Timeout:     timeout,
+		})
+	}
+
 	// If the plan has refreshed the charm, changed the unit count,
 	// or changed placement, wait for the changes to be seen in
 	// status. Including storage as it can be added on a refresh.
 	storageType := req.Config.Schema.GetAttributes()[StorageKey].(schema.SetNestedAttribute).NestedObject.Type()
 	if updateApplicationInput.Channel != "" ||
 		updateApplicationInput.Revision != nil ||
+		updateApplicationInput.CharmPath != "" ||
 		updateApplicationInput.Placement != nil ||
 		updateApplicationInput.Units != nil {
 		readResp, err := r.client.Applications.ReadApplicationWithRetryOnNotFound(ctx, &juju.ReadApplicationInput{
@@ -1055,7 +2295,29 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read application resource after update, got error: %s", err))
 			return
 		}
-		plan.Placement = types.StringValue(readResp.Placement)
+		var dErr diag.Diagnostics
+		plan.Placement, dErr = placementFromMachines(ctx, plan.MapMachines, readResp.Placement)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		setUnitAgentVersions(&plan, readResp)
+		var planCharms []nestedCharm
+		resp.Diagnostics.Append(plan.Charm.ElementsAs(ctx, &planCharms, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The revision of a refreshed charm which is not configured,
+		// e.g. following a channel or a local charm, is set by juju.
+		if len(planCharms) == 1 && planCharms[0].Revision.IsUnknown() {
+			planCharms[0].Revision = types.Int64Value(int64(readResp.Revision))
+			charmType := req.Config.Schema.GetBlocks()[CharmKey].(schema.ListNestedBlock).NestedObject.Type()
+			plan.Charm, dErr = types.ListValueFrom(ctx, charmType, planCharms)
+			resp.Diagnostics.Append(dErr...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
 
 		var nestedStorageSlice []nestedStorage
 		for name, storage := range readResp.Storage {
@@ -1083,12 +2345,27 @@ func (r *applicationResource) Update(ctx context.Context, req resource.UpdateReq
 		} else {
 			plan.Storage.IsNull()
 		}
+		// Unknown if the state predates the agent versions.
+		if plan.MinUnitAgentVersion.IsUnknown() {
+			plan.MinUnitAgentVersion = state.MinUnitAgentVersion
+		}
+		if plan.MaxUnitAgentVersion.IsUnknown() {
+			plan.MaxUnitAgentVersion = state.MaxUnitAgentVersion
+		}
+		if plan.AgentUpgradePending.IsUnknown() {
+			plan.AgentUpgradePending = state.AgentUpgradePending
+		}
 	}
 
-	plan.ID = types.StringValue(newAppID(plan.ModelName.ValueString(), plan.ApplicationName.ValueString()))
+	plan.ID = types.StringValue(ids.ApplicationID{Model: plan.ModelName.ValueString(), Application: plan.ApplicationName.ValueString()}.String())
 	plan.Principal = types.BoolNull()
 	r.trace("Updated", applicationResourceModelForLogging(ctx, &plan))
+	r.client.RecordOperation(ctx, applicationOperationSummary(ctx, juju.OperationUpdate, &state, &plan))
+	plan.CLIEquivalent = applicationCLIEquivalent(ctx, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if waitErr != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Application %q is not ready after update, got error: %s", updateApplicationInput.AppName, waitErr))
+	}
 }
 
 // updateStorage compares the plan storage directives to the
@@ -1169,6 +2446,17 @@ func (r *applicationResource) computeExposeDeltas(ctx context.Context, stateExpo
 	plan := planNestedExpose[0].transformToMapStringInterface()
 	state := stateNestedExpose[0].transformToMapStringInterface()
 
+	// Switching from or to the auto mode changes the exposed
+	// endpoints, unexpose everything and expose the plan. In auto
+	// mode, the plan is exposed again on any change so the spaces
+	// and CIDRs are applied to all the charm endpoints.
+	if planNestedExpose[0].isAuto() != stateNestedExpose[0].isAuto() {
+		return plan, []string{""}, diags
+	}
+	if planNestedExpose[0].isAuto() {
+		return plan, toUnexpose, diags
+	}
+
 	// if we have plan endpoints we have to expose them
 	for endpoint, v := range plan {
 		_, found := state[endpoint]
@@ -1244,18 +2532,94 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		"ID": state.ID.ValueString(),
 	})
 
-	modelName, appName, dErr := modelAppNameFromID(state.ID.ValueString())
-	if dErr.HasError() {
-		resp.Diagnostics.Append(dErr...)
+	appID, err := ids.ParseApplicationID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
 	}
+	modelName, appName := appID.Model, appID.Application
 
-	if err := r.client.Applications.DestroyApplication(&juju.DestroyApplicationInput{
+	var actions []nestedPreDestroyAction
+	resp.Diagnostics.Append(state.PreDestroyAction.ElementsAs(ctx, &actions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(actions) == 1 {
+		action := actions[0]
+		actionParams := make(map[string]string)
+		resp.Diagnostics.Append(action.Params.ElementsAs(ctx, &actionParams, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		timeout, _ := time.ParseDuration(action.Timeout.ValueString())
+		r.trace(fmt.Sprintf("running pre destroy action %q of application %q", action.Name.ValueString(), appName))
+		if err := r.client.Applications.RunLeaderAction(ctx, &juju.RunLeaderActionInput{
+			ModelName:  modelName,
+			AppName:    appName,
+			ActionName: action.Name.ValueString(),
+			Params:     actionParams,
+			Timeout:    timeout,
+		}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run the pre destroy action %q of application %q, "+
+				"the application has not been destroyed, got error: %s", action.Name.ValueString(), appName, err))
+			return
+		}
+	}
+
+	if err := r.client.Applications.DestroyApplication(ctx, &juju.DestroyApplicationInput{
 		ApplicationName: appName,
 		ModelName:       modelName,
+		RetainStorage:   state.RetainStorageOnReplace.ValueBool(),
 	}); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete application, got error: %s", err))
+		return
 	}
 	r.trace(fmt.Sprintf("deleted application resource %q", state.ID.ValueString()))
+	r.client.RecordOperation(ctx, applicationOperationSummary(ctx, juju.OperationDelete, &state, nil))
+}
+
+// applicationOperationSummary returns the summary of an operation on an
+// application: its charm, channel, revision and unit count before and
+// after the operation. The model before a create and after a delete is
+// nil. On update only the values which changed are included.
+func applicationOperationSummary(ctx context.Context, operation string, before, after *applicationResourceModel) juju.OperationSummary {
+	summarize := func(app *applicationResourceModel) map[string]interface{} {
+		if app == nil {
+			return nil
+		}
+		values := map[string]interface{}{
+			"units": app.UnitCount.ValueInt64(),
+		}
+		var charms []nestedCharm
+		_ = app.Charm.ElementsAs(ctx, &charms, false)
+		if len(charms) == 1 {
+			values["charm"] = charms[0].Name.ValueString()
+			values["channel"] = charms[0].Channel.ValueString()
+			values["revision"] = charms[0].Revision.ValueInt64()
+		}
+		return values
+	}
+
+	summary := juju.OperationSummary{
+		Resource:  "juju_application",
+		Operation: operation,
+		Changes:   make(map[string]juju.OperationChange),
+	}
+	if after != nil {
+		summary.ID = after.ID.ValueString()
+	} else if before != nil {
+		summary.ID = before.ID.ValueString()
+	}
+	beforeValues, afterValues := summarize(before), summarize(after)
+	for _, key := range []string{"charm", "channel", "revision", "units"} {
+		b, inBefore := beforeValues[key]
+		a, inAfter := afterValues[key]
+		if !inBefore && !inAfter || b == a {
+			continue
+		}
+		summary.Changes[key] = juju.OperationChange{Before: b, After: a}
+	}
+	return summary
 }
 
 // ImportState is called when the provider must import the state of a
@@ -1265,23 +2629,7 @@ func (r *applicationResource) Delete(ctx context.Context, req resource.DeleteReq
 // If setting an attribute with the import identifier, it is recommended
 // to use the ImportStatePassthroughID() call in this method.
 func (r *applicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
-
-// ID is '<model name>:<app name>'
-func newAppID(model, app string) string {
-	return fmt.Sprintf("%s:%s", model, app)
-}
-
-func modelAppNameFromID(value string) (string, string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	id := strings.Split(value, ":")
-	//If importing with an incorrect ID we need to catch and provide a user-friendly error
-	if len(id) != 2 {
-		diags.AddError("Malformed ID", fmt.Sprintf("unable to parse model and application name from provided ID: %q", value))
-		return "", "", diags
-	}
-	return id[0], id[1], diags
+	importStatePassthroughValidID(ctx, ids.ParseApplicationID, req, resp)
 }
 
 func (r *applicationResource) trace(msg string, additionalFields ...map[string]interface{}) {
@@ -1295,6 +2643,34 @@ func (r *applicationResource) trace(msg string, additionalFields ...map[string]i
 	tflog.SubsystemTrace(r.subCtx, LogResourceApplication, msg, additionalFields...)
 }
 
+// placementFromMachines returns the placement to keep in state from the
+// sorted machines hosting the units of the application. Machines mapped with
+// map_machines are reported with the IDs used in the placement.
+func placementFromMachines(ctx context.Context, mapMachines types.Map, machines string) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if mapMachines.IsNull() || mapMachines.IsUnknown() {
+		return types.StringValue(machines), diags
+	}
+	mapping := make(map[string]string)
+	diags.Append(mapMachines.ElementsAs(ctx, &mapping, false)...)
+	if diags.HasError() {
+		return types.StringNull(), diags
+	}
+	reverse := make(map[string]string, len(mapping))
+	for from, to := range mapping {
+		reverse[to] = from
+	}
+	placement, err := juju.MapPlacementMachines(machines, reverse)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to map the machines of the application, got error: %s", err))
+		return types.StringNull(), diags
+	}
+	// Keep the machines sorted as when they are not mapped.
+	directives := strings.Split(placement, ",")
+	sort.Strings(directives)
+	return types.StringValue(strings.Join(directives, ",")), diags
+}
+
 func applicationResourceModelForLogging(_ context.Context, app *applicationResourceModel) map[string]interface{} {
 	value := map[string]interface{}{
 		"application-name": app.ApplicationName.ValueString(),
@@ -1305,6 +2681,8 @@ func applicationResourceModelForLogging(_ context.Context, app *applicationResou
 		"expose":           app.Expose.String(),
 		"trust":            app.Trust.ValueBoolPointer(),
 		"units":            app.UnitCount.ValueInt64(),
+		"wait-for-ready":   app.WaitForReady.ValueBool(),
+		"wait-for-active":  app.WaitForActive.ValueBool(),
 		"storage":          app.Storage.String(),
 	}
 	return value
@@ -4,28 +4,405 @@
 package provider
 
 import (
+	"archive/zip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	apiapplication "github.com/juju/juju/api/client/application"
 	apiclient "github.com/juju/juju/api/client/client"
 	apispaces "github.com/juju/juju/api/client/spaces"
+	apistorage "github.com/juju/juju/api/client/storage"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/names/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 	internaljuju "github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
 	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
 )
 
+func TestCharmConfigWarnings(t *testing.T) {
+	options := map[string]juju.CharmConfigOption{
+		"log-level":  {Type: "string", Description: "The log level."},
+		"debug-mode": {Type: "boolean", Description: "DEPRECATED: use log-level instead."},
+	}
+	config := map[string]string{
+		"log-level":  "info",
+		"debug-mode": "true",
+		"removed":    "value",
+	}
+
+	diags := charmConfigWarnings(config, options)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	summaries := make(map[string]string)
+	for _, d := range diags.Warnings() {
+		summaries[d.(diag.DiagnosticWithPath).Path().String()] = d.Summary()
+	}
+	expected := map[string]string{
+		`config["debug-mode"]`: "Deprecated Charm Config Option",
+		`config["removed"]`:    "Unknown Charm Config Option",
+	}
+	if fmt.Sprint(summaries) != fmt.Sprint(expected) {
+		t.Fatalf("expected warnings %v, got %v", expected, summaries)
+	}
+}
+
+func TestValidateCharmhubPlan(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	applicationsClient := mocks.NewMockApplicationsClient(ctlr)
+
+	r := &applicationResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	charm := nestedCharm{
+		Name:          types.StringValue("postgresql"),
+		Path:          types.StringNull(),
+		SHA256:        types.StringUnknown(),
+		Channel:       types.StringValue("14/edge"),
+		Revision:      types.Int64Null(),
+		Base:          types.StringValue("ubuntu@22.04"),
+		Series:        types.StringUnknown(),
+		BaseSelection: types.StringNull(),
+	}
+	require.False(t, plan.SetAttribute(ctx, path.Root("model"), "development").HasError())
+	require.False(t, plan.SetAttribute(ctx, path.Root(CharmKey), []nestedCharm{charm}).HasError())
+	nullState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	modifyPlan := func(features map[string]bool) fwresource.ModifyPlanResponse {
+		client, err := juju.NewClient(ctx, juju.ControllerConfiguration{Features: features})
+		require.NoError(t, err)
+		client.Applications = applicationsClient
+		r.client = client
+		resp := fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: nullState}, &resp)
+		return resp
+	}
+
+	// Charms are not resolved when planning by default.
+	resp := modifyPlan(nil)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// Charms which cannot be resolved fail the plan with the feature.
+	features := map[string]bool{featureCharmhubPlanValidation: true}
+	applicationsClient.EXPECT().ResolveCharm(gomock.Any(), &juju.ResolveCharmInput{
+		ModelName: "development",
+		CharmName: "postgresql",
+		Channel:   "14/edge",
+		Revision:  juju.UnspecifiedRevision,
+		Base:      "ubuntu@22.04",
+	}).Return(errors.New("channel not found"))
+	resp = modifyPlan(features)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Charm Not Found", resp.Diagnostics.Errors()[0].Summary())
+
+	applicationsClient.EXPECT().ResolveCharm(gomock.Any(), gomock.Any()).Return(nil)
+	resp = modifyPlan(features)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// Models created by the same apply are left to the deployment.
+	applicationsClient.EXPECT().ResolveCharm(gomock.Any(), gomock.Any()).Return(juju.ModelNotFoundError)
+	resp = modifyPlan(features)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+func TestKnownMapElements(t *testing.T) {
+	ctx := context.Background()
+	config := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"log-level":  types.StringValue("info"),
+		"db-address": types.StringUnknown(),
+		"unset":      types.StringNull(),
+	})
+	known, diags := knownMapElements(ctx, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	if expected := map[string]string{"log-level": "info"}; fmt.Sprint(known) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, known)
+	}
+}
+
+func TestResourceAttachWarning(t *testing.T) {
+	ctx := context.Background()
+	state := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"file-res":  types.StringValue("./old.tar"),
+		"image-res": types.StringValue("2"),
+	})
+	plan := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"file-res":  types.StringValue("./new.tar"),
+		"image-res": types.StringValue("2"),
+		"extra-res": types.StringValue("3"),
+	})
+
+	diags := resourceAttachWarning(ctx, plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	if len(diags.Warnings()) != 1 {
+		t.Fatalf("expected one warning, got %v", diags.Warnings())
+	}
+	if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, "extra-res, file-res.") {
+		t.Fatalf("unexpected warning detail %q", detail)
+	}
+	if diags := resourceAttachWarning(ctx, state, state); len(diags) != 0 {
+		t.Fatalf("expected no warnings, got %v", diags)
+	}
+
+	// A revision only known at apply time is planned as a change.
+	plan = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"file-res":  types.StringValue("./old.tar"),
+		"image-res": types.StringUnknown(),
+	})
+	diags = resourceAttachWarning(ctx, plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	if len(diags.Warnings()) != 1 || !strings.Contains(diags.Warnings()[0].Detail(), ": image-res.") {
+		t.Fatalf("expected a warning for image-res, got %v", diags.Warnings())
+	}
+}
+
+func TestConfigureResourceData(t *testing.T) {
+	ctx := context.Background()
+	state := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"store-res":    types.StringValue("3"),
+		"image-res":    types.StringValue("ghcr.io/canonical/image:1.0"),
+		"attached-res": types.StringValue("2"),
+	})
+	r := &applicationResource{}
+
+	// The revision from Charmhub is refreshed, the OCI image in state is
+	// kept and a revision replaced by an upload is reported.
+	got, diags := r.configureResourceData(ctx, types.StringType, state,
+		map[string]string{"store-res": "4", "unmanaged-res": "1"}, []string{"attached-res", "image-res"})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"store-res":    types.StringValue("4"),
+		"image-res":    types.StringValue("ghcr.io/canonical/image:1.0"),
+		"attached-res": types.StringValue(uploadedResourceValue),
+	})
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	// Resources are left null when none are in state.
+	got, diags = r.configureResourceData(ctx, types.StringType, types.MapNull(types.StringType),
+		map[string]string{"store-res": "4"}, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	if !got.IsNull() {
+		t.Errorf("expected null resources, got %s", got)
+	}
+}
+
+func TestPlacementFromMachines(t *testing.T) {
+	ctx := context.Background()
+	mapMachines := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"0": types.StringValue("7"),
+		"1": types.StringValue("4"),
+	})
+	tests := []struct {
+		mapMachines types.Map
+		machines    string
+		expected    types.String
+	}{
+		{mapMachines, "4,7", types.StringValue("0,1")},
+		{mapMachines, "2,7/lxd/0", types.StringValue("0/lxd/0,2")},
+		{mapMachines, "", types.StringValue("")},
+		{types.MapNull(types.StringType), "4,7", types.StringValue("4,7")},
+	}
+	for _, test := range tests {
+		got, diags := placementFromMachines(ctx, test.mapMachines, test.machines)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags.Errors())
+		}
+		if !got.Equal(test.expected) {
+			t.Errorf("machines %q: expected %s, got %s", test.machines, test.expected, got)
+		}
+	}
+}
+
+func TestPlacementFromDirectives(t *testing.T) {
+	ctx := context.Background()
+	directiveType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"scope":          types.StringType,
+		"directive":      types.StringType,
+		"container_type": types.StringType,
+	}}
+	directive := func(scope, machine, containerType string) attr.Value {
+		value := func(s string) types.String {
+			if s == "" {
+				return types.StringNull()
+			}
+			return types.StringValue(s)
+		}
+		return types.ObjectValueMust(directiveType.AttrTypes, map[string]attr.Value{
+			"scope":          types.StringValue(scope),
+			"directive":      value(machine),
+			"container_type": value(containerType),
+		})
+	}
+	tests := []struct {
+		directives types.Set
+		expected   string
+	}{
+		{types.SetNull(directiveType), ""},
+		{types.SetValueMust(directiveType, []attr.Value{
+			directive("machine", "3", ""),
+			directive("machine", "1/lxd/0", ""),
+		}), "1/lxd/0,3"},
+		{types.SetValueMust(directiveType, []attr.Value{
+			directive("container", "2", "lxd"),
+			directive("container", "", "kvm"),
+		}), "kvm,lxd:2"},
+	}
+	for _, test := range tests {
+		got, diags := placementFromDirectives(ctx, test.directives)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags.Errors())
+		}
+		if got != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, got)
+		}
+	}
+}
+
+func TestSameCommaDelimitedList(t *testing.T) {
+	tests := []struct {
+		current, prior, expected types.String
+	}{
+		{types.StringValue("admin,website"), types.StringValue("website, admin"), types.StringValue("website, admin")},
+		{types.StringValue("admin,website"), types.StringValue("website"), types.StringValue("admin,website")},
+		{types.StringValue("admin"), types.StringNull(), types.StringValue("admin")},
+		{types.StringNull(), types.StringValue("admin"), types.StringNull()},
+	}
+	for _, test := range tests {
+		if got := sameCommaDelimitedList(test.current, test.prior); !got.Equal(test.expected) {
+			t.Errorf("sameCommaDelimitedList(%s, %s): expected %s, got %s", test.current, test.prior, test.expected, got)
+		}
+	}
+}
+
+func TestLocalCharmSHA256(t *testing.T) {
+	dir := t.TempDir()
+	charmPath := filepath.Join(dir, "test.charm")
+	if err := os.WriteFile(charmPath, []byte("charm"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := localCharmSHA256(charmPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte("charm"))
+	if expected := hex.EncodeToString(sum[:]); hash != expected {
+		t.Errorf("expected %q, got %q", expected, hash)
+	}
+
+	if _, err := localCharmSHA256(filepath.Join(dir, "missing.charm")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected a missing file to be rejected, got %v", err)
+	}
+}
+
+func TestLocalCharmDirSHA256(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "src"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("metadata.yaml", "name: test")
+	writeFile(filepath.Join("src", "charm.py"), "print('charm')")
+
+	hash, err := localCharmSHA256(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, err := localCharmSHA256(dir); err != nil || again != hash {
+		t.Errorf("expected the same hash %q, got %q, %v", hash, again, err)
+	}
+
+	// A change to a file changes the hash.
+	writeFile(filepath.Join("src", "charm.py"), "print('changed')")
+	changed, err := localCharmSHA256(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed == hash {
+		t.Errorf("expected the hash to change after a file changed")
+	}
+
+	// So does renaming a file.
+	if err := os.Rename(filepath.Join(dir, "src", "charm.py"), filepath.Join(dir, "src", "main.py")); err != nil {
+		t.Fatal(err)
+	}
+	renamed, err := localCharmSHA256(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renamed == changed {
+		t.Errorf("expected the hash to change after a file was renamed")
+	}
+}
+
+func TestMajorTrackChange(t *testing.T) {
+	tests := []struct {
+		from, to string
+		major    bool
+	}{
+		{"14/stable", "16/stable", true},
+		{"16/stable", "14/edge", true},
+		{"8.0/stable", "8.1/stable", false},
+		{"3.4-strict/stable", "4.0-strict/stable", true},
+		{"14/stable", "14/edge", false},
+		{"latest/stable", "16/stable", false},
+		{"stable", "14/stable", false},
+		{"", "16/stable", false},
+	}
+	for _, test := range tests {
+		if _, _, major := majorTrackChange(test.from, test.to); major != test.major {
+			t.Errorf("majorTrackChange(%q, %q): expected %t, got %t", test.from, test.to, test.major, major)
+		}
+	}
+}
+
 func TestAcc_ResourceApplication(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-application")
 	appName := "test-app"
@@ -57,6 +434,25 @@ func TestAcc_ResourceApplication(t *testing.T) {
 					resource.TestCheckResourceAttr("juju_application.this", "constraints", "arch=amd64 cores=1 mem=4096M"),
 				),
 			},
+			{
+				// The same constraints as attributes, written differently,
+				// do not replace the application.
+				SkipFunc: func() (bool, error) {
+					return testingCloud != LXDCloudTesting, nil
+				},
+				Config: testAccResourceApplicationStructuredConstraints(modelName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("juju_application.this", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "constraints", "arch=amd64 cores=1 mem=4096M"),
+					resource.TestCheckResourceAttr("juju_application.this", "structured_constraints.mem", "4G"),
+					resource.TestCheckResourceAttr("juju_application.this", "structured_constraints.cores", "1"),
+					resource.TestCheckResourceAttr("juju_application.this", "structured_constraints.arch", "amd64"),
+				),
+			},
 			{
 				// specific constraints for k8s
 				SkipFunc: func() (bool, error) {
@@ -84,9 +480,10 @@ func TestAcc_ResourceApplication(t *testing.T) {
 				),
 			},
 			{
-				ImportStateVerify: true,
-				ImportState:       true,
-				ResourceName:      "juju_application.this",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: unitAgentVersionAttributes,
+				ImportState:             true,
+				ResourceName:            "juju_application.this",
 			},
 		},
 	})
@@ -151,9 +548,233 @@ func TestAcc_ResourceApplication_Updates(t *testing.T) {
 				Check:  resource.TestCheckResourceAttr("juju_application.this", "expose.#", "1"),
 			},
 			{
-				ImportStateVerify: true,
-				ImportState:       true,
-				ResourceName:      "juju_application.this",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: unitAgentVersionAttributes,
+				ImportState:             true,
+				ResourceName:            "juju_application.this",
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_TrustDrift(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	resourceName := "juju_application.testapp"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationBasic_Minimal(modelName, "juju-qa-test"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "trust", "false"),
+			},
+			{
+				// Trust the application out of band, like `juju trust`.
+				PreConfig: func() {
+					trust := true
+					err := TestClient.Applications.UpdateApplication(context.Background(), &juju.UpdateApplicationInput{
+						ModelName: modelName,
+						AppName:   "juju-qa-test",
+						Trust:     &trust,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccResourceApplicationBasic_Minimal(modelName, "juju-qa-test"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "trust", "false"),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_ExposeAuto(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-expose")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationExposeMode(modelName, "auto"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "expose.#", "1"),
+					resource.TestCheckResourceAttr("juju_application.this", "expose.0.mode", "auto"),
+					resource.TestCheckNoResourceAttr("juju_application.this", "expose.0.endpoints"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationExposeMode(modelName, "all"),
+				Check:  resource.TestCheckResourceAttr("juju_application.this", "expose.0.mode", "all"),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_ExposeImport(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-expose")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationExposeEndpoints(modelName, "sink", "10.0.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "expose.#", "1"),
+					resource.TestCheckResourceAttr("juju_application.this", "expose.0.endpoints", "sink"),
+					resource.TestCheckResourceAttr("juju_application.this", "expose.0.cidrs", "10.0.0.0/24"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: unitAgentVersionAttributes,
+				ImportState:             true,
+				ResourceName:            "juju_application.this",
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_ColocateWith(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-colocate")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationColocateWith(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.colocated", "colocate_with", "test-app"),
+					resource.TestCheckResourceAttrPair("juju_application.colocated", "placement", "juju_application.this", "placement"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_SubordinateUnits(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-subordinate")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The units of the subordinate are not set and follow the
+				// integration, the plan must be empty after apply.
+				Config: testAccResourceApplicationSubordinateUnits(modelName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.subordinate", "units", "1"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationSubordinateUnits(modelName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "units", "2"),
+					resource.TestCheckResourceAttr("juju_application.subordinate", "units", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_MapMachines(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-map-machines")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationMapMachines(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "placement", "5"),
+					resource.TestCheckResourceAttrPair("juju_application.this", "map_machines.5", "juju_machine.this", "machine_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_PlacementDirective(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-placement-directive")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationPlacementDirective(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("juju_application.this", "placement", "juju_machine.this", "machine_id"),
+					resource.TestCheckResourceAttr("juju_application.this", "placement_directive.#", "1"),
+				),
+			},
+			{
+				// The directives are kept as configured.
+				Config:   testAccResourceApplicationPlacementDirective(modelName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_UnitPlacement(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-unit-placement")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationUnitPlacement(modelName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("juju_application.this", "placement", "juju_machine.first", "machine_id"),
+					resource.TestCheckResourceAttr("juju_application.this", "unit_placement.#", "2"),
+				),
+			},
+			{
+				// The added unit is placed with the second directive.
+				Config: testAccResourceApplicationUnitPlacement(modelName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application.this", "units", "2"),
+					resource.TestCheckResourceAttr("juju_application.this", "placement", "0,1"),
+				),
+			},
+			{
+				Config:   testAccResourceApplicationUnitPlacement(modelName, 2),
+				PlanOnly: true,
 			},
 		},
 	})
@@ -170,7 +791,7 @@ func TestAcc_ResourceApplication_UpdateImportedSubordinate(t *testing.T) {
 
 	ctx := context.Background()
 
-	_, err := TestClient.Models.CreateModel(juju.CreateModelInput{
+	_, err := TestClient.Models.CreateModel(context.Background(), juju.CreateModelInput{
 		Name: modelName,
 	})
 	if err != nil {
@@ -277,6 +898,44 @@ func TestAcc_CharmUpdates(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceApplication_UpgradePolicyChannelOnly(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	resourceName := "juju_application.this"
+	var revision string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationUpgradePolicy(modelName, "latest/stable", "channel-only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "upgrade_policy", "channel-only"),
+					resource.TestCheckResourceAttrWith(resourceName, "charm.0.revision", func(value string) error {
+						revision = value
+						return nil
+					}),
+				),
+			},
+			{
+				// The channel is set, the charm is not refreshed.
+				Config: testAccResourceApplicationUpgradePolicy(modelName, "latest/edge", "channel-only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "charm.0.channel", "latest/edge"),
+					resource.TestCheckResourceAttrWith(resourceName, "charm.0.revision", func(value string) error {
+						if value != revision {
+							return fmt.Errorf("expected revision %s to be kept, got %s", revision, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceRevisionUpdatesLXD(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -595,9 +1254,200 @@ func TestAcc_ResourceApplication_Minimal(t *testing.T) {
 					checkResourceAttr...),
 			},
 			{
-				ImportStateVerify: true,
-				ImportState:       true,
-				ResourceName:      resourceName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: unitAgentVersionAttributes,
+				ImportState:             true,
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_BaseSelection(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	resourceName := "juju_application.testapp"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceApplicationBaseSelection(modelName, "explicit"),
+				ExpectError: regexp.MustCompile("requires base or series to be set"),
+			},
+			{
+				Config: testAccResourceApplicationBaseSelection(modelName, "latest-lts"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "charm.0.base_selection", "latest-lts"),
+					resource.TestCheckResourceAttrSet(resourceName, "charm.0.base"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_ProviderDefaults(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	resourceName := "juju_application.testapp"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationProviderDefaults(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "charm.0.channel", "latest/edge"),
+					resource.TestCheckResourceAttr(resourceName, "charm.0.base", "ubuntu@20.04"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceApplication_CharmPath(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application")
+	resourceName := "juju_application.testapp"
+	charmPath := filepath.Join(t.TempDir(), "tf-local-test.charm")
+	var firstSHA256 string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeTestLocalCharm(t, charmPath, "first") },
+				Config:    testAccResourceApplicationCharmPath(modelName, charmPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-local-test"),
+					resource.TestCheckResourceAttr(resourceName, "charm.0.name", "tf-local-test"),
+					resource.TestCheckResourceAttr(resourceName, "charm.0.path", charmPath),
+					resource.TestCheckResourceAttrSet(resourceName, "charm.0.revision"),
+					resource.TestCheckResourceAttrWith(resourceName, "charm.0.sha256", func(value string) error {
+						firstSHA256 = value
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() { writeTestLocalCharm(t, charmPath, "second") },
+				Config:    testAccResourceApplicationCharmPath(modelName, charmPath),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrWith(resourceName, "charm.0.sha256", func(value string) error {
+					if value == firstSHA256 {
+						return fmt.Errorf("expected the hash of the updated charm, got %q", value)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+// writeTestLocalCharm writes a minimal machine charm archive, with the
+// given description, to the given path.
+func writeTestLocalCharm(t *testing.T, charmPath, description string) {
+	f, err := os.Create(charmPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	files := []struct {
+		name, content string
+		mode          os.FileMode
+	}{
+		{"metadata.yaml", fmt.Sprintf("name: tf-local-test\nsummary: Local test charm\ndescription: %s\n", description), 0644},
+		{"manifest.yaml", "bases:\n- name: ubuntu\n  channel: \"22.04\"\n  architectures: [amd64]\n", 0644},
+		{"dispatch", "#!/bin/sh\n", 0755},
+	}
+	w := zip.NewWriter(f)
+	for _, file := range files {
+		header := &zip.FileHeader{Name: file.name, Method: zip.Deflate}
+		header.SetMode(file.mode)
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write([]byte(file.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAcc_ResourceApplication_WaitForReady(t *testing.T) {
+	if testingCloud != MicroK8sTesting {
+		t.Skip(t.Name() + " only runs with Microk8s")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-wait")
+	resourceName := "juju_application.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationWaitForReady(modelName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_ready", "true"),
+					resource.TestCheckResourceAttr(resourceName, "units", "1"),
+				),
+			},
+			{
+				Config: testAccResourceApplicationWaitForReady(modelName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "units", "2"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: append([]string{"wait_for_ready"}, unitAgentVersionAttributes...),
+				ImportState:             true,
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+// unitAgentVersionAttributes change when the agents of the units start,
+// they are not stable between creating and importing an application.
+var unitAgentVersionAttributes = []string{"min_unit_agent_version", "max_unit_agent_version", "agent_upgrade_pending"}
+
+func TestAcc_ResourceApplication_WaitForActive(t *testing.T) {
+	if testingCloud != MicroK8sTesting {
+		t.Skip(t.Name() + " only runs with Microk8s")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-active")
+	resourceName := "juju_application.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationWaitForActive(modelName, "1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.create", "1m"),
+					// The agent of an active unit has reported its version.
+					resource.TestCheckResourceAttrSet(resourceName, "min_unit_agent_version"),
+					resource.TestCheckResourceAttr(resourceName, "agent_upgrade_pending", "false"),
+				),
+			},
+			{
+				Config:      testAccResourceApplicationWaitForActive(modelName, "one minute"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a duration"),
 			},
 		},
 	})
@@ -664,9 +1514,10 @@ func TestAcc_ResourceApplication_EndpointBindings(t *testing.T) {
 				),
 			},
 			{
-				ImportStateVerify: true,
-				ImportState:       true,
-				ResourceName:      "juju_application." + appName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: unitAgentVersionAttributes,
+				ImportState:             true,
+				ResourceName:            "juju_application." + appName,
 			},
 		},
 	})
@@ -730,9 +1581,10 @@ func TestAcc_ResourceApplication_UpdateEndpointBindings(t *testing.T) {
 				),
 			},
 			{
-				ImportStateVerify: true,
-				ImportState:       true,
-				ResourceName:      "juju_application." + appName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: unitAgentVersionAttributes,
+				ImportState:             true,
+				ResourceName:            "juju_application." + appName,
 			},
 		},
 	})
@@ -766,6 +1618,88 @@ func TestAcc_ResourceApplication_StorageLXD(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceApplication_RetainStorageOnReplace(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-application-storage")
+	resourceName := "juju_application.runner"
+
+	var storageTags []string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationRetainStorage(modelName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "retain_storage_on_replace", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.count", "1"),
+					func(*terraform.State) error {
+						var err error
+						storageTags, err = testAccAttachedStorage(modelName, "runner")
+						return err
+					},
+				),
+			},
+			{
+				// Replacing the application attaches the storage of
+				// the destroyed unit to the new one.
+				Config: testAccResourceApplicationRetainStorage(modelName, "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "units", "1"),
+					func(*terraform.State) error {
+						attached, err := testAccAttachedStorage(modelName, "runner")
+						if err != nil {
+							return err
+						}
+						if strings.Join(attached, ",") != strings.Join(storageTags, ",") {
+							return fmt.Errorf("expected storage %v to be reattached, got %v", storageTags, attached)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccAttachedStorage returns the tags of the storage attached to the
+// units of the application.
+func testAccAttachedStorage(modelName, appName string) ([]string, error) {
+	conn, err := TestClient.Models.GetConnection(context.Background(), &modelName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+	details, err := apistorage.NewClient(conn).ListStorageDetails()
+	if err != nil {
+		return nil, err
+	}
+	var tags []string
+	for _, detail := range details {
+		for tag := range detail.Attachments {
+			unitTag, err := names.ParseUnitTag(tag)
+			if err != nil {
+				continue
+			}
+			if unitApp, _ := names.UnitApplication(unitTag.Id()); unitApp == appName {
+				tags = append(tags, detail.StorageTag)
+			}
+		}
+	}
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("no storage attached to the units of %q", appName)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
 func TestAcc_ResourceApplication_StorageK8s(t *testing.T) {
 	if testingCloud != MicroK8sTesting {
 		t.Skip(t.Name() + " only runs with Microk8s")
@@ -773,40 +1707,128 @@ func TestAcc_ResourceApplication_StorageK8s(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-application-storage")
 	appName := "test-app-storage"
 
-	storageConstraints := map[string]string{"label": "pgdata", "size": "2G"}
+	storageConstraints := map[string]string{"label": "pgdata", "size": "2G"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApplicationStorageK8s(modelName, appName, storageConstraints),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_application."+appName, "model", modelName),
+					resource.TestCheckResourceAttr("juju_application."+appName, "storage_directives.pgdata", "2G"),
+					resource.TestCheckResourceAttr("juju_application."+appName, "storage.0.label", "pgdata"),
+					resource.TestCheckResourceAttr("juju_application."+appName, "storage.0.count", "1"),
+					resource.TestCheckResourceAttr("juju_application."+appName, "storage.0.size", "2G"),
+					resource.TestCheckResourceAttr("juju_application."+appName, "storage.0.pool", "kubernetes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationBasic_Minimal(modelName, charmName string) string {
+	return fmt.Sprintf(`
+		resource "juju_model" "testmodel" {
+		  name = %q
+		}
+		
+		resource "juju_application" "testapp" {
+		  model = juju_model.testmodel.name
+		  charm {
+			name = %q
+		  }
+		}
+		`, modelName, charmName)
+}
+
+func testAccResourceApplicationBaseSelection(modelName, baseSelection string) string {
+	return fmt.Sprintf(`
+		resource "juju_model" "testmodel" {
+		  name = %q
+		}
+
+		resource "juju_application" "testapp" {
+		  model = juju_model.testmodel.name
+		  charm {
+			name           = "juju-qa-test"
+			base_selection = %q
+		  }
+		}
+		`, modelName, baseSelection)
+}
+
+func testAccResourceApplicationProviderDefaults(modelName string) string {
+	return fmt.Sprintf(`
+		provider "juju" {
+		  default_charm_channel = "latest/edge"
+		  default_base          = "ubuntu@20.04"
+		}
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: frameworkProviderFactories,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccResourceApplicationStorageK8s(modelName, appName, storageConstraints),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("juju_application."+appName, "model", modelName),
-					resource.TestCheckResourceAttr("juju_application."+appName, "storage_directives.pgdata", "2G"),
-					resource.TestCheckResourceAttr("juju_application."+appName, "storage.0.label", "pgdata"),
-					resource.TestCheckResourceAttr("juju_application."+appName, "storage.0.count", "1"),
-					resource.TestCheckResourceAttr("juju_application."+appName, "storage.0.size", "2G"),
-					resource.TestCheckResourceAttr("juju_application."+appName, "storage.0.pool", "kubernetes"),
-				),
-			},
-		},
-	})
+		resource "juju_model" "testmodel" {
+		  name = %q
+		}
+
+		resource "juju_application" "testapp" {
+		  model = juju_model.testmodel.name
+		  charm {
+			name = "juju-qa-test"
+		  }
+		}
+		`, modelName)
 }
 
-func testAccResourceApplicationBasic_Minimal(modelName, charmName string) string {
+func testAccResourceApplicationCharmPath(modelName, charmPath string) string {
 	return fmt.Sprintf(`
 		resource "juju_model" "testmodel" {
 		  name = %q
 		}
-		
+
 		resource "juju_application" "testapp" {
 		  model = juju_model.testmodel.name
 		  charm {
-			name = %q
+			path = %q
 		  }
 		}
-		`, modelName, charmName)
+		`, modelName, charmPath)
+}
+
+func testAccResourceApplicationWaitForReady(modelName string, units int) string {
+	return fmt.Sprintf(`
+		resource "juju_model" "this" {
+		  name = %q
+		}
+
+		resource "juju_application" "this" {
+		  model = juju_model.this.name
+		  charm {
+			name = "jameinel-ubuntu-lite"
+		  }
+		  units          = %d
+		  wait_for_ready = true
+		}
+		`, modelName, units)
+}
+
+func testAccResourceApplicationWaitForActive(modelName, timeout string) string {
+	return fmt.Sprintf(`
+		resource "juju_model" "this" {
+		  name = %q
+		}
+
+		resource "juju_application" "this" {
+		  model = juju_model.this.name
+		  charm {
+			name = "jameinel-ubuntu-lite"
+		  }
+		  wait_for_active = true
+
+		  timeouts {
+			create = %q
+		  }
+		}
+		`, modelName, timeout)
 }
 
 func testAccResourceApplicationBasic(modelName, appName string) string {
@@ -938,6 +1960,230 @@ resource "juju_application" "this" {
 `, modelName, channel)
 }
 
+func testAccResourceApplicationExposeMode(modelName, mode string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceApplicationExposeMode",
+		`
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  charm {
+    name = "juju-qa-dummy-source"
+    base = "ubuntu@22.04"
+  }
+  expose {
+    mode = "{{.Mode}}"
+  }
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+			"Mode":      mode,
+		})
+}
+
+func testAccResourceApplicationExposeEndpoints(modelName, endpoints, cidrs string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceApplicationExposeEndpoints",
+		`
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  charm {
+    name = "juju-qa-dummy-source"
+    base = "ubuntu@22.04"
+  }
+  expose {
+    endpoints = "{{.Endpoints}}"
+    cidrs     = "{{.CIDRs}}"
+  }
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+			"Endpoints": endpoints,
+			"CIDRs":     cidrs,
+		})
+}
+
+func testAccResourceApplicationColocateWith(modelName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceApplicationColocateWith",
+		`
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  charm {
+    name = "jameinel-ubuntu-lite"
+  }
+}
+
+resource "juju_application" "colocated" {
+  model = juju_model.this.name
+  name  = "colocated-app"
+  charm {
+    name = "juju-qa-test"
+    base = "ubuntu@22.04"
+  }
+  colocate_with = juju_application.this.name
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+		})
+}
+
+func testAccResourceApplicationSubordinateUnits(modelName string, units int) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceApplicationSubordinateUnits",
+		`
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  units = {{.Units}}
+  charm {
+    name = "jameinel-ubuntu-lite"
+  }
+}
+
+resource "juju_application" "subordinate" {
+  model = juju_model.this.name
+  name  = "test-subordinate"
+  charm {
+    name     = "nrpe"
+    revision = 96
+  }
+}
+
+resource "juju_integration" "this" {
+  model = juju_model.this.name
+
+  application {
+    name = juju_application.this.name
+  }
+
+  application {
+    name     = juju_application.subordinate.name
+    endpoint = "general-info"
+  }
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+			"Units":     units,
+		})
+}
+
+func testAccResourceApplicationMapMachines(modelName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceApplicationMapMachines",
+		`
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_machine" "this" {
+  model = juju_model.this.name
+  base  = "ubuntu@22.04"
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  charm {
+    name = "juju-qa-test"
+    base = "ubuntu@22.04"
+  }
+  placement = "5"
+  map_machines = {
+    "5" = juju_machine.this.machine_id
+  }
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+		})
+}
+
+func testAccResourceApplicationPlacementDirective(modelName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceApplicationPlacementDirective",
+		`
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_machine" "this" {
+  model = juju_model.this.name
+  base  = "ubuntu@22.04"
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  charm {
+    name = "juju-qa-test"
+    base = "ubuntu@22.04"
+  }
+  placement_directive {
+    scope     = "machine"
+    directive = juju_machine.this.machine_id
+  }
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+		})
+}
+
+func testAccResourceApplicationUnitPlacement(modelName string, units int) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceApplicationUnitPlacement",
+		`
+resource "juju_model" "this" {
+  name = "{{.ModelName}}"
+}
+
+resource "juju_machine" "first" {
+  model = juju_model.this.name
+  base  = "ubuntu@22.04"
+}
+
+resource "juju_machine" "second" {
+  model      = juju_model.this.name
+  base       = "ubuntu@22.04"
+  depends_on = [juju_machine.first]
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  name  = "test-app"
+  units = {{.Units}}
+  charm {
+    name = "juju-qa-test"
+    base = "ubuntu@22.04"
+  }
+  unit_placement = [
+    juju_machine.first.machine_id,
+    juju_machine.second.machine_id,
+  ]
+}
+`, internaltesting.TemplateData{
+			"ModelName": modelName,
+			"Units":     units,
+		})
+}
+
 func testAccResourceApplicationUpdates(modelName string, units int, expose bool, hostname string) string {
 	exposeStr := "expose{}"
 	if !expose {
@@ -988,6 +2234,24 @@ func testAccResourceApplicationUpdates(modelName string, units int, expose bool,
 	}
 }
 
+func testAccResourceApplicationUpgradePolicy(modelName, channel, upgradePolicy string) string {
+	return fmt.Sprintf(`
+		resource "juju_model" "this" {
+		  name = %q
+		}
+
+		resource "juju_application" "this" {
+		  model = juju_model.this.name
+		  name  = "test-app"
+		  charm {
+			name    = "ubuntu"
+			channel = %q
+		  }
+		  upgrade_policy = %q
+		}
+		`, modelName, channel, upgradePolicy)
+}
+
 func testAccResourceApplicationUpdatesCharm(modelName string, channel string) string {
 	if testingCloud == LXDCloudTesting {
 		return fmt.Sprintf(`
@@ -1086,6 +2350,32 @@ resource "juju_application" "telegraf" {
 `, modelName, subordinateRevision)
 }
 
+func testAccResourceApplicationStructuredConstraints(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  model = juju_model.this.name
+  units = 0
+  name = "test-app"
+  charm {
+    name     = "jameinel-ubuntu-lite"
+    revision = 10
+  }
+
+  trust = true
+  expose{}
+  structured_constraints = {
+    arch  = "amd64"
+    cores = 1
+    mem   = "4G"
+  }
+}
+`, modelName)
+}
+
 func testAccResourceApplicationConstraintsSubordinate(modelName string, constraints string) string {
 	return fmt.Sprintf(`
 resource "juju_model" "this" {
@@ -1121,19 +2411,19 @@ func setupModelAndSpaces(t *testing.T, modelName string) (string, string, func()
 	// All the space setup is needed until https://github.com/juju/terraform-provider-juju/issues/336 is implemented
 	// called to have TestClient populated
 	testAccPreCheck(t)
-	model, err := TestClient.Models.CreateModel(internaljuju.CreateModelInput{
+	model, err := TestClient.Models.CreateModel(context.Background(), internaljuju.CreateModelInput{
 		Name: modelName,
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	conn, err := TestClient.Models.GetConnection(&modelName)
+	conn, err := TestClient.Models.GetConnection(context.Background(), &modelName)
 	if err != nil {
 		t.Fatal(err)
 	}
 	cleanUp := func() {
-		_ = TestClient.Models.DestroyModel(internaljuju.DestroyModelInput{UUID: model.UUID})
+		_ = TestClient.Models.DestroyModel(context.Background(), internaljuju.DestroyModelInput{UUID: model.UUID})
 		_ = conn.Close()
 	}
 
@@ -1232,6 +2522,39 @@ resource "juju_application" "{{.AppName}}" {
 	})
 }
 
+func testAccResourceApplicationRetainStorage(modelName, trigger string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "terraform_data" "replace" {
+  input = %q
+}
+
+resource "juju_application" "runner" {
+  model = juju_model.this.name
+  name  = "runner"
+  charm {
+    name     = "github-runner"
+    channel  = "latest/stable"
+    revision = 177
+  }
+
+  storage_directives = {
+    runner = "lxd,2G"
+  }
+  retain_storage_on_replace = true
+
+  units = 1
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.replace]
+  }
+}
+`, modelName, trigger)
+}
+
 func testAccResourceApplicationStorageK8s(modelName, appName string, storageConstraints map[string]string) string {
 	return internaltesting.GetStringFromTemplateWithData("testAccResourceApplicationStorage", `
 resource "juju_model" "{{.ModelName}}" {
@@ -1261,14 +2584,14 @@ resource "juju_application" "{{.AppName}}" {
 
 func testCheckEndpointsAreSetToCorrectSpace(modelName, appName, defaultSpace string, configuredEndpoints map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn, err := TestClient.Models.GetConnection(&modelName)
+		conn, err := TestClient.Models.GetConnection(context.Background(), &modelName)
 		if err != nil {
 			return err
 		}
 		defer func() { _ = conn.Close() }()
 
 		applicationAPIClient := apiapplication.NewClient(conn)
-		clientAPIClient := apiclient.NewClient(conn, TestClient.Applications.JujuLogger())
+		clientAPIClient := apiclient.NewClient(conn, TestClient.Users.JujuLogger())
 
 		apps, err := applicationAPIClient.ApplicationsInfo([]names.ApplicationTag{names.NewApplicationTag(appName)})
 		if err != nil {
@@ -0,0 +1,423 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &cloudResource{}
+var _ resource.ResourceWithConfigure = &cloudResource{}
+var _ resource.ResourceWithConfigValidators = &cloudResource{}
+var _ resource.ResourceWithImportState = &cloudResource{}
+
+// NewCloudResource returns a new instance of the cloud resource.
+func NewCloudResource() resource.Resource {
+	return &cloudResource{}
+}
+
+type cloudResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type cloudResourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Description      types.String `tfsdk:"description"`
+	AuthTypes        types.Set    `tfsdk:"auth_types"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	IdentityEndpoint types.String `tfsdk:"identity_endpoint"`
+	StorageEndpoint  types.String `tfsdk:"storage_endpoint"`
+	CACertificates   types.List   `tfsdk:"ca_certificates"`
+	Regions          types.List   `tfsdk:"region"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// nestedCloudRegion represents an element of the region ListNestedBlock
+// of the cloud resource schema.
+type nestedCloudRegion struct {
+	Name             types.String `tfsdk:"name"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	IdentityEndpoint types.String `tfsdk:"identity_endpoint"`
+	StorageEndpoint  types.String `tfsdk:"storage_endpoint"`
+}
+
+// defaultCloudRegion is the name of the region juju gives to clouds
+// defined without regions.
+const defaultCloudRegion = "default"
+
+var cloudRegionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":              types.StringType,
+		"endpoint":          types.StringType,
+		"identity_endpoint": types.StringType,
+		"storage_endpoint":  types.StringType,
+	},
+}
+
+// Clouds can be imported with their name.
+func (r *cloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *cloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceCloud)
+}
+
+func (r *cloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud"
+}
+
+// ConfigValidators sets validators for the resource.
+func (r *cloudResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	// JAAS only hosts kubernetes clouds.
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(r.client, "juju_kubernetes_cloud"),
+	}
+}
+
+func (r *cloudResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a cloud added to the controller, as done by " +
+			"`juju add-cloud --controller`, e.g. a MAAS or an OpenStack cloud. Kubernetes clouds are " +
+			"managed with the `juju_kubernetes_cloud` resource.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the cloud. Changing this value will cause the cloud to be destroyed and recreated by terraform.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the cloud, e.g. `maas`, `manual`, `openstack` or `vsphere`. " +
+					"Changing this value will cause the cloud to be destroyed and recreated by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("lxd", "maas", "manual", "openstack", "vsphere"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the cloud.",
+				Optional:    true,
+			},
+			"auth_types": schema.SetAttribute{
+				Description: "The authentication types supported by the cloud, e.g. `oauth1` for MAAS, " +
+					"`userpass` for OpenStack or `empty` for a manual cloud.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "The endpoint of the cloud, e.g. the URL of the MAAS API, or the host of a manual cloud.",
+				Optional:    true,
+			},
+			"identity_endpoint": schema.StringAttribute{
+				Description: "The endpoint of the identity service of the cloud.",
+				Optional:    true,
+			},
+			"storage_endpoint": schema.StringAttribute{
+				Description: "The endpoint of the storage service of the cloud.",
+				Optional:    true,
+			},
+			"ca_certificates": schema.ListAttribute{
+				Description: "The CA certificates, in PEM format, used to verify the endpoints of the cloud.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"region": schema.ListNestedBlock{
+				Description: "A region of the cloud. The first region is the default region of the cloud.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the region.",
+							Required:    true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: "The endpoint of the region, if it differs from the endpoint of the cloud.",
+							Optional:    true,
+						},
+						"identity_endpoint": schema.StringAttribute{
+							Description: "The endpoint of the identity service of the region.",
+							Optional:    true,
+						},
+						"storage_endpoint": schema.StringAttribute{
+							Description: "The endpoint of the storage service of the region.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "create")
+		return
+	}
+
+	var plan cloudResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, dErr := plan.cloudInput(ctx)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.Clouds.CreateCloud(ctx, &input); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cloud %q, got error: %s", input.Name, err))
+		return
+	}
+	r.trace(fmt.Sprintf("created cloud %q", input.Name))
+
+	plan.ID = types.StringValue(input.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *cloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "read")
+		return
+	}
+
+	var state cloudResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.Clouds.ReadCloud(ctx, state.ID.ValueString())
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "cloud") {
+			return
+		}
+		resp.Diagnostics.Append(handleCloudNotFoundError(ctx, err, &resp.State)...)
+		return
+	}
+	r.trace(fmt.Sprintf("read cloud %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(state.setCloud(ctx, response)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *cloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "update")
+		return
+	}
+
+	var plan cloudResourceModel
+
+	// Read Terraform configuration from the request into the plan model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, dErr := plan.cloudInput(ctx)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateInput := juju.UpdateCloudInput(input)
+	if err := r.client.Clouds.UpdateCloud(ctx, &updateInput); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cloud %q, got error: %s", input.Name, err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated cloud %q", input.Name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *cloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "cloud", "delete")
+		return
+	}
+
+	var state cloudResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Clouds.DestroyCloud(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cloud %q, got error: %s", state.ID.ValueString(), err))
+		return
+	}
+	r.trace(fmt.Sprintf("deleted cloud %q", state.ID.ValueString()))
+}
+
+// cloudInput returns the definition of the cloud described by the model.
+func (m cloudResourceModel) cloudInput(ctx context.Context) (juju.CreateCloudInput, diag.Diagnostics) {
+	input := juju.CreateCloudInput{
+		Name:             m.Name.ValueString(),
+		Type:             m.Type.ValueString(),
+		Description:      m.Description.ValueString(),
+		Endpoint:         m.Endpoint.ValueString(),
+		IdentityEndpoint: m.IdentityEndpoint.ValueString(),
+		StorageEndpoint:  m.StorageEndpoint.ValueString(),
+	}
+	var diags diag.Diagnostics
+	diags.Append(m.AuthTypes.ElementsAs(ctx, &input.AuthTypes, false)...)
+	diags.Append(m.CACertificates.ElementsAs(ctx, &input.CACertificates, false)...)
+	var regions []nestedCloudRegion
+	diags.Append(m.Regions.ElementsAs(ctx, &regions, false)...)
+	for _, region := range regions {
+		input.Regions = append(input.Regions, juju.CloudRegion{
+			Name:             region.Name.ValueString(),
+			Endpoint:         region.Endpoint.ValueString(),
+			IdentityEndpoint: region.IdentityEndpoint.ValueString(),
+			StorageEndpoint:  region.StorageEndpoint.ValueString(),
+		})
+	}
+	return input, diags
+}
+
+// setCloud sets the model from a cloud read on the controller. Empty
+// values are kept null so that unset attributes do not show a diff.
+func (m *cloudResourceModel) setCloud(ctx context.Context, cloud *juju.ReadCloudResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Name = types.StringValue(cloud.Name)
+	m.Type = types.StringValue(cloud.Type)
+	m.Description = cloudStringValue(cloud.Description)
+	m.Endpoint = cloudStringValue(cloud.Endpoint)
+	m.IdentityEndpoint = cloudStringValue(cloud.IdentityEndpoint)
+	m.StorageEndpoint = cloudStringValue(cloud.StorageEndpoint)
+
+	authTypes, dErr := types.SetValueFrom(ctx, types.StringType, cloud.AuthTypes)
+	diags.Append(dErr...)
+	m.AuthTypes = authTypes
+
+	if len(cloud.CACertificates) > 0 {
+		caCertificates, dErr := types.ListValueFrom(ctx, types.StringType, cloud.CACertificates)
+		diags.Append(dErr...)
+		m.CACertificates = caCertificates
+	} else {
+		m.CACertificates = types.ListNull(types.StringType)
+	}
+
+	// Juju gives a cloud without regions a default region, which is
+	// not shown when no region is configured.
+	cloudRegions := cloud.Regions
+	if len(m.Regions.Elements()) == 0 && len(cloudRegions) == 1 && cloudRegions[0] == (juju.CloudRegion{Name: defaultCloudRegion}) {
+		cloudRegions = nil
+	}
+	regions := make([]nestedCloudRegion, 0, len(cloudRegions))
+	for _, region := range cloudRegions {
+		regions = append(regions, nestedCloudRegion{
+			Name:             types.StringValue(region.Name),
+			Endpoint:         cloudStringValue(region.Endpoint),
+			IdentityEndpoint: cloudStringValue(region.IdentityEndpoint),
+			StorageEndpoint:  cloudStringValue(region.StorageEndpoint),
+		})
+	}
+	regionsValue, dErr := types.ListValueFrom(ctx, cloudRegionType, regions)
+	diags.Append(dErr...)
+	m.Regions = regionsValue
+	return diags
+}
+
+func cloudStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func handleCloudNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
+	if errors.As(err, &juju.CloudNotFoundError) {
+		// Cloud manually removed
+		st.RemoveResource(ctx)
+		return diag.Diagnostics{}
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", err.Error())
+	return diags
+}
+
+func (r *cloudResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceCloud, msg, additionalFields...)
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func TestCloudResourceModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	cloud := &juju.ReadCloudResponse{
+		Name:      "my-maas",
+		Type:      "maas",
+		AuthTypes: []string{"oauth1"},
+		Endpoint:  "http://10.0.0.2:5240/MAAS",
+		Regions: []juju.CloudRegion{
+			{Name: "default"},
+			{Name: "dc2", Endpoint: "http://10.0.1.2:5240/MAAS"},
+		},
+	}
+
+	model := cloudResourceModel{Regions: types.ListNull(cloudRegionType)}
+	require.False(t, model.setCloud(ctx, cloud).HasError())
+	assert.True(t, model.Description.IsNull())
+	assert.True(t, model.CACertificates.IsNull())
+	assert.Len(t, model.Regions.Elements(), 2)
+
+	input, diags := model.cloudInput(ctx)
+	require.False(t, diags.HasError())
+	assert.Equal(t, juju.CreateCloudInput(*cloud), input)
+}
+
+func TestCloudResourceModelDefaultRegion(t *testing.T) {
+	ctx := context.Background()
+	cloud := &juju.ReadCloudResponse{
+		Name:      "my-manual",
+		Type:      "manual",
+		AuthTypes: []string{"empty"},
+		Endpoint:  "10.0.0.3",
+		Regions:   []juju.CloudRegion{{Name: defaultCloudRegion}},
+	}
+
+	// The default region given by juju is not shown when no region is
+	// configured.
+	model := cloudResourceModel{Regions: types.ListValueMust(cloudRegionType, nil)}
+	require.False(t, model.setCloud(ctx, cloud).HasError())
+	assert.Empty(t, model.Regions.Elements())
+
+	// It is shown once configured.
+	require.False(t, model.setCloud(ctx, &juju.ReadCloudResponse{
+		Name:    "my-manual",
+		Regions: []juju.CloudRegion{{Name: "dc1"}},
+	}).HasError())
+	require.False(t, model.setCloud(ctx, cloud).HasError())
+	assert.Len(t, model.Regions.Elements(), 1)
+}
+
+func TestAcc_ResourceCloud(t *testing.T) {
+	SkipJAAS(t)
+	cloudName := acctest.RandomWithPrefix("tf-test-cloud")
+	resourceName := "juju_cloud.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCloud(cloudName, "first description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "type", "manual"),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "region.0.name", "dc1"),
+				),
+			},
+			{
+				Config: testAccResourceCloud(cloudName, "second description"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "description", "second description"),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceCloud(cloudName, description string) string {
+	return fmt.Sprintf(`
+resource "juju_cloud" "test" {
+  name        = %q
+  type        = "manual"
+  description = %q
+  auth_types  = ["empty"]
+  endpoint    = "10.10.10.10"
+
+  region {
+    name = "dc1"
+  }
+}
+`, cloudName, description)
+}
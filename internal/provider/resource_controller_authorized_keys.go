@@ -0,0 +1,322 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/utils"
+)
+
+var _ resource.Resource = &controllerAuthorizedKeysResource{}
+var _ resource.ResourceWithConfigure = &controllerAuthorizedKeysResource{}
+var _ resource.ResourceWithImportState = &controllerAuthorizedKeysResource{}
+
+// NewControllerAuthorizedKeysResource returns a new instance of the
+// controller authorized keys resource.
+func NewControllerAuthorizedKeysResource() resource.Resource {
+	return &controllerAuthorizedKeysResource{}
+}
+
+type controllerAuthorizedKeysResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type controllerAuthorizedKeysResourceModel struct {
+	Cloud types.String `tfsdk:"cloud"`
+	Keys  types.Set    `tfsdk:"keys"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the metadata for the controller authorized keys resource.
+func (r *controllerAuthorizedKeysResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller_authorized_keys"
+}
+
+// Schema defines the schema for the controller authorized keys resource.
+func (r *controllerAuthorizedKeysResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that manages SSH keys set as the controller default `authorized-keys` for new " +
+			"models on a cloud. Keys set by other means are left untouched. Use juju_ssh_key to manage the " +
+			"keys of an existing model.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				Description: "The name of the cloud the default applies to. Changing this value will cause the " +
+					"resource to be destroyed and recreated by terraform.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keys": schema.SetAttribute{
+				Description: "The SSH public keys added to new models.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(ValidatorMatchString(
+						func(key string) bool { return utils.GetKeyIdentifierFromSSHKey(key) != "" },
+						"must be an SSH public key",
+					)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets up the controller authorized keys resource with the provider data.
+func (r *controllerAuthorizedKeysResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceControllerAuthorizedKeys)
+}
+
+// Create adds the keys to the controller default authorized keys.
+func (r *controllerAuthorizedKeysResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceControllerAuthorizedKeys, "create")
+		return
+	}
+
+	var plan controllerAuthorizedKeysResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planKeys := authorizedKeysFromSet(ctx, plan.Keys, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloud := plan.Cloud.ValueString()
+	if err := r.reconcile(ctx, cloud, planKeys, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add authorized keys for cloud %q, got error: %s", cloud, err))
+		return
+	}
+	r.trace(fmt.Sprintf("added %d authorized key(s) for cloud %q", len(planKeys), cloud))
+
+	plan.ID = types.StringValue(cloud)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read keeps the keys of the state which are still set as the controller
+// default authorized keys.
+func (r *controllerAuthorizedKeysResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceControllerAuthorizedKeys, "read")
+		return
+	}
+
+	var state controllerAuthorizedKeysResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cloud := state.ID.ValueString()
+
+	currentKeys, err := r.client.SSHKeys.ReadControllerAuthorizedKeys(ctx, cloud)
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "controller authorized keys") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read authorized keys for cloud %q, got error: %s", cloud, err))
+		return
+	}
+
+	keys := []string{}
+	if state.Keys.IsNull() {
+		// Imported, all the keys are managed.
+		keys = append(keys, currentKeys...)
+	} else {
+		stateKeys := authorizedKeysFromSet(ctx, state.Keys, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		current := make(map[string]bool)
+		for _, key := range currentKeys {
+			current[key] = true
+		}
+		for _, key := range stateKeys {
+			if current[strings.TrimSpace(key)] {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	keySet, diags := types.SetValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Cloud = types.StringValue(cloud)
+	state.Keys = keySet
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update adds the keys added to the plan and removes the keys removed
+// from it.
+func (r *controllerAuthorizedKeysResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceControllerAuthorizedKeys, "update")
+		return
+	}
+
+	var plan, state controllerAuthorizedKeysResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planKeys := authorizedKeysFromSet(ctx, plan.Keys, &resp.Diagnostics)
+	stateKeys := authorizedKeysFromSet(ctx, state.Keys, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloud := plan.Cloud.ValueString()
+	if err := r.reconcile(ctx, cloud, planKeys, stateKeys); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update authorized keys for cloud %q, got error: %s", cloud, err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated authorized keys for cloud %q", cloud))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the keys of the resource from the controller default
+// authorized keys.
+func (r *controllerAuthorizedKeysResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceControllerAuthorizedKeys, "delete")
+		return
+	}
+
+	var state controllerAuthorizedKeysResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateKeys := authorizedKeysFromSet(ctx, state.Keys, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloud := state.Cloud.ValueString()
+	if err := r.reconcile(ctx, cloud, nil, stateKeys); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove authorized keys for cloud %q, got error: %s", cloud, err))
+	}
+}
+
+// ImportState imports the controller default authorized keys of a cloud,
+// the import ID is the cloud name.
+func (r *controllerAuthorizedKeysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// reconcile removes the keys which are no longer wanted from the
+// controller default authorized keys and adds the wanted ones, leaving
+// keys set by other means untouched.
+func (r *controllerAuthorizedKeysResource) reconcile(ctx context.Context, cloud string, wanted, previous []string) error {
+	currentKeys, err := r.client.SSHKeys.ReadControllerAuthorizedKeys(ctx, cloud)
+	if err != nil {
+		return err
+	}
+	keys := reconcileAuthorizedKeys(currentKeys, wanted, previous)
+	return r.client.SSHKeys.SetControllerAuthorizedKeys(ctx, cloud, keys)
+}
+
+// reconcileAuthorizedKeys returns the current keys without the previous
+// keys which are not wanted anymore, followed by the wanted keys which
+// are missing.
+func reconcileAuthorizedKeys(current, wanted, previous []string) []string {
+	wantedSet := make(map[string]bool)
+	for i, key := range wanted {
+		wanted[i] = strings.TrimSpace(key)
+		wantedSet[wanted[i]] = true
+	}
+	removed := make(map[string]bool)
+	for _, key := range previous {
+		if key = strings.TrimSpace(key); !wantedSet[key] {
+			removed[key] = true
+		}
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, key := range current {
+		if removed[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	for _, key := range wanted {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func authorizedKeysFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	var keys []string
+	if set.IsNull() || set.IsUnknown() {
+		return keys
+	}
+	diags.Append(set.ElementsAs(ctx, &keys, false)...)
+	return keys
+}
+
+func (r *controllerAuthorizedKeysResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceControllerAuthorizedKeys, msg, additionalFields...)
+}
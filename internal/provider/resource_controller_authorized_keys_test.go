@@ -0,0 +1,60 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestReconcileAuthorizedKeys(t *testing.T) {
+	current := []string{"key-a", "key-b", "external"}
+	keys := reconcileAuthorizedKeys(current, []string{"key-b", "key-c\n"}, []string{"key-a", "key-b"})
+	expected := []string{"key-b", "external", "key-c"}
+	if fmt.Sprint(keys) != fmt.Sprint(expected) {
+		t.Fatalf("expected keys %v, got %v", expected, keys)
+	}
+}
+
+func TestAcc_ResourceControllerAuthorizedKeys(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	sshKey1 := `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAID3gjJTJtYZU55HTUr+hu0JF9p152yiC9czJi9nKojuW jimmy@somewhere`
+	sshKey2 := `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFm1g4ffV9KuQwzZ96XLbX1R9wHxQ5B8rKJgA1TU4UrQ bob@somewhere`
+	resourceName := "juju_controller_authorized_keys.this"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceControllerAuthorizedKeys(sshKey1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cloud", "localhost"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "keys.*", sshKey1),
+				),
+			},
+			{
+				Config: testAccResourceControllerAuthorizedKeys(sshKey2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "keys.*", sshKey2),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceControllerAuthorizedKeys(sshKey string) string {
+	return fmt.Sprintf(`
+resource "juju_controller_authorized_keys" "this" {
+  cloud = "localhost"
+  keys  = [%q]
+}
+`, sshKey)
+}
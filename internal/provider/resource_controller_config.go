@@ -0,0 +1,328 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/juju/controller"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ resource.Resource = &controllerConfigResource{}
+var _ resource.ResourceWithConfigure = &controllerConfigResource{}
+var _ resource.ResourceWithConfigValidators = &controllerConfigResource{}
+var _ resource.ResourceWithImportState = &controllerConfigResource{}
+var _ resource.ResourceWithValidateConfig = &controllerConfigResource{}
+
+// NewControllerConfigResource returns a new instance of the controller
+// config resource.
+func NewControllerConfigResource() resource.Resource {
+	return &controllerConfigResource{}
+}
+
+type controllerConfigResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type controllerConfigResourceModel struct {
+	Config types.Map `tfsdk:"config"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the metadata for the controller config resource.
+func (r *controllerConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller_config"
+}
+
+// ConfigValidators sets validators for the resource.
+func (r *controllerConfigResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(r.client, ""),
+	}
+}
+
+// Schema defines the schema for the controller config resource.
+func (r *controllerConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that manages the configuration of the controller the provider is connected to. " +
+			"Only the keys set in the resource are managed, other keys are left untouched. Keys removed from " +
+			"the resource, or of a destroyed resource, are reset to their juju default when there is one, " +
+			"otherwise they keep their current value.",
+		Attributes: map[string]schema.Attribute{
+			"config": schema.MapAttribute{
+				Description: "The controller config keys to manage, e.g. `audit-log-max-size`, and their " +
+					"value. Lists, such as `features`, are given in YAML or JSON, e.g. `[\"a\", \"b\"]`. " +
+					"Only the keys which can be updated once the controller is bootstrapped are supported.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(ValidatorMatchString(
+						controller.AllowedUpdateConfigAttributes.Contains,
+						"must be a controller config key which can be updated",
+					)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The UUID of the controller.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the known config values can be converted
+// to the type juju expects for their key.
+func (r *controllerConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data controllerConfigResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Config.IsUnknown() {
+		return
+	}
+	for k, v := range data.Config.Elements() {
+		value, ok := v.(types.String)
+		if !ok || value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if !controller.AllowedUpdateConfigAttributes.Contains(k) {
+			// Reported by the key validator.
+			continue
+		}
+		if _, err := juju.CoerceControllerConfig(map[string]string{k: value.ValueString()}); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("config").AtMapKey(k), "Invalid Attribute Value",
+				fmt.Sprintf("Invalid value for controller config %q: %s", k, err))
+		}
+	}
+}
+
+// Configure sets up the controller config resource with the provider data.
+func (r *controllerConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceControllerConfig)
+}
+
+// Create sets the controller config keys of the plan.
+func (r *controllerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceControllerConfig, "create")
+		return
+	}
+
+	var plan controllerConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config := map[string]string{}
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &config, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Controllers.UpdateControllerConfig(ctx, juju.UpdateControllerConfigInput{Config: config}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set controller config, got error: %s", err))
+		return
+	}
+	current, err := r.client.Controllers.ReadControllerConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controller config, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("set %d controller config key(s)", len(config)))
+
+	plan.ID = types.StringValue(current[controller.ControllerUUIDKey])
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the values of the managed controller config keys.
+func (r *controllerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceControllerConfig, "read")
+		return
+	}
+
+	var state controllerConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.Controllers.ReadControllerConfig(ctx)
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "controller config") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controller config, got error: %s", err))
+		return
+	}
+
+	var stateConfig map[string]string
+	if !state.Config.IsNull() {
+		resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	config, diags := types.MapValueFrom(ctx, types.StringType, managedControllerConfig(stateConfig, current))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Config = config
+	state.ID = types.StringValue(current[controller.ControllerUUIDKey])
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update sets the controller config keys whose value changed and resets
+// the keys removed from the plan.
+func (r *controllerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceControllerConfig, "update")
+		return
+	}
+
+	var plan, state controllerConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planConfig := map[string]string{}
+	stateConfig := map[string]string{}
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &planConfig, false)...)
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := juju.UpdateControllerConfigInput{Config: map[string]string{}}
+	for k, v := range planConfig {
+		if previous, ok := stateConfig[k]; !ok || previous != v {
+			input.Config[k] = v
+		}
+	}
+	for k := range stateConfig {
+		if _, ok := planConfig[k]; !ok {
+			input.Reset = append(input.Reset, k)
+		}
+	}
+	if err := r.client.Controllers.UpdateControllerConfig(ctx, input); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update controller config, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated %d and reset %d controller config key(s)", len(input.Config), len(input.Reset)))
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete resets the managed controller config keys to their default.
+func (r *controllerConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceControllerConfig, "delete")
+		return
+	}
+
+	var state controllerConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateConfig := map[string]string{}
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := juju.UpdateControllerConfigInput{}
+	for k := range stateConfig {
+		input.Reset = append(input.Reset, k)
+	}
+	if err := r.client.Controllers.UpdateControllerConfig(ctx, input); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset controller config, got error: %s", err))
+	}
+}
+
+// ImportState imports the controller config, the import ID is the
+// controller UUID. All the keys which can be updated are then managed by
+// Terraform.
+func (r *controllerConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// managedControllerConfig returns the current value of the keys of the
+// state config. The state value is kept when it has the same meaning as
+// the current one, e.g. a list written differently. Keys which are not
+// set anymore are dropped. When there is no state config, as after an
+// import, all the current keys which can be updated are returned.
+func managedControllerConfig(stateConfig, current map[string]string) map[string]string {
+	config := make(map[string]string)
+	if stateConfig == nil {
+		for k, v := range current {
+			if controller.AllowedUpdateConfigAttributes.Contains(k) {
+				config[k] = v
+			}
+		}
+		return config
+	}
+	for k, v := range stateConfig {
+		value, ok := current[k]
+		if !ok {
+			continue
+		}
+		if juju.ControllerConfigValueEqual(k, v, value) {
+			value = v
+		}
+		config[k] = value
+	}
+	return config
+}
+
+func (r *controllerConfigResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceControllerConfig, msg, additionalFields...)
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManagedControllerConfig(t *testing.T) {
+	current := map[string]string{
+		"controller-uuid":    "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		"audit-log-max-size": "200M",
+		"features":           `["a","b"]`,
+	}
+
+	config := managedControllerConfig(map[string]string{
+		"audit-log-max-size": "100M",
+		"features":           "[a, b]",
+		"juju-ha-space":      "ha",
+	}, current)
+	assert.Equal(t, map[string]string{
+		"audit-log-max-size": "200M",
+		"features":           "[a, b]",
+	}, config)
+
+	// Imported, all the keys which can be updated are managed.
+	config = managedControllerConfig(nil, current)
+	assert.Equal(t, map[string]string{
+		"audit-log-max-size": "200M",
+		"features":           `["a","b"]`,
+	}, config)
+}
+
+func TestAcc_ResourceControllerConfig(t *testing.T) {
+	SkipJAAS(t)
+	resourceName := "juju_controller_config.this"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceControllerConfig("250M"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.audit-log-max-size", "250M"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccResourceControllerConfig("350M"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "config.audit-log-max-size", "350M"),
+			},
+		},
+	})
+}
+
+func testAccResourceControllerConfig(auditLogMaxSize string) string {
+	return fmt.Sprintf(`
+resource "juju_controller_config" "this" {
+  config = {
+    "audit-log-max-size" = %q
+  }
+}
+`, auditLogMaxSize)
+}
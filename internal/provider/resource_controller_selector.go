@@ -0,0 +1,416 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &controllerSelector{}
+var _ resource.ResourceWithConfigure = &controllerSelector{}
+var _ resource.ResourceWithConfigValidators = &controllerSelector{}
+var _ resource.ResourceWithImportState = &controllerSelector{}
+var _ resource.ResourceWithModifyPlan = &controllerSelector{}
+var _ resource.ResourceWithValidateConfig = &controllerSelector{}
+
+// controllerImportIDSeparator separates the name of the controller
+// from the import ID of a resource on a controller selected with
+// controller_name, e.g. `production::development:postgresql`.
+const controllerImportIDSeparator = "::"
+
+// controllerNameDescription is the description of the controller_name
+// attribute of the resources and data sources.
+const controllerNameDescription = "The name of a controller known to the local juju CLI client to use instead of " +
+	"the controller of the provider, e.g. to manage staging and production from a single provider. Its " +
+	"addresses, certificate and credentials are read as for the `controller_name` of the provider, and " +
+	"its client is shared by all the resources and data sources using it."
+
+// controllerSelector wraps a resource to add the controller_name
+// attribute, which selects the controller the resource is on instead of
+// the controller of the provider. The resource does not know about the
+// attribute: it is configured with the client of the controller
+// selected, and given its values without the attribute. The optional
+// interfaces of the resource are forwarded to it.
+type controllerSelector struct {
+	resource.Resource
+
+	newResource func() resource.Resource
+	client      *juju.Client
+}
+
+func newControllerSelector(newResource func() resource.Resource) resource.Resource {
+	return &controllerSelector{Resource: newResource(), newResource: newResource}
+}
+
+// Configure keeps the client of the provider, to get the clients of the
+// controllers selected, and configures the resource with it.
+func (s *controllerSelector) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(*juju.Client); ok {
+		s.client = client
+	}
+	if r, ok := s.Resource.(resource.ResourceWithConfigure); ok {
+		r.Configure(ctx, req, resp)
+	}
+}
+
+// Schema adds the controller_name attribute to the schema of the
+// resource.
+func (s *controllerSelector) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	s.Resource.Schema(ctx, req, resp)
+	resp.Schema.Attributes = maps.Clone(resp.Schema.Attributes)
+	resp.Schema.Attributes[JujuControllerName] = schema.StringAttribute{
+		Description: controllerNameDescription + " Changing it replaces the resource.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (s *controllerSelector) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	v := s.values(ctx)
+	plan, controller := v.split(req.Plan.Raw)
+	config, _ := v.split(req.Config.Raw)
+	state, _ := v.split(resp.State.Raw)
+	r := s.resourceFor(ctx, controller, &resp.Diagnostics)
+	if r == nil {
+		return
+	}
+
+	innerResp := resource.CreateResponse{
+		State:       tfsdk.State{Schema: v.schema, Raw: state},
+		Private:     resp.Private,
+		Diagnostics: resp.Diagnostics,
+	}
+	r.Create(ctx, resource.CreateRequest{
+		Config:       tfsdk.Config{Schema: v.schema, Raw: config},
+		Plan:         tfsdk.Plan{Schema: v.schema, Raw: plan},
+		ProviderMeta: req.ProviderMeta,
+	}, &innerResp)
+	resp.State.Raw = v.join(innerResp.State.Raw, controller)
+	resp.Private = innerResp.Private
+	resp.Diagnostics = innerResp.Diagnostics
+}
+
+func (s *controllerSelector) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	v := s.values(ctx)
+	state, controller := v.split(req.State.Raw)
+	respState, _ := v.split(resp.State.Raw)
+	r := s.resourceFor(ctx, controller, &resp.Diagnostics)
+	if r == nil {
+		return
+	}
+
+	innerResp := resource.ReadResponse{
+		State:       tfsdk.State{Schema: v.schema, Raw: respState},
+		Private:     resp.Private,
+		Diagnostics: resp.Diagnostics,
+	}
+	r.Read(ctx, resource.ReadRequest{
+		State:        tfsdk.State{Schema: v.schema, Raw: state},
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, &innerResp)
+	resp.State.Raw = v.join(innerResp.State.Raw, controller)
+	resp.Private = innerResp.Private
+	resp.Diagnostics = innerResp.Diagnostics
+}
+
+func (s *controllerSelector) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	v := s.values(ctx)
+	plan, controller := v.split(req.Plan.Raw)
+	config, _ := v.split(req.Config.Raw)
+	state, _ := v.split(req.State.Raw)
+	respState, _ := v.split(resp.State.Raw)
+	r := s.resourceFor(ctx, controller, &resp.Diagnostics)
+	if r == nil {
+		return
+	}
+
+	innerResp := resource.UpdateResponse{
+		State:       tfsdk.State{Schema: v.schema, Raw: respState},
+		Private:     resp.Private,
+		Diagnostics: resp.Diagnostics,
+	}
+	r.Update(ctx, resource.UpdateRequest{
+		Config:       tfsdk.Config{Schema: v.schema, Raw: config},
+		Plan:         tfsdk.Plan{Schema: v.schema, Raw: plan},
+		State:        tfsdk.State{Schema: v.schema, Raw: state},
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, &innerResp)
+	resp.State.Raw = v.join(innerResp.State.Raw, controller)
+	resp.Private = innerResp.Private
+	resp.Diagnostics = innerResp.Diagnostics
+}
+
+func (s *controllerSelector) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	v := s.values(ctx)
+	state, controller := v.split(req.State.Raw)
+	respState, _ := v.split(resp.State.Raw)
+	r := s.resourceFor(ctx, controller, &resp.Diagnostics)
+	if r == nil {
+		return
+	}
+
+	innerResp := resource.DeleteResponse{
+		State:       tfsdk.State{Schema: v.schema, Raw: respState},
+		Private:     resp.Private,
+		Diagnostics: resp.Diagnostics,
+	}
+	r.Delete(ctx, resource.DeleteRequest{
+		State:        tfsdk.State{Schema: v.schema, Raw: state},
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, &innerResp)
+	resp.State.Raw = v.join(innerResp.State.Raw, controller)
+	resp.Private = innerResp.Private
+	resp.Diagnostics = innerResp.Diagnostics
+}
+
+func (s *controllerSelector) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if _, ok := s.Resource.(resource.ResourceWithConfigValidators); !ok {
+		return nil
+	}
+	return []resource.ConfigValidator{controllerConfigValidators{selector: s}}
+}
+
+// ImportState imports the resource on the controller of the provider,
+// or on the controller named before the controllerImportIDSeparator of
+// the import ID.
+func (s *controllerSelector) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	v := s.values(ctx)
+	name, id := splitControllerImportID(req.ID)
+	controller := tftypes.NewValue(tftypes.String, nil)
+	if name != "" {
+		controller = tftypes.NewValue(tftypes.String, name)
+	}
+	r := s.resourceFor(ctx, controller, &resp.Diagnostics)
+	if r == nil {
+		return
+	}
+	importer, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		// Same as the framework for resources which cannot be imported.
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+
+	state, _ := v.split(resp.State.Raw)
+	innerResp := resource.ImportStateResponse{
+		State:       tfsdk.State{Schema: v.schema, Raw: state},
+		Private:     resp.Private,
+		Diagnostics: resp.Diagnostics,
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: id}, &innerResp)
+	resp.State.Raw = v.join(innerResp.State.Raw, controller)
+	resp.Private = innerResp.Private
+	resp.Diagnostics = innerResp.Diagnostics
+	if name != "" && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(JujuControllerName), name)...)
+	}
+}
+
+// ModifyPlan modifies the plan with the resource configured for the
+// controller planned, or the controller of the state when the resource
+// is destroyed.
+func (s *controllerSelector) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if _, ok := s.Resource.(resource.ResourceWithModifyPlan); !ok {
+		return
+	}
+	v := s.values(ctx)
+	plan, controller := v.split(req.Plan.Raw)
+	config, _ := v.split(req.Config.Raw)
+	state, stateController := v.split(req.State.Raw)
+	respPlan, _ := v.split(resp.Plan.Raw)
+	if req.Plan.Raw.IsNull() {
+		controller = stateController
+	}
+	r, ok := s.resourceFor(ctx, controller, &resp.Diagnostics).(resource.ResourceWithModifyPlan)
+	if !ok {
+		return
+	}
+
+	innerResp := resource.ModifyPlanResponse{
+		Plan:            tfsdk.Plan{Schema: v.schema, Raw: respPlan},
+		RequiresReplace: resp.RequiresReplace,
+		Private:         resp.Private,
+		Diagnostics:     resp.Diagnostics,
+	}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config:       tfsdk.Config{Schema: v.schema, Raw: config},
+		Plan:         tfsdk.Plan{Schema: v.schema, Raw: plan},
+		State:        tfsdk.State{Schema: v.schema, Raw: state},
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, &innerResp)
+	_, planController := v.split(resp.Plan.Raw)
+	resp.Plan.Raw = v.join(innerResp.Plan.Raw, planController)
+	resp.RequiresReplace = innerResp.RequiresReplace
+	resp.Private = innerResp.Private
+	resp.Diagnostics = innerResp.Diagnostics
+}
+
+func (s *controllerSelector) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if _, ok := s.Resource.(resource.ResourceWithValidateConfig); !ok {
+		return
+	}
+	v := s.values(ctx)
+	config, controller := v.split(req.Config.Raw)
+	if validator, ok := s.resourceFor(ctx, controller, &resp.Diagnostics).(resource.ResourceWithValidateConfig); ok {
+		validator.ValidateConfig(ctx, resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: v.schema, Raw: config},
+		}, resp)
+	}
+}
+
+// resourceFor returns the resource configured with the client of the
+// controller selected. A resource which is not configured is returned
+// while the controller is not known, so that the client of another
+// controller is not used. Nil is returned if the controller cannot be
+// found.
+func (s *controllerSelector) resourceFor(ctx context.Context, controller tftypes.Value, diags *diag.Diagnostics) resource.Resource {
+	if !controller.IsKnown() {
+		return s.newResource()
+	}
+	var name string
+	if !controller.IsNull() {
+		_ = controller.As(&name)
+	}
+	if name == "" || s.client == nil {
+		return s.Resource
+	}
+	client, err := s.client.ForController(ctx, name)
+	if err != nil {
+		diags.AddAttributeError(path.Root(JujuControllerName), "Controller Not Found",
+			fmt.Sprintf("Unable to select controller %q, got error: %s", name, err))
+		return nil
+	}
+	r := s.newResource()
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return r
+}
+
+// resourceValues converts the values of the resource, whose own schema
+// is schema.
+type resourceValues struct {
+	controllerValues
+	schema schema.Schema
+}
+
+// values returns the converter of the values of the resource.
+func (s *controllerSelector) values(ctx context.Context) resourceValues {
+	var resp resource.SchemaResponse
+	s.Resource.Schema(ctx, resource.SchemaRequest{}, &resp)
+	var outer resource.SchemaResponse
+	s.Schema(ctx, resource.SchemaRequest{}, &outer)
+	return resourceValues{
+		controllerValues: controllerValues{
+			innerType: resp.Schema.Type().TerraformType(ctx),
+			outerType: outer.Schema.Type().TerraformType(ctx),
+		},
+		schema: resp.Schema,
+	}
+}
+
+// controllerConfigValidators runs the config validators of the resource
+// configured for the controller selected.
+type controllerConfigValidators struct {
+	selector *controllerSelector
+}
+
+func (v controllerConfigValidators) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v controllerConfigValidators) MarkdownDescription(_ context.Context) string {
+	return "Validates the configuration with the controller selected by controller_name"
+}
+
+func (v controllerConfigValidators) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	values := v.selector.values(ctx)
+	config, controller := values.split(req.Config.Raw)
+	withValidators, ok := v.selector.resourceFor(ctx, controller, &resp.Diagnostics).(resource.ResourceWithConfigValidators)
+	if !ok {
+		return
+	}
+	innerReq := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: values.schema, Raw: config}}
+	for _, validator := range withValidators.ConfigValidators(ctx) {
+		validator.ValidateResource(ctx, innerReq, resp)
+	}
+}
+
+// controllerValues converts the values of a resource, or data source,
+// between its schema with the controller_name attribute and its own
+// schema.
+type controllerValues struct {
+	innerType tftypes.Type
+	outerType tftypes.Type
+}
+
+// split returns the value for the schema of the resource, and the value
+// of the controller_name attribute.
+func (v controllerValues) split(value tftypes.Value) (tftypes.Value, tftypes.Value) {
+	if !value.IsKnown() {
+		return tftypes.NewValue(v.innerType, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+	var attrs map[string]tftypes.Value
+	if value.IsNull() || value.As(&attrs) != nil {
+		return tftypes.NewValue(v.innerType, nil), tftypes.NewValue(tftypes.String, nil)
+	}
+	controller, ok := attrs[JujuControllerName]
+	if !ok {
+		controller = tftypes.NewValue(tftypes.String, nil)
+	}
+	delete(attrs, JujuControllerName)
+	return tftypes.NewValue(v.innerType, attrs), controller
+}
+
+// join returns the value for the schema with the controller_name
+// attribute of the value for the schema of the resource.
+func (v controllerValues) join(value, controller tftypes.Value) tftypes.Value {
+	if !value.IsKnown() {
+		return tftypes.NewValue(v.outerType, tftypes.UnknownValue)
+	}
+	var attrs map[string]tftypes.Value
+	if value.IsNull() || value.As(&attrs) != nil {
+		return tftypes.NewValue(v.outerType, nil)
+	}
+	attrs[JujuControllerName] = controller
+	return tftypes.NewValue(v.outerType, attrs)
+}
+
+// splitControllerImportID returns the name of the controller and the
+// import ID of the resource of an import ID in the format
+// `<controller>::<import ID>`. The controller name is empty if the
+// import ID is not in this format.
+func splitControllerImportID(value string) (string, string) {
+	name, id, ok := strings.Cut(value, controllerImportIDSeparator)
+	if !ok || name == "" || id == "" || strings.Contains(name, ":") {
+		return "", value
+	}
+	return name, id
+}
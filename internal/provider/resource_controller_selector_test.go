@@ -0,0 +1,171 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// clientResource counts its operations by the client it is configured
+// with, and copies its plan to its state. Its model does not know
+// controller_name.
+type clientResource struct {
+	client     *juju.Client
+	operations map[*juju.Client]int
+}
+
+type clientResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *clientResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client"
+}
+
+func (r *clientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Computed: true},
+		"name": schema.StringAttribute{Optional: true},
+	}}
+}
+
+func (r *clientResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(*juju.Client); ok {
+		r.client = client
+	}
+}
+
+func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	plan.ID = types.StringValue(plan.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	r.operations[r.client]++
+}
+
+func (r *clientResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *clientResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *clientResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func (r *clientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	r.operations[r.client]++
+}
+
+func (r *clientResource) ModifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse) {
+	r.operations[r.client]++
+}
+
+func TestControllerSelector(t *testing.T) {
+	ctx := context.Background()
+	client, err := juju.NewClient(ctx, juju.ControllerConfiguration{
+		ControllerLookup: func(name string) (juju.ControllerConfiguration, error) {
+			if name != "production" {
+				return juju.ControllerConfiguration{}, errors.New("not found")
+			}
+			return juju.ControllerConfiguration{ControllerAddresses: []string{"10.0.1.1:17070"}}, nil
+		},
+	})
+	require.NoError(t, err)
+	production, err := client.ForController(ctx, "production")
+	require.NoError(t, err)
+
+	var operations map[*juju.Client]int
+	newSelector := func() resource.Resource {
+		operations = map[*juju.Client]int{}
+		s := newControllerSelector(func() resource.Resource { return &clientResource{operations: operations} })
+		s.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+		return s
+	}
+	var schemaResp resource.SchemaResponse
+	newSelector().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.Contains(t, schemaResp.Schema.Attributes, JujuControllerName)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	nullState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+	plan := func(controller interface{}) tfsdk.Plan {
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":               tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":             tftypes.NewValue(tftypes.String, "postgresql"),
+			JujuControllerName: tftypes.NewValue(tftypes.String, controller),
+		})}
+	}
+
+	// Resources are on the controller of the provider by default.
+	createResp := resource.CreateResponse{State: nullState}
+	newSelector().Create(ctx, resource.CreateRequest{Plan: plan(nil)}, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, map[*juju.Client]int{client: 1}, operations)
+
+	// Or on the controller selected, which is kept in the state.
+	createResp = resource.CreateResponse{State: nullState}
+	newSelector().Create(ctx, resource.CreateRequest{Plan: plan("production")}, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, map[*juju.Client]int{production: 1}, operations)
+	var name, id types.String
+	require.False(t, createResp.State.GetAttribute(ctx, path.Root(JujuControllerName), &name).HasError())
+	require.False(t, createResp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+	assert.Equal(t, "production", name.ValueString())
+	assert.Equal(t, "postgresql", id.ValueString())
+
+	// A controller not known yet is not replaced by another one.
+	modifyResp := resource.ModifyPlanResponse{Plan: plan(tftypes.UnknownValue)}
+	newSelector().(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Plan:  plan(tftypes.UnknownValue),
+		State: nullState,
+	}, &modifyResp)
+	require.False(t, modifyResp.Diagnostics.HasError(), modifyResp.Diagnostics)
+	assert.Equal(t, map[*juju.Client]int{nil: 1}, operations)
+
+	// Controllers which cannot be found are reported.
+	createResp = resource.CreateResponse{State: nullState}
+	newSelector().Create(ctx, resource.CreateRequest{Plan: plan("unknown")}, &createResp)
+	require.True(t, createResp.Diagnostics.HasError())
+	assert.Equal(t, "Controller Not Found", createResp.Diagnostics.Errors()[0].Summary())
+
+	// Resources are imported on the controller prefixing the import ID.
+	importResp := resource.ImportStateResponse{State: nullState}
+	newSelector().(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{
+		ID: "production::postgresql",
+	}, &importResp)
+	require.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+	assert.Equal(t, map[*juju.Client]int{production: 1}, operations)
+	require.False(t, importResp.State.GetAttribute(ctx, path.Root(JujuControllerName), &name).HasError())
+	require.False(t, importResp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+	assert.Equal(t, "production", name.ValueString())
+	assert.Equal(t, "postgresql", id.ValueString())
+}
+
+func TestSplitControllerImportID(t *testing.T) {
+	for _, test := range []struct {
+		value, controller, id string
+	}{
+		{value: "development:postgresql", id: "development:postgresql"},
+		{value: "production::development:postgresql", controller: "production", id: "development:postgresql"},
+		// The instance ID of a manually provisioned machine may be an
+		// IPv6 address.
+		{value: "development:instance-id:manual:fe80::1", id: "development:instance-id:manual:fe80::1"},
+		{value: "::development:postgresql", id: "::development:postgresql"},
+		{value: "production::", id: "production::"},
+	} {
+		controller, id := splitControllerImportID(test.value)
+		assert.Equal(t, test.controller, controller, test.value)
+		assert.Equal(t, test.id, id, test.value)
+	}
+}
@@ -5,11 +5,15 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,11 +22,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jujuerrors "github.com/juju/errors"
+	jujucloud "github.com/juju/juju/cloud"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -30,6 +38,8 @@ import (
 var _ resource.Resource = &credentialResource{}
 var _ resource.ResourceWithConfigure = &credentialResource{}
 var _ resource.ResourceWithImportState = &credentialResource{}
+var _ resource.ResourceWithConfigValidators = &credentialResource{}
+var _ resource.ResourceWithModifyPlan = &credentialResource{}
 
 func NewCredentialResource() resource.Resource {
 	return &credentialResource{}
@@ -46,9 +56,12 @@ type credentialResourceModel struct {
 	Cloud                types.List   `tfsdk:"cloud"`
 	Attributes           types.Map    `tfsdk:"attributes"`
 	AuthType             types.String `tfsdk:"auth_type"`
+	FromEnvironment      types.String `tfsdk:"from_environment"`
+	EnvironmentSHA256    types.String `tfsdk:"environment_sha256"`
 	ClientCredential     types.Bool   `tfsdk:"client_credential"`
 	ControllerCredential types.Bool   `tfsdk:"controller_credential"`
 	Name                 types.String `tfsdk:"name"`
+	Models               types.Set    `tfsdk:"models"`
 
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
@@ -82,14 +95,45 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		},
 		Attributes: map[string]schema.Attribute{
 			"attributes": schema.MapAttribute{
-				Description: "Credential attributes accordingly to the cloud",
+				Description: "Credential attributes accordingly to the cloud and auth_type, e.g. `access-key` and " +
+					"`secret-key` for the `access-key` auth_type of an AWS cloud. Validated at plan time against " +
+					"the credential schema of the cloud, when the cloud is known to the controller.",
 				ElementType: types.StringType,
 				Optional:    true,
 				Sensitive:   true,
 			},
 			"auth_type": schema.StringAttribute{
-				Description: "Credential authorization type",
-				Required:    true,
+				Description: "Credential authorization type, one of the auth types supported by the cloud: " +
+					"`access-key`, `certificate`, `clientcertificate`, `empty`, `httpsig`, `instance-role`, " +
+					"`interactive`, `jsonfile`, `oauth1`, `oauth2`, `oauth2withcert`, `service-principal-secret` " +
+					"or `userpass`. Required unless from_environment is set, in which case it defaults to the " +
+					"authorization type of the credential read from the environment.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					authTypeFromEnvironment{},
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(credentialAuthTypes...),
+				},
+			},
+			"from_environment": schema.StringAttribute{
+				Description: "Build the credential attributes from the standard environment variables of a cloud " +
+					"provider. One of `aws` (AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY), `gcp` (the service account " +
+					"key file referenced by GOOGLE_APPLICATION_CREDENTIALS) or `azure` (AZURE_CLIENT_ID, " +
+					"AZURE_CLIENT_SECRET and AZURE_SUBSCRIPTION_ID). Unlike `juju autoload-credentials`, credential " +
+					"files such as `~/.aws/credentials` and instance metadata are not read. Values set in attributes " +
+					"take precedence. The environment is read again on each plan, a rotated key updates the credential.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(juju.CredentialEnvironmentAWS, juju.CredentialEnvironmentGCP, juju.CredentialEnvironmentAzure),
+				},
+			},
+			"environment_sha256": schema.StringAttribute{
+				Description: "The SHA-256 of the credential attributes built with from_environment, computed from " +
+					"the environment on plan and from the credential on refresh, so that a change of either " +
+					"updates the credential.",
+				Computed: true,
 			},
 			"client_credential": schema.BoolAttribute{
 				Description: "Add credentials to the client",
@@ -110,6 +154,15 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"models": schema.SetAttribute{
+				Description: "The names of the models using the credential. Only controller credentials " +
+					"are used by models. Updated on refresh.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
 
 			// ID required by the testing framework
 			"id": schema.StringAttribute{
@@ -122,6 +175,98 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ConfigValidators sets validators for the resource.
+func (c *credentialResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("auth_type"),
+			path.MatchRoot("from_environment"),
+		),
+	}
+}
+
+// credentialAuthTypes are the auth types of the credentials supported by
+// the juju clouds.
+var credentialAuthTypes = []string{
+	string(jujucloud.AccessKeyAuthType),
+	string(jujucloud.CertificateAuthType),
+	string(jujucloud.ClientCertificateAuthType),
+	string(jujucloud.EmptyAuthType),
+	string(jujucloud.HTTPSigAuthType),
+	string(jujucloud.InstanceRoleAuthType),
+	string(jujucloud.InteractiveAuthType),
+	string(jujucloud.JSONFileAuthType),
+	string(jujucloud.OAuth1AuthType),
+	string(jujucloud.OAuth2AuthType),
+	string(jujucloud.OAuth2WithCertAuthType),
+	"service-principal-secret",
+	string(jujucloud.UserPassAuthType),
+}
+
+// ModifyPlan validates the auth type and the attributes of the credential
+// against the cloud and its credential schema, so that an invalid
+// credential is reported at plan time rather than rejected at apply time.
+// Credentials built from the environment, and credentials of clouds
+// unknown to the controller, are validated on apply only.
+func (c *credentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or without a configured provider.
+	if c.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan credentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(modifyEnvironmentSHA256Plan(ctx, plan, resp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.FromEnvironment.IsNull() || plan.AuthType.IsUnknown() || plan.Attributes.IsUnknown() ||
+		plan.Cloud.IsUnknown() || len(plan.Cloud.Elements()) != 1 {
+		return
+	}
+	cloud, ok := plan.Cloud.Elements()[0].(types.Object)
+	if !ok {
+		return
+	}
+	cloudName, ok := cloud.Attributes()["name"].(types.String)
+	if !ok || cloudName.IsUnknown() {
+		return
+	}
+	var values map[string]types.String
+	resp.Diagnostics.Append(plan.Attributes.ElementsAs(ctx, &values, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	attributes := make(map[string]string, len(values))
+	for k, v := range values {
+		// Unknown values are only validated on apply.
+		if v.IsUnknown() {
+			return
+		}
+		attributes[k] = v.ValueString()
+	}
+
+	err := c.client.Credentials.ValidateCredential(ctx, juju.ValidateCredentialInput{
+		Attributes: attributes,
+		AuthType:   plan.AuthType.ValueString(),
+		CloudName:  cloudName.ValueString(),
+	})
+	switch {
+	case err == nil:
+	case jujuerrors.Is(err, jujuerrors.NotSupported):
+		resp.Diagnostics.AddAttributeError(path.Root("auth_type"), "Invalid Credential", err.Error())
+	case jujuerrors.Is(err, jujuerrors.NotValid):
+		resp.Diagnostics.AddAttributeError(path.Root("attributes"), "Invalid Credential", err.Error())
+	default:
+		// The cloud may not be known to the controller, e.g. for client
+		// credentials, leave the validation to apply.
+		c.trace("unable to validate credential", map[string]interface{}{"error": err.Error()})
+	}
+}
+
 func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Check first if the client is configured
 	if c.client == nil {
@@ -139,8 +284,7 @@ func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Access the fields
 	// attributes
-	var attributes map[string]string
-	resp.Diagnostics.Append(data.Attributes.ElementsAs(ctx, &attributes, false)...)
+	attributes := credentialAttributes(ctx, data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -165,7 +309,7 @@ func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	credentialName := data.Name.ValueString()
 
 	// Perform logic or external calls
-	response, err := c.client.Credentials.CreateCredential(juju.CreateCredentialInput{
+	response, err := c.client.Credentials.CreateCredential(ctx, juju.CreateCredentialInput{
 		Attributes:           attributes,
 		AuthType:             authType,
 		ClientCredential:     clientCredential,
@@ -179,7 +323,15 @@ func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 	c.trace(fmt.Sprintf("created credential resource %q", credentialName))
 
-	data.ID = types.StringValue(newCredentialIDFrom(credentialName, response.CloudName, clientCredential, controllerCredential))
+	data.EnvironmentSHA256 = environmentSHA256Value(data, attributes)
+	data.ID = types.StringValue(ids.CredentialID{
+		Name:       credentialName,
+		Cloud:      response.CloudName,
+		Client:     clientCredential,
+		Controller: controllerCredential,
+	}.String())
+	// A new credential is not used by any model yet.
+	data.Models = credentialModelsValue(nil)
 
 	// Write the state data into the Response.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -202,20 +354,24 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Access prior state data
 
-	credentialName, cloudName, clientCredential, controllerCredential := retrieveCredentialDataFromID(data.ID.ValueString(), &resp.Diagnostics,
-		"read")
-	if resp.Diagnostics.HasError() {
+	credentialID, err := ids.ParseCredentialID(data.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	credentialName, cloudName, clientCredential, controllerCredential := credentialID.Name, credentialID.Cloud, credentialID.Client, credentialID.Controller
 
 	// Retrieve updated resource state from upstream
-	response, err := c.client.Credentials.ReadCredential(juju.ReadCredentialInput{
+	response, err := c.client.Credentials.ReadCredential(ctx, juju.ReadCredentialInput{
 		ClientCredential:     clientCredential,
 		CloudName:            cloudName,
 		ControllerCredential: controllerCredential,
 		Name:                 credentialName,
 	})
 	if err != nil {
+		if keepStateDuringControllerUpgrade(c.client, err, &resp.Diagnostics, "credential") {
+			return
+		}
 		// TODO (cderici): call resp.State.RemoveResource() if NotFound
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential resource, got error: %s", err))
 		return
@@ -238,8 +394,12 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.Name = types.StringValue(response.CloudCredential.Label)
 	data.AuthType = types.StringValue(string(response.CloudCredential.AuthType()))
 
+	// retrieve the models using the credential
+	data.Models = credentialModelsValue(response.Models)
+
 	// retrieve the attributes
 	receivedAttributes := response.CloudCredential.Attributes()
+	data.EnvironmentSHA256 = c.readEnvironmentSHA256(ctx, data, receivedAttributes)
 	if len(receivedAttributes) > 0 {
 		var configuredAttributes map[string]string
 		resp.Diagnostics.Append(data.Attributes.ElementsAs(ctx, &configuredAttributes, false)...)
@@ -293,16 +453,20 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	if data.AuthType.Equal(state.AuthType) &&
 		data.ClientCredential.Equal(state.ClientCredential) &&
 		data.ControllerCredential.Equal(state.ControllerCredential) &&
-		data.Attributes.Equal(state.Attributes) {
+		data.Attributes.Equal(state.Attributes) &&
+		data.FromEnvironment.Equal(state.FromEnvironment) &&
+		data.EnvironmentSHA256.Equal(state.EnvironmentSHA256) {
 		return
 	}
 
 	// Extract fields from the ID for the UpdateCredentialInput call
 	// name & cloud.name fields
-	credentialName, cloudName, _, _ := retrieveCredentialDataFromID(data.ID.ValueString(), &resp.Diagnostics, "update")
-	if resp.Diagnostics.HasError() {
+	credentialID, err := ids.ParseCredentialID(data.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	credentialName, cloudName := credentialID.Name, credentialID.Cloud
 
 	// auth_type
 	newAuthType := data.AuthType.ValueString()
@@ -312,14 +476,13 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	newControllerCredential := data.ControllerCredential.ValueBool()
 
 	// attributes
-	var newAttributes map[string]string
-	resp.Diagnostics.Append(data.Attributes.ElementsAs(ctx, &newAttributes, false)...)
+	newAttributes := credentialAttributes(ctx, data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Perform external call to modify resource
-	err := c.client.Credentials.UpdateCredential(juju.UpdateCredentialInput{
+	err = c.client.Credentials.UpdateCredential(ctx, juju.UpdateCredentialInput{
 		Attributes:           newAttributes,
 		AuthType:             newAuthType,
 		ClientCredential:     newClientCredential,
@@ -333,7 +496,13 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 	c.trace(fmt.Sprintf("updated credential resource %q", credentialName))
 
-	data.ID = types.StringValue(newCredentialIDFrom(credentialName, cloudName, newClientCredential, newControllerCredential))
+	data.EnvironmentSHA256 = environmentSHA256Value(data, newAttributes)
+	data.ID = types.StringValue(ids.CredentialID{
+		Name:       credentialName,
+		Cloud:      cloudName,
+		Client:     newClientCredential,
+		Controller: newControllerCredential,
+	}.String())
 
 	// Write the updated state data into the Response.State
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -357,14 +526,15 @@ func (c *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Access prior state data
 
 	// extract : name & cloud.name, client_credential, controller_credential
-	credentialName, cloudName, clientCredential, controllerCredential := retrieveCredentialDataFromID(data.ID.ValueString(), &resp.Diagnostics,
-		"update")
-	if resp.Diagnostics.HasError() {
+	credentialID, err := ids.ParseCredentialID(data.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	credentialName, cloudName, clientCredential, controllerCredential := credentialID.Name, credentialID.Cloud, credentialID.Client, credentialID.Controller
 
 	// Perform external call to destroy the resource
-	err := c.client.Credentials.DestroyCredential(juju.DestroyCredentialInput{
+	err = c.client.Credentials.DestroyCredential(ctx, juju.DestroyCredentialInput{
 		ClientCredential:     clientCredential,
 		CloudName:            cloudName,
 		ControllerCredential: controllerCredential,
@@ -392,11 +562,21 @@ func (c *credentialResource) Configure(ctx context.Context, req resource.Configu
 	}
 	c.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	c.subCtx = tflog.NewSubsystem(ctx, LogResourceCredential)
+	c.subCtx = client.NewLogSubsystem(ctx, LogResourceCredential)
 }
 
 func (c credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughValidID(ctx, ids.ParseCredentialID, req, resp)
+}
+
+// credentialModelsValue returns the set of the names of the models using
+// a credential, empty rather than null when no model uses it.
+func credentialModelsValue(models []string) types.Set {
+	elements := make([]attr.Value, 0, len(models))
+	for _, model := range models {
+		elements = append(elements, types.StringValue(model))
+	}
+	return types.SetValueMust(types.StringType, elements)
 }
 
 func (c *credentialResource) trace(msg string, additionalFields ...map[string]interface{}) {
@@ -410,6 +590,125 @@ func (c *credentialResource) trace(msg string, additionalFields ...map[string]in
 	tflog.SubsystemTrace(c.subCtx, LogResourceCredential, msg, additionalFields...)
 }
 
+// credentialAttributes returns the configured credential attributes,
+// merged over the attributes read from the environment when
+// from_environment is set.
+func credentialAttributes(ctx context.Context, data credentialResourceModel, diags *diag.Diagnostics) map[string]string {
+	var configured map[string]string
+	diags.Append(data.Attributes.ElementsAs(ctx, &configured, false)...)
+	if diags.HasError() || data.FromEnvironment.IsNull() {
+		return configured
+	}
+	attributes, err := juju.EnvironmentCredentialAttributes(data.FromEnvironment.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("from_environment"), "Credential Environment Error",
+			fmt.Sprintf("Unable to read %s credential from the environment, got error: %s", data.FromEnvironment.ValueString(), err))
+		return nil
+	}
+	for k, v := range configured {
+		attributes[k] = v
+	}
+	return attributes
+}
+
+// credentialAttributesSHA256 returns the SHA-256 of the given credential
+// attributes, independent of their order.
+func credentialAttributesSHA256(attributes map[string]string) string {
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(h, "%s=%q\n", k, attributes[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// environmentSHA256Value returns the environment_sha256 of a credential
+// created or updated with the given attributes, null when the credential
+// is not built from the environment.
+func environmentSHA256Value(data credentialResourceModel, attributes map[string]string) types.String {
+	if data.FromEnvironment.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(credentialAttributesSHA256(attributes))
+}
+
+// modifyEnvironmentSHA256Plan reads the credential attributes from the
+// environment again, so that a key rotated in the environment plans an
+// update of the credential. When the environment cannot be read, the
+// value is left unknown and the error is reported on apply.
+func modifyEnvironmentSHA256Plan(ctx context.Context, plan credentialResourceModel, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	sum := types.StringNull()
+	if plan.FromEnvironment.IsUnknown() || plan.Attributes.IsUnknown() {
+		sum = types.StringUnknown()
+	} else if !plan.FromEnvironment.IsNull() {
+		var diags diag.Diagnostics
+		attributes := credentialAttributes(ctx, plan, &diags)
+		if diags.HasError() {
+			sum = types.StringUnknown()
+		} else {
+			sum = types.StringValue(credentialAttributesSHA256(attributes))
+		}
+	}
+	return resp.Plan.SetAttribute(ctx, path.Root("environment_sha256"), sum)
+}
+
+// readEnvironmentSHA256 returns the environment_sha256 of the attributes
+// of the credential read from juju, for the attributes built from the
+// environment. The prior value is kept when the environment cannot be
+// read.
+func (c *credentialResource) readEnvironmentSHA256(ctx context.Context, data credentialResourceModel, received map[string]string) types.String {
+	if data.FromEnvironment.IsNull() {
+		return types.StringNull()
+	}
+	var diags diag.Diagnostics
+	expected := credentialAttributes(ctx, data, &diags)
+	if diags.HasError() {
+		c.trace("unable to read credential from the environment", map[string]interface{}{"from_environment": data.FromEnvironment.ValueString()})
+		return data.EnvironmentSHA256
+	}
+	actual := make(map[string]string, len(expected))
+	for k := range expected {
+		if value, ok := received[k]; ok {
+			actual[k] = value
+		}
+	}
+	return types.StringValue(credentialAttributesSHA256(actual))
+}
+
+// authTypeFromEnvironment is a plan modifier which sets the auth type
+// to the one of the credential read from the environment when auth_type
+// is not configured and from_environment is.
+type authTypeFromEnvironment struct{}
+
+func (m authTypeFromEnvironment) Description(_ context.Context) string {
+	return "Defaults to the authorization type of the credential read with from_environment."
+}
+
+func (m authTypeFromEnvironment) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m authTypeFromEnvironment) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	var fromEnvironment types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("from_environment"), &fromEnvironment)...)
+	if resp.Diagnostics.HasError() || fromEnvironment.IsNull() || fromEnvironment.IsUnknown() {
+		return
+	}
+	authType, err := juju.EnvironmentCredentialAuthType(fromEnvironment.ValueString())
+	if err != nil {
+		// Reported by the from_environment validator.
+		return
+	}
+	resp.PlanValue = types.StringValue(authType)
+}
+
 func cloudNameFromCredentialCloud(ctx context.Context, element attr.Value, diag diag.Diagnostics) (string,
 	diag.Diagnostics) {
 	blockAttributeType := map[string]attr.Type{
@@ -446,29 +745,6 @@ func newCredentialCloudFromCloudName(ctx context.Context, cloudName string, diag
 	return cloud, diag
 }
 
-func newCredentialIDFrom(credentialName string, cloudName string, clientCredential bool, controllerCredential bool) string {
-	return fmt.Sprintf("%s:%s:%t:%t", credentialName, cloudName, clientCredential, controllerCredential)
-}
-
-func retrieveCredentialDataFromID(idStr string, diag *diag.Diagnostics, method string) (string, string, bool, bool) {
-	resID := strings.Split(idStr, ":")
-	if len(resID) != 4 {
-		diag.AddError("Provider Error",
-			fmt.Sprintf("unable to %s credential resource, invalid ID, expected {credentialName, cloudName, "+
-				"isClient, isController} - given : %q",
-				method, resID))
-		return "", "", false, false
-	}
-	credentialName, cloudName, clientCredentialStr, controllerCredentialStr := resID[0], resID[1], resID[2], resID[3]
-	clientCredential, controllerCredential, err := convertOptionsBool(clientCredentialStr, controllerCredentialStr)
-	if err != nil {
-		diag.AddError("Provider Error",
-			fmt.Sprintf("Unable to %s credential resource, got error: %s", method, err))
-		return "", "", false, false
-	}
-	return credentialName, cloudName, clientCredential, controllerCredential
-}
-
 func attributeEntryToString(input interface{}) string {
 	switch t := input.(type) {
 	case bool:
@@ -481,17 +757,3 @@ func attributeEntryToString(input interface{}) string {
 		return input.(string)
 	}
 }
-
-func convertOptionsBool(clientCredentialStr, controllerCredentialStr string) (bool, bool, error) {
-	clientCredentialBool, err := strconv.ParseBool(clientCredentialStr)
-	if err != nil {
-		return false, false, fmt.Errorf("unable to parse client credential from provided ID")
-	}
-
-	controllerCredentialBool, err := strconv.ParseBool(controllerCredentialStr)
-	if err != nil {
-		return false, false, fmt.Errorf("unable to parse controller credential from provided ID")
-	}
-
-	return clientCredentialBool, controllerCredentialBool, nil
-}
@@ -4,39 +4,156 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func TestCredentialAttributesFromEnvironment(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "discovered-access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "discovered-secret-key")
+
+	data := credentialResourceModel{
+		FromEnvironment: types.StringValue("aws"),
+		Attributes: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"secret-key": types.StringValue("configured-secret-key"),
+		}),
+	}
+	var diags diag.Diagnostics
+	attributes := credentialAttributes(context.Background(), data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	expected := map[string]string{
+		"access-key": "discovered-access-key",
+		"secret-key": "configured-secret-key",
+	}
+	if fmt.Sprint(attributes) != fmt.Sprint(expected) {
+		t.Fatalf("expected attributes %v, got %v", expected, attributes)
+	}
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	_ = credentialAttributes(context.Background(), data, &diags)
+	if !diags.HasError() {
+		t.Fatal("expected an error when the AWS environment variables are not set")
+	}
+}
+
+func TestReadEnvironmentSHA256(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret-key")
+
+	c := &credentialResource{}
+	data := credentialResourceModel{
+		FromEnvironment: types.StringValue("aws"),
+		Attributes:      types.MapNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	created := environmentSHA256Value(data, credentialAttributes(context.Background(), data, &diags))
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	data.EnvironmentSHA256 = created
+	received := map[string]string{
+		"access-key": "access-key",
+		"secret-key": "secret-key",
+	}
+	if read := c.readEnvironmentSHA256(context.Background(), data, received); !read.Equal(created) {
+		t.Fatalf("expected %s for the credential created, got %s", created, read)
+	}
+
+	// The key is rotated in the environment, the credential is updated.
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "rotated-secret-key")
+	var schemaResp fwresource.SchemaResponse
+	c.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+	resp := fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}}
+	diags = modifyEnvironmentSHA256Plan(context.Background(), data, &resp)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags.Errors())
+	}
+	var planned types.String
+	resp.Plan.GetAttribute(context.Background(), path.Root("environment_sha256"), &planned)
+	if planned.IsUnknown() || planned.Equal(created) {
+		t.Fatalf("expected the rotated key to change the environment SHA-256, got %s", planned)
+	}
+	if read := c.readEnvironmentSHA256(context.Background(), data, received); !read.Equal(created) {
+		t.Fatalf("expected %s for the credential not updated yet, got %s", created, read)
+	}
+
+	// The prior value is kept when the environment cannot be read.
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	if read := c.readEnvironmentSHA256(context.Background(), data, received); !read.Equal(created) {
+		t.Fatalf("expected the prior value %s, got %s", created, read)
+	}
+
+	// Credentials not built from the environment have no environment SHA-256.
+	data.FromEnvironment = types.StringNull()
+	if read := c.readEnvironmentSHA256(context.Background(), data, received); !read.IsNull() {
+		t.Fatalf("expected a null value, got %s", read)
+	}
+}
+
+func TestCredentialModelsValue(t *testing.T) {
+	// Client credentials are not used by models.
+	models := credentialModelsValue(nil)
+	if models.IsNull() || len(models.Elements()) != 0 {
+		t.Fatalf("expected an empty set, got %s", models)
+	}
+	models = credentialModelsValue([]string{"development", "production"})
+	expected := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("development"),
+		types.StringValue("production"),
+	})
+	if !models.Equal(expected) {
+		t.Fatalf("expected %s, got %s", expected, models)
+	}
+}
+
 func TestAcc_ResourceCredential(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
 	}
 	credentialName := acctest.RandomWithPrefix("tf-test-credential")
 	authType := "certificate"
-	token := "123abc"
+	clientKey := "123abc"
 
 	resourceName := "juju_credential.test-credential"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: frameworkProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceCredentialToken(credentialName, authType, "123abc"),
+				ExpectError: regexp.MustCompile(`missing "server-cert", "client-cert", "client-key"; unknown "token"`),
+			},
 			{
 				Config: testAccResourceCredential(credentialName, authType),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", credentialName),
 					resource.TestCheckResourceAttr(resourceName, "auth_type", authType),
+					resource.TestCheckResourceAttr(resourceName, "models.#", "0"),
 				),
 			},
 			{
-				Config: testAccResourceCredentialToken(credentialName, authType, token),
+				Config: testAccResourceCredentialClientKey(credentialName, authType, clientKey),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", credentialName),
 					resource.TestCheckResourceAttr(resourceName, "auth_type", authType),
-					resource.TestCheckResourceAttr(resourceName, "attributes.token", token),
+					resource.TestCheckResourceAttr(resourceName, "attributes.client-key", clientKey),
 				),
 			},
 			{
@@ -45,7 +162,9 @@ func TestAcc_ResourceCredential(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerifyIgnore: []string{
 					"attributes.%",
-					"attributes.token"},
+					"attributes.server-cert",
+					"attributes.client-cert",
+					"attributes.client-key"},
 				ImportStateId: fmt.Sprintf("%s:localhost:false:true", credentialName),
 				ResourceName:  resourceName,
 			},
@@ -97,9 +216,34 @@ resource "juju_credential" "test-credential" {
   }
 
   auth_type = "%s"
+
+  attributes = {
+	server-cert = "server-cert"
+	client-cert = "client-cert"
+	client-key  = "client-key"
+  }
 }`, credentialName, authType)
 }
 
+func testAccResourceCredentialClientKey(credentialName, authType, clientKey string) string {
+	return fmt.Sprintf(`
+resource "juju_credential" "test-credential" {
+  name = %q
+
+  cloud {
+   name   = "localhost"
+  }
+
+  auth_type = "%s"
+
+  attributes = {
+	server-cert = "server-cert"
+	client-cert = "client-cert"
+	client-key  = "%s"
+  }
+}`, credentialName, authType, clientKey)
+}
+
 func testAccResourceCredentialToken(credentialName, authType, token string) string {
 	return fmt.Sprintf(`
 resource "juju_credential" "test-credential" {
@@ -7,13 +7,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -45,6 +46,11 @@ type integrationResourceModel struct {
 	ModelName   types.String `tfsdk:"model"`
 	Via         types.String `tfsdk:"via"`
 	Application types.Set    `tfsdk:"application"`
+	// AdoptExisting makes Create adopt an integration which
+	// already exists between the same endpoints.
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+	// CLIEquivalent is computed from the other attributes.
+	CLIEquivalent types.String `tfsdk:"cli_equivalent"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -55,11 +61,12 @@ type nestedApplication struct {
 	Name     types.String `tfsdk:"name"`
 	Endpoint types.String `tfsdk:"endpoint"`
 	OfferURL types.String `tfsdk:"offer_url"`
+	SAASName types.String `tfsdk:"saas_name"`
 }
 
 func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughValidID(ctx, ids.ParseIntegrationID, req, resp)
 }
 
 func (r *integrationResource) Configure(ctx context.Context, req resource.ConfigureRequest,
@@ -78,7 +85,7 @@ func (r *integrationResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 	r.client = client
-	r.subCtx = tflog.NewSubsystem(ctx, LogResourceIntegration)
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceIntegration)
 }
 
 // Called during terraform validate through ValidateResourceConfig RPC
@@ -101,6 +108,8 @@ func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.V
 			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "the \"offer_url\" and \"name\" fields are mutually exclusive.")
 		} else if !app.OfferURL.IsNull() && !app.Endpoint.IsNull() {
 			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "the \"endpoint\" field can not be specified with the \"offer_url\" field.")
+		} else if app.OfferURL.IsNull() && !app.SAASName.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("applications"), "Attribute Error", "the \"saas_name\" field can only be specified with the \"offer_url\" field.")
 		}
 	}
 }
@@ -121,6 +130,16 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "A comma separated list of CIDRs for outbound traffic.",
 				Optional:    true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "Whether an integration which already exists between the same endpoints is " +
+					"adopted into the state on create, rather than failing, e.g. when re-running after a " +
+					"partially failed apply. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			CLIEquivalentKey: cliEquivalentAttribute("creating an equivalent integration, e.g. " +
+				"`juju integrate --model development postgresql:database wordpress:db`"),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -147,12 +166,21 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 							Computed:    true,
 						},
 						"offer_url": schema.StringAttribute{
-							Description: "The URL of a remote application.",
-							Optional:    true,
+							Description: "The URL of a remote application. An offer hosted in the model of the " +
+								"integration is not consumed, the offered application is integrated with directly.",
+							Optional: true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
 						},
+						"saas_name": schema.StringAttribute{
+							Description: "The name of the application standing for the offer in the model, " +
+								"like `juju consume <offer url> <saas name>`. Defaults to the name of the offer. " +
+								"Can only be specified with offer_url. For an offer hosted in the model of the integration, " +
+								"the name of the offered application.",
+							Optional: true,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -190,9 +218,10 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 
 	var offerResponse = &juju.ConsumeRemoteOfferResponse{}
 	if offerURL != nil {
-		offerResponse, err = r.client.Offers.ConsumeRemoteOffer(&juju.ConsumeRemoteOfferInput{
+		offerResponse, err = r.client.Offers.ConsumeRemoteOffer(ctx, &juju.ConsumeRemoteOfferInput{
 			ModelName: modelName,
 			OfferURL:  *offerURL,
+			SAASName:  offerSAASName(apps),
 		})
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to consume remote offer, got error: %s", err))
@@ -201,16 +230,20 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 		r.trace(fmt.Sprintf("remote offer created : %q", *offerURL))
 	}
 
-	if offerResponse.SAASName != "" {
-		endpoints = append(endpoints, offerResponse.SAASName)
+	if endpoint := offerEndpoint(offerResponse); endpoint != "" {
+		endpoints = append(endpoints, endpoint)
+	}
+	if offerResponse.LocalApplication != "" {
+		appNames = append(appNames, offerResponse.LocalApplication)
 	}
 
 	viaCIDRs := plan.Via.ValueString()
-	response, err := r.client.Integrations.CreateIntegration(&juju.IntegrationInput{
-		ModelName: modelName,
-		Apps:      appNames,
-		Endpoints: endpoints,
-		ViaCIDRs:  viaCIDRs,
+	response, err := r.client.Integrations.CreateIntegration(ctx, &juju.IntegrationInput{
+		ModelName:     modelName,
+		Apps:          appNames,
+		Endpoints:     endpoints,
+		ViaCIDRs:      viaCIDRs,
+		AdoptExisting: plan.AdoptExisting.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration, got error: %s", err))
@@ -218,7 +251,7 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	r.trace(fmt.Sprintf("integration created on Juju between %q at %q on model %q", appNames, endpoints, modelName))
 
-	parsedApplications := parseApplications(response.Applications)
+	parsedApplications := keepLocalOffers(keepOfferURLs(parseApplications(response.Applications), apps), apps)
 
 	appsType := req.Plan.Schema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
 	parsedApps, errDiag := types.SetValueFrom(ctx, appsType, parsedApplications)
@@ -233,6 +266,7 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 
 	r.trace(fmt.Sprintf("integration resource created: %q", id))
 	// Write the state plan into the Response.State
+	plan.CLIEquivalent = integrationCLIEquivalent(ctx, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -251,30 +285,42 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	modelName, endpointA, endpointB, idErr := modelNameAndEndpointsFromID(state.ID.ValueString())
-	if idErr.HasError() {
-		resp.Diagnostics.Append(idErr...)
+	integrationID, err := ids.ParseIntegrationID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	modelName := integrationID.Model
 
 	integration := &juju.IntegrationInput{
 		ModelName: modelName,
 		Endpoints: []string{
-			endpointA,
-			endpointB,
+			integrationID.Provider(),
+			integrationID.Requirer(),
 		},
 	}
 
-	response, err := r.client.Integrations.ReadIntegration(integration)
+	response, err := r.client.Integrations.ReadIntegration(ctx, integration)
 	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "integration") {
+			return
+		}
 		resp.Diagnostics.Append(handleIntegrationNotFoundError(ctx, err, &resp.State)...)
 		return
 	}
 	r.trace(fmt.Sprintf("found integration: %v", integration))
 
 	state.ModelName = types.StringValue(modelName)
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
 
-	applications := parseApplications(response.Applications)
+	var stateApps []nestedApplication
+	resp.Diagnostics.Append(state.Application.ElementsAs(ctx, &stateApps, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	applications := keepLocalOffers(keepOfferURLs(parseApplications(response.Applications), stateApps), stateApps)
 	appType := req.State.Schema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
 	apps, aErr := types.SetValueFrom(ctx, appType, applications)
 	if aErr.HasError() {
@@ -285,6 +331,7 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	r.trace(fmt.Sprintf("read integration resource: %v", state.ID.ValueString()))
 	// Set the state onto the Terraform state
+	state.CLIEquivalent = integrationCLIEquivalent(ctx, state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -303,10 +350,18 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	if plan.Application.Equal(state.Application) && plan.Via.Equal(state.Via) {
+		// Only adopt_existing changed, which only applies on create.
+		plan.CLIEquivalent = state.CLIEquivalent
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	modelName := plan.ModelName.ValueString()
 
 	var oldEndpoints, endpoints []string
 	var oldOfferURL, offerURL *string
+	var newApps []nestedApplication
 	var err error
 
 	if !plan.Application.Equal(state.Application) {
@@ -318,7 +373,6 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 			return
 		}
 
-		var newApps []nestedApplication
 		plan.Application.ElementsAs(ctx, &newApps, false)
 		endpoints, offerURL, _, err = parseEndpoints(newApps)
 		if err != nil {
@@ -332,7 +386,7 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 	if oldOfferURL != offerURL && !(oldOfferURL == nil && offerURL == nil) {
 		if oldOfferURL != nil {
 			//destroy old offer
-			errs := r.client.Offers.RemoveRemoteOffer(&juju.RemoveRemoteOfferInput{
+			errs := r.client.Offers.RemoveRemoteOffer(ctx, &juju.RemoveRemoteOfferInput{
 				ModelName: modelName,
 				OfferURL:  *oldOfferURL,
 			})
@@ -345,15 +399,16 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 			r.trace(fmt.Sprintf("removed offer on Juju: %q", *oldOfferURL))
 		}
 		if offerURL != nil {
-			offerResponse, err = r.client.Offers.ConsumeRemoteOffer(&juju.ConsumeRemoteOfferInput{
+			offerResponse, err = r.client.Offers.ConsumeRemoteOffer(ctx, &juju.ConsumeRemoteOfferInput{
 				ModelName: modelName,
 				OfferURL:  *offerURL,
+				SAASName:  offerSAASName(newApps),
 			})
 			if err != nil {
 				resp.Diagnostics.AddError("Client Error", err.Error())
 				return
 			}
-			endpoints = append(endpoints, offerResponse.SAASName)
+			endpoints = append(endpoints, offerEndpoint(offerResponse))
 			r.trace(fmt.Sprintf("added offer on Juju: %q", *offerURL))
 		}
 	}
@@ -365,13 +420,13 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		OldEndpoints: oldEndpoints,
 		ViaCIDRs:     viaCIDRs,
 	}
-	response, err := r.client.Integrations.UpdateIntegration(input)
+	response, err := r.client.Integrations.UpdateIntegration(ctx, input)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
 
-	applications := parseApplications(response.Applications)
+	applications := keepLocalOffers(keepOfferURLs(parseApplications(response.Applications), newApps), newApps)
 	appType := req.State.Schema.GetBlocks()["application"].(schema.SetNestedBlock).NestedObject.Type()
 	apps, aErr := types.SetValueFrom(ctx, appType, applications)
 	if aErr.HasError() {
@@ -383,6 +438,7 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.ID = newId
 	r.trace(fmt.Sprintf("Updated integration resource: %q", newId))
 
+	plan.CLIEquivalent = integrationCLIEquivalent(ctx, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -412,7 +468,7 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	// Remove the integration
-	err = r.client.Integrations.DestroyIntegration(&juju.IntegrationInput{
+	err = r.client.Integrations.DestroyIntegration(ctx, &juju.IntegrationInput{
 		ModelName: modelName,
 		Endpoints: endpoints,
 	})
@@ -446,24 +502,14 @@ func newIDForIntegrationResource(modelName string, apps []juju.Application) stri
 		}
 	}
 
-	id := modelName
-	for _, key := range keys {
-		ep := apps[key]
-		id = fmt.Sprintf("%s:%s:%s", id, ep.Name, ep.Endpoint)
-	}
-
-	return id
-}
-
-func modelNameAndEndpointsFromID(ID string) (string, string, string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	id := strings.Split(ID, ":")
-	if len(id) != 5 {
-		diags.AddError("Malformed ID",
-			fmt.Sprintf("unable to parse model and application name from provided ID: %q", ID))
-		return "", "", "", diags
-	}
-	return id[0], fmt.Sprintf("%v:%v", id[1], id[2]), fmt.Sprintf("%v:%v", id[3], id[4]), diags
+	provider, requirer := apps[keys[0]], apps[keys[1]]
+	return ids.IntegrationID{
+		Model:               modelName,
+		ProviderApplication: provider.Name,
+		ProviderEndpoint:    provider.Endpoint,
+		RequirerApplication: requirer.Name,
+		RequirerEndpoint:    requirer.Endpoint,
+	}.String()
 }
 
 // This function can be used to parse the terraform data into usable juju endpoints
@@ -507,6 +553,8 @@ func parseApplications(apps []juju.Application) []nestedApplication {
 
 		if app.OfferURL != nil {
 			a.OfferURL = types.StringValue(*app.OfferURL)
+			// The application standing for the offer in the model.
+			a.SAASName = types.StringValue(app.Name)
 		} else {
 			a.Endpoint = types.StringValue(app.Endpoint)
 			a.Name = types.StringValue(app.Name)
@@ -517,6 +565,90 @@ func parseApplications(apps []juju.Application) []nestedApplication {
 	return applications
 }
 
+// offerSAASName returns the SAAS name configured for the offer among the
+// applications, if any.
+func offerSAASName(apps []nestedApplication) string {
+	for _, app := range apps {
+		if !app.OfferURL.IsNull() {
+			return app.SAASName.ValueString()
+		}
+	}
+	return ""
+}
+
+// keepOfferURLs keeps the offer URLs of the given applications when the
+// controller reports another URL for the same offer, e.g. without the
+// name of the controller hosting it, so that the offer URL does not drift.
+func keepOfferURLs(applications, given []nestedApplication) []nestedApplication {
+	for i, app := range applications {
+		if app.OfferURL.IsNull() {
+			continue
+		}
+		for _, givenApp := range given {
+			if givenApp.OfferURL.IsNull() || givenApp.OfferURL.IsUnknown() {
+				continue
+			}
+			if juju.OfferURLsMatch(app.OfferURL.ValueString(), givenApp.OfferURL.ValueString()) {
+				applications[i].OfferURL = givenApp.OfferURL
+			}
+		}
+	}
+	return applications
+}
+
+// offerEndpoint returns the endpoint to integrate with for the consumed
+// offer, the offered application itself when the offer is hosted in the
+// model of the integration.
+func offerEndpoint(offer *juju.ConsumeRemoteOfferResponse) string {
+	if offer.LocalApplication == "" {
+		return offer.SAASName
+	}
+	if offer.LocalEndpoint == "" {
+		return offer.LocalApplication
+	}
+	return fmt.Sprintf("%v:%v", offer.LocalApplication, offer.LocalEndpoint)
+}
+
+// keepLocalOffers keeps the offer given among the applications when it
+// is hosted in the model of the integration, and therefore reported as
+// a plain application of the model, so that the offer does not drift.
+func keepLocalOffers(applications, given []nestedApplication) []nestedApplication {
+	var offer *nestedApplication
+	givenNames := make(map[string]bool)
+	for i, givenApp := range given {
+		if !givenApp.OfferURL.IsNull() && !givenApp.OfferURL.IsUnknown() {
+			offer = &given[i]
+		} else {
+			givenNames[givenApp.Name.ValueString()] = true
+		}
+	}
+	if offer == nil {
+		return applications
+	}
+	for _, app := range applications {
+		if !app.OfferURL.IsNull() {
+			return applications
+		}
+	}
+	for i, app := range applications {
+		if givenNames[app.Name.ValueString()] {
+			continue
+		}
+		local := nestedApplication{
+			Endpoint: types.StringNull(),
+			Name:     types.StringNull(),
+			OfferURL: offer.OfferURL,
+			SAASName: app.Name,
+		}
+		if !offer.SAASName.IsNull() && !offer.SAASName.IsUnknown() {
+			local.SAASName = offer.SAASName
+		}
+		applications[i] = local
+		break
+	}
+	return applications
+}
+
 func (r *integrationResource) trace(msg string, additionalFields ...map[string]interface{}) {
 	if r.subCtx == nil {
 		return
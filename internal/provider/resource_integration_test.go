@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 func TestAcc_ResourceIntegration(t *testing.T) {
@@ -212,6 +216,104 @@ resource "juju_integration" "a" {
 `, srcModelName, aOS, dstModelName, bOS, viaCIDRs)
 }
 
+func TestAcc_ResourceIntegrationWithSAASName(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	srcModelName := acctest.RandomWithPrefix("tf-test-integration")
+	dstModelName := acctest.RandomWithPrefix("tf-test-integration-dst")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationWithSAASName(srcModelName, dstModelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.b", "id", fmt.Sprintf("%v:%v:%v", dstModelName, "database:db-admin", "b:backend-db-admin")),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b", "application.*", map[string]string{"saas_name": "database"}),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      "juju_integration.b",
+			},
+		},
+	})
+}
+
+func TestKeepOfferURLs(t *testing.T) {
+	read := []nestedApplication{
+		{Name: types.StringValue("b"), Endpoint: types.StringValue("backend-db-admin")},
+		{OfferURL: types.StringValue("admin/db.postgresql"), SAASName: types.StringValue("database")},
+	}
+	given := []nestedApplication{
+		{Name: types.StringValue("b")},
+		{OfferURL: types.StringValue("prod:admin/db.postgresql"), SAASName: types.StringValue("database")},
+	}
+	assert.Equal(t, "database", offerSAASName(given))
+
+	applications := keepOfferURLs(read, given)
+	assert.Equal(t, types.StringValue("prod:admin/db.postgresql"), applications[1].OfferURL)
+	assert.Equal(t, types.StringValue("b"), applications[0].Name)
+
+	// Another offer is reported as it is.
+	given[1].OfferURL = types.StringValue("prod:admin/db.mysql")
+	applications = keepOfferURLs([]nestedApplication{{OfferURL: types.StringValue("admin/db.postgresql")}}, given)
+	assert.Equal(t, types.StringValue("admin/db.postgresql"), applications[0].OfferURL)
+}
+
+func TestKeepLocalOffers(t *testing.T) {
+	read := []nestedApplication{
+		{Name: types.StringValue("b"), Endpoint: types.StringValue("backend-db-admin")},
+		{Name: types.StringValue("a"), Endpoint: types.StringValue("db-admin")},
+	}
+	given := []nestedApplication{
+		{Name: types.StringValue("b"), Endpoint: types.StringValue("backend-db-admin")},
+		{OfferURL: types.StringValue("admin/db.postgresql"), SAASName: types.StringUnknown()},
+	}
+	assert.Equal(t, "a:db-admin", offerEndpoint(&juju.ConsumeRemoteOfferResponse{LocalApplication: "a", LocalEndpoint: "db-admin"}))
+	assert.Equal(t, "database", offerEndpoint(&juju.ConsumeRemoteOfferResponse{SAASName: "database"}))
+
+	applications := keepLocalOffers(read, given)
+	assert.Equal(t, types.StringValue("b"), applications[0].Name)
+	assert.Equal(t, types.StringValue("admin/db.postgresql"), applications[1].OfferURL)
+	assert.Equal(t, types.StringValue("a"), applications[1].SAASName)
+	assert.True(t, applications[1].Name.IsNull())
+
+	// Consumed offers are reported as they are.
+	read = []nestedApplication{
+		{Name: types.StringValue("b"), Endpoint: types.StringValue("backend-db-admin")},
+		{OfferURL: types.StringValue("admin/db.postgresql"), SAASName: types.StringValue("postgresql")},
+	}
+	applications = keepLocalOffers(read, given)
+	assert.Equal(t, types.StringValue("postgresql"), applications[1].SAASName)
+}
+
+func TestAcc_ResourceIntegrationWithLocalOffer(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-integration")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationWithLocalOffer(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_integration.b", "id", fmt.Sprintf("%v:%v:%v", modelName, "a:db-admin", "b:backend-db-admin")),
+					resource.TestCheckTypeSetElemNestedAttrs("juju_integration.b", "application.*", map[string]string{"saas_name": "a"}),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceIntegrationWithMultipleConsumers(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -265,6 +367,108 @@ func TestAcc_ResourceIntegrationWithMultipleConsumers(t *testing.T) {
 	})
 }
 
+func testAccResourceIntegrationWithSAASName(srcModelName string, dstModelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "a" {
+  name = %q
+}
+
+resource "juju_application" "a" {
+  model = juju_model.a.name
+  name  = "a"
+
+  charm {
+    name = "postgresql"
+    base = "ubuntu@22.04"
+  }
+}
+
+resource "juju_offer" "a" {
+  model            = juju_model.a.name
+  application_name = juju_application.a.name
+  endpoint         = "db-admin"
+}
+
+resource "juju_model" "b" {
+  name = %q
+}
+
+resource "juju_application" "b" {
+  model = juju_model.b.name
+  name  = "b"
+
+  charm {
+    name = "pgbouncer"
+    base = "ubuntu@20.04"
+  }
+}
+
+resource "juju_integration" "b" {
+  model = juju_model.b.name
+
+  application {
+    name     = juju_application.b.name
+    endpoint = "backend-db-admin"
+  }
+
+  application {
+    offer_url = juju_offer.a.url
+    saas_name = "database"
+  }
+}
+`, srcModelName, dstModelName)
+}
+
+// testAccResourceIntegrationWithLocalOffer generates a plan where a
+// pgbouncer application relates to a postgresql:db-admin offer of the
+// same model.
+func testAccResourceIntegrationWithLocalOffer(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "a" {
+  name = %q
+}
+
+resource "juju_application" "a" {
+  model = juju_model.a.name
+  name  = "a"
+
+  charm {
+    name = "postgresql"
+    base = "ubuntu@22.04"
+  }
+}
+
+resource "juju_offer" "a" {
+  model            = juju_model.a.name
+  application_name = juju_application.a.name
+  endpoint         = "db-admin"
+}
+
+resource "juju_application" "b" {
+  model = juju_model.a.name
+  name  = "b"
+
+  charm {
+    name = "pgbouncer"
+    base = "ubuntu@20.04"
+  }
+}
+
+resource "juju_integration" "b" {
+  model = juju_model.a.name
+
+  application {
+    name     = juju_application.b.name
+    endpoint = "backend-db-admin"
+  }
+
+  application {
+    offer_url = juju_offer.a.url
+  }
+}
+`, modelName)
+}
+
 // testAccResourceIntegrationWithMultipleConusmers generates a plan where a
 // two pgbouncer applications relates to postgresql:db-admin offer.
 func testAccResourceIntegrationMultipleConsumers(srcModelName string, dstModelName string) string {
@@ -6,21 +6,27 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/canonical/jimm-go-sdk/v3/names"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 var _ resource.Resource = &jaasGroupResource{}
 var _ resource.ResourceWithConfigure = &jaasGroupResource{}
+var _ resource.ResourceWithImportState = &jaasGroupResource{}
+
+// groupNameImportPrefix prefixes the import ID of a group imported by
+// name rather than UUID.
+const groupNameImportPrefix = "name:"
 
 type jaasGroupResource struct {
 	client *juju.Client
@@ -87,7 +93,36 @@ func (resource *jaasGroupResource) Configure(ctx context.Context, req resource.C
 	}
 	resource.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	resource.subCtx = tflog.NewSubsystem(ctx, LogResourceJAASGroup)
+	resource.subCtx = client.NewLogSubsystem(ctx, LogResourceJAASGroup)
+}
+
+// ImportState imports a group by UUID, or by name with an import ID of
+// the form name:<group-name>, resolving its UUID from JAAS.
+func (resource *jaasGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, byName := strings.CutPrefix(req.ID, groupNameImportPrefix)
+	if !byName {
+		if !names.IsValidGroupId(req.ID) {
+			resp.Diagnostics.AddError(
+				"ImportState Failure",
+				fmt.Sprintf("Malformed import ID %q, expected the UUID of a group or %s<group-name>.", req.ID, groupNameImportPrefix),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), req.ID)...)
+		return
+	}
+
+	if resource.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASGroup, "import")
+		return
+	}
+	group, err := resource.client.Jaas.ReadGroupByName(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find group %q, got error: %s", name, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), group.UUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), group.Name)...)
 }
 
 // Create attempts to create the group represented by the resource in JAAS.
@@ -135,6 +170,9 @@ func (resource *jaasGroupResource) Read(ctx context.Context, req resource.ReadRe
 	// Read the group from JAAS
 	group, err := resource.client.Jaas.ReadGroup(ctx, state.UUID.ValueString())
 	if err != nil {
+		if keepStateDuringControllerUpgrade(resource.client, err, &resp.Diagnostics, "group") {
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group %q, got error: %s", state.Name.ValueString(), err))
 		return
 	}
@@ -0,0 +1,376 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	jimmnames "github.com/canonical/jimm-go-sdk/v3/names"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// groupMemberRelation is the relation of the members of a group to the
+// group.
+const groupMemberRelation = "member"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &jaasGroupMembershipResource{}
+var _ resource.ResourceWithConfigure = &jaasGroupMembershipResource{}
+var _ resource.ResourceWithImportState = &jaasGroupMembershipResource{}
+var _ resource.ResourceWithConfigValidators = &jaasGroupMembershipResource{}
+
+type jaasGroupMembershipResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+// NewJAASGroupMembershipResource returns a new instance of the JAAS group
+// membership resource.
+func NewJAASGroupMembershipResource() resource.Resource {
+	return &jaasGroupMembershipResource{}
+}
+
+type jaasGroupMembershipResourceModel struct {
+	GroupID         types.String `tfsdk:"group_id"`
+	Users           types.Set    `tfsdk:"users"`
+	ServiceAccounts types.Set    `tfsdk:"service_accounts"`
+	Authoritative   types.Bool   `tfsdk:"authoritative"`
+
+	// ID required for imports
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the metadata for the JAAS group membership resource.
+func (r *jaasGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jaas_group_membership"
+}
+
+// Schema defines the schema for the members of JAAS groups.
+func (r *jaasGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents the users and service accounts which are members of a group " +
+			"in JAAS. Nested groups are not managed by this resource, use juju_jaas_access_group to add them.",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				Description: "The UUID of the group. Changing this value will cause the resource to be destroyed " +
+					"and recreated by terraform.",
+				Required: true,
+				Validators: []validator.String{
+					ValidatorMatchString(jimmnames.IsValidGroupId, "group must be a valid UUID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				Description: "The users which are members of the group.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(ValidatorMatchString(names.IsValidUser, "email must be a valid Juju username")),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(basicEmailValidationRe, "email must contain an @ symbol")),
+				},
+			},
+			"service_accounts": schema.SetAttribute{
+				Description: "The service accounts which are members of the group, without the @serviceaccount domain.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(ValidatorMatchString(
+						func(s string) bool {
+							_, err := jimmnames.EnsureValidServiceAccountId(s)
+							return err == nil
+						}, "service account ID must be a valid Juju username")),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(avoidAtSymbolRe, "service account should not contain an @ symbol")),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Description: "Whether the users and service accounts are the only ones in the group. When true, " +
+					"other users and service accounts are removed from the group. When false, only the listed " +
+					"ones are added and removed, so several resources can manage the members of the same group. " +
+					"Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			// ID required for imports
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators sets validators for the resource.
+func (r *jaasGroupMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewRequiresJAASValidator(r.client),
+	}
+}
+
+// Configure sets up the JAAS group membership resource with the provider data.
+func (r *jaasGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceJAASGroupMembership)
+}
+
+// Create adds the members of the plan to the group, and removes the other
+// members of the group when authoritative.
+func (r *jaasGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASGroupMembership, "create")
+		return
+	}
+
+	var plan jaasGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	noMembers := membershipAccessData(types.SetNull(types.StringType), types.SetNull(types.StringType))
+	r.reconcileMembers(ctx, plan, noMembers, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.GroupID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read reads the members of the group. Only the members in the state are
+// kept when the resource is not authoritative.
+func (r *jaasGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASGroupMembership, "read")
+		return
+	}
+
+	var state jaasGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Authoritative is not known when importing, use the default.
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(true)
+	}
+	state.GroupID = state.ID
+
+	current, err := r.readMembers(ctx, state.GroupID.ValueString(), &resp.Diagnostics)
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "group membership") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the members of group %q, got error: %s", state.GroupID.ValueString(), err))
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !state.Authoritative.ValueBool() {
+		current = intersectMembers(current, membershipAccessData(state.Users, state.ServiceAccounts), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	state.Users = current.Users
+	state.ServiceAccounts = current.ServiceAccounts
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update adds and removes members of the group to match the plan.
+func (r *jaasGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASGroupMembership, "update")
+		return
+	}
+
+	var plan, state jaasGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcileMembers(ctx, plan, membershipAccessData(state.Users, state.ServiceAccounts), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the members in the state from the group.
+func (r *jaasGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceJAASGroupMembership, "delete")
+		return
+	}
+
+	var state jaasGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := state.GroupID.ValueString()
+	current, err := r.readMembers(ctx, groupID, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the members of group %q, got error: %s", groupID, err))
+		return
+	}
+	// Members which already left the group cannot be removed again.
+	members := intersectMembers(current, membershipAccessData(state.Users, state.ServiceAccounts), &resp.Diagnostics)
+	tuples := modelToTuples(ctx, jimmnames.NewGroupTag(groupID), members, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || len(tuples) == 0 {
+		return
+	}
+	if err := r.client.Jaas.DeleteRelations(ctx, tuples); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove the members of group %q, got error: %s", groupID, err))
+	}
+}
+
+// ImportState imports the members of a group, given the UUID of the group.
+func (r *jaasGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !jimmnames.IsValidGroupId(req.ID) {
+		resp.Diagnostics.AddError(
+			"ImportState Failure",
+			fmt.Sprintf("Malformed import ID %q, expected the UUID of a group.", req.ID),
+		)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// reconcileMembers adds the members of the plan missing from the group.
+// It removes the other members of the group when the plan is
+// authoritative, otherwise only the previous members which are no longer
+// planned.
+func (r *jaasGroupMembershipResource) reconcileMembers(ctx context.Context, plan jaasGroupMembershipResourceModel, previous genericJAASAccessData, diag *diag.Diagnostics) {
+	groupID := plan.GroupID.ValueString()
+	current, err := r.readMembers(ctx, groupID, diag)
+	if err != nil {
+		diag.AddError("Client Error", fmt.Sprintf("Unable to read the members of group %q, got error: %s", groupID, err))
+		return
+	}
+	if diag.HasError() {
+		return
+	}
+
+	planned := membershipAccessData(plan.Users, plan.ServiceAccounts)
+	toAdd, toRemove := diffModels(planned, current, diag)
+	if !plan.Authoritative.ValueBool() {
+		_, toRemove = diffModels(planned, intersectMembers(current, previous, diag), diag)
+	}
+	groupTag := jimmnames.NewGroupTag(groupID)
+	addTuples := modelToTuples(ctx, groupTag, toAdd, diag)
+	removeTuples := modelToTuples(ctx, groupTag, toRemove, diag)
+	if diag.HasError() {
+		return
+	}
+
+	if len(addTuples) > 0 {
+		if err := r.client.Jaas.AddRelations(ctx, addTuples); err != nil {
+			diag.AddError("Client Error", fmt.Sprintf("Unable to add members to group %q, got error: %s", groupID, err))
+			return
+		}
+	}
+	if len(removeTuples) > 0 {
+		if err := r.client.Jaas.DeleteRelations(ctx, removeTuples); err != nil {
+			diag.AddError("Client Error", fmt.Sprintf("Unable to remove members from group %q, got error: %s", groupID, err))
+			return
+		}
+	}
+	r.trace("reconciled group members", map[string]interface{}{
+		"group":   groupID,
+		"added":   len(addTuples),
+		"removed": len(removeTuples),
+	})
+}
+
+// readMembers returns the users and service accounts which are members of
+// the group. Nested groups are left out.
+func (r *jaasGroupMembershipResource) readMembers(ctx context.Context, groupID string, diag *diag.Diagnostics) (genericJAASAccessData, error) {
+	tuples, err := r.client.Jaas.ReadRelations(ctx, &juju.JaasTuple{
+		Target:   jimmnames.NewGroupTag(groupID).String(),
+		Relation: groupMemberRelation,
+	})
+	if err != nil {
+		return genericJAASAccessData{}, err
+	}
+	members := tuplesToModel(ctx, tuples, diag)
+	return membershipAccessData(members.Users, members.ServiceAccounts), nil
+}
+
+// membershipAccessData returns the access data making the users and
+// service accounts members of a group.
+func membershipAccessData(users, serviceAccounts types.Set) genericJAASAccessData {
+	return genericJAASAccessData{
+		Users:           users,
+		ServiceAccounts: serviceAccounts,
+		GrantToEveryone: types.BoolValue(false),
+		Groups:          types.SetNull(types.StringType),
+		Roles:           types.SetNull(types.StringType),
+		Access:          types.StringValue(groupMemberRelation),
+	}
+}
+
+// intersectMembers returns the members found in both a and b.
+func intersectMembers(a, b genericJAASAccessData, diag *diag.Diagnostics) genericJAASAccessData {
+	intersect := func(x, y basetypes.SetValue) basetypes.SetValue {
+		common := diffSet(x, diffSet(x, y, diag), diag)
+		// Match tuplesToModel, which returns a null set without members.
+		if len(common.Elements()) == 0 {
+			return types.SetNull(types.StringType)
+		}
+		return common
+	}
+	return membershipAccessData(intersect(a.Users, b.Users), intersect(a.ServiceAccounts, b.ServiceAccounts))
+}
+
+func (r *jaasGroupMembershipResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceJAASGroupMembership, msg, additionalFields...)
+}
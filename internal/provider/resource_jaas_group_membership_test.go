@@ -0,0 +1,192 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+const testGroupID = "2f3c4a5b-6d7e-4f80-9a1b-2c3d4e5f6a7b"
+
+func groupMembershipTestState(t *testing.T, r *jaasGroupMembershipResource, model jaasGroupMembershipResourceModel) tfsdk.State {
+	ctx := context.Background()
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, state.Set(ctx, model).HasError())
+	return state
+}
+
+func groupMembersTuples() []juju.JaasTuple {
+	target := "group-" + testGroupID
+	return []juju.JaasTuple{
+		{Object: "user-alice@canonical.com", Relation: groupMemberRelation, Target: target},
+		{Object: "user-bob@canonical.com", Relation: groupMemberRelation, Target: target},
+		{Object: "user-client-id@serviceaccount", Relation: groupMemberRelation, Target: target},
+		{Object: "group-0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d#member", Relation: groupMemberRelation, Target: target},
+	}
+}
+
+func TestResourceJaasGroupMembershipRead(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		authoritative   bool
+		expectedUsers   []string
+		expectedSvcAccs []string
+	}{
+		{authoritative: true, expectedUsers: []string{"alice@canonical.com", "bob@canonical.com"}, expectedSvcAccs: []string{"client-id"}},
+		{authoritative: false, expectedUsers: []string{"alice@canonical.com"}},
+	}
+	for _, test := range tests {
+		ctlr := gomock.NewController(t)
+		jaasClient := mocks.NewMockJaasClient(ctlr)
+		jaasClient.EXPECT().ReadRelations(gomock.Any(), &juju.JaasTuple{
+			Target:   "group-" + testGroupID,
+			Relation: groupMemberRelation,
+		}).Return(groupMembersTuples(), nil)
+
+		r := &jaasGroupMembershipResource{client: &juju.Client{Jaas: jaasClient}}
+		state := groupMembershipTestState(t, r, jaasGroupMembershipResourceModel{
+			GroupID:         types.StringValue(testGroupID),
+			Users:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("alice@canonical.com")}),
+			ServiceAccounts: types.SetNull(types.StringType),
+			Authoritative:   types.BoolValue(test.authoritative),
+			ID:              types.StringValue(testGroupID),
+		})
+
+		resp := fwresource.ReadResponse{State: state}
+		r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var got jaasGroupMembershipResourceModel
+		require.False(t, resp.State.Get(ctx, &got).HasError())
+		var users, svcAccs []string
+		require.False(t, got.Users.ElementsAs(ctx, &users, false).HasError())
+		require.False(t, got.ServiceAccounts.ElementsAs(ctx, &svcAccs, false).HasError())
+		assert.ElementsMatch(t, test.expectedUsers, users, "authoritative %t", test.authoritative)
+		assert.ElementsMatch(t, test.expectedSvcAccs, svcAccs, "authoritative %t", test.authoritative)
+	}
+}
+
+func TestResourceJaasGroupMembershipReconcile(t *testing.T) {
+	ctx := context.Background()
+	target := "group-" + testGroupID
+	carol := juju.JaasTuple{Object: "user-carol@canonical.com", Relation: groupMemberRelation, Target: target}
+	bob := juju.JaasTuple{Object: "user-bob@canonical.com", Relation: groupMemberRelation, Target: target}
+	svcAcc := juju.JaasTuple{Object: "user-client-id@serviceaccount", Relation: groupMemberRelation, Target: target}
+
+	tests := []struct {
+		authoritative  bool
+		expectedRemove []juju.JaasTuple
+	}{
+		// Nested groups are never removed.
+		{authoritative: true, expectedRemove: []juju.JaasTuple{bob, svcAcc}},
+		// Only bob was managed by the resource.
+		{authoritative: false, expectedRemove: []juju.JaasTuple{bob}},
+	}
+	for _, test := range tests {
+		ctlr := gomock.NewController(t)
+		jaasClient := mocks.NewMockJaasClient(ctlr)
+		jaasClient.EXPECT().ReadRelations(gomock.Any(), gomock.Any()).Return(groupMembersTuples(), nil)
+		jaasClient.EXPECT().AddRelations(gomock.Any(), []juju.JaasTuple{carol}).Return(nil)
+		jaasClient.EXPECT().DeleteRelations(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tuples []juju.JaasTuple) error {
+			assert.ElementsMatch(t, test.expectedRemove, tuples, "authoritative %t", test.authoritative)
+			return nil
+		})
+
+		r := &jaasGroupMembershipResource{client: &juju.Client{Jaas: jaasClient}}
+		var diags diag.Diagnostics
+		r.reconcileMembers(ctx, jaasGroupMembershipResourceModel{
+			GroupID: types.StringValue(testGroupID),
+			Users: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("alice@canonical.com"),
+				types.StringValue("carol@canonical.com"),
+			}),
+			ServiceAccounts: types.SetNull(types.StringType),
+			Authoritative:   types.BoolValue(test.authoritative),
+		}, membershipAccessData(
+			types.SetValueMust(types.StringType, []attr.Value{types.StringValue("bob@canonical.com")}),
+			types.SetNull(types.StringType),
+		), &diags)
+		require.False(t, diags.HasError(), diags)
+	}
+}
+
+func TestAcc_ResourceJaasGroupMembership(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	groupName := acctest.RandomWithPrefix("tf-jaas-group-membership")
+	resourceName := "juju_jaas_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJaasGroupMembership(groupName, "foo@domain.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "juju_jaas_group.test", "uuid"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", "foo@domain.com"),
+					resource.TestCheckTypeSetElemAttr("juju_jaas_group_membership.extra", "users.*", "bar@domain.com"),
+				),
+			},
+			{
+				Config: testAccResourceJaasGroupMembership(groupName, "baz@domain.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*", "baz@domain.com"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ResourceName:      "juju_jaas_group_membership.extra",
+				// An imported resource is authoritative.
+				ImportStateVerifyIgnore: []string{"users", "authoritative"},
+			},
+		},
+	})
+}
+
+func testAccResourceJaasGroupMembership(groupName, user string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJaasGroupMembership",
+		`
+resource "juju_jaas_group" "test" {
+  name = "{{ .GroupName }}"
+}
+
+resource "juju_jaas_group_membership" "test" {
+  group_id      = juju_jaas_group.test.uuid
+  users         = ["{{ .User }}"]
+  authoritative = false
+}
+
+resource "juju_jaas_group_membership" "extra" {
+  group_id      = juju_jaas_group.test.uuid
+  users         = ["bar@domain.com"]
+  authoritative = false
+}
+`, internaltesting.TemplateData{
+			"GroupName": groupName,
+			"User":      user,
+		})
+}
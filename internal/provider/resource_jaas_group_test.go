@@ -10,12 +10,90 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	jujuerrors "github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
 	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
 )
 
+func TestResourceJaasGroupReadWithMockClient(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	jaasClient := mocks.NewMockJaasClient(ctlr)
+	jaasClient.EXPECT().ReadGroup(gomock.Any(), "group-uuid").Return(&juju.JaasGroup{Name: "renamed", UUID: "group-uuid"}, nil)
+
+	r := &jaasGroupResource{client: &juju.Client{Jaas: jaasClient}}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, jaasGroupResourceModel{Name: types.StringValue("original"), UUID: types.StringValue("group-uuid")})
+	require.False(t, diags.HasError())
+
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got jaasGroupResourceModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "renamed", got.Name.ValueString())
+}
+
+func TestResourceJaasGroupImportStateWithMockClient(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	jaasClient := mocks.NewMockJaasClient(ctlr)
+	jaasClient.EXPECT().ReadGroupByName(gomock.Any(), "devops-team").Return(&juju.JaasGroup{Name: "devops-team", UUID: "9e5a3b0c-1d2f-4a6b-8c7d-0e1f2a3b4c5d"}, nil)
+	jaasClient.EXPECT().ReadGroupByName(gomock.Any(), "unknown").Return(nil, jujuerrors.NotFoundf("group %q", "unknown"))
+
+	r := NewJAASGroupResource().(*jaasGroupResource)
+	r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: &juju.Client{Jaas: jaasClient}}, &fwresource.ConfigureResponse{})
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	importState := func(id string) (jaasGroupResourceModel, diag.Diagnostics) {
+		resp := fwresource.ImportStateResponse{State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, &resp)
+		var got jaasGroupResourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+		}
+		return got, resp.Diagnostics
+	}
+
+	got, diags := importState("name:devops-team")
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "9e5a3b0c-1d2f-4a6b-8c7d-0e1f2a3b4c5d", got.UUID.ValueString())
+	assert.Equal(t, "devops-team", got.Name.ValueString())
+
+	got, diags = importState("9e5a3b0c-1d2f-4a6b-8c7d-0e1f2a3b4c5d")
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "9e5a3b0c-1d2f-4a6b-8c7d-0e1f2a3b4c5d", got.UUID.ValueString())
+	assert.True(t, got.Name.IsNull())
+
+	_, diags = importState("name:unknown")
+	assert.True(t, diags.HasError())
+	_, diags = importState("devops-team")
+	assert.True(t, diags.HasError())
+}
+
 func TestAcc_ResourceJaasGroup(t *testing.T) {
 	OnlyTestAgainstJAAS(t)
 	groupName := acctest.RandomWithPrefix("tf-jaas-group")
@@ -48,6 +126,20 @@ func TestAcc_ResourceJaasGroup(t *testing.T) {
 					testAccCheckJaasGroupExists(resourceName, true),
 				),
 			},
+			{
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "name:" + newGroupName,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ResourceName:                         resourceName,
+			},
+			{
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccJaasGroupUUID(resourceName),
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ResourceName:                         resourceName,
+			},
 		},
 	})
 }
@@ -64,6 +156,17 @@ resource "juju_jaas_group" "test" {
 		})
 }
 
+// testAccJaasGroupUUID returns the UUID of the group, to import it.
+func testAccJaasGroupUUID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Group %q not found", resourceName)
+		}
+		return rs.Primary.Attributes["uuid"], nil
+	}
+}
+
 // testAccCheckJaasGroupExists returns a function that checks if the group exists if checkExists is true or if it doesn't exist if checkExists is false.
 func testAccCheckJaasGroupExists(resourceName string, checkExists bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -0,0 +1,122 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestResourceJaasRoleReadWithMockClient(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	jaasClient := mocks.NewMockJaasClient(ctlr)
+	jaasClient.EXPECT().ReadRole(gomock.Any(), "role-uuid").Return(&juju.JaasRole{Name: "renamed", UUID: "role-uuid"}, nil)
+
+	r := &jaasRoleResource{client: &juju.Client{Jaas: jaasClient}}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(ctx, jaasRoleResourceModel{Name: types.StringValue("original"), UUID: types.StringValue("role-uuid")})
+	require.False(t, diags.HasError())
+
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got jaasRoleResourceModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "renamed", got.Name.ValueString())
+}
+
+func TestAcc_ResourceJaasRole(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	roleName := acctest.RandomWithPrefix("tf-jaas-role")
+	newRoleName := acctest.RandomWithPrefix("tf-jaas-role-new")
+	resourceName := "juju_jaas_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckJaasRoleExists(resourceName, false),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJaasRole(roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", roleName),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+					testAccCheckJaasRoleExists(resourceName, true),
+				),
+			},
+			{
+				Config: testAccResourceJaasRole("_invalid role"),
+				// Might break if the formatting changes
+				ExpectError: regexp.MustCompile("must start with a letter, end with a letter or number"),
+			},
+			{
+				Config: testAccResourceJaasRole(newRoleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", newRoleName),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+					testAccCheckJaasRoleExists(resourceName, true),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceJaasRole(name string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceJaasRole",
+		`
+resource "juju_jaas_role" "test" {
+  name = "{{ .Name }}"
+}
+`, internaltesting.TemplateData{
+			"Name": name,
+		})
+}
+
+// testAccCheckJaasRoleExists returns a function that checks if the role exists if checkExists is true or if it doesn't exist if checkExists is false.
+func testAccCheckJaasRoleExists(resourceName string, checkExists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Role %q not found", resourceName)
+		}
+
+		uuid := rs.Primary.Attributes["uuid"]
+		if uuid == "" {
+			return errors.New("No role uuid is set")
+		}
+
+		_, err := TestClient.Jaas.ReadRole(context.Background(), uuid)
+		if checkExists && err != nil {
+			return fmt.Errorf("Role with uuid %q does not exist", uuid)
+		} else if !checkExists && err == nil {
+			return fmt.Errorf("Role with uuid %q still exists", uuid)
+		}
+
+		return nil
+	}
+}
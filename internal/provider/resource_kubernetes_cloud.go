@@ -5,12 +5,23 @@ package provider
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -18,32 +29,92 @@ import (
 var _ resource.Resource = &kubernetesCloudResource{}
 var _ resource.ResourceWithConfigure = &kubernetesCloudResource{}
 var _ resource.ResourceWithImportState = &kubernetesCloudResource{}
+var _ resource.ResourceWithValidateConfig = &kubernetesCloudResource{}
+var _ resource.ResourceWithModifyPlan = &kubernetesCloudResource{}
 
+// credentialRefreshWindow is how long before it expires the credential
+// of a kubernetes cloud is refreshed by an apply.
+const credentialRefreshWindow = 15 * time.Minute
+
+// NewKubernetesCloudResource returns a new instance of the kubernetes
+// cloud resource.
 func NewKubernetesCloudResource() resource.Resource {
 	return &kubernetesCloudResource{}
 }
 
 type kubernetesCloudResource struct {
-	*juju.Client
+	client *juju.Client
 
 	// subCtx is the context created with the new tflog subsystem for applications.
-	context.Context
+	subCtx context.Context
 }
 
-func (o *kubernetesCloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+type kubernetesCloudResourceModel struct {
+	Name              types.String `tfsdk:"name"`
+	KubernetesConfig  types.String `tfsdk:"kubernetes_config"`
+	ContextName       types.String `tfsdk:"context_name"`
+	InCluster         types.Bool   `tfsdk:"in_cluster"`
+	ParentCloudName   types.String `tfsdk:"parent_cloud_name"`
+	ParentCloudRegion types.String `tfsdk:"parent_cloud_region"`
+	Credential        types.String `tfsdk:"credential"`
+	// CredentialExpiry cannot be read from the controller, it is set
+	// when the credential is added or updated.
+	CredentialExpiry   types.String `tfsdk:"credential_expiry"`
+	CredentialTriggers types.Map    `tfsdk:"credential_triggers"`
+	Regions            types.List   `tfsdk:"regions"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
 }
 
-func (o *kubernetesCloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+// Kubernetes clouds can be imported with their name.
+func (r *kubernetesCloudResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (o *kubernetesCloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+func (r *kubernetesCloudResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceKubernetesCloud)
+}
+
+// ValidateConfig checks that the cluster is given either by a kubeconfig
+// or as the one Terraform runs in.
+func (r *kubernetesCloudResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data kubernetesCloudResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.KubernetesConfig.IsUnknown() || data.InCluster.IsUnknown() {
+		return
+	}
+	if data.KubernetesConfig.IsNull() && !data.InCluster.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("kubernetes_config"),
+			"Missing Kubernetes Config",
+			"kubernetes_config is required unless in_cluster is set to true.",
+		)
+	}
+}
+
+func (r *kubernetesCloudResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kubernetes_cloud"
 }
 
-func (o *kubernetesCloudResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *kubernetesCloudResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A resource that represent a Juju Cloud for existing controller.",
+		Description: "A resource that represents a Kubernetes cloud added to the controller, as done by " +
+			"`juju add-k8s`. A credential with the name of the cloud is added from the kubeconfig.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Description: "The name of the cloud. Changing this value will cause the cloud to be destroyed and recreated by terraform.",
@@ -52,24 +123,80 @@ func (o *kubernetesCloudResource) Schema(_ context.Context, req resource.SchemaR
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"kubeconfig": schema.StringAttribute{
-				Description: "The kubeconfig file path for the cloud.",
-				Optional:    true,
-				Sensitive:   true,
+			"kubernetes_config": schema.StringAttribute{
+				Description: "The content of the kubeconfig of the cluster, e.g. read with `file()`. The cluster and " +
+					"user of its current context, or of its only context, are used. Changing this value updates the " +
+					"endpoint and the credential of the cloud, e.g. to rotate a token. It is required unless " +
+					"`in_cluster` is set.",
+				Optional:  true,
+				Sensitive: true,
 			},
-			"parentcloudname": schema.StringAttribute{
-				Description: "The parent cloud name in case adding k8s cluster from existed cloud. Changing this value will cause the cloud to be destroyed and recreated by terraform.",
-				Optional:    true,
+			"context_name": schema.StringAttribute{
+				Description: "The context of the kubeconfig to use instead of its current context. Changing this " +
+					"value updates the endpoint and the credential of the cloud.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("kubernetes_config")),
+				},
+			},
+			"in_cluster": schema.BoolAttribute{
+				Description: "Add the cluster Terraform runs in, from the service account of its pod and the " +
+					"`KUBERNETES_SERVICE_HOST` and `KUBERNETES_SERVICE_PORT` environment variables, instead of " +
+					"`kubernetes_config`. The token of the service account is stored in the credential of the cloud, " +
+					"it must not expire for the controller to keep managing the cluster: use a long-lived token, " +
+					"e.g. of a service account token secret. Changing this value updates the endpoint and the " +
+					"credential of the cloud.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("kubernetes_config")),
+				},
+			},
+			"parent_cloud_name": schema.StringAttribute{
+				Description: "The name of the cloud hosting the cluster, e.g. an `ec2` cloud for an EKS cluster. " +
+					"It is required by JAAS. Changing this value will cause the cloud to be destroyed and recreated by terraform.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"parentcloudregion": schema.StringAttribute{
-				Description: "The parent cloud region name in case adding k8s cluster from existed cloud. Changing this value will cause the cloud to be destroyed and recreated by terraform.",
-				Optional:    true,
+			"parent_cloud_region": schema.StringAttribute{
+				Description: "The region of the parent cloud hosting the cluster. It is required by JAAS. " +
+					"Changing this value will cause the cloud to be destroyed and recreated by terraform.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("parent_cloud_name")),
+				},
+			},
+			"credential": schema.StringAttribute{
+				Description: "The name of the credential added with the cloud, which can be used by models.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credential_expiry": schema.StringAttribute{
+				Description: "When the token of the credential expires, in RFC 3339 format, as reported by the " +
+					"exec plugin of the kubeconfig user, e.g. `aws-iam-authenticator` for EKS or " +
+					"`gke-gcloud-auth-plugin` for GKE. Null if the credential does not expire. The plugin is run " +
+					"by the provider as the controller cannot run it, and an apply refreshes the credential once " +
+					"it expires within 15 minutes. The controller loses access to the cluster when the token " +
+					"expires between applies: prefer a long-lived token, or apply on a schedule.",
+				Computed: true,
+			},
+			"credential_triggers": schema.MapAttribute{
+				Description: "Arbitrary values which update the credential of the cloud when they change, running " +
+					"the exec plugin of the kubeconfig user again to get a new token, e.g. a timestamp rotated by " +
+					"the `time_rotating` resource.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"regions": schema.ListAttribute{
+				Description: "The regions of the cloud.",
+				ElementType: types.StringType,
+				Computed:    true,
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -81,18 +208,252 @@ func (o *kubernetesCloudResource) Schema(_ context.Context, req resource.SchemaR
 	}
 }
 
-// Create adds a new kubernetes cloud to controllers used now by Terraform provider.
-func (o *kubernetesCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+// Create adds a new kubernetes cloud to the controller used by Terraform provider.
+func (r *kubernetesCloudResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "kubernetes cloud", "create")
+		return
+	}
+
+	var plan kubernetesCloudResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// JAAS places the cloud on one of its controllers according to the
+	// region of its parent cloud.
+	if r.client.IsJAAS() && (plan.ParentCloudName.IsNull() || plan.ParentCloudRegion.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent_cloud_region"),
+			"Missing Parent Cloud",
+			"Adding a kubernetes cloud to JAAS requires parent_cloud_name and parent_cloud_region.",
+		)
+		return
+	}
+
+	name := plan.Name.ValueString()
+	response, err := r.client.KubernetesClouds.CreateKubernetesCloud(ctx, &juju.CreateKubernetesCloudInput{
+		Name:              name,
+		KubernetesConfig:  plan.KubernetesConfig.ValueString(),
+		ContextName:       plan.ContextName.ValueString(),
+		InCluster:         plan.InCluster.ValueBool(),
+		ParentCloudName:   plan.ParentCloudName.ValueString(),
+		ParentCloudRegion: plan.ParentCloudRegion.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create kubernetes cloud %q, got error: %s", name, err))
+		return
+	}
+	r.trace(fmt.Sprintf("created kubernetes cloud %q", name))
+
+	plan.Credential = types.StringValue(response.CredentialName)
+	plan.CredentialExpiry = credentialExpiryValue(response.CredentialExpiry)
+	plan.ID = types.StringValue(response.Name)
+	resp.Diagnostics.Append(r.readKubernetesCloud(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Read reads the current state of the kubernetes cloud.
-func (o *kubernetesCloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+func (r *kubernetesCloudResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "kubernetes cloud", "read")
+		return
+	}
+
+	var state kubernetesCloudResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.KubernetesClouds.ReadKubernetesCloud(ctx, &juju.ReadKubernetesCloudInput{
+		Name: state.ID.ValueString(),
+	})
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "kubernetes cloud") {
+			return
+		}
+		resp.Diagnostics.Append(handleKubernetesCloudNotFoundError(ctx, err, &resp.State)...)
+		return
+	}
+	r.trace(fmt.Sprintf("read kubernetes cloud %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(state.setKubernetesCloud(ctx, response)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update updates the kubernetes cloud on the controller used by Terraform provider.
-func (o *kubernetesCloudResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+// Update updates the endpoint and the credential of the kubernetes cloud
+// on the controller used by Terraform provider.
+func (r *kubernetesCloudResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "kubernetes cloud", "update")
+		return
+	}
+
+	var plan, state kubernetesCloudResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read Terraform configuration from the request into the plan model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute which can be updated changes the credential, or
+	// requires it to be refreshed, the exec plugin is run again.
+	response, err := r.client.KubernetesClouds.UpdateKubernetesCloud(ctx, &juju.UpdateKubernetesCloudInput{
+		Name:             state.ID.ValueString(),
+		KubernetesConfig: plan.KubernetesConfig.ValueString(),
+		ContextName:      plan.ContextName.ValueString(),
+		InCluster:        plan.InCluster.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update kubernetes cloud %q, got error: %s", state.ID.ValueString(), err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated kubernetes cloud %q", state.ID.ValueString()))
+	plan.CredentialExpiry = credentialExpiryValue(response.CredentialExpiry)
+
+	resp.Diagnostics.Append(r.readKubernetesCloud(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete removes the kubernetes cloud from the controller used by Terraform provider.
-func (o *kubernetesCloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *kubernetesCloudResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "kubernetes cloud", "delete")
+		return
+	}
+
+	var state kubernetesCloudResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.KubernetesClouds.DestroyKubernetesCloud(ctx, &juju.DestroyKubernetesCloudInput{
+		Name: state.ID.ValueString(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete kubernetes cloud %q, got error: %s", state.ID.ValueString(), err))
+		return
+	}
+	r.trace(fmt.Sprintf("deleted kubernetes cloud %q", state.ID.ValueString()))
+}
+
+// ModifyPlan plans an update of the credential when its token expires
+// soon, so that an apply gets a new token from the exec plugin of the
+// kubeconfig.
+func (r *kubernetesCloudResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var state kubernetesCloudResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.CredentialExpiry.IsNull() || state.CredentialExpiry.IsUnknown() {
+		return
+	}
+	expiry, err := time.Parse(time.RFC3339, state.CredentialExpiry.ValueString())
+	if err != nil {
+		return
+	}
+	if time.Until(expiry) > credentialRefreshWindow {
+		return
+	}
+	if time.Now().After(expiry) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("credential_expiry"), "Kubernetes Credential Expired",
+			fmt.Sprintf("The credential of kubernetes cloud %q expired at %s, the controller cannot manage the "+
+				"cluster until it is refreshed by an apply.", state.ID.ValueString(), state.CredentialExpiry.ValueString()))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("credential_expiry"), types.StringUnknown())...)
+}
+
+// credentialExpiryValue returns the expiry of a credential, null if it
+// does not expire.
+func credentialExpiryValue(expiry time.Time) types.String {
+	if expiry.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(expiry.UTC().Format(time.RFC3339))
+}
+
+// readKubernetesCloud sets the computed attributes of the model from the
+// cloud on the controller.
+func (r *kubernetesCloudResource) readKubernetesCloud(ctx context.Context, model *kubernetesCloudResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	response, err := r.client.KubernetesClouds.ReadKubernetesCloud(ctx, &juju.ReadKubernetesCloudInput{
+		Name: model.ID.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read kubernetes cloud %q, got error: %s", model.ID.ValueString(), err))
+		return diags
+	}
+	return model.setKubernetesCloud(ctx, response)
+}
+
+// setKubernetesCloud sets the model from a cloud read on the controller.
+// The kubeconfig, in_cluster and the parent cloud cannot be read back,
+// the values of the model are kept.
+func (m *kubernetesCloudResourceModel) setKubernetesCloud(ctx context.Context, cloud *juju.ReadKubernetesCloudOutput) diag.Diagnostics {
+	regions, diags := types.ListValueFrom(ctx, types.StringType, cloud.Regions)
+	if diags.HasError() {
+		return diags
+	}
+	m.Name = types.StringValue(cloud.Name)
+	m.Regions = regions
+	if cloud.CredentialName != "" {
+		m.Credential = types.StringValue(cloud.CredentialName)
+	} else {
+		m.Credential = types.StringNull()
+	}
+	return diags
+}
+
+func handleKubernetesCloudNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
+	if errors.As(err, &juju.KubernetesCloudNotFoundError) {
+		// Kubernetes cloud manually removed
+		st.RemoveResource(ctx)
+		return diag.Diagnostics{}
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", err.Error())
+	return diags
+}
+
+func (r *kubernetesCloudResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceKubernetesCloud, msg, additionalFields...)
 }
@@ -0,0 +1,206 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
+)
+
+func kubernetesCloudTestState(t *testing.T, r *kubernetesCloudResource, model kubernetesCloudResourceModel) tfsdk.State {
+	ctx := context.Background()
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, state.Set(ctx, model).HasError())
+	return state
+}
+
+func TestResourceKubernetesCloudRead(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	cloudsClient := mocks.NewMockKubernetesCloudsClient(ctlr)
+	cloudsClient.EXPECT().ReadKubernetesCloud(gomock.Any(), &juju.ReadKubernetesCloudInput{Name: "my-k8s"}).Return(&juju.ReadKubernetesCloudOutput{
+		Name:            "my-k8s",
+		CredentialName:  "my-k8s",
+		HostCloudRegion: "ec2/us-east-1",
+		Regions:         []string{"us-east-1"},
+	}, nil)
+
+	r := &kubernetesCloudResource{client: &juju.Client{KubernetesClouds: cloudsClient}}
+	state := kubernetesCloudTestState(t, r, kubernetesCloudResourceModel{
+		Name:               types.StringValue("my-k8s"),
+		KubernetesConfig:   types.StringValue("kubeconfig"),
+		ContextName:        types.StringNull(),
+		ParentCloudName:    types.StringValue("aws"),
+		ParentCloudRegion:  types.StringValue("us-east-1"),
+		Credential:         types.StringNull(),
+		CredentialExpiry:   types.StringNull(),
+		CredentialTriggers: types.MapNull(types.StringType),
+		Regions:            types.ListNull(types.StringType),
+		ID:                 types.StringValue("my-k8s"),
+	})
+
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got kubernetesCloudResourceModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "my-k8s", got.Credential.ValueString())
+	assert.Equal(t, "kubeconfig", got.KubernetesConfig.ValueString())
+	assert.Equal(t, "aws", got.ParentCloudName.ValueString())
+	var regions []string
+	require.False(t, got.Regions.ElementsAs(ctx, &regions, false).HasError())
+	assert.Equal(t, []string{"us-east-1"}, regions)
+}
+
+func TestResourceKubernetesCloudReadNotFound(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	cloudsClient := mocks.NewMockKubernetesCloudsClient(ctlr)
+	cloudsClient.EXPECT().ReadKubernetesCloud(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("reading: %w", juju.KubernetesCloudNotFoundError))
+
+	r := &kubernetesCloudResource{client: &juju.Client{KubernetesClouds: cloudsClient}}
+	state := kubernetesCloudTestState(t, r, kubernetesCloudResourceModel{
+		Name:               types.StringValue("my-k8s"),
+		KubernetesConfig:   types.StringValue("kubeconfig"),
+		ContextName:        types.StringNull(),
+		ParentCloudName:    types.StringNull(),
+		ParentCloudRegion:  types.StringNull(),
+		Credential:         types.StringValue("my-k8s"),
+		CredentialExpiry:   types.StringNull(),
+		CredentialTriggers: types.MapNull(types.StringType),
+		Regions:            types.ListNull(types.StringType),
+		ID:                 types.StringValue("my-k8s"),
+	})
+
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestResourceKubernetesCloudModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &kubernetesCloudResource{}
+	planExpiry := func(expiry types.String) (types.String, fwresource.ModifyPlanResponse) {
+		state := kubernetesCloudTestState(t, r, kubernetesCloudResourceModel{
+			Name:               types.StringValue("my-k8s"),
+			KubernetesConfig:   types.StringValue("kubeconfig"),
+			ContextName:        types.StringNull(),
+			ParentCloudName:    types.StringNull(),
+			ParentCloudRegion:  types.StringNull(),
+			Credential:         types.StringValue("my-k8s"),
+			CredentialExpiry:   expiry,
+			CredentialTriggers: types.MapNull(types.StringType),
+			Regions:            types.ListNull(types.StringType),
+			ID:                 types.StringValue("my-k8s"),
+		})
+		plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+		resp := fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		var got kubernetesCloudResourceModel
+		require.False(t, resp.Plan.Get(ctx, &got).HasError())
+		return got.CredentialExpiry, resp
+	}
+
+	// A credential which does not expire is kept.
+	expiry, resp := planExpiry(types.StringNull())
+	assert.True(t, expiry.IsNull())
+	assert.Empty(t, resp.Diagnostics)
+
+	// A credential expiring later is kept.
+	later := types.StringValue(time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	expiry, _ = planExpiry(later)
+	assert.Equal(t, later, expiry)
+
+	// A credential expiring soon is refreshed.
+	expiry, resp = planExpiry(types.StringValue(time.Now().Add(5 * time.Minute).UTC().Format(time.RFC3339)))
+	assert.True(t, expiry.IsUnknown())
+	assert.Empty(t, resp.Diagnostics)
+
+	// An expired credential is refreshed, with a warning.
+	expiry, resp = planExpiry(types.StringValue(time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)))
+	assert.True(t, expiry.IsUnknown())
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Equal(t, "Kubernetes Credential Expired", resp.Diagnostics.Warnings()[0].Summary())
+}
+
+func TestAcc_ResourceKubernetesCloud(t *testing.T) {
+	SkipJAAS(t)
+	if testingCloud != MicroK8sTesting {
+		t.Skip(t.Name() + " only runs with MicroK8s")
+	}
+	kubeConfigPath := os.Getenv("KUBECONFIG")
+	if kubeConfigPath == "" {
+		t.Skip(t.Name() + " requires KUBECONFIG to be set")
+	}
+	cloudName := acctest.RandomWithPrefix("tf-test-k8scloud")
+	resourceName := "juju_kubernetes_cloud.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceKubernetesCloudWithoutConfig(cloudName),
+				ExpectError: regexp.MustCompile("kubernetes_config is required unless in_cluster is set"),
+			},
+			{
+				Config: testAccResourceKubernetesCloud(cloudName, kubeConfigPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", cloudName),
+					resource.TestCheckResourceAttr(resourceName, "credential", cloudName),
+					// The token of MicroK8s does not expire.
+					resource.TestCheckNoResourceAttr(resourceName, "credential_expiry"),
+					resource.TestCheckResourceAttr(resourceName, "id", cloudName),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ResourceName:            resourceName,
+				ImportStateVerifyIgnore: []string{"kubernetes_config", "context_name", "in_cluster"},
+			},
+		},
+	})
+}
+
+func testAccResourceKubernetesCloud(cloudName, kubeConfigPath string) string {
+	return fmt.Sprintf(`
+resource "juju_kubernetes_cloud" "test" {
+  name              = %q
+  kubernetes_config = file(%q)
+}
+`, cloudName, kubeConfigPath)
+}
+
+func testAccResourceKubernetesCloudWithoutConfig(cloudName string) string {
+	return fmt.Sprintf(`
+resource "juju_kubernetes_cloud" "test" {
+  name       = %q
+  in_cluster = false
+}
+`, cloudName)
+}
@@ -8,11 +8,17 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -20,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -27,6 +34,7 @@ import (
 var _ resource.Resource = &machineResource{}
 var _ resource.ResourceWithConfigure = &machineResource{}
 var _ resource.ResourceWithImportState = &machineResource{}
+var _ resource.ResourceWithModifyPlan = &machineResource{}
 
 func NewMachineResource() resource.Resource {
 	return &machineResource{}
@@ -51,10 +59,33 @@ type machineResourceModel struct {
 	SSHAddress     types.String `tfsdk:"ssh_address"`
 	PublicKeyFile  types.String `tfsdk:"public_key_file"`
 	PrivateKeyFile types.String `tfsdk:"private_key_file"`
+	Zones          types.List   `tfsdk:"zones"`
+	CountPerZone   types.Int64  `tfsdk:"count_per_zone"`
+	MachineIDs     types.List   `tfsdk:"machine_ids"`
+	Containers     types.List   `tfsdk:"containers"`
+	ContainerIDs   types.List   `tfsdk:"container_ids"`
+	MAAS           types.List   `tfsdk:"maas"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
 
+// nestedContainers represents an element of the containers
+// ListNestedBlock of the machine resource schema.
+type nestedContainers struct {
+	Type        types.String `tfsdk:"type"`
+	Count       types.Int64  `tfsdk:"count"`
+	Constraints types.String `tfsdk:"constraints"`
+}
+
+// nestedMAAS represents the maas ListNestedBlock of the machine
+// resource schema.
+type nestedMAAS struct {
+	SystemID types.String `tfsdk:"system_id"`
+	Hostname types.String `tfsdk:"hostname"`
+	Tags     types.Set    `tfsdk:"tags"`
+	Spaces   types.Set    `tfsdk:"spaces"`
+}
+
 func (r *machineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_machine"
 }
@@ -79,7 +110,7 @@ func (r *machineResource) Configure(ctx context.Context, req resource.ConfigureR
 
 	r.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	r.subCtx = tflog.NewSubsystem(ctx, LogResourceMachine)
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceMachine)
 }
 
 const (
@@ -94,11 +125,19 @@ const (
 	SSHAddressKey     = "ssh_address"
 	PrivateKeyFileKey = "private_key_file"
 	PublicKeyFileKey  = "public_key_file"
+	ZonesKey          = "zones"
+	CountPerZoneKey   = "count_per_zone"
+	MachineIDsKey     = "machine_ids"
+	ContainersKey     = "containers"
+	ContainerIDsKey   = "container_ids"
+	MAASKey           = "maas"
 )
 
 func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A resource that represents a Juju machine deployment. Refer to the juju add-machine CLI command for more information and limitations.",
+		Description: "A resource that represents a Juju machine deployment. Refer to the juju add-machine CLI command for more information and limitations. " +
+			"The machine is created once it has been provisioned. If its provisioning fails, the machine is force removed and the error of the provisioner is reported. " +
+			"Several identical machines can be added at once with zones and count_per_zone, in a single call to Juju.",
 		Attributes: map[string]schema.Attribute{
 			NameKey: schema.StringAttribute{
 				Description: "A name for the machine resource in Terraform.",
@@ -125,6 +164,7 @@ func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest,
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot(SSHAddressKey),
 					}...),
+					StringIsConstraintsValidator{},
 				},
 			},
 			DisksKey: schema.StringAttribute{
@@ -181,15 +221,65 @@ func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot(SSHAddressKey),
+						path.MatchRoot(ZonesKey),
+					}...),
+				},
+			},
+			ZonesKey: schema.ListAttribute{
+				Description: "The availability zones to add machines to. count_per_zone machines are added to each zone. " +
+					"Changing this value will cause the machines to be destroyed and recreated by terraform.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot(SSHAddressKey),
+						path.MatchRoot(PlacementKey),
+					}...),
+				},
+			},
+			CountPerZoneKey: schema.Int64Attribute{
+				Description: "The number of identical machines to add to each of the zones, or in total when no zones are given. " +
+					"Defaults to 1. Changing this value will cause the machines to be destroyed and recreated by terraform.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.Expressions{
+						path.MatchRoot(SSHAddressKey),
 					}...),
 				},
 			},
 			MachineIDKey: schema.StringAttribute{
-				Description: "The id of the machine Juju creates.",
+				Description: "The id of the machine Juju creates. When several machines are added, the id of the first one.",
 				Computed:    true,
 				Optional:    false,
 				Required:    false,
 			},
+			MachineIDsKey: schema.ListAttribute{
+				Description: "The ids of all the machines Juju creates. An imported machine only lists its own id. " +
+					"When some of the machines are removed outside of terraform, all the machines are destroyed and recreated.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			ContainerIDsKey: schema.ListAttribute{
+				Description: "The ids of the containers created with the containers blocks, e.g. `0/lxd/0`, in the " +
+					"order of the machines and of the blocks.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			SSHAddressKey: schema.StringAttribute{
 				Description: "The user@host directive for manual provisioning an existing machine via ssh. " +
 					"Requires public_key_file & private_key_file arguments.",
@@ -242,6 +332,107 @@ func (r *machineResource) Schema(_ context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			ContainersKey: schema.ListNestedBlock{
+				Description: "Containers to create on each of the machines once they are provisioned, like " +
+					"`juju add-machine lxd:<machine>` does. Removing the machines removes their containers. " +
+					"Changing this value will cause the machines to be destroyed and recreated by terraform.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the containers, `lxd` or `kvm`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("lxd", "kvm"),
+							},
+						},
+						"count": schema.Int64Attribute{
+							Description: "The number of containers to create on each machine. Defaults to 1.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"constraints": schema.StringAttribute{
+							Description: "The constraints of the containers.",
+							Optional:    true,
+							Validators: []validator.String{
+								StringIsConstraintsValidator{},
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot(SSHAddressKey),
+					}...),
+				},
+			},
+			MAASKey: schema.ListNestedBlock{
+				Description: "How to select the MAAS machines to allocate, translated into the placement directive " +
+					"and the constraints of the machines. Use zones for the MAAS availability zones. Changing this " +
+					"value will cause the machines to be destroyed and recreated by terraform.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"system_id": schema.StringAttribute{
+							Description: "The system ID of the MAAS machine to allocate, e.g. `abc123`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								stringvalidator.ConflictsWith(path.Expressions{
+									path.MatchRelative().AtParent().AtName("hostname"),
+									path.MatchRoot(ZonesKey),
+									path.MatchRoot(CountPerZoneKey),
+								}...),
+							},
+						},
+						"hostname": schema.StringAttribute{
+							Description: "The hostname of the MAAS machine to allocate, e.g. `node1.maas`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								stringvalidator.ConflictsWith(path.Expressions{
+									path.MatchRoot(ZonesKey),
+									path.MatchRoot(CountPerZoneKey),
+								}...),
+							},
+						},
+						"tags": schema.SetAttribute{
+							Description: "The MAAS tags the machines must have. A tag prefixed by `^` excludes the " +
+								"machines with it. Added to the tags constraint.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"spaces": schema.SetAttribute{
+							Description: "The spaces the machines must have a subnet in, e.g. to select machines " +
+								"connected to specific subnets. A space prefixed by `^` excludes the machines in it. " +
+								"Added to the spaces constraint.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot(SSHAddressKey),
+						path.MatchRoot(PlacementKey),
+					}...),
+				},
+			},
+		},
 	}
 }
 
@@ -265,6 +456,41 @@ func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	var zones []string
+	resp.Diagnostics.Append(data.Zones.ElementsAs(ctx, &zones, false)...)
+	var nested []nestedContainers
+	resp.Diagnostics.Append(data.Containers.ElementsAs(ctx, &nested, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	containers := make([]juju.CreateContainerInput, len(nested))
+	for i, n := range nested {
+		containers[i] = juju.CreateContainerInput{
+			Type:        n.Type.ValueString(),
+			Count:       int(n.Count.ValueInt64()),
+			Constraints: n.Constraints.ValueString(),
+		}
+	}
+
+	// The MAAS machine is selected by placement directive, either its
+	// system ID or its hostname, and by constraints.
+	placement := data.Placement.ValueString()
+	var tags, spaces []string
+	var maas []nestedMAAS
+	resp.Diagnostics.Append(data.MAAS.ElementsAs(ctx, &maas, false)...)
+	if len(maas) > 0 {
+		if systemID := maas[0].SystemID.ValueString(); systemID != "" {
+			placement = "system-id=" + systemID
+		} else if hostname := maas[0].Hostname.ValueString(); hostname != "" {
+			placement = hostname
+		}
+		resp.Diagnostics.Append(maas[0].Tags.ElementsAs(ctx, &tags, false)...)
+		resp.Diagnostics.Append(maas[0].Spaces.ElementsAs(ctx, &spaces, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, err := r.client.Machines.CreateMachine(ctx, &juju.CreateMachineInput{
 		Constraints:    data.Constraints.ValueString(),
 		ModelName:      data.ModelName.ValueString(),
@@ -272,24 +498,41 @@ func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest
 		Base:           data.Base.ValueString(),
 		Series:         data.Series.ValueString(),
 		SSHAddress:     data.SSHAddress.ValueString(),
-		Placement:      data.Placement.ValueString(),
+		Placement:      placement,
 		PublicKeyFile:  data.PublicKeyFile.ValueString(),
 		PrivateKeyFile: data.PrivateKeyFile.ValueString(),
+		Zones:          zones,
+		CountPerZone:   int(data.CountPerZone.ValueInt64()),
+		Containers:     containers,
+		Tags:           tags,
+		Spaces:         spaces,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create machine, got error: %s", err))
 		return
 	}
-	r.trace(fmt.Sprintf("create machine resource %q", response.ID))
+	r.trace(fmt.Sprintf("create machine resource %q", response.ID), map[string]interface{}{"machines": response.IDs})
 
 	machineName := data.Name.ValueString()
 	if machineName == "" {
 		machineName = fmt.Sprintf("machine-%s", response.ID)
 	}
 
-	id := newMachineID(data.ModelName.ValueString(), response.ID, machineName)
+	id := ids.MachineID{Model: data.ModelName.ValueString(), MachineID: response.ID, Name: machineName}.String()
 	data.ID = types.StringValue(id)
 	data.MachineID = types.StringValue(response.ID)
+	machineIDs, dErr := types.ListValueFrom(ctx, types.StringType, response.IDs)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.MachineIDs = machineIDs
+	containerIDs, dErr := types.ListValueFrom(ctx, types.StringType, response.ContainerIDs)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ContainerIDs = containerIDs
 	data.Base = types.StringValue(response.Base)
 	data.Series = types.StringValue(response.Series)
 	data.Name = types.StringValue(machineName)
@@ -333,16 +576,33 @@ func (r *machineResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	modelName, machineID, machineName := modelMachineIDAndName(data.ID.ValueString(), &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
+	id, err := ids.ParseMachineID(data.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+	modelName, machineID, machineName := id.Model, id.MachineID, id.Name
+
+	var machineIDs []string
+	if !data.MachineIDs.IsNull() && !data.MachineIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.MachineIDs.ElementsAs(ctx, &machineIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if len(machineIDs) > 1 {
+		r.readMachines(ctx, modelName, machineIDs, &data, resp)
 		return
 	}
 
-	response, err := r.client.Machines.ReadMachine(juju.ReadMachineInput{
+	response, err := r.client.Machines.ReadMachine(ctx, juju.ReadMachineInput{
 		ModelName: modelName,
 		ID:        machineID,
 	})
 	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "machine") {
+			return
+		}
 		resp.Diagnostics.Append(handleMachineNotFoundError(ctx, err, &resp.State)...)
 		return
 	}
@@ -351,6 +611,7 @@ func (r *machineResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.Name = types.StringValue(machineName)
 	data.ModelName = types.StringValue(modelName)
 	data.MachineID = types.StringValue(machineID)
+	data.MachineIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue(machineID)})
 	data.Series = types.StringValue(response.Series)
 	data.Base = types.StringValue(response.Base)
 	if response.Constraints != "" {
@@ -359,6 +620,87 @@ func (r *machineResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// readMachines refreshes the state of a resource which added several
+// machines. Machines removed outside of terraform are dropped from the
+// state, so that ModifyPlan replaces the resource, and the resource is
+// removed once none remain.
+func (r *machineResource) readMachines(ctx context.Context, modelName string, machineIDs []string, data *machineResourceModel, resp *resource.ReadResponse) {
+	responses, err := r.client.Machines.ReadMachines(ctx, juju.ReadMachinesInput{
+		ModelName: modelName,
+		IDs:       machineIDs,
+	})
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "machine") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read machines, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("read machine resource %q", data.ID.ValueString()), map[string]interface{}{"machines": machineIDs})
+	if len(responses) == 0 {
+		// Machines manually removed
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	remaining := make([]string, 0, len(responses))
+	for _, response := range responses {
+		remaining = append(remaining, response.ID)
+	}
+	if len(remaining) != len(machineIDs) {
+		resp.Diagnostics.AddWarning("Machines Removed",
+			fmt.Sprintf("Some of the machines %s of this resource were removed outside of terraform, the remaining machines %s "+
+				"will be destroyed and all the machines recreated on the next apply.",
+				strings.Join(machineIDs, ", "), strings.Join(remaining, ", ")))
+	}
+	remainingIDs, dErr := types.ListValueFrom(ctx, types.StringType, remaining)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.MachineIDs = remainingIDs
+	data.Series = types.StringValue(responses[0].Series)
+	data.Base = types.StringValue(responses[0].Base)
+	if responses[0].Constraints != "" {
+		data.Constraints = types.StringValue(responses[0].Constraints)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// ModifyPlan replaces the machines of the resource when some of them were
+// removed outside of terraform, as Juju cannot add machines back to the
+// zones and the ids of the resource with the same call.
+func (r *machineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var state machineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.MachineIDs.IsNull() || state.MachineIDs.IsUnknown() {
+		return
+	}
+	if len(state.MachineIDs.Elements()) >= expectedMachineCount(state) {
+		return
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root(MachineIDsKey))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(MachineIDsKey), types.ListUnknown(types.StringType))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(MachineIDKey), types.StringUnknown())...)
+}
+
+// expectedMachineCount returns the number of machines the resource adds,
+// count_per_zone in each of the zones, or in total when no zones are given.
+func expectedMachineCount(data machineResourceModel) int {
+	count := 1
+	if !data.CountPerZone.IsNull() && !data.CountPerZone.IsUnknown() {
+		count = int(data.CountPerZone.ValueInt64())
+	}
+	if !data.Zones.IsNull() && !data.Zones.IsUnknown() {
+		count *= len(data.Zones.Elements())
+	}
+	return count
+}
+
 // Update is called to update the state of the resource. Config, planned
 // state, and prior state values should be read from the
 // UpdateRequest and new state values set on the UpdateResponse.
@@ -380,8 +722,8 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 	state.Name = plan.Name
-	id := newMachineID(plan.ModelName.ValueString(), plan.MachineID.ValueString(), plan.Name.ValueString())
-	state.ID = types.StringValue(id)
+	id := ids.MachineID{Model: plan.ModelName.ValueString(), MachineID: plan.MachineID.ValueString(), Name: plan.Name.ValueString()}
+	state.ID = types.StringValue(id.String())
 
 	r.trace(fmt.Sprintf("update machine resource %q", plan.MachineID.ValueString()))
 
@@ -415,14 +757,33 @@ func (r *machineResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	modelName, machineID, _ := modelMachineIDAndName(data.ID.ValueString(), &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
+	id, err := ids.ParseMachineID(data.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	modelName, machineID := id.Model, id.MachineID
+
+	var machineIDs []string
+	if !data.MachineIDs.IsNull() && !data.MachineIDs.IsUnknown() {
+		resp.Diagnostics.Append(data.MachineIDs.ElementsAs(ctx, &machineIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	// All the machines are destroyed with a single call, the machine of
+	// the resource id may have been removed with a partial refresh.
+	var extraIDs []string
+	for _, id := range machineIDs {
+		if id != machineID {
+			extraIDs = append(extraIDs, id)
+		}
+	}
 
-	if err := r.client.Machines.DestroyMachine(&juju.DestroyMachineInput{
+	if err := r.client.Machines.DestroyMachine(ctx, &juju.DestroyMachineInput{
 		ModelName: modelName,
 		ID:        machineID,
+		ExtraIDs:  extraIDs,
 	}); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete machine, got error: %s", err))
 	}
@@ -435,8 +796,31 @@ func (r *machineResource) Delete(ctx context.Context, req resource.DeleteRequest
 //
 // If setting an attribute with the import identifier, it is recommended
 // to use the ImportStatePassthroughID() call in this method.
+//
+// Besides the resource ID, machines can be imported by their cloud instance
+// ID using the format: `model_name:instance-id:<instance id>`. The Juju
+// machine ID is then discovered from the model status.
 func (r *machineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importID, err := ids.ParseMachineInstanceImportID(req.ID)
+	if err != nil {
+		importStatePassthroughValidID(ctx, ids.ParseMachineID, req, resp)
+		return
+	}
+	modelName, instanceID := importID.Model, importID.InstanceID
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "machine", "import")
+		return
+	}
+
+	machineID, err := r.client.Machines.MachineIDFromInstanceID(ctx, modelName, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError("ImportState Failure", fmt.Sprintf("Unable to find machine with instance ID %q, got error: %s", instanceID, err))
+		return
+	}
+	r.trace(fmt.Sprintf("found machine %q for instance %q", machineID, instanceID))
+
+	id := ids.MachineID{Model: modelName, MachineID: machineID, Name: fmt.Sprintf("machine-%s", machineID)}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 }
 
 func (r *machineResource) trace(msg string, additionalFields ...map[string]interface{}) {
@@ -449,18 +833,3 @@ func (r *machineResource) trace(msg string, additionalFields ...map[string]inter
 	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
 	tflog.SubsystemTrace(r.subCtx, LogResourceMachine, msg, additionalFields...)
 }
-
-func newMachineID(model, machine_id, machine_name string) string {
-	return fmt.Sprintf("%s:%s:%s", model, machine_id, machine_name)
-}
-
-// Machines can be imported using the format: `model_name:machine_id:machine_name`.
-func modelMachineIDAndName(value string, diags *diag.Diagnostics) (string, string, string) {
-	id := strings.Split(value, ":")
-	//If importing with an incorrect ID we need to catch and provide a user-friendly error
-	if len(id) != 3 {
-		diags.AddError("Malformed ID", fmt.Sprintf("unable to parse model name, machine id, and machine name from provided ID: %q", value))
-		return "", "", ""
-	}
-	return id[0], id[1], id[2]
-}
@@ -4,14 +4,76 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
 )
 
+func TestResourceMachineModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &machineResource{}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	modifyPlan := func(machineIDs []string) fwresource.ModifyPlanResponse {
+		ids, diags := types.ListValueFrom(ctx, types.StringType, machineIDs)
+		require.False(t, diags.HasError(), diags)
+		zones, diags := types.ListValueFrom(ctx, types.StringType, []string{"zone-a", "zone-b"})
+		require.False(t, diags.HasError(), diags)
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		require.False(t, state.Set(ctx, machineResourceModel{
+			Name:           types.StringValue("machine-0"),
+			ModelName:      types.StringValue("development"),
+			Constraints:    types.StringNull(),
+			Disks:          types.StringNull(),
+			Base:           types.StringValue("ubuntu@22.04"),
+			Series:         types.StringValue("jammy"),
+			Placement:      types.StringNull(),
+			MachineID:      types.StringValue("0"),
+			SSHAddress:     types.StringNull(),
+			PublicKeyFile:  types.StringNull(),
+			PrivateKeyFile: types.StringNull(),
+			Zones:          zones,
+			CountPerZone:   types.Int64Value(2),
+			MachineIDs:     ids,
+			Containers:     types.ListNull(schemaElementType(t, r, ContainersKey)),
+			ContainerIDs:   types.ListNull(types.StringType),
+			MAAS:           types.ListNull(schemaElementType(t, r, MAASKey)),
+			ID:             types.StringValue("development:0:machine-0"),
+		}).HasError())
+		plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+		resp := fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		return resp
+	}
+
+	// All the machines of the zones exist.
+	resp := modifyPlan([]string{"0", "1", "2", "3"})
+	assert.Empty(t, resp.RequiresReplace)
+
+	// A machine was removed outside of terraform.
+	resp = modifyPlan([]string{"0", "1", "3"})
+	assert.Equal(t, path.Paths{path.Root(MachineIDsKey)}, resp.RequiresReplace)
+	var got machineResourceModel
+	require.False(t, resp.Plan.Get(ctx, &got).HasError())
+	assert.True(t, got.MachineIDs.IsUnknown())
+	assert.True(t, got.MachineID.IsUnknown())
+}
+
 func TestAcc_ResourceMachine(t *testing.T) {
 	if testingCloud != LXDCloudTesting {
 		t.Skip(t.Name() + " only runs with LXD")
@@ -92,6 +154,133 @@ func TestAcc_ResourceMachine_WithPlacement(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceMachine_CountPerZone(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-machine")
+	resourceName := "juju_machine.batch"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMachineCountPerZone(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "model", modelName),
+					resource.TestCheckResourceAttr(resourceName, "machine_id", "0"),
+					resource.TestCheckResourceAttr(resourceName, "machine_ids.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "machine_ids.0", "0"),
+					resource.TestCheckResourceAttr(resourceName, "machine_ids.1", "1"),
+					resource.TestCheckResourceAttr(resourceName, "machine_ids.2", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceMachineCountPerZone(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_machine" "batch" {
+	model          = juju_model.this.name
+	count_per_zone = 3
+}
+`, modelName)
+}
+
+func TestAcc_ResourceMachine_Containers(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-machine")
+	resourceName := "juju_machine.host"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMachineContainers(modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "machine_id", "0"),
+					resource.TestCheckResourceAttr(resourceName, "container_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_ids.0", "0/lxd/0"),
+					resource.TestCheckResourceAttr(resourceName, "container_ids.1", "0/lxd/1"),
+				),
+			},
+			{
+				Config:   testAccResourceMachineContainers(modelName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceMachineContainers(modelName string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_machine" "host" {
+	model = juju_model.this.name
+	base  = "ubuntu@22.04"
+	containers {
+		type  = "lxd"
+		count = 2
+	}
+}
+`, modelName)
+}
+
+// TestAcc_ResourceMachine_MAASConflicts verifies a MAAS machine
+// selected by system ID cannot also be placed otherwise or added several
+// times. MAAS is not needed, the plan fails validation.
+func TestAcc_ResourceMachine_MAASConflicts(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-machine")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMachineMAAS(modelName, `
+	count_per_zone = 2
+	maas {
+		system_id = "abc123"
+		tags      = ["ssd"]
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccResourceMachineMAAS(modelName, `
+	placement = "zone=default"
+	maas {
+		spaces = ["storage"]
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccResourceMachineMAAS(modelName, arguments string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_machine" "maas" {
+	model = juju_model.this.name
+	%s
+}
+`, modelName, arguments)
+}
+
 func testAccResourceMachineBasicMinimal(modelName string) string {
 	return fmt.Sprintf(`
 resource "juju_model" "this" {
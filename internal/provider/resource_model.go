@@ -8,22 +8,25 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/juju/juju/core/constraints"
+	jujuconfig "github.com/juju/juju/environs/config"
 	"github.com/juju/names/v4"
 	"github.com/juju/utils/v3"
 
@@ -33,6 +36,7 @@ import (
 var _ resource.Resource = &modelResource{}
 var _ resource.ResourceWithConfigure = &modelResource{}
 var _ resource.ResourceWithImportState = &modelResource{}
+var _ resource.ResourceWithModifyPlan = &modelResource{}
 
 func NewModelResource() resource.Resource {
 	return &modelResource{}
@@ -49,13 +53,40 @@ type modelResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Cloud       types.List   `tfsdk:"cloud"`
 	Config      types.Map    `tfsdk:"config"`
+	ConfigMode  types.String `tfsdk:"config_mode"`
 	Constraints types.String `tfsdk:"constraints"`
 	Credential  types.String `tfsdk:"credential"`
+	Description types.String `tfsdk:"description"`
 	Type        types.String `tfsdk:"type"`
+	OnDestroy   types.String `tfsdk:"on_destroy"`
+	// CLIEquivalent is computed from the other attributes.
+	CLIEquivalent types.String `tfsdk:"cli_equivalent"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
 
+const (
+	// modelOnDestroyDestroy destroys the model when the resource is
+	// destroyed.
+	modelOnDestroyDestroy = "destroy"
+	// modelOnDestroyAbandon only removes the model from the Terraform
+	// state when the resource is destroyed, leaving it intact.
+	modelOnDestroyAbandon = "abandon"
+
+	// modelConfigModeMerge only manages the config keys present in
+	// the configuration of the resource.
+	modelConfigModeMerge = "merge"
+	// modelConfigModeAuthoritative manages every config key set on the
+	// model, keys absent from the configuration are reset to their
+	// default.
+	modelConfigModeAuthoritative = "authoritative"
+)
+
+// modelJujuConfigKeys is the private state key of the config keys set on
+// the model by Juju itself rather than by the user, which are never
+// tracked nor reset in authoritative mode.
+const modelJujuConfigKeys = "juju_config_keys"
+
 // nestedCloud represents an element in a Cloud list of a model resource
 type nestedCloud struct {
 	Name   types.String `tfsdk:"name"`
@@ -74,13 +105,28 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"config": schema.MapAttribute{
-				Description: "Override default model configuration",
+				Description: "Override default model configuration. Keys unknown to the controller, e.g. misspelled " +
+					"ones, are reported as warnings, as Juju stores them without using them.",
 				Optional:    true,
 				ElementType: types.StringType,
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"config_mode": schema.StringAttribute{
+				Description: fmt.Sprintf("How the model config is managed: %q only manages the keys of `config`, "+
+					"%q manages every key set on the model, keys set outside of Terraform are reported as "+
+					"changes and reset to their default when absent from `config`. The keys Juju sets itself, i.e. "+
+					"the keys set on the model but not in `config` when it is created, imported or switched to "+
+					"this mode, are left out. Defaults to %q.",
+					modelConfigModeMerge, modelConfigModeAuthoritative, modelConfigModeMerge),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(modelConfigModeMerge),
+				Validators: []validator.String{
+					stringvalidator.OneOf(modelConfigModeMerge, modelConfigModeAuthoritative),
+				},
+			},
 			"constraints": schema.StringAttribute{
 				Description: "Constraints imposed to this model",
 				Optional:    true,
@@ -89,13 +135,35 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"credential": schema.StringAttribute{
-				Description: "Credential used to add the model",
+				Description: "Credential used by the model. Changing it updates the model in place.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"description": schema.StringAttribute{
+				Description: "A description of the model, e.g. its purpose and owner. It is stored on the model " +
+					"as the `" + juju.DescriptionAnnotation + "` annotation, which should not also be " +
+					"managed by `juju_annotation`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"on_destroy": schema.StringAttribute{
+				Description: fmt.Sprintf("What happens to the model when the resource is destroyed: %q destroys "+
+					"it, %q only removes it from the Terraform state and leaves the model intact, e.g. to "+
+					"transfer its ownership to another workspace. It must be applied before the resource is "+
+					"removed from the configuration. Defaults to %q.",
+					modelOnDestroyDestroy, modelOnDestroyAbandon, modelOnDestroyDestroy),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(modelOnDestroyDestroy),
+				Validators: []validator.String{
+					stringvalidator.OneOf(modelOnDestroyDestroy, modelOnDestroyAbandon),
+				},
+			},
 			"type": schema.StringAttribute{
 				Description: "Type of the model. Set by the Juju's API server",
 				Computed:    true,
@@ -103,6 +171,8 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			CLIEquivalentKey: cliEquivalentAttribute("adding an equivalent model, e.g. " +
+				"`juju add-model development localhost/localhost --config 'logging-config=<root>=INFO'`"),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -112,10 +182,9 @@ func (r *modelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 		},
 		Blocks: map[string]schema.Block{
 			"cloud": schema.ListNestedBlock{
-				Description: "JuJu Cloud where the model will operate",
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
+				Description: "JuJu Cloud where the model will operate. Changing the cloud or its region " +
+					"destroys the model and creates a new one, adding or removing the block for the " +
+					"cloud the model already operates in does not.",
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
@@ -155,7 +224,7 @@ func (r *modelResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 	r.client = client
-	r.subCtx = tflog.NewSubsystem(ctx, LogResourceModel)
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceModel)
 }
 
 func (r *modelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -215,7 +284,7 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 		cloudRegionInput = clouds[0].Region.ValueString()
 	}
 
-	response, err := r.client.Models.CreateModel(juju.CreateModelInput{
+	response, err := r.client.Models.CreateModel(ctx, juju.CreateModelInput{
 		Name:        modelName,
 		CloudName:   cloudNameInput,
 		CloudRegion: cloudRegionInput,
@@ -228,6 +297,20 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	r.trace(fmt.Sprintf("model created : %q", modelName))
+	configKeys := make([]string, 0, len(config))
+	for k := range config {
+		configKeys = append(configKeys, k)
+	}
+	r.warnUnknownConfigKeys(ctx, response.Cloud, configKeys, &resp.Diagnostics)
+
+	// The config keys Juju sets when creating the model are recorded, so
+	// that authoritative mode does not reset them.
+	if plan.ConfigMode.ValueString() == modelConfigModeAuthoritative {
+		if _, diags := r.managedModelConfig(ctx, modelName, resp.Private, config); diags.HasError() {
+			// The keys are derived from the state on the next read.
+			r.trace("unable to record the config keys set by juju", map[string]interface{}{"diagnostics": diags})
+		}
+	}
 
 	if !plan.Cloud.IsNull() {
 		// Set the cloud value if required
@@ -248,9 +331,25 @@ func (r *modelResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.Type = types.StringValue(response.Type)
 	plan.ID = types.StringValue(response.UUID)
 
+	if !plan.Description.IsNull() {
+		if err := setDescription(ctx, r.client, modelName, names.NewModelTag(response.UUID).String(), plan.Description); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the description of model %q, got error: %s", modelName, err))
+			return
+		}
+	}
+
 	r.trace(fmt.Sprintf("model resource created: %q", modelName))
+	r.client.RecordOperation(ctx, juju.OperationSummary{
+		Resource:  "juju_model",
+		ID:        plan.ID.ValueString(),
+		Operation: juju.OperationCreate,
+		Changes: map[string]juju.OperationChange{
+			"name": {After: modelName},
+		},
+	})
 
 	// Write the state plan into the Response.State
+	plan.CLIEquivalent = modelCLIEquivalent(ctx, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -283,8 +382,11 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		modelName = state.ID.ValueString()
 	}
 
-	response, err := r.client.Models.ReadModel(modelName)
+	response, err := r.client.Models.ReadModel(ctx, modelName)
 	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "model") {
+			return
+		}
 		resp.Diagnostics.Append(handleModelNotFoundError(ctx, err, &resp.State)...)
 		return
 	}
@@ -320,6 +422,15 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.Constraints = types.StringValue(response.ModelConstraints.String())
 	}
 
+	// Description
+	if imported || !state.Description.IsNull() {
+		state.Description, err = readDescription(ctx, r.client, modelName, names.NewModelTag(response.ModelInfo.UUID).String())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the description of model %q, got error: %s", modelName, err))
+			return
+		}
+	}
+
 	// Config
 	if len(response.ModelConfig) > 0 {
 		// we make the stateConfig (instead of only declaring), because
@@ -362,14 +473,48 @@ func (r *modelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.Config = newStateConfig
 	}
 
+	// In authoritative mode, every key set on the model is tracked so
+	// keys set outside of Terraform show up as changes.
+	if state.ConfigMode.ValueString() == modelConfigModeAuthoritative {
+		stateConfig := map[string]string{}
+		resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		modelConfig, diags := r.managedModelConfig(ctx, modelName, resp.Private, stateConfig)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for k, v := range modelConfig {
+			stateConfig[k] = v
+		}
+		if len(stateConfig) > 0 || !state.Config.IsNull() {
+			configType := req.State.Schema.GetAttributes()["config"].(schema.MapAttribute).ElementType
+			newStateConfig, errDiag := types.MapValueFrom(ctx, configType, stateConfig)
+			resp.Diagnostics.Append(errDiag...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			state.Config = newStateConfig
+		}
+	}
+
 	// Name, Type, Credential, and Id.
 	state.Name = types.StringValue(modelName)
 	state.Type = types.StringValue(response.ModelInfo.Type)
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(modelOnDestroyDestroy)
+	}
+	if state.ConfigMode.IsNull() {
+		state.ConfigMode = types.StringValue(modelConfigModeMerge)
+	}
 	state.Credential = types.StringValue(credential)
 	state.ID = types.StringValue(response.ModelInfo.UUID)
 
 	r.trace(fmt.Sprintf("Read model resource for: %v", modelName))
 	// Set the state onto the Terraform state
+	state.CLIEquivalent = modelCLIEquivalent(ctx, state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -414,6 +559,42 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 			}
 		}
 		configMap = newConfigMap
+
+		// Only warn about the keys added since the last apply.
+		var newConfigKeys []string
+		for k := range newConfigMap {
+			if _, ok := oldConfigMap[k]; !ok {
+				newConfigKeys = append(newConfigKeys, k)
+			}
+		}
+		if len(newConfigKeys) > 0 {
+			if current, err := r.currentCloud(ctx, state); err == nil {
+				r.warnUnknownConfigKeys(ctx, current.Name.ValueString(), newConfigKeys, &resp.Diagnostics)
+			}
+		}
+	}
+
+	// In authoritative mode, keys set on the model outside of Terraform
+	// since the last refresh are reset too.
+	if plan.ConfigMode.ValueString() == modelConfigModeAuthoritative {
+		planConfig := map[string]string{}
+		resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &planConfig, false)...)
+		stateConfig := map[string]string{}
+		resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		modelConfig, diags := r.managedModelConfig(ctx, plan.Name.ValueString(), resp.Private, stateConfig, planConfig)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for k := range modelConfig {
+			if _, ok := planConfig[k]; !ok && !slices.Contains(unsetConfigKeys, k) {
+				noChange = false
+				unsetConfigKeys = append(unsetConfigKeys, k)
+			}
+		}
 	}
 
 	// Check the constraints
@@ -438,7 +619,18 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		credentialUpdate = plan.Credential.ValueString()
 	}
 
+	if !plan.Description.Equal(state.Description) {
+		if err := setDescription(ctx, r.client, plan.Name.ValueString(), names.NewModelTag(state.ID.ValueString()).String(), plan.Description); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the description of model %q, got error: %s", plan.Name.ValueString(), err))
+			return
+		}
+	}
+
 	if noChange {
+		// Only on_destroy or the description changed, which are not set
+		// by updating the model.
+		plan.CLIEquivalent = state.CLIEquivalent
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		return
 	}
 
@@ -454,7 +646,7 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		cloudNameInput = clouds[0].Name.ValueString()
 	}
 
-	err = r.client.Models.UpdateModel(juju.UpdateModelInput{
+	err = r.client.Models.UpdateModel(ctx, juju.UpdateModelInput{
 		Name:        plan.Name.ValueString(),
 		CloudName:   cloudNameInput,
 		Config:      configMap,
@@ -468,9 +660,209 @@ func (r *modelResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	r.trace(fmt.Sprintf("Updated model resource: %q", plan.Name.ValueString()))
+	plan.CLIEquivalent = modelCLIEquivalent(ctx, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// ModifyPlan replaces the model only when the planned cloud or region
+// differs from where the model actually operates. The cloud block may be
+// absent from the state, e.g. when it is added to the configuration of an
+// existing model, so the model is read to compare against. The region is
+// validated against the regions of the cloud whenever a model is going
+// to be created in it.
+func (r *modelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on destroy.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan modelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Cloud.IsNull() || plan.Cloud.IsUnknown() {
+		return
+	}
+	var planClouds []nestedCloud
+	resp.Diagnostics.Append(plan.Cloud.ElementsAs(ctx, &planClouds, false)...)
+	if resp.Diagnostics.HasError() || len(planClouds) == 0 {
+		return
+	}
+	planCloud := planClouds[0]
+
+	if req.State.Raw.IsNull() {
+		r.validateCloudRegion(ctx, planCloud, &resp.Diagnostics)
+		return
+	}
+
+	var state modelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current, err := r.currentCloud(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the cloud of model %q, got error: %s", state.Name.ValueString(), err))
+		return
+	}
+
+	if planCloud.Name.IsUnknown() || planCloud.Name.ValueString() != current.Name.ValueString() ||
+		(!planCloud.Region.IsUnknown() && planCloud.Region.ValueString() != current.Region.ValueString()) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("cloud"))
+		r.validateCloudRegion(ctx, planCloud, &resp.Diagnostics)
+		return
+	}
+	if planCloud.Region.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cloud").AtListIndex(0).AtName("region"), current.Region)...)
+	}
+}
+
+// validateCloudRegion reports an error when the region of the cloud is
+// not one of the regions of the cloud on the controller. Nothing is
+// validated when either is not known yet, or when the cloud is not found,
+// e.g. it is created by the same plan.
+func (r *modelResource) validateCloudRegion(ctx context.Context, cloud nestedCloud, diags *diag.Diagnostics) {
+	if cloud.Name.IsUnknown() || cloud.Region.IsUnknown() || cloud.Region.IsNull() {
+		return
+	}
+	response, err := r.client.Clouds.ReadCloud(ctx, cloud.Name.ValueString())
+	if errors.As(err, &juju.CloudNotFoundError) {
+		return
+	} else if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read cloud %q, got error: %s", cloud.Name.ValueString(), err))
+		return
+	}
+	if len(response.Regions) == 0 {
+		return
+	}
+	regions := make([]string, len(response.Regions))
+	for i, region := range response.Regions {
+		if region.Name == cloud.Region.ValueString() {
+			return
+		}
+		regions[i] = region.Name
+	}
+	diags.AddAttributeError(path.Root("cloud").AtListIndex(0).AtName("region"), "Invalid Cloud Region",
+		fmt.Sprintf("Region %q does not exist on cloud %q, its regions are: %s.",
+			cloud.Region.ValueString(), cloud.Name.ValueString(), strings.Join(regions, ", ")))
+}
+
+// currentCloud returns the cloud of the model, taken from the state when
+// it is tracked there, otherwise read from the controller.
+func (r *modelResource) currentCloud(ctx context.Context, state modelResourceModel) (nestedCloud, error) {
+	if !state.Cloud.IsNull() && !state.Cloud.IsUnknown() {
+		var clouds []nestedCloud
+		if diags := state.Cloud.ElementsAs(ctx, &clouds, false); diags.HasError() {
+			return nestedCloud{}, errors.New("unable to convert the cloud of the state")
+		}
+		if len(clouds) > 0 {
+			return clouds[0], nil
+		}
+	}
+	response, err := r.client.Models.ReadModel(ctx, state.Name.ValueString())
+	if err != nil {
+		return nestedCloud{}, err
+	}
+	return nestedCloud{
+		Name:   types.StringValue(strings.TrimPrefix(response.ModelInfo.CloudTag, juju.PrefixCloud)),
+		Region: types.StringValue(response.ModelInfo.CloudRegion),
+	}, nil
+}
+
+// warnUnknownConfigKeys adds a warning for each config key which is not
+// known to the controller, as Juju silently stores such keys, e.g. a
+// misspelled "loggin-config". The keys are not checked when the
+// controller cannot tell which keys it knows.
+func (r *modelResource) warnUnknownConfigKeys(ctx context.Context, cloudName string, keys []string, diags *diag.Diagnostics) {
+	if cloudName == "" || len(keys) == 0 {
+		return
+	}
+	unknown, err := r.client.Models.UnknownConfigKeys(ctx, cloudName, keys)
+	if err != nil {
+		r.trace("unable to check the model config keys", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	for _, key := range unknown {
+		diags.AddAttributeWarning(path.Root("config").AtMapKey(key), "Unknown Model Config Key",
+			fmt.Sprintf("%q is not a model config key known to the controller, it may be misspelled. "+
+				"Juju stores the value but does not use it.", key))
+	}
+}
+
+// modelConfigSetOnModel returns the config keys set on the model, rather
+// than inherited from defaults, including the keys set by Juju itself.
+func (r *modelResource) modelConfigSetOnModel(ctx context.Context, modelName string) (map[string]string, error) {
+	response, err := r.client.Models.ReadModelConfig(ctx, juju.ReadModelConfigInput{Name: modelName})
+	if err != nil {
+		return nil, err
+	}
+	config := make(map[string]string)
+	for k, source := range response.Sources {
+		if source == jujuconfig.JujuModelConfigSource {
+			config[k] = response.Config[k]
+		}
+	}
+	return config, nil
+}
+
+// managedModelConfig returns the config keys set on the model which are
+// managed in authoritative mode, leaving out the keys set by Juju itself.
+// The keys set by Juju are recorded in the private state the first time,
+// as the keys set on the model but absent from the given user config.
+func (r *modelResource) managedModelConfig(ctx context.Context, modelName string, private privateState, userConfigs ...map[string]string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	modelConfig, err := r.modelConfigSetOnModel(ctx, modelName)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read the config of model %q, got error: %s", modelName, err))
+		return nil, diags
+	}
+	value, dErr := private.GetKey(ctx, modelJujuConfigKeys)
+	diags.Append(dErr...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	var jujuKeys []string
+	if value != nil {
+		if err := json.Unmarshal(value, &jujuKeys); err != nil {
+			diags.AddError("Provider Error", fmt.Sprintf("Unable to read the config keys set by juju on model %q, got error: %s", modelName, err))
+			return nil, diags
+		}
+	} else {
+		jujuKeys = jujuSetConfigKeys(modelConfig, userConfigs...)
+		value, err := json.Marshal(jujuKeys)
+		if err != nil {
+			diags.AddError("Provider Error", fmt.Sprintf("Unable to record the config keys set by juju on model %q, got error: %s", modelName, err))
+			return nil, diags
+		}
+		diags.Append(private.SetKey(ctx, modelJujuConfigKeys, value)...)
+	}
+	for _, k := range jujuKeys {
+		delete(modelConfig, k)
+	}
+	return modelConfig, diags
+}
+
+// jujuSetConfigKeys returns the sorted keys of the model config which are
+// absent from all the given user configs, i.e. set by Juju itself.
+func jujuSetConfigKeys(modelConfig map[string]string, userConfigs ...map[string]string) []string {
+	keys := []string{}
+	for k := range modelConfig {
+		userSet := false
+		for _, userConfig := range userConfigs {
+			if _, ok := userConfig[k]; ok {
+				userSet = true
+				break
+			}
+		}
+		if !userSet {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
 func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Prevent panic if the provider has not been configured.
 	if r.client == nil {
@@ -486,7 +878,12 @@ func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.Models.DestroyModel(juju.DestroyModelInput{
+	if state.OnDestroy.ValueString() == modelOnDestroyAbandon {
+		r.trace(fmt.Sprintf("model abandoned : %q", state.Name.ValueString()))
+		return
+	}
+
+	err := r.client.Models.DestroyModel(ctx, juju.DestroyModelInput{
 		UUID: state.ID.ValueString(),
 	})
 	if err != nil {
@@ -494,6 +891,14 @@ func (r *modelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 	r.trace(fmt.Sprintf("model deleted : %q", state.Name.ValueString()))
+	r.client.RecordOperation(ctx, juju.OperationSummary{
+		Resource:  "juju_model",
+		ID:        state.ID.ValueString(),
+		Operation: juju.OperationDelete,
+		Changes: map[string]juju.OperationChange{
+			"name": {Before: state.Name.ValueString()},
+		},
+	})
 }
 
 func handleModelNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
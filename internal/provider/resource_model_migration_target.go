@@ -0,0 +1,315 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	jujuerrors "github.com/juju/errors"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ resource.Resource = &modelMigrationTargetResource{}
+var _ resource.ResourceWithConfigure = &modelMigrationTargetResource{}
+var _ resource.ResourceWithConfigValidators = &modelMigrationTargetResource{}
+
+type modelMigrationTargetResource struct {
+	client *juju.Client
+
+	// context for the logging subsystem.
+	subCtx context.Context
+}
+
+// NewModelMigrationTargetResource returns a new instance of the model
+// migration target resource.
+func NewModelMigrationTargetResource() resource.Resource {
+	return &modelMigrationTargetResource{}
+}
+
+type modelMigrationTargetResourceModel struct {
+	Controller      types.String `tfsdk:"controller"`
+	Models          types.Set    `tfsdk:"models"`
+	AcceptNewModels types.Bool   `tfsdk:"accept_new_models"`
+	// InitiallyDeprecated is whether the controller was deprecated
+	// when the resource was created, restored on delete.
+	InitiallyDeprecated types.Bool `tfsdk:"initially_deprecated"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Metadata returns the metadata for the model migration target resource.
+func (r *modelMigrationTargetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_migration_target"
+}
+
+// Schema defines the schema for the model migration target resource.
+func (r *modelMigrationTargetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that pins models to a controller attached to JAAS and " +
+			"controls whether JAAS places new models on that controller.",
+		Attributes: map[string]schema.Attribute{
+			"controller": schema.StringAttribute{
+				Description: "The name of the controller attached to JAAS. Changing this value will cause the resource to be destroyed and recreated by terraform.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"models": schema.SetAttribute{
+				Description: "The UUIDs of the models pinned to the controller. Models added to this set are migrated to " +
+					"the controller. Removing a model from this set does not migrate it away.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"accept_new_models": schema.BoolAttribute{
+				Description: "Whether JAAS may place new models on the controller. When false, the controller is marked " +
+					"as deprecated. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"initially_deprecated": schema.BoolAttribute{
+				Description: "Whether the controller was marked as deprecated before the resource was created. " +
+					"The controller is marked as deprecated, or not, as it was when the resource is destroyed.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators sets validators for the resource.
+func (r *modelMigrationTargetResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewRequiresJAASValidator(r.client),
+	}
+}
+
+// Configure sets up the model migration target resource with the provider data.
+func (r *modelMigrationTargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceModelMigrationTarget)
+}
+
+// Create migrates the models to the controller and sets whether
+// the controller accepts new models.
+func (r *modelMigrationTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceModelMigrationTarget, "create")
+		return
+	}
+
+	// Read Terraform configuration from the request into the model
+	var plan modelMigrationTargetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	controller := plan.Controller.ValueString()
+
+	// Fail early if the controller is not attached to JAAS
+	info, err := r.client.Jaas.ReadController(ctx, controller)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controller %q, got error: %s", controller, err))
+		return
+	}
+	initiallyDeprecated := info.Status == juju.ControllerStatusDeprecated
+	deprecated := !plan.AcceptNewModels.ValueBool()
+
+	if deprecated != initiallyDeprecated {
+		if err := r.client.Jaas.SetControllerDeprecated(ctx, controller, deprecated); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update controller %q, got error: %s", controller, err))
+			return
+		}
+	}
+
+	models, diags := modelUUIDsFromSet(ctx, plan.Models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(models) > 0 {
+		if err := r.client.Jaas.MigrateModels(ctx, controller, models); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to migrate models to controller %q, got error: %s", controller, err))
+			// The resource is not created, restore whether JAAS places
+			// new models on the controller.
+			if deprecated != initiallyDeprecated {
+				r.restoreDeprecation(ctx, controller, initiallyDeprecated, &resp.Diagnostics)
+			}
+			return
+		}
+	}
+	r.trace(fmt.Sprintf("pinned %d model(s) to controller %q", len(models), controller))
+
+	plan.InitiallyDeprecated = types.BoolValue(initiallyDeprecated)
+	plan.ID = types.StringValue(controller)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read reads whether the controller accepts new models. Which controller
+// a model is running on is not exposed by JAAS, so the pinned models
+// are kept as they are in the state.
+func (r *modelMigrationTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceModelMigrationTarget, "read")
+		return
+	}
+
+	// Read the Terraform state from the request into the model
+	var state modelMigrationTargetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	controller, err := r.client.Jaas.ReadController(ctx, state.Controller.ValueString())
+	if jujuerrors.Is(err, jujuerrors.NotFound) {
+		// The controller has been removed from JAAS.
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "model migration target") {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read controller %q, got error: %s", state.Controller.ValueString(), err))
+		return
+	}
+
+	state.AcceptNewModels = types.BoolValue(controller.Status != juju.ControllerStatusDeprecated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update migrates the models newly added to the resource and updates
+// whether the controller accepts new models.
+func (r *modelMigrationTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceModelMigrationTarget, "update")
+		return
+	}
+
+	var plan, state modelMigrationTargetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	controller := plan.Controller.ValueString()
+
+	if !plan.AcceptNewModels.Equal(state.AcceptNewModels) {
+		if err := r.client.Jaas.SetControllerDeprecated(ctx, controller, !plan.AcceptNewModels.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update controller %q, got error: %s", controller, err))
+			return
+		}
+	}
+
+	// Only the models added to the set are migrated
+	newModels := diffSet(plan.Models, state.Models, &resp.Diagnostics)
+	toMigrate, diags := modelUUIDsFromSet(ctx, newModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(toMigrate) > 0 {
+		if err := r.client.Jaas.MigrateModels(ctx, controller, toMigrate); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to migrate models to controller %q, got error: %s", controller, err))
+			// The state is not updated, restore whether the controller
+			// accepts new models as it is in the state.
+			if !plan.AcceptNewModels.Equal(state.AcceptNewModels) {
+				r.restoreDeprecation(ctx, controller, !state.AcceptNewModels.ValueBool(), &resp.Diagnostics)
+			}
+			return
+		}
+	}
+	r.trace(fmt.Sprintf("pinned %d new model(s) to controller %q", len(toMigrate), controller))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete marks the controller as deprecated, or not, as it was when the
+// resource was created. The pinned models are left on the controller.
+func (r *modelMigrationTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Check first if the client is configured
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, LogResourceModelMigrationTarget, "delete")
+		return
+	}
+
+	var state modelMigrationTargetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources created before initially_deprecated was recorded let
+	// JAAS place new models on the controller again.
+	initiallyDeprecated := state.InitiallyDeprecated.ValueBool()
+	if !state.AcceptNewModels.ValueBool() == initiallyDeprecated {
+		return
+	}
+	if err := r.client.Jaas.SetControllerDeprecated(ctx, state.Controller.ValueString(), initiallyDeprecated); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update controller %q, got error: %s", state.Controller.ValueString(), err))
+	}
+}
+
+// restoreDeprecation sets back whether the controller is deprecated after
+// the models failed to be migrated to it.
+func (r *modelMigrationTargetResource) restoreDeprecation(ctx context.Context, controller string, deprecated bool, diags *diag.Diagnostics) {
+	if err := r.client.Jaas.SetControllerDeprecated(ctx, controller, deprecated); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to restore whether controller %q accepts new models, "+
+			"got error: %s", controller, err))
+	}
+}
+
+func (r *modelMigrationTargetResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceModelMigrationTarget, msg, additionalFields...)
+}
+
+func modelUUIDsFromSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var models []string
+	if set.IsNull() || set.IsUnknown() {
+		return models, nil
+	}
+	diags := set.ElementsAs(ctx, &models, false)
+	return models, diags
+}
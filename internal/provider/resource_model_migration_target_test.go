@@ -0,0 +1,128 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
+	internaltesting "github.com/juju/terraform-provider-juju/internal/testing"
+)
+
+func TestResourceModelMigrationTargetCreateMigrationFails(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	jaasClient := mocks.NewMockJaasClient(ctlr)
+	gomock.InOrder(
+		jaasClient.EXPECT().ReadController(gomock.Any(), "controller-a").Return(&juju.JaasController{Name: "controller-a"}, nil),
+		jaasClient.EXPECT().SetControllerDeprecated(gomock.Any(), "controller-a", true).Return(nil),
+		jaasClient.EXPECT().MigrateModels(gomock.Any(), "controller-a", []string{"model-uuid"}).Return(errors.New("migration failed")),
+		// The controller accepts new models again.
+		jaasClient.EXPECT().SetControllerDeprecated(gomock.Any(), "controller-a", false).Return(nil),
+	)
+
+	r := &modelMigrationTargetResource{client: &juju.Client{Jaas: jaasClient}}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	models, diags := types.SetValueFrom(ctx, types.StringType, []string{"model-uuid"})
+	require.False(t, diags.HasError(), diags)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.False(t, plan.Set(ctx, modelMigrationTargetResourceModel{
+		Controller:      types.StringValue("controller-a"),
+		Models:          models,
+		AcceptNewModels: types.BoolValue(false),
+		ID:              types.StringUnknown(),
+	}).HasError())
+
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "migration failed")
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestResourceModelMigrationTargetRestoresDeprecation(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	jaasClient := mocks.NewMockJaasClient(ctlr)
+	r := &modelMigrationTargetResource{client: &juju.Client{Jaas: jaasClient}}
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	// The controller is already deprecated, the resource lets JAAS
+	// place new models on it.
+	jaasClient.EXPECT().ReadController(gomock.Any(), "controller-a").Return(
+		&juju.JaasController{Name: "controller-a", Status: juju.ControllerStatusDeprecated}, nil)
+	jaasClient.EXPECT().SetControllerDeprecated(gomock.Any(), "controller-a", false).Return(nil)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.False(t, plan.Set(ctx, modelMigrationTargetResourceModel{
+		Controller:          types.StringValue("controller-a"),
+		Models:              types.SetNull(types.StringType),
+		AcceptNewModels:     types.BoolValue(true),
+		InitiallyDeprecated: types.BoolUnknown(),
+		ID:                  types.StringUnknown(),
+	}).HasError())
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	var state modelMigrationTargetResourceModel
+	require.False(t, createResp.State.Get(ctx, &state).HasError())
+	assert.True(t, state.InitiallyDeprecated.ValueBool())
+
+	// The controller is deprecated again when the resource is destroyed.
+	jaasClient.EXPECT().SetControllerDeprecated(gomock.Any(), "controller-a", true).Return(nil)
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+
+	// Nothing is restored when the controller is as it was.
+	state.AcceptNewModels = types.BoolValue(false)
+	require.False(t, createResp.State.Set(ctx, state).HasError())
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+}
+
+func TestAcc_ResourceModelMigrationTargetUnknownController(t *testing.T) {
+	OnlyTestAgainstJAAS(t)
+	controllerName := acctest.RandomWithPrefix("tf-missing-controller")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceModelMigrationTarget(controllerName),
+				ExpectError: regexp.MustCompile("Unable to read controller"),
+			},
+		},
+	})
+}
+
+func testAccResourceModelMigrationTarget(controllerName string) string {
+	return internaltesting.GetStringFromTemplateWithData(
+		"testAccResourceModelMigrationTarget",
+		`
+resource "juju_model_migration_target" "test" {
+  controller        = "{{ .Controller }}"
+  accept_new_models = false
+}
+`, internaltesting.TemplateData{
+			"Controller": controllerName,
+		})
+}
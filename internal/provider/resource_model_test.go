@@ -4,16 +4,84 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/juju/juju/api/client/modelconfig"
 	"github.com/juju/juju/rpc/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+	"github.com/juju/terraform-provider-juju/internal/juju/mocks"
 )
 
+// testPrivateState is a privateState kept in memory.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestModelManagedConfig(t *testing.T) {
+	ctx := context.Background()
+	ctlr := gomock.NewController(t)
+	modelsClient := mocks.NewMockModelsClient(ctlr)
+	r := &modelResource{client: &juju.Client{Models: modelsClient}}
+
+	// The keys Juju sets when creating the model.
+	config := map[string]string{
+		"name":                        "development",
+		"uuid":                        "00000000-0000-0000-0000-000000000001",
+		"type":                        "lxd",
+		"agent-version":               "3.5.0",
+		"default-base":                "ubuntu@22.04",
+		"secret-backend":              "auto",
+		"logging-config":              "<root>=INFO",
+		"update-status-hook-interval": "5m",
+		"apt-mirror":                  "",
+	}
+	sources := map[string]string{"apt-mirror": "default"}
+	for k := range config {
+		if k != "apt-mirror" {
+			sources[k] = "model"
+		}
+	}
+	modelsClient.EXPECT().ReadModelConfig(gomock.Any(), juju.ReadModelConfigInput{Name: "development"}).DoAndReturn(
+		func(context.Context, juju.ReadModelConfigInput) (*juju.ReadModelConfigResponse, error) {
+			return &juju.ReadModelConfigResponse{Name: "development", Config: config, Sources: sources}, nil
+		}).Times(2)
+
+	// On creation, only the keys set by the user are managed.
+	private := testPrivateState{}
+	managed, diags := r.managedModelConfig(ctx, "development", private, map[string]string{"update-status-hook-interval": "5m"})
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]string{"update-status-hook-interval": "5m"}, managed)
+	assert.JSONEq(t, `["agent-version", "default-base", "logging-config", "name", "secret-backend", "type", "uuid"]`,
+		string(private[modelJujuConfigKeys]))
+
+	// A key set outside of terraform is managed, the keys set by Juju
+	// are still left out once the user config changes.
+	config["test-mode"] = "true"
+	sources["test-mode"] = "model"
+	managed, diags = r.managedModelConfig(ctx, "development", private)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]string{"update-status-hook-interval": "5m", "test-mode": "true"}, managed)
+}
+
 func TestAcc_ResourceModel(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-model")
 	logLevelInfo := "INFO"
@@ -29,6 +97,9 @@ func TestAcc_ResourceModel(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", modelName),
 					resource.TestCheckResourceAttr(resourceName, "config.logging-config", fmt.Sprintf("<root>=%s", logLevelInfo)),
+					resource.TestCheckResourceAttr(resourceName, "cli_equivalent",
+						fmt.Sprintf("juju add-model %s %s/localhost --config 'logging-config=<root>=%s'",
+							modelName, testingCloud.CloudName(), logLevelInfo)),
 				),
 			},
 			{
@@ -48,7 +119,8 @@ func TestAcc_ResourceModel(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerifyIgnore: []string{
 					"config.%",
-					"config.logging-config"},
+					"config.logging-config",
+					"cli_equivalent"},
 				ImportStateId: modelName,
 				ResourceName:  resourceName,
 			},
@@ -93,6 +165,52 @@ resource "juju_model" "this" {
 	})
 }
 
+func TestAcc_ResourceModel_AuthoritativeConfig(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	config := fmt.Sprintf(`
+resource "juju_model" "this" {
+  name        = %q
+  config_mode = "authoritative"
+
+  config = {
+    logging-config = "<root>=DEBUG"
+  }
+}`, modelName)
+
+	resourceName := "juju_model.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config_mode", "authoritative"),
+					resource.TestCheckResourceAttr(resourceName, "config.logging-config", "<root>=DEBUG"),
+				),
+			},
+			{
+				// A key set outside of Terraform is reset to its default.
+				PreConfig: func() {
+					conn, err := TestClient.Models.GetConnection(context.Background(), &modelName)
+					if err != nil {
+						t.Fatal(err)
+					}
+					defer func() { _ = conn.Close() }()
+					if err := modelconfig.NewClient(conn).ModelSet(map[string]interface{}{"development": true}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "config.development"),
+					testAccCheckDevelopmentConfigIsUnset(modelName),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ResourceModel_Minimal(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-model")
 	resource.ParallelTest(t, resource.TestCase{
@@ -112,6 +230,150 @@ resource "juju_model" "testmodel" {
 	})
 }
 
+func TestAcc_ResourceModel_AddCloudInPlace(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name = %q
+}`, modelName),
+			},
+			{
+				// Adding the cloud the model already operates in must
+				// not replace the model.
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name = %q
+
+  cloud {
+    name = %q
+  }
+}`, modelName, testingCloud.CloudName()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("juju_model.testmodel", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "cloud.0.name", testingCloud.CloudName()),
+					resource.TestCheckResourceAttrSet("juju_model.testmodel", "cloud.0.region"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceModel_InvalidRegion(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name = %q
+
+  cloud {
+    name   = %q
+    region = "not-a-region"
+  }
+}`, modelName, testingCloud.CloudName()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Region "not-a-region" does not exist on cloud`),
+			},
+		},
+	})
+}
+
+func TestAcc_ResourceModel_Description(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelDescription(modelName, "Owned by the data team"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "description", "Owned by the data team"),
+					resource.TestCheckResourceAttr("data.juju_model.testmodel", "description", "Owned by the data team"),
+				),
+			},
+			{
+				Config: testAccResourceModelDescription(modelName, "Owned by the platform team"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "description", "Owned by the platform team"),
+					resource.TestCheckResourceAttr("data.juju_model.testmodel", "description", "Owned by the platform team"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     modelName,
+				ResourceName:      "juju_model.testmodel",
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name = %q
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("juju_model.testmodel", "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceModelDescription(modelName, description string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name        = %q
+  description = %q
+}
+
+data "juju_model" "testmodel" {
+  name = juju_model.testmodel.name
+}`, modelName, description)
+}
+
+func TestAcc_ResourceModel_OnDestroyAbandon(t *testing.T) {
+	modelName := acctest.RandomWithPrefix("tf-test-model")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		CheckDestroy:             testAccCheckModelAbandoned(modelName),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "juju_model" "testmodel" {
+  name       = %q
+  on_destroy = "abandon"
+}`, modelName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_model.testmodel", "on_destroy", "abandon"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckModelAbandoned checks that the model is still there once
+// the resource is destroyed, then destroys it.
+func testAccCheckModelAbandoned(modelName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		model, err := TestClient.Models.ReadModel(context.Background(), modelName)
+		if err != nil {
+			return fmt.Errorf("expecting model %q to be abandoned, got error: %w", modelName, err)
+		}
+		return TestClient.Models.DestroyModel(context.Background(), juju.DestroyModelInput{UUID: model.ModelInfo.UUID})
+	}
+}
+
 func TestAcc_ResourceModel_UpgradeProvider(t *testing.T) {
 	modelName := acctest.RandomWithPrefix("tf-test-model")
 	logLevelDebug := "DEBUG"
@@ -144,7 +406,7 @@ func TestAcc_ResourceModel_UpgradeProvider(t *testing.T) {
 
 func testAccCheckDevelopmentConfigIsUnset(modelName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn, err := TestClient.Models.GetConnection(&modelName)
+		conn, err := TestClient.Models.GetConnection(context.Background(), &modelName)
 		if err != nil {
 			return err
 		}
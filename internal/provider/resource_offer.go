@@ -6,17 +6,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
 
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
@@ -43,6 +47,8 @@ type offerResourceModel struct {
 	ApplicationName types.String `tfsdk:"application_name"`
 	EndpointName    types.String `tfsdk:"endpoint"`
 	URL             types.String `tfsdk:"url"`
+	Description     types.String `tfsdk:"description"`
+	ConsumeUsers    types.Set    `tfsdk:"consume_users"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -86,6 +92,24 @@ func (o *offerResource) Schema(_ context.Context, req resource.SchemaRequest, re
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"description": schema.StringAttribute{
+				Description: "A human-readable description of the offer. Defaults to the charm description.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"consume_users": schema.SetAttribute{
+				Description: "The users granted consume access to the offer, e.g. `bob` or `alice@external`. " +
+					"Removing a user revokes the consume access, leaving read access. Users given consume access " +
+					"out of band are removed when this attribute is set, and ignored otherwise.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(ValidatorMatchString(names.IsValidUser, "must be a valid Juju username")),
+				},
+			},
 			"url": schema.StringAttribute{
 				Description: "The offer URL.",
 				Computed:    true,
@@ -116,7 +140,7 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	modelName := plan.ModelName.ValueString()
-	modelInfo, err := o.client.Models.GetModelByName(modelName)
+	modelInfo, err := o.client.Models.GetModelByName(ctx, modelName)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get model %q, got error: %s", modelName, err))
 		return
@@ -133,12 +157,13 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 		offerName = plan.ApplicationName.ValueString()
 	}
 
-	response, errs := o.client.Offers.CreateOffer(&juju.CreateOfferInput{
+	response, errs := o.client.Offers.CreateOffer(ctx, &juju.CreateOfferInput{
 		ModelName:       modelName,
 		ModelOwner:      modelOwner,
 		Name:            offerName,
 		ApplicationName: plan.ApplicationName.ValueString(),
 		Endpoint:        plan.EndpointName.ValueString(),
+		Description:     plan.Description.ValueString(),
 	})
 	if errs != nil {
 		// TODO 10-Aug-2023
@@ -156,8 +181,24 @@ func (o *offerResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	plan.OfferName = types.StringValue(response.Name)
 	plan.URL = types.StringValue(response.OfferURL)
+	plan.Description = types.StringValue(response.Description)
 	plan.ID = types.StringValue(response.OfferURL)
 
+	// The offer is saved even if granting access fails, so that it is
+	// tainted and replaced rather than left behind.
+	var consumeUsers []string
+	resp.Diagnostics.Append(plan.ConsumeUsers.ElementsAs(ctx, &consumeUsers, false)...)
+	if len(consumeUsers) > 0 {
+		err := o.client.Offers.UpdateOfferAccess(ctx, &juju.UpdateOfferAccessInput{
+			OfferURL: response.OfferURL,
+			Access:   consumeAccess,
+			Grant:    consumeUsers,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant consume access to offer, got error: %s", err))
+		}
+	}
+
 	// Set the plan onto the Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -175,10 +216,13 @@ func (o *offerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	response, err := o.client.Offers.ReadOffer(&juju.ReadOfferInput{
+	response, err := o.client.Offers.ReadOffer(ctx, &juju.ReadOfferInput{
 		OfferURL: state.ID.ValueString(),
 	})
 	if err != nil {
+		if keepStateDuringControllerUpgrade(o.client, err, &resp.Diagnostics, "offer") {
+			return
+		}
 		resp.Diagnostics.Append(handleOfferNotFoundError(ctx, err, &resp.State)...)
 		return
 	}
@@ -190,14 +234,84 @@ func (o *offerResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.ApplicationName = types.StringValue(response.ApplicationName)
 	state.EndpointName = types.StringValue(response.Endpoint)
 	state.URL = types.StringValue(response.OfferURL)
+	state.Description = types.StringValue(response.Description)
 	state.ID = types.StringValue(response.OfferURL)
 
+	// Consume access is only tracked when managed by the resource.
+	if !state.ConsumeUsers.IsNull() {
+		var consumeUsers []string
+		for _, user := range response.Users {
+			if user.Access == consumeAccess {
+				consumeUsers = append(consumeUsers, user.Name)
+			}
+		}
+		consumeUsersValue, dErr := types.SetValueFrom(ctx, types.StringType, consumeUsers)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ConsumeUsers = consumeUsersValue
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (o *offerResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
-	// There's no non-Computed attribute that's not RequiresReplace
-	// So no in-place update can happen on any field on this resource
+func (o *offerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if o.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "offer", "update")
+		return
+	}
+	var plan, state offerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The description and the consume users are the only attributes
+	// which can be updated in place, all others require replacement.
+	if !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description) {
+		err := o.client.Offers.UpdateOffer(ctx, &juju.UpdateOfferInput{
+			ModelName:       state.ModelName.ValueString(),
+			ApplicationName: state.ApplicationName.ValueString(),
+			Endpoint:        state.EndpointName.ValueString(),
+			Name:            state.OfferName.ValueString(),
+			Description:     plan.Description.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update offer, got error: %s", err))
+			return
+		}
+		o.trace(fmt.Sprintf("updated description of offer %q", state.URL.ValueString()))
+	}
+
+	if !plan.ConsumeUsers.Equal(state.ConsumeUsers) {
+		var planUsers, stateUsers []string
+		resp.Diagnostics.Append(plan.ConsumeUsers.ElementsAs(ctx, &planUsers, false)...)
+		resp.Diagnostics.Append(state.ConsumeUsers.ElementsAs(ctx, &stateUsers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		grant, revoke := diffStrings(planUsers, stateUsers), diffStrings(stateUsers, planUsers)
+		err := o.client.Offers.UpdateOfferAccess(ctx, &juju.UpdateOfferAccessInput{
+			OfferURL: state.URL.ValueString(),
+			Access:   consumeAccess,
+			Grant:    grant,
+			Revoke:   revoke,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update consume access to offer, got error: %s", err))
+			return
+		}
+		o.trace(fmt.Sprintf("updated consume access to offer %q", state.URL.ValueString()),
+			map[string]interface{}{"grant": grant, "revoke": revoke})
+	}
+
+	plan.URL = state.URL
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -223,7 +337,7 @@ func (o *offerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := o.client.Offers.DestroyOffer(&juju.DestroyOfferInput{
+	err := o.client.Offers.DestroyOffer(ctx, &juju.DestroyOfferInput{
 		OfferURL: plan.URL.ValueString(),
 	})
 	if err != nil {
@@ -250,7 +364,7 @@ func (o *offerResource) Configure(ctx context.Context, req resource.ConfigureReq
 
 	o.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	o.subCtx = tflog.NewSubsystem(ctx, LogResourceOffer)
+	o.subCtx = client.NewLogSubsystem(ctx, LogResourceOffer)
 }
 
 func (o *offerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -268,6 +382,20 @@ func (o *offerResource) trace(msg string, additionalFields ...map[string]interfa
 	tflog.SubsystemTrace(o.subCtx, LogResourceOffer, msg, additionalFields...)
 }
 
+// consumeAccess is the access to an offer needed to relate to it.
+const consumeAccess = "consume"
+
+// diffStrings returns the values of a which are not in b.
+func diffStrings(a, b []string) []string {
+	var diff []string
+	for _, value := range a {
+		if !slices.Contains(b, value) {
+			diff = append(diff, value)
+		}
+	}
+	return diff
+}
+
 func isOfferNotFound(err error) bool {
 	return strings.Contains(err.Error(), "expected to find one result for url")
 }
@@ -4,11 +4,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
 func TestAcc_ResourceOffer(t *testing.T) {
@@ -31,6 +35,13 @@ func TestAcc_ResourceOffer(t *testing.T) {
 					resource.TestCheckResourceAttr("juju_offer.this", "id", fmt.Sprintf("%v/%v.%v", expectedResourceOwner(), modelName, "this")),
 				),
 			},
+			{
+				Config: testAccResourceOfferDescription(modelName, "Shared postgresql database"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "description", "Shared postgresql database"),
+					resource.TestCheckResourceAttr("juju_offer.this", "url", fmt.Sprintf("%v/%v.%v", expectedResourceOwner(), modelName, "this")),
+				),
+			},
 			{
 				Config: testAccResourceOfferXIntegration(modelName2, destModelName),
 				Check: resource.ComposeTestCheckFunc(
@@ -54,6 +65,99 @@ func TestAcc_ResourceOffer(t *testing.T) {
 	})
 }
 
+func TestAcc_ResourceOffer_ConsumeUsers(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-offer")
+	userOne := acctest.RandomWithPrefix("tfuser")
+	userTwo := acctest.RandomWithPrefix("tfuser")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceOfferConsumeUsers(modelName, userOne, userTwo, "[juju_user.one.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "consume_users.#", "1"),
+					resource.TestCheckTypeSetElemAttr("juju_offer.this", "consume_users.*", userOne),
+					testAccCheckOfferAccess("juju_offer.this", userOne, "consume"),
+				),
+			},
+			{
+				// Removing a user revokes consume access, leaving read access.
+				Config: testAccResourceOfferConsumeUsers(modelName, userOne, userTwo, "[juju_user.two.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("juju_offer.this", "consume_users.#", "1"),
+					resource.TestCheckTypeSetElemAttr("juju_offer.this", "consume_users.*", userTwo),
+					testAccCheckOfferAccess("juju_offer.this", userOne, "read"),
+					testAccCheckOfferAccess("juju_offer.this", userTwo, "consume"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceOfferConsumeUsers(modelName, userOne, userTwo, consumeUsers string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "this" {
+	model = juju_model.this.name
+	name  = "this"
+
+	charm {
+		name = "juju-qa-dummy-source"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_user" "one" {
+	name     = %q
+	password = "password"
+}
+
+resource "juju_user" "two" {
+	name     = %q
+	password = "password"
+}
+
+resource "juju_offer" "this" {
+	model            = juju_model.this.name
+	application_name = juju_application.this.name
+	endpoint         = "sink"
+	consume_users    = %s
+}
+`, modelName, userOne, userTwo, consumeUsers)
+}
+
+// testAccCheckOfferAccess checks the user has the access to the offer of
+// the resource.
+func testAccCheckOfferAccess(resourceName, user, access string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		offer, err := TestClient.Offers.ReadOffer(context.Background(), &juju.ReadOfferInput{OfferURL: rs.Primary.ID})
+		if err != nil {
+			return err
+		}
+		for _, offerUser := range offer.Users {
+			if offerUser.Name == user {
+				if offerUser.Access != access {
+					return fmt.Errorf("expected %s access to offer for %q, got %s", access, user, offerUser.Access)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("user %q has no access to offer %q", user, rs.Primary.ID)
+	}
+}
+
 func testAccResourceOfferXIntegration(srcModelName string, destModelName string) string {
 	return fmt.Sprintf(`
 resource "juju_model" "modelone" {
@@ -162,3 +266,29 @@ resource "juju_offer" "this" {
 }
 `, modelName, os)
 }
+
+func testAccResourceOfferDescription(modelName, description string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+	name = %q
+}
+
+resource "juju_application" "this" {
+	model = juju_model.this.name
+	name  = "this"
+
+	charm {
+		name = "postgresql"
+		channel = "latest/stable"
+		base = "ubuntu@22.04"
+	}
+}
+
+resource "juju_offer" "this" {
+	model            = juju_model.this.name
+	application_name = juju_application.this.name
+	endpoint         = "db"
+	description      = %q
+}
+`, modelName, description)
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &readOnlyGuard{}
+var _ resource.ResourceWithConfigure = &readOnlyGuard{}
+var _ resource.ResourceWithConfigValidators = &readOnlyGuard{}
+var _ resource.ResourceWithImportState = &readOnlyGuard{}
+var _ resource.ResourceWithModifyPlan = &readOnlyGuard{}
+var _ resource.ResourceWithValidateConfig = &readOnlyGuard{}
+
+// readOnlyGuard wraps a resource to make its Create, Update and Delete
+// fail when the provider is in read only mode. The optional interfaces
+// of the resource are forwarded to it.
+type readOnlyGuard struct {
+	resource.Resource
+
+	client *juju.Client
+}
+
+func newReadOnlyGuard(r resource.Resource) resource.Resource {
+	return &readOnlyGuard{Resource: r}
+}
+
+// Configure keeps the client, to know whether the provider is in read
+// only mode, and configures the resource.
+func (g *readOnlyGuard) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(*juju.Client); ok {
+		g.client = client
+	}
+	if r, ok := g.Resource.(resource.ResourceWithConfigure); ok {
+		r.Configure(ctx, req, resp)
+	}
+}
+
+func (g *readOnlyGuard) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if g.readOnly(ctx, "create", &resp.Diagnostics) {
+		return
+	}
+	g.Resource.Create(ctx, req, resp)
+}
+
+func (g *readOnlyGuard) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if g.readOnly(ctx, "update", &resp.Diagnostics) {
+		return
+	}
+	g.Resource.Update(ctx, req, resp)
+}
+
+func (g *readOnlyGuard) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if g.readOnly(ctx, "delete", &resp.Diagnostics) {
+		return
+	}
+	g.Resource.Delete(ctx, req, resp)
+}
+
+func (g *readOnlyGuard) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if r, ok := g.Resource.(resource.ResourceWithConfigValidators); ok {
+		return r.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (g *readOnlyGuard) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if r, ok := g.Resource.(resource.ResourceWithImportState); ok {
+		r.ImportState(ctx, req, resp)
+		return
+	}
+	// Same as the framework for resources which cannot be imported.
+	resp.Diagnostics.AddError(
+		"Resource Import Not Implemented",
+		"This resource does not support import. Please contact the provider developer for additional information.",
+	)
+}
+
+func (g *readOnlyGuard) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r, ok := g.Resource.(resource.ResourceWithModifyPlan); ok {
+		r.ModifyPlan(ctx, req, resp)
+	}
+}
+
+func (g *readOnlyGuard) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if r, ok := g.Resource.(resource.ResourceWithValidateConfig); ok {
+		r.ValidateConfig(ctx, req, resp)
+	}
+}
+
+// readOnly adds an error to the diagnostics and returns true if the
+// provider is in read only mode.
+func (g *readOnlyGuard) readOnly(ctx context.Context, operation string, diags *diag.Diagnostics) bool {
+	if g.client == nil || !g.client.ReadOnly() {
+		return false
+	}
+	var metadata resource.MetadataResponse
+	g.Resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "juju"}, &metadata)
+	diags.AddError("Read Only Mode",
+		fmt.Sprintf("Unable to %s %s resource, the provider is configured with %s = true.", operation, metadata.TypeName, JujuReadOnly))
+	return true
+}
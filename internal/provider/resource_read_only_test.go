@@ -0,0 +1,78 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// recordingResource records the operations it is asked to perform.
+type recordingResource struct {
+	operations []string
+}
+
+func (r *recordingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recording"
+}
+
+func (r *recordingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{}
+}
+
+func (r *recordingResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+	r.operations = append(r.operations, "create")
+}
+
+func (r *recordingResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+	r.operations = append(r.operations, "read")
+}
+
+func (r *recordingResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+	r.operations = append(r.operations, "update")
+}
+
+func (r *recordingResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+	r.operations = append(r.operations, "delete")
+}
+
+func TestReadOnlyGuard(t *testing.T) {
+	ctx := context.Background()
+	for _, readOnly := range []bool{false, true} {
+		client, err := juju.NewClient(ctx, juju.ControllerConfiguration{ReadOnly: readOnly})
+		require.NoError(t, err)
+
+		inner := &recordingResource{}
+		guard := newReadOnlyGuard(inner)
+		guard.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+
+		var createResp resource.CreateResponse
+		guard.Create(ctx, resource.CreateRequest{}, &createResp)
+		guard.Read(ctx, resource.ReadRequest{}, &resource.ReadResponse{})
+		var updateResp resource.UpdateResponse
+		guard.Update(ctx, resource.UpdateRequest{}, &updateResp)
+		var deleteResp resource.DeleteResponse
+		guard.Delete(ctx, resource.DeleteRequest{}, &deleteResp)
+
+		if !readOnly {
+			assert.Equal(t, []string{"create", "read", "update", "delete"}, inner.operations)
+			assert.False(t, createResp.Diagnostics.HasError())
+			continue
+		}
+		// Only reads are let through in read only mode.
+		assert.Equal(t, []string{"read"}, inner.operations)
+		require.True(t, createResp.Diagnostics.HasError())
+		assert.Equal(t, "Unable to create juju_recording resource, the provider is configured with read_only = true.",
+			createResp.Diagnostics.Errors()[0].Detail())
+		assert.True(t, updateResp.Diagnostics.HasError())
+		assert.True(t, deleteResp.Diagnostics.HasError())
+	}
+}
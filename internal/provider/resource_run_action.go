@@ -0,0 +1,297 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &runActionResource{}
+var _ resource.ResourceWithConfigure = &runActionResource{}
+
+func NewRunActionResource() resource.Resource {
+	return &runActionResource{}
+}
+
+type runActionResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type runActionResourceModel struct {
+	ModelName       types.String `tfsdk:"model"`
+	ApplicationName types.String `tfsdk:"application"`
+	Units           types.Set    `tfsdk:"units"`
+	Action          types.String `tfsdk:"action"`
+	Params          types.Map    `tfsdk:"params"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	Timeout         types.String `tfsdk:"timeout"`
+	Results         types.List   `tfsdk:"results"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// actionResultModel is the result of the action on a unit.
+type actionResultModel struct {
+	Unit   types.String `tfsdk:"unit"`
+	Status types.String `tfsdk:"status"`
+	Output types.Map    `tfsdk:"output"`
+}
+
+func (r *runActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceRunAction)
+}
+
+func (r *runActionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_action"
+}
+
+func (r *runActionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that runs a charm action once, when it is created, and waits for it to complete, " +
+			"e.g. for one-time initialization actions like `create-backup` or `add-admin-user`. The apply fails " +
+			"if the action fails on a unit. Changing any argument but timeout runs the action again, use triggers " +
+			"to run it again when other values change. Destroying the resource does not undo the action.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model of the application.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application": schema.StringAttribute{
+				Description: "The name of the application whose charm defines the action.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"units": schema.SetAttribute{
+				Description: "The names of the units of the application to run the action on, e.g. `mysql/0`. " +
+					"The action runs on the leader unit of the application if not set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(ValidatorMatchString(names.IsValidUnit, "must be a unit name, e.g. \"mysql/0\"")),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "The name of the action.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"params": schema.MapAttribute{
+				Description: "The parameters of the action. Values are parsed as YAML scalars, like `juju run` " +
+					"does, e.g. \"3\" is passed as a number and \"true\" as a boolean.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values which run the action again when they change, e.g. the revision " +
+					"of the charm of the application.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the action to complete on every unit, e.g. \"30m\". Defaults to 10 minutes.",
+				Optional:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(isDuration, "must be a duration, e.g. \"30m\""),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The results of the action on each unit.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"unit": schema.StringAttribute{
+							Description: "The name of the unit.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the action, `completed`.",
+							Computed:    true,
+						},
+						"output": schema.MapAttribute{
+							Description: "The output of the action. The keys of nested values are joined with " +
+								"dots, e.g. `result.password`. Sensitive, as actions often return credentials.",
+							ElementType: types.StringType,
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *runActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "run action", "create")
+		return
+	}
+
+	var plan runActionResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var units []string
+	resp.Diagnostics.Append(plan.Units.ElementsAs(ctx, &units, false)...)
+	actionParams := make(map[string]string)
+	resp.Diagnostics.Append(plan.Params.ElementsAs(ctx, &actionParams, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var timeout time.Duration
+	if plan.Timeout.ValueString() != "" {
+		var err error
+		timeout, err = time.ParseDuration(plan.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Attribute Value", err.Error())
+			return
+		}
+	}
+
+	modelName := plan.ModelName.ValueString()
+	appName := plan.ApplicationName.ValueString()
+	actionName := plan.Action.ValueString()
+	r.trace(fmt.Sprintf("running action %q of application %q", actionName, appName), map[string]interface{}{"units": units})
+	response, err := r.client.Applications.RunAction(ctx, &juju.RunActionInput{
+		ModelName:  modelName,
+		AppName:    appName,
+		Units:      units,
+		ActionName: actionName,
+		Params:     actionParams,
+		Timeout:    timeout,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run action %q of application %q, got error: %s", actionName, appName, err))
+		return
+	}
+
+	results := make([]actionResultModel, len(response.Results))
+	for i, result := range response.Results {
+		output, dErr := types.MapValueFrom(ctx, types.StringType, result.Output)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		results[i] = actionResultModel{
+			Unit:   types.StringValue(result.Unit),
+			Status: types.StringValue(result.Status),
+			Output: output,
+		}
+	}
+	resultType := req.Plan.Schema.GetAttributes()["results"].(schema.ListNestedAttribute).NestedObject.Type()
+	resultsValue, dErr := types.ListValueFrom(ctx, resultType, results)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Results = resultsValue
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s:%s", modelName, appName, actionName, response.OperationID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as it is, the action ran once and its results
+// do not change.
+func (r *runActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state runActionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only saves the timeout, any other change runs the action again
+// by replacing the resource.
+func (r *runActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan runActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state, an action cannot be
+// undone.
+func (r *runActionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *runActionResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	//SubsystemTrace(subCtx, "my-subsystem", "hello, world", map[string]interface{}{"foo": 123})
+	// Output:
+	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
+	tflog.SubsystemTrace(r.subCtx, LogResourceRunAction, msg, additionalFields...)
+}
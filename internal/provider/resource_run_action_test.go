@@ -0,0 +1,76 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAcc_ResourceRunAction(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-run-action")
+	resourceName := "juju_run_action.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRunAction(modelName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.unit", "test-app/0"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.status", "completed"),
+					resource.TestCheckResourceAttrSet(resourceName, "results.0.output.fortune"),
+				),
+			},
+			{
+				// Changing the triggers runs the action again.
+				Config: testAccResourceRunAction(modelName, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "results.0.status", "completed"),
+			},
+		},
+	})
+}
+
+func testAccResourceRunAction(modelName, trigger string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_application" "this" {
+  name  = "test-app"
+  model = juju_model.this.name
+  charm {
+    name = "juju-qa-test"
+  }
+}
+
+resource "juju_run_action" "this" {
+  model       = juju_model.this.name
+  application = juju_application.this.name
+  units       = ["test-app/0"]
+  action      = "fortune"
+  params = {
+    length = "short"
+  }
+  triggers = {
+    run = %q
+  }
+}
+`, modelName, trigger)
+}
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -60,18 +60,18 @@ func (s *secretResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	// model:name
-	parts := strings.Split(req.ID, ":")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	importID, err := ids.ParseSecretID(req.ID)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: <modelname>:<secretname>. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: <modelname>:<secretname>. %s", err),
 		)
 		return
 	}
-	modelName := parts[0]
-	secretName := parts[1]
+	modelName := importID.Model
+	secretName := importID.Secret
 
-	readSecretOutput, err := s.client.Secrets.ReadSecret(&juju.ReadSecretInput{
+	readSecretOutput, err := s.client.Secrets.ReadSecret(ctx, &juju.ReadSecretInput{
 		ModelName: modelName,
 		Name:      &secretName,
 	})
@@ -110,7 +110,9 @@ func (s *secretResource) Metadata(_ context.Context, req resource.MetadataReques
 
 func (s *secretResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A resource that represents a Juju secret.",
+		Description: "A resource that represents a Juju secret. A rotation policy or an expiry time cannot be " +
+			"set: Juju only applies them to the secrets of charms, and ignores them on the user secrets this " +
+			"resource manages.",
 		Attributes: map[string]schema.Attribute{
 			"model": schema.StringAttribute{
 				Description: "The model in which the secret belongs.",
@@ -168,7 +170,7 @@ func (s *secretResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 	s.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	s.subCtx = tflog.NewSubsystem(ctx, LogResourceSecret)
+	s.subCtx = client.NewLogSubsystem(ctx, LogResourceSecret)
 }
 
 // Create creates a new secret in the Juju model.
@@ -192,7 +194,7 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 	secretValue := make(map[string]string)
 	resp.Diagnostics.Append(plan.Value.ElementsAs(ctx, &secretValue, false)...)
 
-	createSecretOutput, err := s.client.Secrets.CreateSecret(&juju.CreateSecretInput{
+	createSecretOutput, err := s.client.Secrets.CreateSecret(ctx, &juju.CreateSecretInput{
 		ModelName: plan.Model.ValueString(),
 		Name:      plan.Name.ValueString(),
 		Value:     secretValue,
@@ -204,7 +206,7 @@ func (s *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	plan.SecretId = types.StringValue(createSecretOutput.SecretId)
-	plan.ID = types.StringValue(newSecretID(plan.Model.ValueString(), plan.SecretId.ValueString()))
+	plan.ID = types.StringValue(ids.SecretID{Model: plan.Model.ValueString(), Secret: plan.SecretId.ValueString()}.String())
 	s.trace(fmt.Sprintf("saving secret resource %q", plan.SecretId.ValueString()),
 		map[string]interface{}{
 			"secretID": plan.SecretId.ValueString(),
@@ -238,11 +240,14 @@ func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	s.trace(fmt.Sprintf("reading secret resource %q", state.SecretId))
 
-	readSecretOutput, err := s.client.Secrets.ReadSecret(&juju.ReadSecretInput{
+	readSecretOutput, err := s.client.Secrets.ReadSecret(ctx, &juju.ReadSecretInput{
 		SecretId:  state.SecretId.ValueString(),
 		ModelName: state.Model.ValueString(),
 	})
 	if err != nil {
+		if keepStateDuringControllerUpgrade(s.client, err, &resp.Diagnostics, "secret") {
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
 		return
 	}
@@ -254,7 +259,7 @@ func (s *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if !state.Info.IsNull() {
 		state.Info = types.StringValue(readSecretOutput.Info)
 	}
-	state.ID = types.StringValue(newSecretID(state.Model.ValueString(), readSecretOutput.SecretId))
+	state.ID = types.StringValue(ids.SecretID{Model: state.Model.ValueString(), Secret: readSecretOutput.SecretId}.String())
 
 	secretValue, errDiag := types.MapValueFrom(ctx, types.StringType, readSecretOutput.Value)
 	resp.Diagnostics.Append(errDiag...)
@@ -287,8 +292,6 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	s.trace(fmt.Sprintf("updating secret resource %q", state.SecretId))
-	s.trace(fmt.Sprintf("Update - current state: %v", state))
-	s.trace(fmt.Sprintf("Update - proposed plan: %v", plan))
 
 	var err error
 	noChange := true
@@ -329,7 +332,7 @@ func (s *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	err = s.client.Secrets.UpdateSecret(&updatedSecretInput)
+	err = s.client.Secrets.UpdateSecret(ctx, &updatedSecretInput)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
 		return
@@ -359,7 +362,7 @@ func (s *secretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	s.trace(fmt.Sprintf("deleting secret resource %q", state.SecretId))
 
-	err := s.client.Secrets.DeleteSecret(&juju.DeleteSecretInput{
+	err := s.client.Secrets.DeleteSecret(ctx, &juju.DeleteSecretInput{
 		ModelName: state.Model.ValueString(),
 		SecretId:  state.SecretId.ValueString(),
 	})
@@ -377,7 +380,3 @@ func (s *secretResource) trace(msg string, additionalFields ...map[string]interf
 	}
 	tflog.SubsystemTrace(s.subCtx, LogResourceSecret, msg, additionalFields...)
 }
-
-func newSecretID(model, secret string) string {
-	return fmt.Sprintf("%s:%s", model, secret)
-}
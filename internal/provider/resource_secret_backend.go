@@ -0,0 +1,372 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &secretBackendResource{}
+var _ resource.ResourceWithConfigure = &secretBackendResource{}
+var _ resource.ResourceWithConfigValidators = &secretBackendResource{}
+var _ resource.ResourceWithImportState = &secretBackendResource{}
+
+// NewSecretBackendResource returns a new instance of the secret backend
+// resource.
+func NewSecretBackendResource() resource.Resource {
+	return &secretBackendResource{}
+}
+
+type secretBackendResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type secretBackendResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	BackendType         types.String `tfsdk:"backend_type"`
+	Config              types.Map    `tfsdk:"config"`
+	TokenRotateInterval types.String `tfsdk:"token_rotate_interval"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Secret backends can be imported with their name.
+func (r *secretBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *secretBackendResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceSecretBackend)
+}
+
+func (r *secretBackendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_backend"
+}
+
+// ConfigValidators sets validators for the resource.
+func (r *secretBackendResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		NewAvoidJAASValidator(r.client, ""),
+	}
+}
+
+func (r *secretBackendResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents an external secret backend, e.g. Vault, registered on the " +
+			"controller. Models use the backend once their `secret-backend` config is set to its name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the secret backend. Changing this value will cause the secret backend to be destroyed and recreated by terraform.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.NoneOf("auto", "internal"),
+				},
+			},
+			"backend_type": schema.StringAttribute{
+				Description: "The type of the secret backend, `vault` or `kubernetes`. Changing this value will cause the secret backend to be destroyed and recreated by terraform.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("vault", "kubernetes"),
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "The config of the secret backend, which depends on its type, e.g. `endpoint` and " +
+					"`token` for vault. Lists, such as `ca-certs`, are given in YAML or JSON, e.g. " +
+					"`[\"a\", \"b\"]`. Keys removed from the map are reset on the backend.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"token_rotate_interval": schema.StringAttribute{
+				Description: "How often juju rotates the token it uses to access the backend, e.g. `48h`. " +
+					"The token is never rotated if not set.",
+				Optional: true,
+				Validators: []validator.String{
+					ValidatorMatchString(isDuration, "must be a duration, e.g. \"48h\""),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *secretBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret backend", "create")
+		return
+	}
+
+	var plan secretBackendResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := make(map[string]string)
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &config, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	interval, _ := time.ParseDuration(plan.TokenRotateInterval.ValueString())
+
+	name := plan.Name.ValueString()
+	if err := r.client.SecretBackends.CreateSecretBackend(ctx, &juju.CreateSecretBackendInput{
+		Name:                name,
+		BackendType:         plan.BackendType.ValueString(),
+		TokenRotateInterval: interval,
+		Config:              config,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret backend, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("created secret backend %q", name))
+
+	plan.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *secretBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret backend", "read")
+		return
+	}
+
+	var state secretBackendResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.SecretBackends.ReadSecretBackend(ctx, state.ID.ValueString())
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "secret backend") {
+			return
+		}
+		resp.Diagnostics.Append(handleSecretBackendNotFoundError(ctx, err, &resp.State)...)
+		return
+	}
+	r.trace(fmt.Sprintf("read secret backend %q", state.ID.ValueString()))
+
+	state.Name = types.StringValue(response.Name)
+	state.BackendType = types.StringValue(response.BackendType)
+	state.TokenRotateInterval = tokenRotateIntervalValue(state.TokenRotateInterval, response.TokenRotateInterval)
+
+	stateConfig := make(map[string]string)
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config := managedSecretBackendConfig(stateConfig, response.Config, state.Config.IsNull())
+	// Keep a null map when the backend has no config and none was set,
+	// so that an unset attribute does not show a diff.
+	if len(config) > 0 || !state.Config.IsNull() {
+		configValue, dErr := types.MapValueFrom(ctx, types.StringType, config)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Config = configValue
+	}
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *secretBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret backend", "update")
+		return
+	}
+
+	var plan, state secretBackendResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read Terraform configuration from the request into the plan model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planConfig := make(map[string]string)
+	resp.Diagnostics.Append(plan.Config.ElementsAs(ctx, &planConfig, false)...)
+	stateConfig := make(map[string]string)
+	resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &stateConfig, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &juju.UpdateSecretBackendInput{
+		Name:   state.Name.ValueString(),
+		Config: make(map[string]string),
+	}
+	for k, v := range planConfig {
+		if current, ok := stateConfig[k]; !ok || current != v {
+			input.Config[k] = v
+		}
+	}
+	for k := range stateConfig {
+		if _, ok := planConfig[k]; !ok {
+			input.Reset = append(input.Reset, k)
+		}
+	}
+	sort.Strings(input.Reset)
+	if !plan.TokenRotateInterval.Equal(state.TokenRotateInterval) {
+		interval, _ := time.ParseDuration(plan.TokenRotateInterval.ValueString())
+		input.TokenRotateInterval = &interval
+	}
+
+	if len(input.Config) > 0 || len(input.Reset) > 0 || input.TokenRotateInterval != nil {
+		if err := r.client.SecretBackends.UpdateSecretBackend(ctx, input); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret backend %q, got error: %s", input.Name, err))
+			return
+		}
+		r.trace(fmt.Sprintf("updated secret backend %q", state.ID.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *secretBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "secret backend", "delete")
+		return
+	}
+
+	var state secretBackendResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.SecretBackends.DestroySecretBackend(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret backend, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("deleted secret backend %q", state.ID.ValueString()))
+}
+
+// managedSecretBackendConfig returns the current value of the keys of the
+// state config, or of every key on import. The state value is kept when
+// it has the same meaning as the current value.
+func managedSecretBackendConfig(state, current map[string]string, all bool) map[string]string {
+	config := make(map[string]string, len(state))
+	if all {
+		for k, v := range current {
+			config[k] = v
+		}
+		return config
+	}
+	for k, v := range state {
+		value, ok := current[k]
+		if !ok {
+			continue
+		}
+		if juju.SecretBackendConfigValueEqual(v, value) {
+			value = v
+		}
+		config[k] = value
+	}
+	return config
+}
+
+// tokenRotateIntervalValue returns the token rotate interval read from
+// the backend, keeping the state value when it is the same duration,
+// e.g. `48h` read back as `48h0m0s`.
+func tokenRotateIntervalValue(state types.String, interval time.Duration) types.String {
+	if stateInterval, err := time.ParseDuration(state.ValueString()); err == nil && stateInterval == interval {
+		return state
+	}
+	if interval == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(interval.String())
+}
+
+func handleSecretBackendNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
+	if errors.As(err, &juju.SecretBackendNotFoundError) {
+		// Secret backend manually removed
+		st.RemoveResource(ctx)
+		return diag.Diagnostics{}
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", err.Error())
+	return diags
+}
+
+func (r *secretBackendResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceSecretBackend, msg, additionalFields...)
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManagedSecretBackendConfig(t *testing.T) {
+	current := map[string]string{
+		"endpoint": "https://vault.example.com:8200",
+		"token":    "s.rotated",
+		"ca-certs": `["a","b"]`,
+	}
+
+	config := managedSecretBackendConfig(map[string]string{
+		"token":     "s.initial",
+		"ca-certs":  "[a, b]",
+		"namespace": "juju",
+	}, current, false)
+	assert.Equal(t, map[string]string{
+		"token":    "s.rotated",
+		"ca-certs": "[a, b]",
+	}, config)
+
+	// Imported, all the keys are managed.
+	config = managedSecretBackendConfig(nil, current, true)
+	assert.Equal(t, current, config)
+}
+
+func TestTokenRotateIntervalValue(t *testing.T) {
+	assert.Equal(t, types.StringValue("48h"), tokenRotateIntervalValue(types.StringValue("48h"), 48*time.Hour))
+	assert.Equal(t, types.StringValue("24h0m0s"), tokenRotateIntervalValue(types.StringValue("48h"), 24*time.Hour))
+	assert.Equal(t, types.StringNull(), tokenRotateIntervalValue(types.StringValue("48h"), 0))
+	assert.Equal(t, types.StringNull(), tokenRotateIntervalValue(types.StringNull(), 0))
+}
+
+func TestAcc_ResourceSecretBackend_Validation(t *testing.T) {
+	SkipJAAS(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSecretBackend("internal", "48h"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be none of`),
+			},
+			{
+				Config:      testAccResourceSecretBackend("vault-backend", "two days"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be a duration`),
+			},
+		},
+	})
+}
+
+func testAccResourceSecretBackend(name, tokenRotateInterval string) string {
+	return fmt.Sprintf(`
+resource "juju_secret_backend" "this" {
+  name                  = %q
+  backend_type          = "vault"
+  token_rotate_interval = %q
+
+  config = {
+    endpoint = "https://vault.example.com:8200"
+    token    = "s.token"
+  }
+}
+`, name, tokenRotateInterval)
+}
@@ -0,0 +1,331 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/collections/set"
+	"github.com/juju/names/v5"
+
+	"github.com/juju/terraform-provider-juju/internal/ids"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &spaceResource{}
+var _ resource.ResourceWithConfigure = &spaceResource{}
+var _ resource.ResourceWithImportState = &spaceResource{}
+var _ resource.ResourceWithModifyPlan = &spaceResource{}
+
+func NewSpaceResource() resource.Resource {
+	return &spaceResource{}
+}
+
+type spaceResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type spaceResourceModel struct {
+	ModelName types.String `tfsdk:"model"`
+	Name      types.String `tfsdk:"name"`
+	Subnets   types.Set    `tfsdk:"subnets"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Spaces can be imported with the name of the model and the name of the
+// space: <model>:<space>
+func (r *spaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughValidID(ctx, ids.ParseSpaceID, req, resp)
+}
+
+func (r *spaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceSpace)
+}
+
+func (r *spaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space"
+}
+
+func (r *spaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a Juju network space.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model where the space is created. Changing this value will cause the space to be destroyed and recreated by terraform.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the space. Changing this value renames the space.",
+				Required:    true,
+				Validators: []validator.String{
+					ValidatorMatchString(names.IsValidSpace, "must be a valid space name"),
+				},
+			},
+			"subnets": schema.SetAttribute{
+				Description: "The CIDRs of the subnets in the space. The subnets must already be known to the model. " +
+					"Subnets removed from this set are moved back to the alpha space.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(ValidatorMatchString(func(s string) bool {
+						_, _, err := net.ParseCIDR(s)
+						return err == nil
+					}, "must be a valid CIDR")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan marks the ID as unknown when the space is renamed, as the
+// name of the space is part of it.
+func (r *spaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planName, stateName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &planName)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !planName.Equal(stateName) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
+func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "create")
+		return
+	}
+
+	var plan spaceResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cidrs []string
+	resp.Diagnostics.Append(plan.Subnets.ElementsAs(ctx, &cidrs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := plan.ModelName.ValueString()
+	spaceName := plan.Name.ValueString()
+	if err := r.client.Spaces.CreateSpace(ctx, &juju.CreateSpaceInput{
+		ModelName: modelName,
+		Name:      spaceName,
+		CIDRs:     cidrs,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("created space %q", spaceName))
+
+	plan.ID = types.StringValue(ids.SpaceID{Model: modelName, Space: spaceName}.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "read")
+		return
+	}
+
+	var state spaceResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID, err := ids.ParseSpaceID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+
+	response, err := r.client.Spaces.ReadSpace(ctx, &juju.ReadSpaceInput{
+		ModelName: spaceID.Model,
+		Name:      spaceID.Space,
+	})
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "space") {
+			return
+		}
+		resp.Diagnostics.Append(handleSpaceNotFoundError(ctx, err, &resp.State)...)
+		return
+	}
+	r.trace(fmt.Sprintf("read space %q", state.ID.ValueString()))
+
+	subnets, dErr := types.SetValueFrom(ctx, types.StringType, response.CIDRs)
+	resp.Diagnostics.Append(dErr...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ModelName = types.StringValue(spaceID.Model)
+	state.Name = types.StringValue(response.Name)
+	state.Subnets = subnets
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "update")
+		return
+	}
+
+	var plan, state spaceResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read Terraform configuration from the request into the plan model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planCIDRs, stateCIDRs []string
+	resp.Diagnostics.Append(plan.Subnets.ElementsAs(ctx, &planCIDRs, false)...)
+	resp.Diagnostics.Append(state.Subnets.ElementsAs(ctx, &stateCIDRs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planSubnets, stateSubnets := set.NewStrings(planCIDRs...), set.NewStrings(stateCIDRs...)
+
+	modelName := state.ModelName.ValueString()
+	spaceName := plan.Name.ValueString()
+	if err := r.client.Spaces.UpdateSpace(ctx, &juju.UpdateSpaceInput{
+		ModelName:   modelName,
+		Name:        state.Name.ValueString(),
+		NewName:     spaceName,
+		AddCIDRs:    planSubnets.Difference(stateSubnets).SortedValues(),
+		RemoveCIDRs: stateSubnets.Difference(planSubnets).SortedValues(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update space %q, got error: %s", state.Name.ValueString(), err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated space %q", spaceName))
+
+	plan.ID = types.StringValue(ids.SpaceID{Model: modelName, Space: spaceName}.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "space", "delete")
+		return
+	}
+
+	var state spaceResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID, err := ids.ParseSpaceID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+
+	if err := r.client.Spaces.DestroySpace(ctx, &juju.DestroySpaceInput{
+		ModelName: spaceID.Model,
+		Name:      spaceID.Space,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete space, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("deleted space %q", state.ID.ValueString()))
+}
+
+func handleSpaceNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
+	if errors.As(err, &juju.SpaceNotFoundError) {
+		// Space manually removed
+		st.RemoveResource(ctx)
+		return diag.Diagnostics{}
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", err.Error())
+	return diags
+}
+
+func (r *spaceResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceSpace, msg, additionalFields...)
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceSpace(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	// The subnet must be known to the model, e.g. the subnet of the LXD bridge.
+	cidr := os.Getenv(TestSpaceCIDREnvKey)
+	if cidr == "" {
+		t.Skipf("environment variable %v not set", TestSpaceCIDREnvKey)
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-space")
+	resourceName := "juju_space.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSpace(modelName, "space", "not-a-cidr"),
+				ExpectError: regexp.MustCompile("must be a valid CIDR"),
+			},
+			{
+				Config: testAccResourceSpace(modelName, "space", cidr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "space"),
+					resource.TestCheckResourceAttr(resourceName, "id", modelName+":space"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subnets.*", cidr),
+				),
+			},
+			{
+				Config: testAccResourceSpace(modelName, "renamed-space", cidr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "renamed-space"),
+					resource.TestCheckResourceAttr(resourceName, "id", modelName+":renamed-space"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     modelName + ":renamed-space",
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceSpace(modelName, spaceName, cidr string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_space" "this" {
+  model   = juju_model.this.name
+  name    = %q
+  subnets = [%q]
+}
+`, modelName, spaceName, cidr)
+}
@@ -6,10 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 	"github.com/juju/terraform-provider-juju/internal/utils"
 )
@@ -44,8 +42,12 @@ type sshKeyResourceModel struct {
 	ID types.String `tfsdk:"id"`
 }
 
+// Keys can be imported with the name of the model and the identifier of the key
+// sshkey:<modelName>:<ssh-key-identifier>
+// the key identifier is currently based on the comment section of the ssh key
+// (e.g. user@hostname) (TODO: issue #267)
 func (s *sshKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughValidID(ctx, ids.ParseSSHKeyID, req, resp)
 }
 
 func (s *sshKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -64,7 +66,7 @@ func (s *sshKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 	s.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	s.subCtx = tflog.NewSubsystem(ctx, LogResourceSSHKey)
+	s.subCtx = client.NewLogSubsystem(ctx, LogResourceSSHKey)
 }
 
 func (s *sshKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -121,7 +123,7 @@ func (s *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	modelName := plan.ModelName.ValueString()
 
-	if err := s.client.SSHKeys.CreateSSHKey(&juju.CreateSSHKeyInput{
+	if err := s.client.SSHKeys.CreateSSHKey(ctx, &juju.CreateSSHKeyInput{
 		ModelName: modelName,
 		Payload:   payload,
 	}); err != nil {
@@ -130,28 +132,10 @@ func (s *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	s.trace(fmt.Sprintf("created ssh_key for: %q", keyIdentifier))
 
-	plan.ID = types.StringValue(newSSHKeyID(modelName, keyIdentifier))
+	plan.ID = types.StringValue(ids.SSHKeyID{Model: modelName, KeyIdentifier: keyIdentifier}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func newSSHKeyID(modelName string, keyIdentifier string) string {
-	return fmt.Sprintf("sshkey:%s:%s", modelName, keyIdentifier)
-}
-
-// Keys can be imported with the name of the model and the identifier of the key
-// ssh_key:<modelName>:<ssh-key-identifier>
-// the key identifier is currently based on the comment section of the ssh key
-// (e.g. user@hostname) (TODO: issue #267)
-func retrieveModelKeyNameFromID(id string, d *diag.Diagnostics) (string, string) {
-	tokens := strings.Split(id, ":")
-	//If importing with an incorrect ID we need to catch and provide a user-friendly error
-	if len(tokens) != 3 {
-		d.AddError("Malformed ID", fmt.Sprintf("unable to parse model name and user from provided ID: %q", id))
-		return "", ""
-	}
-	return tokens[1], tokens[2]
-}
-
 func (s *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Prevent panic if the provider has not been configured.
 	if s.client == nil {
@@ -167,16 +151,21 @@ func (s *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	modelName, keyIdentifier := retrieveModelKeyNameFromID(plan.ID.ValueString(), &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
+	keyID, err := ids.ParseSSHKeyID(plan.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	modelName, keyIdentifier := keyID.Model, keyID.KeyIdentifier
 
-	result, err := s.client.SSHKeys.ReadSSHKey(&juju.ReadSSHKeyInput{
+	result, err := s.client.SSHKeys.ReadSSHKey(ctx, &juju.ReadSSHKeyInput{
 		ModelName:     modelName,
 		KeyIdentifier: keyIdentifier,
 	})
 	if err != nil {
+		if keepStateDuringControllerUpgrade(s.client, err, &resp.Diagnostics, "ssh key") {
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ssh key, got error: %s", err))
 		return
 	}
@@ -214,13 +203,15 @@ func (s *sshKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	modelName, keyIdentifier := retrieveModelKeyNameFromID(plan.ID.ValueString(), &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
+	keyID, err := ids.ParseSSHKeyID(plan.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	modelName, keyIdentifier := keyID.Model, keyID.KeyIdentifier
 
 	// Delete the key
-	if err := s.client.SSHKeys.DeleteSSHKey(&juju.DeleteSSHKeyInput{
+	if err := s.client.SSHKeys.DeleteSSHKey(ctx, &juju.DeleteSSHKeyInput{
 		ModelName:     modelName,
 		KeyIdentifier: keyIdentifier,
 	}); err != nil {
@@ -230,7 +221,7 @@ func (s *sshKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	s.trace(fmt.Sprintf("ssh key deleted : %q", state.ID.ValueString()))
 
 	// Create a new key
-	if err := s.client.SSHKeys.CreateSSHKey(&juju.CreateSSHKeyInput{
+	if err := s.client.SSHKeys.CreateSSHKey(ctx, &juju.CreateSSHKeyInput{
 		ModelName: plan.ModelName.ValueString(),
 		Payload:   plan.Payload.ValueString(),
 	}); err != nil {
@@ -267,13 +258,15 @@ func (s *sshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	modelName, keyIdentifier := retrieveModelKeyNameFromID(plan.ID.ValueString(), &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
+	keyID, err := ids.ParseSSHKeyID(plan.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
+	modelName, keyIdentifier := keyID.Model, keyID.KeyIdentifier
 
 	// Delete the key
-	if err := s.client.SSHKeys.DeleteSSHKey(&juju.DeleteSSHKeyInput{
+	if err := s.client.SSHKeys.DeleteSSHKey(ctx, &juju.DeleteSSHKeyInput{
 		ModelName:     modelName,
 		KeyIdentifier: keyIdentifier,
 	}); err != nil {
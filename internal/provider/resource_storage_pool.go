@@ -0,0 +1,303 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/juju/terraform-provider-juju/internal/ids"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &storagePoolResource{}
+var _ resource.ResourceWithConfigure = &storagePoolResource{}
+var _ resource.ResourceWithImportState = &storagePoolResource{}
+
+func NewStoragePoolResource() resource.Resource {
+	return &storagePoolResource{}
+}
+
+type storagePoolResource struct {
+	client *juju.Client
+
+	// subCtx is the context created with the new tflog subsystem for applications.
+	subCtx context.Context
+}
+
+type storagePoolResourceModel struct {
+	ModelName  types.String `tfsdk:"model"`
+	Name       types.String `tfsdk:"name"`
+	Provider   types.String `tfsdk:"storage_provider"`
+	Attributes types.Map    `tfsdk:"attributes"`
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+// Storage pools can be imported with the name of the model and the name
+// of the storage pool: <model>:<storage pool>
+func (r *storagePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughValidID(ctx, ids.ParseStoragePoolID, req, resp)
+}
+
+func (r *storagePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	// Create the local logging subsystem here, using the TF context when creating it.
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceStoragePool)
+}
+
+func (r *storagePoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_pool"
+}
+
+func (r *storagePoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A resource that represents a Juju storage pool.",
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				Description: "The name of the model where the storage pool is created. Changing this value will cause the storage pool to be destroyed and recreated by terraform.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the storage pool. Changing this value will cause the storage pool to be destroyed and recreated by terraform.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_provider": schema.StringAttribute{
+				Description: "The type of storage provider backing the pool, e.g. `ebs`, `cinder` or `kubernetes`.",
+				Required:    true,
+			},
+			"attributes": schema.MapAttribute{
+				Description: "The configuration attributes of the storage pool, which depend on the storage provider. " +
+					"Attributes changed outside of terraform are reported as drift and reset on the next apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *storagePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "storage pool", "create")
+		return
+	}
+
+	var plan storagePoolResourceModel
+
+	// Read Terraform configuration from the request into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes := make(map[string]string)
+	resp.Diagnostics.Append(plan.Attributes.ElementsAs(ctx, &attributes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modelName := plan.ModelName.ValueString()
+	poolName := plan.Name.ValueString()
+	if err := r.client.StoragePools.CreateStoragePool(ctx, &juju.CreateStoragePoolInput{
+		ModelName:  modelName,
+		Name:       poolName,
+		Provider:   plan.Provider.ValueString(),
+		Attributes: attributes,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create storage pool, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("created storage pool %q", poolName))
+
+	plan.ID = types.StringValue(ids.StoragePoolID{Model: modelName, Pool: poolName}.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storagePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "storage pool", "read")
+		return
+	}
+
+	var state storagePoolResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolID, err := ids.ParseStoragePoolID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+
+	response, err := r.client.StoragePools.ReadStoragePool(ctx, &juju.ReadStoragePoolInput{
+		ModelName: poolID.Model,
+		Name:      poolID.Pool,
+	})
+	if err != nil {
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "storage pool") {
+			return
+		}
+		resp.Diagnostics.Append(handleStoragePoolNotFoundError(ctx, err, &resp.State)...)
+		return
+	}
+	r.trace(fmt.Sprintf("read storage pool %q", state.ID.ValueString()))
+
+	state.ModelName = types.StringValue(poolID.Model)
+	state.Name = types.StringValue(response.Name)
+	state.Provider = types.StringValue(response.Provider)
+	// Keep a null map when the pool has no attributes and none were set,
+	// so that an unset attribute does not show a diff.
+	if len(response.Attributes) > 0 || !state.Attributes.IsNull() {
+		attributes, dErr := types.MapValueFrom(ctx, types.StringType, response.Attributes)
+		resp.Diagnostics.Append(dErr...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Attributes = attributes
+	}
+
+	// Set the state onto the Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storagePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "storage pool", "update")
+		return
+	}
+
+	var plan, state storagePoolResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read Terraform configuration from the request into the plan model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes := make(map[string]string)
+	resp.Diagnostics.Append(plan.Attributes.ElementsAs(ctx, &attributes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The attributes are replaced as a whole, so attributes removed from
+	// the plan are removed from the storage pool.
+	if err := r.client.StoragePools.UpdateStoragePool(ctx, &juju.UpdateStoragePoolInput{
+		ModelName:  state.ModelName.ValueString(),
+		Name:       state.Name.ValueString(),
+		Provider:   plan.Provider.ValueString(),
+		Attributes: attributes,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update storage pool %q, got error: %s", state.Name.ValueString(), err))
+		return
+	}
+	r.trace(fmt.Sprintf("updated storage pool %q", state.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *storagePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Prevent panic if the provider has not been configured.
+	if r.client == nil {
+		addClientNotConfiguredError(&resp.Diagnostics, "storage pool", "delete")
+		return
+	}
+
+	var state storagePoolResourceModel
+
+	// Get the Terraform state from the request into the state model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolID, err := ids.ParseStoragePoolID(state.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
+		return
+	}
+
+	if err := r.client.StoragePools.DestroyStoragePool(ctx, &juju.DestroyStoragePoolInput{
+		ModelName: poolID.Model,
+		Name:      poolID.Pool,
+	}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete storage pool, got error: %s", err))
+		return
+	}
+	r.trace(fmt.Sprintf("deleted storage pool %q", state.ID.ValueString()))
+}
+
+func handleStoragePoolNotFoundError(ctx context.Context, err error, st *tfsdk.State) diag.Diagnostics {
+	if errors.As(err, &juju.StoragePoolNotFoundError) {
+		// Storage pool manually removed
+		st.RemoveResource(ctx)
+		return diag.Diagnostics{}
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", err.Error())
+	return diags
+}
+
+func (r *storagePoolResource) trace(msg string, additionalFields ...map[string]interface{}) {
+	if r.subCtx == nil {
+		return
+	}
+
+	tflog.SubsystemTrace(r.subCtx, LogResourceStoragePool, msg, additionalFields...)
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ResourceStoragePool(t *testing.T) {
+	if testingCloud != LXDCloudTesting {
+		t.Skip(t.Name() + " only runs with LXD")
+	}
+	modelName := acctest.RandomWithPrefix("tf-test-storage-pool")
+	resourceName := "juju_storage_pool.this"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStoragePool(modelName, `{ tag = "one" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", modelName+":pool"),
+					resource.TestCheckResourceAttr(resourceName, "storage_provider", "tmpfs"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.tag", "one"),
+				),
+			},
+			{
+				Config: testAccResourceStoragePool(modelName, `{ tag = "two", other = "value" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.tag", "two"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.other", "value"),
+				),
+			},
+			{
+				ImportStateVerify: true,
+				ImportState:       true,
+				ImportStateId:     modelName + ":pool",
+				ResourceName:      resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceStoragePool(modelName, attributes string) string {
+	return fmt.Sprintf(`
+resource "juju_model" "this" {
+  name = %q
+}
+
+resource "juju_storage_pool" "this" {
+  model            = juju_model.this.name
+  name             = "pool"
+  storage_provider = "tmpfs"
+  attributes       = %s
+}
+`, modelName, attributes)
+}
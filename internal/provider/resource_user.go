@@ -6,17 +6,18 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	jujuerrors "github.com/juju/errors"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/juju/terraform-provider-juju/internal/ids"
 	"github.com/juju/terraform-provider-juju/internal/juju"
 )
 
@@ -44,6 +45,7 @@ type userResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	DisplayName types.String `tfsdk:"display_name"`
 	Password    types.String `tfsdk:"password"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
 }
@@ -84,9 +86,17 @@ func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "The password to be assigned to the user",
-				Required:    true,
-				Sensitive:   true,
+				Description: "The password to be assigned to the user. It cannot be read back from " +
+					"the controller, so it is set again after an import.",
+				Required:  true,
+				Sensitive: true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Whether the user is disabled, like `juju disable-user`. A disabled user " +
+					"cannot log in. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -118,7 +128,7 @@ func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 	r.client = client
 	// Create the local logging subsystem here, using the TF context when creating it.
-	r.subCtx = tflog.NewSubsystem(ctx, LogResourceUser)
+	r.subCtx = client.NewLogSubsystem(ctx, LogResourceUser)
 }
 
 // Create is called when the provider must create a new resource. Config
@@ -143,7 +153,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	_, err := r.client.Users.CreateUser(juju.CreateUserInput{
+	_, err := r.client.Users.CreateUser(ctx, juju.CreateUserInput{
 		Name:        data.Name.ValueString(),
 		DisplayName: data.DisplayName.ValueString(),
 		Password:    data.Password.ValueString(),
@@ -153,9 +163,24 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 	r.trace(fmt.Sprintf("created user resource %q", data.Name))
+	data.ID = types.StringValue(ids.UserID{Name: data.Name.ValueString()}.String())
+
+	if data.Disabled.ValueBool() {
+		disabled := true
+		if err := r.client.Users.UpdateUser(ctx, juju.UpdateUserInput{
+			Name:     data.Name.ValueString(),
+			Disabled: &disabled,
+		}); err != nil {
+			// The user exists, save it so that the resource is tainted
+			// rather than left behind.
+			data.Disabled = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable user %q, got error: %s", data.Name.ValueString(), err))
+			return
+		}
+	}
 
 	// Save data into Terraform state
-	data.ID = types.StringValue(newIDFromUserName(data.Name.ValueString()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -184,17 +209,22 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
-	userName, diagErr := userNameFromID(data.ID.ValueString())
-	resp.Diagnostics.Append(diagErr...)
-	if resp.Diagnostics.HasError() {
+	userID, err := ids.ParseUserID(data.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
-	response, err := r.client.Users.ReadUser(userName)
+	userName := userID.Name
+	response, err := r.client.Users.ReadUser(ctx, userName)
 	if err != nil {
-		// TODO (hmlanigan) 2023-06-14
-		// Add a user NotFound error type to the client.
-		// On read, if NotFound, remove the resource:
-		// resp.State.RemoveResource()
+		if keepStateDuringControllerUpgrade(r.client, err, &resp.Diagnostics, "user") {
+			return
+		}
+		if jujuerrors.Is(err, jujuerrors.NotFound) {
+			// The user was removed outside of Terraform.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user resource, got error: %s", err))
 		return
 	}
@@ -204,7 +234,8 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	plan := userResourceModel{
 		Name:     types.StringValue(response.UserInfo.Username),
 		Password: data.Password,
-		ID:       types.StringValue(newIDFromUserName(response.UserInfo.Username)),
+		Disabled: types.BoolValue(response.UserInfo.Disabled),
+		ID:       types.StringValue(ids.UserID{Name: response.UserInfo.Username}.String()),
 	}
 	// Display name is optional, therefore if it doesn't exist in the plan,
 	// do not add an empty string as they are not the same thing.
@@ -240,26 +271,35 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	if data.Name.Equal(state.Name) || data.DisplayName.Equal(state.DisplayName) {
+	if !data.DisplayName.Equal(state.DisplayName) {
 		// This does violates terraform's declarative model. There is a
-		// todo to make both values ForceNew in the future.
-		resp.Diagnostics.AddWarning("Not Supported", "Unable to update name %q or display name %q")
+		// todo to make display name ForceNew in the future.
+		resp.Diagnostics.AddWarning("Not Supported", fmt.Sprintf("Unable to update the display name of user %q", data.Name.ValueString()))
 	}
-	if data.Password.Equal(state.Password) {
-		r.info(fmt.Sprintf("Password not different, no updates for user %q made", data.Name.ValueString()))
-		return
+
+	// Update user can only change the user's password and whether it is
+	// disabled. It is not currently possible to change the display name
+	// via terraform after the user is created. Nor is it possible to
+	// change an existing username.
+	input := juju.UpdateUserInput{
+		Name: data.Name.ValueString(),
 	}
-	// Update user can only change the user's password. It is not currently
-	// possible to change the display name via terraform after the user is
-	// created. Nor is it possible to change an existing username.
-	if err := r.client.Users.UpdateUser(juju.UpdateUserInput{
-		Name:     data.Name.ValueString(),
-		Password: data.Password.ValueString(),
-	}); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user resource, got error: %s", err))
-		return
+	if !data.Password.Equal(state.Password) {
+		input.Password = data.Password.ValueString()
+	}
+	if !data.Disabled.Equal(state.Disabled) {
+		disabled := data.Disabled.ValueBool()
+		input.Disabled = &disabled
+	}
+	if input.Password == "" && input.Disabled == nil {
+		r.info(fmt.Sprintf("Password and disabled not different, no updates for user %q made", data.Name.ValueString()))
+	} else {
+		if err := r.client.Users.UpdateUser(ctx, input); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user resource, got error: %s", err))
+			return
+		}
+		r.trace(fmt.Sprintf("updated user resource %q", data.Name))
 	}
-	r.trace(fmt.Sprintf("updated user resource %q", data.Name))
 
 	// Save updated data into Terraform state, save a new copy for
 	// update functionality.
@@ -267,7 +307,8 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Name:        types.StringValue(data.Name.ValueString()),
 		DisplayName: data.DisplayName,
 		Password:    types.StringValue(data.Password.ValueString()),
-		ID:          types.StringValue(newIDFromUserName(data.Name.ValueString())),
+		Disabled:    data.Disabled,
+		ID:          types.StringValue(ids.UserID{Name: data.Name.ValueString()}.String()),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -296,12 +337,13 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	userName, diagErr := userNameFromID(data.ID.ValueString())
-	resp.Diagnostics.Append(diagErr...)
-	if resp.Diagnostics.HasError() {
+	userID, err := ids.ParseUserID(data.ID.ValueString())
+	if err != nil {
+		addMalformedIDError(&resp.Diagnostics, err)
 		return
 	}
-	err := r.client.Users.DestroyUser(juju.DestroyUserInput{
+	userName := userID.Name
+	err = r.client.Users.DestroyUser(ctx, juju.DestroyUserInput{
 		Name: userName,
 	})
 	if err != nil {
@@ -312,7 +354,7 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStatePassthroughValidID(ctx, ids.ParseUserID, req, resp)
 }
 
 func (r *userResource) info(msg string, additionalFields ...map[string]interface{}) {
@@ -336,18 +378,3 @@ func (r *userResource) trace(msg string, additionalFields ...map[string]interfac
 	// {"@level":"trace","@message":"hello, world","@module":"provider.my-subsystem","foo":123}
 	tflog.SubsystemTrace(r.subCtx, LogResourceUser, msg, additionalFields...)
 }
-
-// ID is 'user:<username>'
-func newIDFromUserName(value string) string {
-	return fmt.Sprintf("user:%s", value)
-}
-
-func userNameFromID(value string) (string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	values := strings.Split(value, ":")
-	if len(values) != 2 || values[0] != "user" {
-		diags.AddError("Malformed ID", fmt.Sprintf("User ID %q is malformed, please use the format 'user:<username>'", value))
-		return "", diags
-	}
-	return values[1], diags
-}
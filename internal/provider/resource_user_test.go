@@ -49,6 +49,48 @@ resource "juju_user" "user" {
 }`, userName, userPassword)
 }
 
+func TestAcc_ResourceUser_Disabled(t *testing.T) {
+	SkipJAAS(t)
+	userName := acctest.RandomWithPrefix("tfuser")
+	userPassword := acctest.RandomWithPrefix("tf-test-user")
+
+	resourceName := "juju_user.user"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: frameworkProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserDisabled(userName, userPassword, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceUserDisabled(userName, userPassword+"-changed", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+			{
+				ImportStateVerify:       true,
+				ImportState:             true,
+				ImportStateVerifyIgnore: []string{"password"},
+				ImportStateId:           fmt.Sprintf("user:%s", userName),
+				ResourceName:            resourceName,
+			},
+		},
+	})
+}
+
+func testAccResourceUserDisabled(userName, userPassword string, disabled bool) string {
+	return fmt.Sprintf(`
+resource "juju_user" "user" {
+  name     = %q
+  password = %q
+  disabled = %t
+}`, userName, userPassword, disabled)
+}
+
 func TestAcc_ResourceUser_UpgradeProvider(t *testing.T) {
 	SkipJAAS(t)
 	userName := acctest.RandomWithPrefix("tfuser")
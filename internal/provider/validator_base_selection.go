@@ -0,0 +1,68 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+var _ validator.String = baseSelectionValidator{}
+
+// baseSelectionValidator checks the base selection policy of an
+// application against the base and series set next to it: the
+// explicit policy requires one of them, the others conflict with both.
+type baseSelectionValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v baseSelectionValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v baseSelectionValidator) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("%q requires base or series to be set, other values conflict with them", juju.BaseSelectionExplicit)
+}
+
+// ValidateString runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v baseSelectionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	osSet := false
+	for _, key := range []string{BaseKey, SeriesKey} {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(key), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// An unknown value will be known at apply time, assume it is set.
+		if !value.IsNull() {
+			osSet = true
+		}
+	}
+
+	selection := req.ConfigValue.ValueString()
+	switch {
+	case selection == juju.BaseSelectionExplicit && !osSet:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Missing Base",
+			fmt.Sprintf("Base selection %q requires base or series to be set.", selection),
+		)
+	case selection != juju.BaseSelectionExplicit && osSet:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Conflicting Base",
+			fmt.Sprintf("Base selection %q cannot be used together with base or series.", selection),
+		)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBaseSelectionValidator(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			BaseKey:          schema.StringAttribute{Optional: true},
+			SeriesKey:        schema.StringAttribute{Optional: true},
+			BaseSelectionKey: schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		BaseKey:          tftypes.String,
+		SeriesKey:        tftypes.String,
+		BaseSelectionKey: tftypes.String,
+	}}
+
+	tests := []struct {
+		selection string
+		base      interface{}
+		wantError bool
+	}{
+		{selection: "explicit", base: "ubuntu@22.04"},
+		{selection: "explicit", base: nil, wantError: true},
+		{selection: "latest-lts", base: nil},
+		{selection: "latest-lts", base: "ubuntu@22.04", wantError: true},
+		{selection: "charm-default", base: tftypes.UnknownValue, wantError: true},
+	}
+	for _, test := range tests {
+		config := tfsdk.Config{
+			Schema: testSchema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				BaseKey:          tftypes.NewValue(tftypes.String, test.base),
+				SeriesKey:        tftypes.NewValue(tftypes.String, nil),
+				BaseSelectionKey: tftypes.NewValue(tftypes.String, test.selection),
+			}),
+		}
+		req := validator.StringRequest{
+			Path:        path.Root(BaseSelectionKey),
+			ConfigValue: types.StringValue(test.selection),
+			Config:      config,
+		}
+		var resp validator.StringResponse
+		baseSelectionValidator{}.ValidateString(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() != test.wantError {
+			t.Errorf("selection %q with base %v: expected error %v, got %v", test.selection, test.base, test.wantError, resp.Diagnostics.Errors())
+		}
+	}
+}
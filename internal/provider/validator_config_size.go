@@ -0,0 +1,108 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// maxApplicationConfigSize is the maximum size of the application
+	// config accepted by the controller. Application settings are stored
+	// in a single database document, which is limited to 16 MiB; some
+	// of that space is left for the document's own bookkeeping.
+	maxApplicationConfigSize = 15 * 1024 * 1024
+
+	// largeApplicationConfigValueSize is the size above which a single
+	// config value is reported as a warning: such values are sent with
+	// every config change and usually belong in a charm resource.
+	largeApplicationConfigValueSize = 1024 * 1024
+)
+
+// ApplicationConfigSizeValidator checks the size of the application config
+// against the limits of the controller, so that oversized values, e.g. the
+// contents of files, are reported at plan time rather than by the
+// controller when the config is set.
+type ApplicationConfigSizeValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v ApplicationConfigSizeValidator) Description(context.Context) string {
+	return fmt.Sprintf("the total size of the config must not exceed %d bytes", maxApplicationConfigSize)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v ApplicationConfigSizeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateMap Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v ApplicationConfigSizeValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	var config map[string]types.String
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &config, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	type entry struct {
+		key  string
+		size int
+	}
+	var (
+		entries []entry
+		total   int
+	)
+	for key, value := range config {
+		// Values which are not yet known are checked once they are.
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		size := len(key) + len(value.ValueString())
+		total += size
+		entries = append(entries, entry{key: key, size: size})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].size != entries[j].size {
+			return entries[i].size > entries[j].size
+		}
+		return entries[i].key < entries[j].key
+	})
+
+	for _, e := range entries {
+		if e.size <= largeApplicationConfigValueSize {
+			break
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path.AtMapKey(e.key),
+			"Large Config Value",
+			fmt.Sprintf("The value of %q is %d bytes. Large values are sent to the controller with every "+
+				"config change; consider providing the content as a charm resource instead.", e.key, e.size),
+		)
+	}
+
+	if total <= maxApplicationConfigSize {
+		return
+	}
+	largest := make([]string, 0, 3)
+	for i := 0; i < len(entries) && i < 3; i++ {
+		largest = append(largest, fmt.Sprintf("%q (%d bytes)", entries[i].key, entries[i].size))
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Application Config Too Large",
+		fmt.Sprintf("The application config is %d bytes, which exceeds the %d bytes the controller can store. "+
+			"The largest values are %s. Provide large content, such as certificates or other files, as a "+
+			"charm resource instead.", total, maxApplicationConfigSize, strings.Join(largest, ", ")),
+	)
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/juju/terraform-provider-juju/internal/provider"
+)
+
+func validateConfigSize(t *testing.T, config map[string]string) validator.MapResponse {
+	ctx := context.Background()
+	configValue, diags := types.MapValueFrom(ctx, types.StringType, config)
+	if diags.HasError() {
+		t.Fatalf("errors %v", diags.Errors())
+	}
+	req := validator.MapRequest{
+		Path:        path.Root("config"),
+		ConfigValue: configValue,
+	}
+	var resp validator.MapResponse
+	provider.ApplicationConfigSizeValidator{}.ValidateMap(ctx, req, &resp)
+	return resp
+}
+
+func TestApplicationConfigSizeValidatorValid(t *testing.T) {
+	resp := validateConfigSize(t, map[string]string{
+		"log-level": "debug",
+		"tls-cert":  strings.Repeat("a", 4096),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Errorf("errors %v", resp.Diagnostics.Errors())
+	}
+	if resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("warnings %v", resp.Diagnostics.Warnings())
+	}
+}
+
+func TestApplicationConfigSizeValidatorLargeValue(t *testing.T) {
+	resp := validateConfigSize(t, map[string]string{
+		"log-level": "debug",
+		"blob":      strings.Repeat("a", 2*1024*1024),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Errorf("errors %v", resp.Diagnostics.Errors())
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning, got %v", resp.Diagnostics.Warnings())
+	}
+	if !strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), `"blob"`) {
+		t.Errorf("unexpected warning %v", resp.Diagnostics.Warnings()[0].Detail())
+	}
+}
+
+func TestApplicationConfigSizeValidatorTooLarge(t *testing.T) {
+	resp := validateConfigSize(t, map[string]string{
+		"first":  strings.Repeat("a", 8*1024*1024),
+		"second": strings.Repeat("b", 8*1024*1024),
+	})
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %v", resp.Diagnostics.Errors())
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Application Config Too Large" {
+		t.Errorf("unexpected error %q", summary)
+	}
+}
+
+func TestApplicationConfigSizeValidatorUnknown(t *testing.T) {
+	ctx := context.Background()
+	req := validator.MapRequest{
+		Path:        path.Root("config"),
+		ConfigValue: types.MapUnknown(types.StringType),
+	}
+	var resp validator.MapResponse
+	provider.ApplicationConfigSizeValidator{}.ValidateMap(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("errors %v", resp.Diagnostics.Errors())
+	}
+}
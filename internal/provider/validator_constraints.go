@@ -0,0 +1,130 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/juju/juju/core/constraints"
+)
+
+// constraintKeys are the constraints known to juju, including aliases.
+var constraintKeys = []string{
+	constraints.Arch,
+	constraints.Container,
+	constraints.Cores,
+	"cpu-cores",
+	constraints.CpuPower,
+	constraints.Mem,
+	constraints.RootDisk,
+	constraints.RootDiskSource,
+	constraints.Tags,
+	constraints.InstanceRole,
+	constraints.InstanceType,
+	constraints.Spaces,
+	constraints.VirtType,
+	constraints.Zones,
+	constraints.AllocatePublicIP,
+	constraints.ImageID,
+}
+
+// constraintSuggestions maps common mistakes which are too far from a
+// known constraint to be caught by their spelling.
+var constraintSuggestions = map[string]string{
+	"memory":   constraints.Mem,
+	"ram":      constraints.Mem,
+	"cpu":      constraints.Cores,
+	"cpus":     constraints.Cores,
+	"disk":     constraints.RootDisk,
+	"zone":     constraints.Zones,
+	"space":    constraints.Spaces,
+	"tag":      constraints.Tags,
+	"image":    constraints.ImageID,
+	"instance": constraints.InstanceType,
+}
+
+var unknownConstraintRegexp = regexp.MustCompile(`unknown constraint "([^"]*)"`)
+
+// StringIsConstraintsValidator checks at plan time that a string is a
+// valid set of juju constraints. Provider specific requirements, e.g.
+// GPUs, are given with the instance-type or tags constraints, whose
+// values are passed to the cloud as they are.
+type StringIsConstraintsValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v StringIsConstraintsValidator) Description(context.Context) string {
+	return "string must be space separated juju constraints, e.g. \"mem=8G cores=2\""
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v StringIsConstraintsValidator) MarkdownDescription(context.Context) string {
+	return "string must be space separated juju constraints, e.g. `mem=8G cores=2`"
+}
+
+// ValidateString runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v StringIsConstraintsValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	_, err := constraints.Parse(req.ConfigValue.ValueString())
+	if err == nil {
+		return
+	}
+	detail := err.Error()
+	if match := unknownConstraintRegexp.FindStringSubmatch(detail); match != nil {
+		if suggestion := suggestConstraint(match[1]); suggestion != "" {
+			detail += fmt.Sprintf(", did you mean %q?", suggestion)
+		} else {
+			detail += "."
+		}
+		detail += " Provider specific requirements, such as GPUs, are requested with the instance-type " +
+			"or tags constraints, e.g. \"instance-type=p3.2xlarge\" or \"tags=gpu\"."
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Constraints",
+		detail,
+	)
+}
+
+// suggestConstraint returns the known constraint closest to the given
+// unknown one, or an empty string if none is close enough.
+func suggestConstraint(name string) string {
+	if suggestion, ok := constraintSuggestions[name]; ok {
+		return suggestion
+	}
+	best, bestDistance := "", 3
+	for _, key := range constraintKeys {
+		if distance := editDistance(name, key); distance < bestDistance {
+			best, bestDistance = key, distance
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/provider"
+)
+
+func TestConstraintsValidatorValid(t *testing.T) {
+	validConstraints := []types.String{
+		types.StringValue("mem=8G cores=2"),
+		types.StringValue("cpu-cores=2 arch=arm64"),
+		types.StringValue("instance-type=p3.2xlarge tags=gpu,nvme"),
+		types.StringValue(""),
+		types.StringNull(),
+		types.StringUnknown(),
+	}
+
+	constraintsValidator := provider.StringIsConstraintsValidator{}
+	for _, constraints := range validConstraints {
+		req := validator.StringRequest{
+			ConfigValue: constraints,
+		}
+		var resp validator.StringResponse
+		constraintsValidator.ValidateString(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Errorf("errors %v", resp.Diagnostics.Errors())
+		}
+	}
+}
+
+func TestConstraintsValidatorInvalid(t *testing.T) {
+	invalidConstraints := []struct {
+		str types.String
+		err string
+	}{{
+		str: types.StringValue("memory=8G"),
+		err: `unknown constraint "memory", did you mean "mem"?`,
+	}, {
+		str: types.StringValue("mem=8G root-disc=20G"),
+		err: `unknown constraint "root-disc", did you mean "root-disk"?`,
+	}, {
+		str: types.StringValue("gpu=1"),
+		err: `unknown constraint "gpu". Provider specific requirements`,
+	}, {
+		str: types.StringValue("mem=8G,cores=2"),
+		err: `bad "mem" constraint`,
+	}}
+
+	constraintsValidator := provider.StringIsConstraintsValidator{}
+	for _, test := range invalidConstraints {
+		req := validator.StringRequest{
+			ConfigValue: test.str,
+		}
+		var resp validator.StringResponse
+		constraintsValidator.ValidateString(context.Background(), req, &resp)
+
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("expected an error for %q", test.str.ValueString())
+			continue
+		}
+		if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, test.err) {
+			t.Errorf("expected error %q, got %q", test.err, detail)
+		}
+	}
+}
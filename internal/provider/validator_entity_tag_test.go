@@ -0,0 +1,71 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/provider"
+)
+
+func TestEntityTagValidatorValid(t *testing.T) {
+	validTags := []types.String{
+		types.StringValue("application-postgresql"),
+		types.StringValue("unit-postgresql-0"),
+		types.StringValue("machine-0-lxd-1"),
+		types.StringValue("model-0a8a7a3b-0b8c-4a84-8f4d-2f5c4b0e2d10"),
+		types.StringNull(),
+		types.StringUnknown(),
+	}
+
+	tagValidator := provider.StringIsEntityTagValidator{}
+	for _, tag := range validTags {
+		req := validator.StringRequest{
+			ConfigValue: tag,
+		}
+		var resp validator.StringResponse
+		tagValidator.ValidateString(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Errorf("errors %v", resp.Diagnostics.Errors())
+		}
+	}
+}
+
+func TestEntityTagValidatorInvalid(t *testing.T) {
+	invalidTags := []struct {
+		str types.String
+		err string
+	}{{
+		str: types.StringValue("postgresql"),
+		err: `"postgresql" is not a valid tag`,
+	}, {
+		str: types.StringValue("model-development"),
+		err: `"model-development" is not a valid model tag`,
+	}, {
+		str: types.StringValue("unit-postgresql"),
+		err: `"unit-postgresql" is not a valid unit tag`,
+	}}
+
+	tagValidator := provider.StringIsEntityTagValidator{}
+	for _, test := range invalidTags {
+		req := validator.StringRequest{
+			ConfigValue: test.str,
+		}
+		var resp validator.StringResponse
+		tagValidator.ValidateString(context.Background(), req, &resp)
+
+		if c := resp.Diagnostics.ErrorsCount(); c != 1 {
+			t.Errorf("expected one error, got %d", c)
+			continue
+		}
+		if deets := resp.Diagnostics.Errors()[0].Detail(); deets != test.err {
+			t.Errorf("expected error %q, got %q", test.err, deets)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = grantToEveryoneValidator{}
+
+// grantToEveryoneValidator prevents the everyone@external identity from
+// being managed twice, through grant_to_everyone and the users set.
+type grantToEveryoneValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v grantToEveryoneValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v grantToEveryoneValidator) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("Ensures users does not contain %q when grant_to_everyone is true", everyoneUser)
+}
+
+// ValidateResource performs the validation on the resource.
+func (v grantToEveryoneValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var grantToEveryone types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("grant_to_everyone"), &grantToEveryone)...)
+	if resp.Diagnostics.HasError() || !grantToEveryone.ValueBool() {
+		return
+	}
+
+	var users types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("users"), &users)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if users.IsUnknown() {
+		return
+	}
+	for _, user := range users.Elements() {
+		if user.Equal(types.StringValue(everyoneUser)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("users"),
+				"Conflicting Users",
+				fmt.Sprintf("%q cannot be set in users when grant_to_everyone is true.", everyoneUser),
+			)
+			return
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGrantToEveryoneValidator(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"users":             schema.SetAttribute{Optional: true, ElementType: types.StringType},
+			"grant_to_everyone": schema.BoolAttribute{Optional: true},
+		},
+	}
+	usersType := tftypes.Set{ElementType: tftypes.String}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"users":             usersType,
+		"grant_to_everyone": tftypes.Bool,
+	}}
+	users := func(names ...string) tftypes.Value {
+		values := make([]tftypes.Value, len(names))
+		for i, name := range names {
+			values[i] = tftypes.NewValue(tftypes.String, name)
+		}
+		return tftypes.NewValue(usersType, values)
+	}
+
+	tests := []struct {
+		users     tftypes.Value
+		grant     interface{}
+		wantError bool
+	}{
+		{users: users("foo@domain.com", everyoneUser), grant: true, wantError: true},
+		{users: users("foo@domain.com"), grant: true},
+		{users: users(everyoneUser), grant: false},
+		{users: users(everyoneUser), grant: nil},
+		{users: tftypes.NewValue(usersType, tftypes.UnknownValue), grant: true},
+		// Elements unknown at plan time are checked at apply time.
+		{users: tftypes.NewValue(usersType, []tftypes.Value{tftypes.NewValue(tftypes.String, tftypes.UnknownValue)}), grant: true},
+		{users: tftypes.NewValue(usersType, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			tftypes.NewValue(tftypes.String, everyoneUser),
+		}), grant: true, wantError: true},
+	}
+	for _, test := range tests {
+		req := resource.ValidateConfigRequest{
+			Config: tfsdk.Config{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"users":             test.users,
+					"grant_to_everyone": tftypes.NewValue(tftypes.Bool, test.grant),
+				}),
+			},
+		}
+		var resp resource.ValidateConfigResponse
+		grantToEveryoneValidator{}.ValidateResource(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() != test.wantError {
+			t.Errorf("users %v with grant_to_everyone %v: expected error %v, got %v", test.users, test.grant, test.wantError, resp.Diagnostics.Errors())
+		}
+	}
+}
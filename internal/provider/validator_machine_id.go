@@ -0,0 +1,41 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/juju/names/v5"
+)
+
+type StringIsMachineIDValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v StringIsMachineIDValidator) Description(context.Context) string {
+	return "string must be the ID of a machine, e.g. 3"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v StringIsMachineIDValidator) MarkdownDescription(context.Context) string {
+	return "string must be the ID of a machine, e.g. `3`"
+}
+
+// Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v StringIsMachineIDValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	id := req.ConfigValue.ValueString()
+	if !names.IsValidMachine(id) || names.IsContainerMachine(id) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Machine ID",
+			fmt.Sprintf("%q is not the ID of a machine, containers cannot be mapped.", id),
+		)
+	}
+}
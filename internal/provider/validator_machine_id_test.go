@@ -0,0 +1,42 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/provider"
+)
+
+func TestMachineIDValidator(t *testing.T) {
+	tests := []struct {
+		id    types.String
+		valid bool
+	}{
+		{id: types.StringValue("0"), valid: true},
+		{id: types.StringValue("12"), valid: true},
+		{id: types.StringNull(), valid: true},
+		{id: types.StringUnknown(), valid: true},
+		{id: types.StringValue("0/lxd/1"), valid: false},
+		{id: types.StringValue("machine-0"), valid: false},
+		{id: types.StringValue(""), valid: false},
+	}
+
+	machineIDValidator := provider.StringIsMachineIDValidator{}
+	for _, test := range tests {
+		req := validator.StringRequest{
+			ConfigValue: test.id,
+		}
+		var resp validator.StringResponse
+		machineIDValidator.ValidateString(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() == test.valid {
+			t.Errorf("machine ID %s: expected valid %v, got errors %v", test.id, test.valid, resp.Diagnostics.Errors())
+		}
+	}
+}
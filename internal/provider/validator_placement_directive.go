@@ -0,0 +1,86 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/juju/names/v5"
+)
+
+var _ validator.Object = placementDirectiveValidator{}
+
+// placementDirectiveValidator checks that the scope, directive and
+// container type of a placement directive block of an application can
+// be used together.
+type placementDirectiveValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v placementDirectiveValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v placementDirectiveValidator) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("%q scope requires the ID of a machine as directive and no container type, "+
+		"%q scope requires a container type and an optional ID of a machine as directive",
+		placementScopeMachine, placementScopeContainer)
+}
+
+// ValidateObject runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v placementDirectiveValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	var placement nestedPlacementDirective
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &placement, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Unknown values will be validated once known.
+	if placement.Scope.IsUnknown() || placement.Directive.IsUnknown() || placement.ContainerType.IsUnknown() {
+		return
+	}
+
+	directive := placement.Directive.ValueString()
+	if !placement.Directive.IsNull() && !names.IsValidMachine(directive) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("directive"),
+			"Invalid Placement Directive",
+			fmt.Sprintf("%q is not the ID of a machine, e.g. 3 or 3/lxd/0.", directive),
+		)
+	}
+
+	switch placement.Scope.ValueString() {
+	case placementScopeMachine:
+		if placement.Directive.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("directive"),
+				"Missing Placement Directive",
+				fmt.Sprintf("Scope %q requires the ID of a machine as directive.", placementScopeMachine),
+			)
+		}
+		if !placement.ContainerType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("container_type"),
+				"Conflicting Container Type",
+				fmt.Sprintf("Scope %q cannot be used with a container type, use scope %q to create a container.",
+					placementScopeMachine, placementScopeContainer),
+			)
+		}
+	case placementScopeContainer:
+		if placement.ContainerType.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("container_type"),
+				"Missing Container Type",
+				fmt.Sprintf("Scope %q requires a container type.", placementScopeContainer),
+			)
+		}
+	}
+}
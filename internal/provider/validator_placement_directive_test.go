@@ -0,0 +1,65 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the Apache License, Version 2.0, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlacementDirectiveValidator(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"scope":          types.StringType,
+		"directive":      types.StringType,
+		"container_type": types.StringType,
+	}
+	value := func(v interface{}) types.String {
+		switch v := v.(type) {
+		case string:
+			return types.StringValue(v)
+		case types.String:
+			return v
+		}
+		return types.StringNull()
+	}
+
+	tests := []struct {
+		scope         string
+		directive     interface{}
+		containerType interface{}
+		wantError     bool
+	}{
+		{scope: "machine", directive: "3"},
+		{scope: "machine", directive: "3/lxd/0"},
+		{scope: "machine", directive: nil, wantError: true},
+		{scope: "machine", directive: "zone=a", wantError: true},
+		{scope: "machine", directive: "3", containerType: "lxd", wantError: true},
+		{scope: "container", containerType: "lxd"},
+		{scope: "container", directive: "3", containerType: "kvm"},
+		{scope: "container", directive: "3", wantError: true},
+		{scope: "container", directive: types.StringUnknown(), containerType: "lxd"},
+	}
+	for _, test := range tests {
+		req := validator.ObjectRequest{
+			Path: path.Root("placement_directive"),
+			ConfigValue: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"scope":          types.StringValue(test.scope),
+				"directive":      value(test.directive),
+				"container_type": value(test.containerType),
+			}),
+		}
+		var resp validator.ObjectResponse
+		placementDirectiveValidator{}.ValidateObject(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() != test.wantError {
+			t.Errorf("scope %q, directive %v, container type %v: expected error %t, got %v",
+				test.scope, test.directive, test.containerType, test.wantError, resp.Diagnostics.Errors())
+		}
+	}
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
 	"regexp"
 	"strconv"
 
@@ -16,7 +17,7 @@ type StringIsResourceKeyValidator struct{}
 
 // Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
 func (v StringIsResourceKeyValidator) Description(context.Context) string {
-	return "string must conform to a charm resource: a resource revision number from CharmHub or a custom OCI image resource"
+	return "string must conform to a charm resource: a resource revision number from CharmHub, a custom OCI image resource or the path of a local file"
 }
 
 // MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
@@ -39,6 +40,10 @@ func (v StringIsResourceKeyValidator) ValidateMap(ctx context.Context, req valid
 	for name, value := range resourceKey {
 		providedRev, err := strconv.Atoi(value)
 		if err != nil {
+			// A local file, uploaded to the controller.
+			if info, err := os.Stat(value); err == nil && !info.IsDir() {
+				continue
+			}
 			imageUrlPattern := `(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]):[\w][\w.-]{0,127}`
 			urlRegex := regexp.MustCompile(imageUrlPattern)
 			if urlRegex.MatchString(value) {
@@ -47,7 +52,7 @@ func (v StringIsResourceKeyValidator) ValidateMap(ctx context.Context, req valid
 			resp.Diagnostics.AddAttributeError(
 				req.Path,
 				"Invalid resource value",
-				fmt.Sprintf("value of %q should be a valid revision number or image URL.", name),
+				fmt.Sprintf("value of %q should be a valid revision number, image URL or file path.", name),
 			)
 			continue
 		}
@@ -55,7 +60,7 @@ func (v StringIsResourceKeyValidator) ValidateMap(ctx context.Context, req valid
 			resp.Diagnostics.AddAttributeError(
 				req.Path,
 				"Invalid resource value",
-				fmt.Sprintf("value of %q should be a valid revision number or image URL.", name),
+				fmt.Sprintf("value of %q should be a valid revision number, image URL or file path.", name),
 			)
 			continue
 		}
@@ -5,6 +5,8 @@ package provider_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -65,3 +67,25 @@ func TestResourceKeyValidatorInvalidRevision(t *testing.T) {
 		t.Errorf("expected error %q, got %q", err, deets)
 	}
 }
+
+func TestResourceKeyValidatorFilePath(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "resource.txt")
+	if err := os.WriteFile(filePath, []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	resourceValidator := provider.StringIsResourceKeyValidator{}
+	resourceValue, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"file": filePath})
+
+	req := validator.MapRequest{
+		ConfigValue: resourceValue,
+	}
+
+	var resp validator.MapResponse
+	resourceValidator.ValidateMap(context.Background(), req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("errors %v", resp.Diagnostics.Errors())
+	}
+}